@@ -4,3 +4,4 @@ CREATE DATABASE catalog;
 CREATE DATABASE activity;
 CREATE DATABASE billing;
 CREATE DATABASE social;
+CREATE DATABASE ingestion;
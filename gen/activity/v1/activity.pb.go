@@ -399,6 +399,104 @@ func (x *GetContinueWatchingResponse) GetNextCursor() string {
 	return ""
 }
 
+// GetEpisodesProgressRequest looks up the user's progress on specific episodes.
+type GetEpisodesProgressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EpisodeIds    []string               `protobuf:"bytes,2,rep,name=episode_ids,json=episodeIds,proto3" json:"episode_ids,omitempty"` // at most 1000
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEpisodesProgressRequest) Reset() {
+	*x = GetEpisodesProgressRequest{}
+	mi := &file_activity_v1_activity_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEpisodesProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpisodesProgressRequest) ProtoMessage() {}
+
+func (x *GetEpisodesProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_v1_activity_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpisodesProgressRequest.ProtoReflect.Descriptor instead.
+func (*GetEpisodesProgressRequest) Descriptor() ([]byte, []int) {
+	return file_activity_v1_activity_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetEpisodesProgressRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetEpisodesProgressRequest) GetEpisodeIds() []string {
+	if x != nil {
+		return x.EpisodeIds
+	}
+	return nil
+}
+
+type GetEpisodesProgressResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only episodes the user has progress on; order is unspecified.
+	Progress      []*EpisodeProgress `protobuf:"bytes,1,rep,name=progress,proto3" json:"progress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEpisodesProgressResponse) Reset() {
+	*x = GetEpisodesProgressResponse{}
+	mi := &file_activity_v1_activity_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEpisodesProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpisodesProgressResponse) ProtoMessage() {}
+
+func (x *GetEpisodesProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_v1_activity_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpisodesProgressResponse.ProtoReflect.Descriptor instead.
+func (*GetEpisodesProgressResponse) Descriptor() ([]byte, []int) {
+	return file_activity_v1_activity_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetEpisodesProgressResponse) GetProgress() []*EpisodeProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
 var File_activity_v1_activity_proto protoreflect.FileDescriptor
 
 const file_activity_v1_activity_proto_rawDesc = "" +
@@ -434,10 +532,17 @@ const file_activity_v1_activity_proto_rawDesc = "" +
 	"\x05items\x18\x01 \x03(\v2\x19.activity.v1.ContinueItemR\x05items\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1f\n" +
 	"\vnext_cursor\x18\x03 \x01(\tR\n" +
-	"nextCursor2\xeb\x01\n" +
+	"nextCursor\"V\n" +
+	"\x1aGetEpisodesProgressRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vepisode_ids\x18\x02 \x03(\tR\n" +
+	"episodeIds\"W\n" +
+	"\x1bGetEpisodesProgressResponse\x128\n" +
+	"\bprogress\x18\x01 \x03(\v2\x1c.activity.v1.EpisodeProgressR\bprogress2\xd5\x02\n" +
 	"\x0fActivityService\x12n\n" +
 	"\x15UpsertEpisodeProgress\x12).activity.v1.UpsertEpisodeProgressRequest\x1a*.activity.v1.UpsertEpisodeProgressResponse\x12h\n" +
-	"\x13GetContinueWatching\x12'.activity.v1.GetContinueWatchingRequest\x1a(.activity.v1.GetContinueWatchingResponseB\xab\x01\n" +
+	"\x13GetContinueWatching\x12'.activity.v1.GetContinueWatchingRequest\x1a(.activity.v1.GetContinueWatchingResponse\x12h\n" +
+	"\x13GetEpisodesProgress\x12'.activity.v1.GetEpisodesProgressRequest\x1a(.activity.v1.GetEpisodesProgressResponseB\xab\x01\n" +
 	"\x0fcom.activity.v1B\rActivityProtoP\x01Z<github.com/example/anime-platform/gen/activity/v1;activityv1\xa2\x02\x03AXX\xaa\x02\vActivity.V1\xca\x02\vActivity\\V1\xe2\x02\x17Activity\\V1\\GPBMetadata\xea\x02\fActivity::V1b\x06proto3"
 
 var (
@@ -452,7 +557,7 @@ func file_activity_v1_activity_proto_rawDescGZIP() []byte {
 	return file_activity_v1_activity_proto_rawDescData
 }
 
-var file_activity_v1_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_activity_v1_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_activity_v1_activity_proto_goTypes = []any{
 	(*UpsertEpisodeProgressRequest)(nil),  // 0: activity.v1.UpsertEpisodeProgressRequest
 	(*EpisodeProgress)(nil),               // 1: activity.v1.EpisodeProgress
@@ -460,20 +565,25 @@ var file_activity_v1_activity_proto_goTypes = []any{
 	(*GetContinueWatchingRequest)(nil),    // 3: activity.v1.GetContinueWatchingRequest
 	(*ContinueItem)(nil),                  // 4: activity.v1.ContinueItem
 	(*GetContinueWatchingResponse)(nil),   // 5: activity.v1.GetContinueWatchingResponse
+	(*GetEpisodesProgressRequest)(nil),    // 6: activity.v1.GetEpisodesProgressRequest
+	(*GetEpisodesProgressResponse)(nil),   // 7: activity.v1.GetEpisodesProgressResponse
 }
 var file_activity_v1_activity_proto_depIdxs = []int32{
 	1, // 0: activity.v1.UpsertEpisodeProgressResponse.progress:type_name -> activity.v1.EpisodeProgress
 	1, // 1: activity.v1.ContinueItem.progress:type_name -> activity.v1.EpisodeProgress
 	4, // 2: activity.v1.GetContinueWatchingResponse.items:type_name -> activity.v1.ContinueItem
-	0, // 3: activity.v1.ActivityService.UpsertEpisodeProgress:input_type -> activity.v1.UpsertEpisodeProgressRequest
-	3, // 4: activity.v1.ActivityService.GetContinueWatching:input_type -> activity.v1.GetContinueWatchingRequest
-	2, // 5: activity.v1.ActivityService.UpsertEpisodeProgress:output_type -> activity.v1.UpsertEpisodeProgressResponse
-	5, // 6: activity.v1.ActivityService.GetContinueWatching:output_type -> activity.v1.GetContinueWatchingResponse
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	1, // 3: activity.v1.GetEpisodesProgressResponse.progress:type_name -> activity.v1.EpisodeProgress
+	0, // 4: activity.v1.ActivityService.UpsertEpisodeProgress:input_type -> activity.v1.UpsertEpisodeProgressRequest
+	3, // 5: activity.v1.ActivityService.GetContinueWatching:input_type -> activity.v1.GetContinueWatchingRequest
+	6, // 6: activity.v1.ActivityService.GetEpisodesProgress:input_type -> activity.v1.GetEpisodesProgressRequest
+	2, // 7: activity.v1.ActivityService.UpsertEpisodeProgress:output_type -> activity.v1.UpsertEpisodeProgressResponse
+	5, // 8: activity.v1.ActivityService.GetContinueWatching:output_type -> activity.v1.GetContinueWatchingResponse
+	7, // 9: activity.v1.ActivityService.GetEpisodesProgress:output_type -> activity.v1.GetEpisodesProgressResponse
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_activity_v1_activity_proto_init() }
@@ -487,7 +597,7 @@ func file_activity_v1_activity_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_activity_v1_activity_proto_rawDesc), len(file_activity_v1_activity_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,6 +22,7 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	ActivityService_UpsertEpisodeProgress_FullMethodName = "/activity.v1.ActivityService/UpsertEpisodeProgress"
 	ActivityService_GetContinueWatching_FullMethodName   = "/activity.v1.ActivityService/GetContinueWatching"
+	ActivityService_GetEpisodesProgress_FullMethodName   = "/activity.v1.ActivityService/GetEpisodesProgress"
 )
 
 // ActivityServiceClient is the client API for ActivityService service.
@@ -30,6 +31,7 @@ const (
 type ActivityServiceClient interface {
 	UpsertEpisodeProgress(ctx context.Context, in *UpsertEpisodeProgressRequest, opts ...grpc.CallOption) (*UpsertEpisodeProgressResponse, error)
 	GetContinueWatching(ctx context.Context, in *GetContinueWatchingRequest, opts ...grpc.CallOption) (*GetContinueWatchingResponse, error)
+	GetEpisodesProgress(ctx context.Context, in *GetEpisodesProgressRequest, opts ...grpc.CallOption) (*GetEpisodesProgressResponse, error)
 }
 
 type activityServiceClient struct {
@@ -60,12 +62,23 @@ func (c *activityServiceClient) GetContinueWatching(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *activityServiceClient) GetEpisodesProgress(ctx context.Context, in *GetEpisodesProgressRequest, opts ...grpc.CallOption) (*GetEpisodesProgressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEpisodesProgressResponse)
+	err := c.cc.Invoke(ctx, ActivityService_GetEpisodesProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ActivityServiceServer is the server API for ActivityService service.
 // All implementations must embed UnimplementedActivityServiceServer
 // for forward compatibility.
 type ActivityServiceServer interface {
 	UpsertEpisodeProgress(context.Context, *UpsertEpisodeProgressRequest) (*UpsertEpisodeProgressResponse, error)
 	GetContinueWatching(context.Context, *GetContinueWatchingRequest) (*GetContinueWatchingResponse, error)
+	GetEpisodesProgress(context.Context, *GetEpisodesProgressRequest) (*GetEpisodesProgressResponse, error)
 	mustEmbedUnimplementedActivityServiceServer()
 }
 
@@ -82,6 +95,9 @@ func (UnimplementedActivityServiceServer) UpsertEpisodeProgress(context.Context,
 func (UnimplementedActivityServiceServer) GetContinueWatching(context.Context, *GetContinueWatchingRequest) (*GetContinueWatchingResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetContinueWatching not implemented")
 }
+func (UnimplementedActivityServiceServer) GetEpisodesProgress(context.Context, *GetEpisodesProgressRequest) (*GetEpisodesProgressResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEpisodesProgress not implemented")
+}
 func (UnimplementedActivityServiceServer) mustEmbedUnimplementedActivityServiceServer() {}
 func (UnimplementedActivityServiceServer) testEmbeddedByValue()                         {}
 
@@ -139,6 +155,24 @@ func _ActivityService_GetContinueWatching_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ActivityService_GetEpisodesProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEpisodesProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).GetEpisodesProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_GetEpisodesProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).GetEpisodesProgress(ctx, req.(*GetEpisodesProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ActivityService_ServiceDesc is the grpc.ServiceDesc for ActivityService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -154,6 +188,10 @@ var ActivityService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetContinueWatching",
 			Handler:    _ActivityService_GetContinueWatching_Handler,
 		},
+		{
+			MethodName: "GetEpisodesProgress",
+			Handler:    _ActivityService_GetEpisodesProgress_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "activity/v1/activity.proto",
@@ -0,0 +1,584 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: catalog/events/v1/events.proto
+
+// Payloads of the catalog.* events written to catalog_outbox, published to NATS
+// and streamed by WatchCatalogChanges. Payloads are protojson with proto field
+// names, so JSON consumers reading e.g. anime_id keep working. The fully
+// qualified message name travels alongside each event (NATS header
+// Catalog-Event-Schema, CatalogChange.schema); incompatible changes go into a
+// new package version.
+
+package catalogeventsv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// catalog.anime.upserted: the anime's fields after the write.
+type AnimeUpserted struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AnimeId          string                 `protobuf:"bytes,1,opt,name=anime_id,json=animeId,proto3" json:"anime_id,omitempty"`
+	Title            string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	TitleEnglish     string                 `protobuf:"bytes,3,opt,name=title_english,json=titleEnglish,proto3" json:"title_english,omitempty"`
+	TitleJapanese    string                 `protobuf:"bytes,4,opt,name=title_japanese,json=titleJapanese,proto3" json:"title_japanese,omitempty"`
+	Image            string                 `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
+	Type             string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	Status           string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	TotalEpisodes    int32                  `protobuf:"varint,8,opt,name=total_episodes,json=totalEpisodes,proto3" json:"total_episodes,omitempty"`
+	Score            float32                `protobuf:"fixed32,9,opt,name=score,proto3" json:"score,omitempty"`
+	Genres           []string               `protobuf:"bytes,10,rep,name=genres,proto3" json:"genres,omitempty"`
+	Visibility       string                 `protobuf:"bytes,11,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	UpdatedAtRfc3339 string                 `protobuf:"bytes,12,opt,name=updated_at_rfc3339,json=updatedAtRfc3339,proto3" json:"updated_at_rfc3339,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AnimeUpserted) Reset() {
+	*x = AnimeUpserted{}
+	mi := &file_catalog_events_v1_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnimeUpserted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnimeUpserted) ProtoMessage() {}
+
+func (x *AnimeUpserted) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_events_v1_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnimeUpserted.ProtoReflect.Descriptor instead.
+func (*AnimeUpserted) Descriptor() ([]byte, []int) {
+	return file_catalog_events_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AnimeUpserted) GetAnimeId() string {
+	if x != nil {
+		return x.AnimeId
+	}
+	return ""
+}
+
+func (x *AnimeUpserted) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AnimeUpserted) GetTitleEnglish() string {
+	if x != nil {
+		return x.TitleEnglish
+	}
+	return ""
+}
+
+func (x *AnimeUpserted) GetTitleJapanese() string {
+	if x != nil {
+		return x.TitleJapanese
+	}
+	return ""
+}
+
+func (x *AnimeUpserted) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *AnimeUpserted) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AnimeUpserted) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AnimeUpserted) GetTotalEpisodes() int32 {
+	if x != nil {
+		return x.TotalEpisodes
+	}
+	return 0
+}
+
+func (x *AnimeUpserted) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *AnimeUpserted) GetGenres() []string {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *AnimeUpserted) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *AnimeUpserted) GetUpdatedAtRfc3339() string {
+	if x != nil {
+		return x.UpdatedAtRfc3339
+	}
+	return ""
+}
+
+// catalog.anime.deleted: the anime row is gone. reason is "merged" when it was
+// folded into merged_into_anime_id.
+type AnimeDeleted struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	AnimeId           string                 `protobuf:"bytes,1,opt,name=anime_id,json=animeId,proto3" json:"anime_id,omitempty"`
+	Reason            string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	MergedIntoAnimeId string                 `protobuf:"bytes,3,opt,name=merged_into_anime_id,json=mergedIntoAnimeId,proto3" json:"merged_into_anime_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AnimeDeleted) Reset() {
+	*x = AnimeDeleted{}
+	mi := &file_catalog_events_v1_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnimeDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnimeDeleted) ProtoMessage() {}
+
+func (x *AnimeDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_events_v1_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnimeDeleted.ProtoReflect.Descriptor instead.
+func (*AnimeDeleted) Descriptor() ([]byte, []int) {
+	return file_catalog_events_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AnimeDeleted) GetAnimeId() string {
+	if x != nil {
+		return x.AnimeId
+	}
+	return ""
+}
+
+func (x *AnimeDeleted) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AnimeDeleted) GetMergedIntoAnimeId() string {
+	if x != nil {
+		return x.MergedIntoAnimeId
+	}
+	return ""
+}
+
+// catalog.anime.merged
+type AnimeMerged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceAnimeId string                 `protobuf:"bytes,1,opt,name=source_anime_id,json=sourceAnimeId,proto3" json:"source_anime_id,omitempty"`
+	TargetAnimeId string                 `protobuf:"bytes,2,opt,name=target_anime_id,json=targetAnimeId,proto3" json:"target_anime_id,omitempty"`
+	// Source episode IDs folded into an existing target episode -> that episode's ID.
+	EpisodeIdMap  map[string]string `protobuf:"bytes,3,rep,name=episode_id_map,json=episodeIdMap,proto3" json:"episode_id_map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnimeMerged) Reset() {
+	*x = AnimeMerged{}
+	mi := &file_catalog_events_v1_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnimeMerged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnimeMerged) ProtoMessage() {}
+
+func (x *AnimeMerged) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_events_v1_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnimeMerged.ProtoReflect.Descriptor instead.
+func (*AnimeMerged) Descriptor() ([]byte, []int) {
+	return file_catalog_events_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AnimeMerged) GetSourceAnimeId() string {
+	if x != nil {
+		return x.SourceAnimeId
+	}
+	return ""
+}
+
+func (x *AnimeMerged) GetTargetAnimeId() string {
+	if x != nil {
+		return x.TargetAnimeId
+	}
+	return ""
+}
+
+func (x *AnimeMerged) GetEpisodeIdMap() map[string]string {
+	if x != nil {
+		return x.EpisodeIdMap
+	}
+	return nil
+}
+
+// catalog.episode.upserted: the episode's fields after the write.
+type EpisodeUpserted struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	EpisodeId        string                 `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	AnimeId          string                 `protobuf:"bytes,2,opt,name=anime_id,json=animeId,proto3" json:"anime_id,omitempty"`
+	Number           int32                  `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
+	Title            string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	AiredAtRfc3339   string                 `protobuf:"bytes,5,opt,name=aired_at_rfc3339,json=airedAtRfc3339,proto3" json:"aired_at_rfc3339,omitempty"`
+	IsFiller         bool                   `protobuf:"varint,6,opt,name=is_filler,json=isFiller,proto3" json:"is_filler,omitempty"`
+	IsRecap          bool                   `protobuf:"varint,7,opt,name=is_recap,json=isRecap,proto3" json:"is_recap,omitempty"`
+	DurationSeconds  int32                  `protobuf:"varint,8,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	Visibility       string                 `protobuf:"bytes,9,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	UpdatedAtRfc3339 string                 `protobuf:"bytes,10,opt,name=updated_at_rfc3339,json=updatedAtRfc3339,proto3" json:"updated_at_rfc3339,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *EpisodeUpserted) Reset() {
+	*x = EpisodeUpserted{}
+	mi := &file_catalog_events_v1_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EpisodeUpserted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpisodeUpserted) ProtoMessage() {}
+
+func (x *EpisodeUpserted) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_events_v1_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpisodeUpserted.ProtoReflect.Descriptor instead.
+func (*EpisodeUpserted) Descriptor() ([]byte, []int) {
+	return file_catalog_events_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EpisodeUpserted) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *EpisodeUpserted) GetAnimeId() string {
+	if x != nil {
+		return x.AnimeId
+	}
+	return ""
+}
+
+func (x *EpisodeUpserted) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *EpisodeUpserted) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *EpisodeUpserted) GetAiredAtRfc3339() string {
+	if x != nil {
+		return x.AiredAtRfc3339
+	}
+	return ""
+}
+
+func (x *EpisodeUpserted) GetIsFiller() bool {
+	if x != nil {
+		return x.IsFiller
+	}
+	return false
+}
+
+func (x *EpisodeUpserted) GetIsRecap() bool {
+	if x != nil {
+		return x.IsRecap
+	}
+	return false
+}
+
+func (x *EpisodeUpserted) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *EpisodeUpserted) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *EpisodeUpserted) GetUpdatedAtRfc3339() string {
+	if x != nil {
+		return x.UpdatedAtRfc3339
+	}
+	return ""
+}
+
+// catalog.external_id.attached: a provider ID was newly mapped (or re-pointed) to
+// an anime, or to an episode when episode_id is set.
+type ExternalIDAttached struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ExternalId    string                 `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	AnimeId       string                 `protobuf:"bytes,3,opt,name=anime_id,json=animeId,proto3" json:"anime_id,omitempty"`
+	EpisodeId     string                 `protobuf:"bytes,4,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExternalIDAttached) Reset() {
+	*x = ExternalIDAttached{}
+	mi := &file_catalog_events_v1_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExternalIDAttached) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalIDAttached) ProtoMessage() {}
+
+func (x *ExternalIDAttached) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_events_v1_events_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalIDAttached.ProtoReflect.Descriptor instead.
+func (*ExternalIDAttached) Descriptor() ([]byte, []int) {
+	return file_catalog_events_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ExternalIDAttached) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ExternalIDAttached) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *ExternalIDAttached) GetAnimeId() string {
+	if x != nil {
+		return x.AnimeId
+	}
+	return ""
+}
+
+func (x *ExternalIDAttached) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+var File_catalog_events_v1_events_proto protoreflect.FileDescriptor
+
+const file_catalog_events_v1_events_proto_rawDesc = "" +
+	"\n" +
+	"\x1ecatalog/events/v1/events.proto\x12\x11catalog.events.v1\"\xf1\x02\n" +
+	"\rAnimeUpserted\x12\x19\n" +
+	"\banime_id\x18\x01 \x01(\tR\aanimeId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12#\n" +
+	"\rtitle_english\x18\x03 \x01(\tR\ftitleEnglish\x12%\n" +
+	"\x0etitle_japanese\x18\x04 \x01(\tR\rtitleJapanese\x12\x14\n" +
+	"\x05image\x18\x05 \x01(\tR\x05image\x12\x12\n" +
+	"\x04type\x18\x06 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12%\n" +
+	"\x0etotal_episodes\x18\b \x01(\x05R\rtotalEpisodes\x12\x14\n" +
+	"\x05score\x18\t \x01(\x02R\x05score\x12\x16\n" +
+	"\x06genres\x18\n" +
+	" \x03(\tR\x06genres\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\v \x01(\tR\n" +
+	"visibility\x12,\n" +
+	"\x12updated_at_rfc3339\x18\f \x01(\tR\x10updatedAtRfc3339\"r\n" +
+	"\fAnimeDeleted\x12\x19\n" +
+	"\banime_id\x18\x01 \x01(\tR\aanimeId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12/\n" +
+	"\x14merged_into_anime_id\x18\x03 \x01(\tR\x11mergedIntoAnimeId\"\xf6\x01\n" +
+	"\vAnimeMerged\x12&\n" +
+	"\x0fsource_anime_id\x18\x01 \x01(\tR\rsourceAnimeId\x12&\n" +
+	"\x0ftarget_anime_id\x18\x02 \x01(\tR\rtargetAnimeId\x12V\n" +
+	"\x0eepisode_id_map\x18\x03 \x03(\v20.catalog.events.v1.AnimeMerged.EpisodeIdMapEntryR\fepisodeIdMap\x1a?\n" +
+	"\x11EpisodeIdMapEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd4\x02\n" +
+	"\x0fEpisodeUpserted\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tR\tepisodeId\x12\x19\n" +
+	"\banime_id\x18\x02 \x01(\tR\aanimeId\x12\x16\n" +
+	"\x06number\x18\x03 \x01(\x05R\x06number\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12(\n" +
+	"\x10aired_at_rfc3339\x18\x05 \x01(\tR\x0eairedAtRfc3339\x12\x1b\n" +
+	"\tis_filler\x18\x06 \x01(\bR\bisFiller\x12\x19\n" +
+	"\bis_recap\x18\a \x01(\bR\aisRecap\x12)\n" +
+	"\x10duration_seconds\x18\b \x01(\x05R\x0fdurationSeconds\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\t \x01(\tR\n" +
+	"visibility\x12,\n" +
+	"\x12updated_at_rfc3339\x18\n" +
+	" \x01(\tR\x10updatedAtRfc3339\"\x8b\x01\n" +
+	"\x12ExternalIDAttached\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x1f\n" +
+	"\vexternal_id\x18\x02 \x01(\tR\n" +
+	"externalId\x12\x19\n" +
+	"\banime_id\x18\x03 \x01(\tR\aanimeId\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x04 \x01(\tR\tepisodeIdB\xd3\x01\n" +
+	"\x15com.catalog.events.v1B\vEventsProtoP\x01ZGgithub.com/example/anime-platform/gen/catalog/events/v1;catalogeventsv1\xa2\x02\x03CEX\xaa\x02\x11Catalog.Events.V1\xca\x02\x11Catalog\\Events\\V1\xe2\x02\x1dCatalog\\Events\\V1\\GPBMetadata\xea\x02\x13Catalog::Events::V1b\x06proto3"
+
+var (
+	file_catalog_events_v1_events_proto_rawDescOnce sync.Once
+	file_catalog_events_v1_events_proto_rawDescData []byte
+)
+
+func file_catalog_events_v1_events_proto_rawDescGZIP() []byte {
+	file_catalog_events_v1_events_proto_rawDescOnce.Do(func() {
+		file_catalog_events_v1_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_catalog_events_v1_events_proto_rawDesc), len(file_catalog_events_v1_events_proto_rawDesc)))
+	})
+	return file_catalog_events_v1_events_proto_rawDescData
+}
+
+var file_catalog_events_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_catalog_events_v1_events_proto_goTypes = []any{
+	(*AnimeUpserted)(nil),      // 0: catalog.events.v1.AnimeUpserted
+	(*AnimeDeleted)(nil),       // 1: catalog.events.v1.AnimeDeleted
+	(*AnimeMerged)(nil),        // 2: catalog.events.v1.AnimeMerged
+	(*EpisodeUpserted)(nil),    // 3: catalog.events.v1.EpisodeUpserted
+	(*ExternalIDAttached)(nil), // 4: catalog.events.v1.ExternalIDAttached
+	nil,                        // 5: catalog.events.v1.AnimeMerged.EpisodeIdMapEntry
+}
+var file_catalog_events_v1_events_proto_depIdxs = []int32{
+	5, // 0: catalog.events.v1.AnimeMerged.episode_id_map:type_name -> catalog.events.v1.AnimeMerged.EpisodeIdMapEntry
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_catalog_events_v1_events_proto_init() }
+func file_catalog_events_v1_events_proto_init() {
+	if File_catalog_events_v1_events_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_catalog_events_v1_events_proto_rawDesc), len(file_catalog_events_v1_events_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_catalog_events_v1_events_proto_goTypes,
+		DependencyIndexes: file_catalog_events_v1_events_proto_depIdxs,
+		MessageInfos:      file_catalog_events_v1_events_proto_msgTypes,
+	}.Build()
+	File_catalog_events_v1_events_proto = out.File
+	file_catalog_events_v1_events_proto_goTypes = nil
+	file_catalog_events_v1_events_proto_depIdxs = nil
+}
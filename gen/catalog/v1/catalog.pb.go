@@ -23,14 +23,19 @@ const (
 )
 
 type Episode struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	AnimeId        string                 `protobuf:"bytes,2,opt,name=anime_id,json=animeId,proto3" json:"anime_id,omitempty"`
-	Number         int32                  `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
-	Title          string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	AiredAtRfc3339 string                 `protobuf:"bytes,5,opt,name=aired_at_rfc3339,json=airedAtRfc3339,proto3" json:"aired_at_rfc3339,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AnimeId         string                 `protobuf:"bytes,2,opt,name=anime_id,json=animeId,proto3" json:"anime_id,omitempty"`
+	Number          int32                  `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
+	Title           string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	AiredAtRfc3339  string                 `protobuf:"bytes,5,opt,name=aired_at_rfc3339,json=airedAtRfc3339,proto3" json:"aired_at_rfc3339,omitempty"`
+	IsFiller        bool                   `protobuf:"varint,6,opt,name=is_filler,json=isFiller,proto3" json:"is_filler,omitempty"`
+	IsRecap         bool                   `protobuf:"varint,7,opt,name=is_recap,json=isRecap,proto3" json:"is_recap,omitempty"`
+	DurationSeconds int32                  `protobuf:"varint,8,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	Synopsis        string                 `protobuf:"bytes,9,opt,name=synopsis,proto3" json:"synopsis,omitempty"`
+	Thumbnail       string                 `protobuf:"bytes,10,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Episode) Reset() {
@@ -98,6 +103,41 @@ func (x *Episode) GetAiredAtRfc3339() string {
 	return ""
 }
 
+func (x *Episode) GetIsFiller() bool {
+	if x != nil {
+		return x.IsFiller
+	}
+	return false
+}
+
+func (x *Episode) GetIsRecap() bool {
+	if x != nil {
+		return x.IsRecap
+	}
+	return false
+}
+
+func (x *Episode) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *Episode) GetSynopsis() string {
+	if x != nil {
+		return x.Synopsis
+	}
+	return ""
+}
+
+func (x *Episode) GetThumbnail() string {
+	if x != nil {
+		return x.Thumbnail
+	}
+	return ""
+}
+
 type Anime struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -111,8 +151,20 @@ type Anime struct {
 	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
 	Type          string                 `protobuf:"bytes,10,opt,name=type,proto3" json:"type,omitempty"`
 	TotalEpisodes int32                  `protobuf:"varint,11,opt,name=total_episodes,json=totalEpisodes,proto3" json:"total_episodes,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Locale        string                 `protobuf:"bytes,12,opt,name=locale,proto3" json:"locale,omitempty"` // locale of title/description after fallback
+	GenreTags     []*Genre               `protobuf:"bytes,13,rep,name=genre_tags,json=genreTags,proto3" json:"genre_tags,omitempty"`
+	Themes        []*Genre               `protobuf:"bytes,14,rep,name=themes,proto3" json:"themes,omitempty"`
+	Demographics  []*Genre               `protobuf:"bytes,15,rep,name=demographics,proto3" json:"demographics,omitempty"`
+	// Mirrored copies of image; empty until mirroring has succeeded.
+	ImageVariants []*ImageVariant `protobuf:"bytes,16,rep,name=image_variants,json=imageVariants,proto3" json:"image_variants,omitempty"`
+	Popularity    *Popularity     `protobuf:"bytes,17,opt,name=popularity,proto3" json:"popularity,omitempty"`
+	// banner_image, provider_scores and tags come from enrichment providers
+	// such as AniList and are empty until one has been synced.
+	BannerImage    string           `protobuf:"bytes,18,opt,name=banner_image,json=bannerImage,proto3" json:"banner_image,omitempty"`
+	ProviderScores []*ProviderScore `protobuf:"bytes,19,rep,name=provider_scores,json=providerScores,proto3" json:"provider_scores,omitempty"`
+	Tags           []*Tag           `protobuf:"bytes,20,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Anime) Reset() {
@@ -222,27 +274,93 @@ func (x *Anime) GetTotalEpisodes() int32 {
 	return 0
 }
 
-type GetAnimeByIDsRequest struct {
+func (x *Anime) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Anime) GetGenreTags() []*Genre {
+	if x != nil {
+		return x.GenreTags
+	}
+	return nil
+}
+
+func (x *Anime) GetThemes() []*Genre {
+	if x != nil {
+		return x.Themes
+	}
+	return nil
+}
+
+func (x *Anime) GetDemographics() []*Genre {
+	if x != nil {
+		return x.Demographics
+	}
+	return nil
+}
+
+func (x *Anime) GetImageVariants() []*ImageVariant {
+	if x != nil {
+		return x.ImageVariants
+	}
+	return nil
+}
+
+func (x *Anime) GetPopularity() *Popularity {
+	if x != nil {
+		return x.Popularity
+	}
+	return nil
+}
+
+func (x *Anime) GetBannerImage() string {
+	if x != nil {
+		return x.BannerImage
+	}
+	return ""
+}
+
+func (x *Anime) GetProviderScores() []*ProviderScore {
+	if x != nil {
+		return x.ProviderScores
+	}
+	return nil
+}
+
+func (x *Anime) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// ProviderScore is an external provider's rating, normalized to 0-10.
+type ProviderScore struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AnimeIds      []string               `protobuf:"bytes,1,rep,name=anime_ids,json=animeIds,proto3" json:"anime_ids,omitempty"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Score         float32                `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
+	ScoreCount    int32                  `protobuf:"varint,3,opt,name=score_count,json=scoreCount,proto3" json:"score_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAnimeByIDsRequest) Reset() {
-	*x = GetAnimeByIDsRequest{}
+func (x *ProviderScore) Reset() {
+	*x = ProviderScore{}
 	mi := &file_catalog_v1_catalog_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAnimeByIDsRequest) String() string {
+func (x *ProviderScore) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAnimeByIDsRequest) ProtoMessage() {}
+func (*ProviderScore) ProtoMessage() {}
 
-func (x *GetAnimeByIDsRequest) ProtoReflect() protoreflect.Message {
+func (x *ProviderScore) ProtoReflect() protoreflect.Message {
 	mi := &file_catalog_v1_catalog_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -254,39 +372,57 @@ func (x *GetAnimeByIDsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAnimeByIDsRequest.ProtoReflect.Descriptor instead.
-func (*GetAnimeByIDsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProviderScore.ProtoReflect.Descriptor instead.
+func (*ProviderScore) Descriptor() ([]byte, []int) {
 	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetAnimeByIDsRequest) GetAnimeIds() []string {
+func (x *ProviderScore) GetProvider() string {
 	if x != nil {
-		return x.AnimeIds
+		return x.Provider
 	}
-	return nil
+	return ""
 }
 
-type GetAnimeByIDsResponse struct {
+func (x *ProviderScore) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ProviderScore) GetScoreCount() int32 {
+	if x != nil {
+		return x.ScoreCount
+	}
+	return 0
+}
+
+// Tag is a descriptive provider tag; rank is the provider's 0-100 relevance.
+type Tag struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Anime         []*Anime               `protobuf:"bytes,1,rep,name=anime,proto3" json:"anime,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Rank          int32                  `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	Spoiler       bool                   `protobuf:"varint,4,opt,name=spoiler,proto3" json:"spoiler,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAnimeByIDsResponse) Reset() {
-	*x = GetAnimeByIDsResponse{}
+func (x *Tag) Reset() {
+	*x = Tag{}
 	mi := &file_catalog_v1_catalog_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAnimeByIDsResponse) String() string {
+func (x *Tag) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAnimeByIDsResponse) ProtoMessage() {}
+func (*Tag) ProtoMessage() {}
 
-func (x *GetAnimeByIDsResponse) ProtoReflect() protoreflect.Message {
+func (x *Tag) ProtoReflect() protoreflect.Message {
 	mi := &file_catalog_v1_catalog_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -298,38 +434,64 @@ func (x *GetAnimeByIDsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAnimeByIDsResponse.ProtoReflect.Descriptor instead.
-func (*GetAnimeByIDsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
 	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetAnimeByIDsResponse) GetAnime() []*Anime {
+func (x *Tag) GetName() string {
 	if x != nil {
-		return x.Anime
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type GetAnimeIDsRequest struct {
+func (x *Tag) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Tag) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *Tag) GetSpoiler() bool {
+	if x != nil {
+		return x.Spoiler
+	}
+	return false
+}
+
+// Popularity is platform activity (watch progress, playback starts, ratings)
+// decayed exponentially with a 24h, 7d or 30d time constant, as of the read.
+type Popularity struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Score_24H     float64                `protobuf:"fixed64,1,opt,name=score_24h,json=score24h,proto3" json:"score_24h,omitempty"`
+	Score_7D      float64                `protobuf:"fixed64,2,opt,name=score_7d,json=score7d,proto3" json:"score_7d,omitempty"`
+	Score_30D     float64                `protobuf:"fixed64,3,opt,name=score_30d,json=score30d,proto3" json:"score_30d,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAnimeIDsRequest) Reset() {
-	*x = GetAnimeIDsRequest{}
+func (x *Popularity) Reset() {
+	*x = Popularity{}
 	mi := &file_catalog_v1_catalog_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAnimeIDsRequest) String() string {
+func (x *Popularity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAnimeIDsRequest) ProtoMessage() {}
+func (*Popularity) ProtoMessage() {}
 
-func (x *GetAnimeIDsRequest) ProtoReflect() protoreflect.Message {
+func (x *Popularity) ProtoReflect() protoreflect.Message {
 	mi := &file_catalog_v1_catalog_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -341,32 +503,59 @@ func (x *GetAnimeIDsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAnimeIDsRequest.ProtoReflect.Descriptor instead.
-func (*GetAnimeIDsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Popularity.ProtoReflect.Descriptor instead.
+func (*Popularity) Descriptor() ([]byte, []int) {
 	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{4}
 }
 
-type GetAnimeIDsResponse struct {
+func (x *Popularity) GetScore_24H() float64 {
+	if x != nil {
+		return x.Score_24H
+	}
+	return 0
+}
+
+func (x *Popularity) GetScore_7D() float64 {
+	if x != nil {
+		return x.Score_7D
+	}
+	return 0
+}
+
+func (x *Popularity) GetScore_30D() float64 {
+	if x != nil {
+		return x.Score_30D
+	}
+	return 0
+}
+
+// ImageVariant is a resized cover stored in blob storage. key is signed by the
+// caller and served from the catalog's /images endpoint.
+type ImageVariant struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AnimeIds      []string               `protobuf:"bytes,1,rep,name=anime_ids,json=animeIds,proto3" json:"anime_ids,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // thumb, medium or large
+	Width         int32                  `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Format        string                 `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"` // jpeg
+	Key           string                 `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAnimeIDsResponse) Reset() {
-	*x = GetAnimeIDsResponse{}
+func (x *ImageVariant) Reset() {
+	*x = ImageVariant{}
 	mi := &file_catalog_v1_catalog_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAnimeIDsResponse) String() string {
+func (x *ImageVariant) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAnimeIDsResponse) ProtoMessage() {}
+func (*ImageVariant) ProtoMessage() {}
 
-func (x *GetAnimeIDsResponse) ProtoReflect() protoreflect.Message {
+func (x *ImageVariant) ProtoReflect() protoreflect.Message {
 	mi := &file_catalog_v1_catalog_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -378,39 +567,69 @@ func (x *GetAnimeIDsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAnimeIDsResponse.ProtoReflect.Descriptor instead.
-func (*GetAnimeIDsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImageVariant.ProtoReflect.Descriptor instead.
+func (*ImageVariant) Descriptor() ([]byte, []int) {
 	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetAnimeIDsResponse) GetAnimeIds() []string {
+func (x *ImageVariant) GetName() string {
 	if x != nil {
-		return x.AnimeIds
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type GetEpisodesByIDsRequest struct {
+func (x *ImageVariant) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ImageVariant) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ImageVariant) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImageVariant) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// Genre is a normalized taxonomy entry (genre, theme or demographic).
+type Genre struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EpisodeIds    []string               `protobuf:"bytes,1,rep,name=episode_ids,json=episodeIds,proto3" json:"episode_ids,omitempty"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEpisodesByIDsRequest) Reset() {
-	*x = GetEpisodesByIDsRequest{}
+func (x *Genre) Reset() {
+	*x = Genre{}
 	mi := &file_catalog_v1_catalog_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEpisodesByIDsRequest) String() string {
+func (x *Genre) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEpisodesByIDsRequest) ProtoMessage() {}
+func (*Genre) ProtoMessage() {}
 
-func (x *GetEpisodesByIDsRequest) ProtoReflect() protoreflect.Message {
+func (x *Genre) ProtoReflect() protoreflect.Message {
 	mi := &file_catalog_v1_catalog_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -422,39 +641,49 @@ func (x *GetEpisodesByIDsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEpisodesByIDsRequest.ProtoReflect.Descriptor instead.
-func (*GetEpisodesByIDsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Genre.ProtoReflect.Descriptor instead.
+func (*Genre) Descriptor() ([]byte, []int) {
 	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetEpisodesByIDsRequest) GetEpisodeIds() []string {
+func (x *Genre) GetSlug() string {
 	if x != nil {
-		return x.EpisodeIds
+		return x.Slug
 	}
-	return nil
+	return ""
 }
 
-type GetEpisodesByIDsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Episodes      []*Episode             `protobuf:"bytes,1,rep,name=episodes,proto3" json:"episodes,omitempty"`
+func (x *Genre) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetAnimeByIDsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	AnimeIds []string               `protobuf:"bytes,1,rep,name=anime_ids,json=animeIds,proto3" json:"anime_ids,omitempty"`
+	// BCP 47 tag such as "pt-BR". Title and description fall back along
+	// pt-BR → pt → en, where "en" is the untranslated base record.
+	Locale        string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEpisodesByIDsResponse) Reset() {
-	*x = GetEpisodesByIDsResponse{}
+func (x *GetAnimeByIDsRequest) Reset() {
+	*x = GetAnimeByIDsRequest{}
 	mi := &file_catalog_v1_catalog_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEpisodesByIDsResponse) String() string {
+func (x *GetAnimeByIDsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEpisodesByIDsResponse) ProtoMessage() {}
+func (*GetAnimeByIDsRequest) ProtoMessage() {}
 
-func (x *GetEpisodesByIDsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetAnimeByIDsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_catalog_v1_catalog_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -466,40 +695,46 @@ func (x *GetEpisodesByIDsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEpisodesByIDsResponse.ProtoReflect.Descriptor instead.
-func (*GetEpisodesByIDsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAnimeByIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetAnimeByIDsRequest) Descriptor() ([]byte, []int) {
 	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetEpisodesByIDsResponse) GetEpisodes() []*Episode {
+func (x *GetAnimeByIDsRequest) GetAnimeIds() []string {
 	if x != nil {
-		return x.Episodes
+		return x.AnimeIds
 	}
 	return nil
 }
 
-type GetProviderEpisodeIDRequest struct {
+func (x *GetAnimeByIDsRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type GetAnimeByIDsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EpisodeId     string                 `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
-	Provider      string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Anime         []*Anime               `protobuf:"bytes,1,rep,name=anime,proto3" json:"anime,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProviderEpisodeIDRequest) Reset() {
-	*x = GetProviderEpisodeIDRequest{}
+func (x *GetAnimeByIDsResponse) Reset() {
+	*x = GetAnimeByIDsResponse{}
 	mi := &file_catalog_v1_catalog_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProviderEpisodeIDRequest) String() string {
+func (x *GetAnimeByIDsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProviderEpisodeIDRequest) ProtoMessage() {}
+func (*GetAnimeByIDsResponse) ProtoMessage() {}
 
-func (x *GetProviderEpisodeIDRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAnimeByIDsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_catalog_v1_catalog_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -511,14 +746,227 @@ func (x *GetProviderEpisodeIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProviderEpisodeIDRequest.ProtoReflect.Descriptor instead.
-func (*GetProviderEpisodeIDRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAnimeByIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetAnimeByIDsResponse) Descriptor() ([]byte, []int) {
 	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetProviderEpisodeIDRequest) GetEpisodeId() string {
+func (x *GetAnimeByIDsResponse) GetAnime() []*Anime {
 	if x != nil {
-		return x.EpisodeId
+		return x.Anime
+	}
+	return nil
+}
+
+type GetAnimeIDsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnimeIDsRequest) Reset() {
+	*x = GetAnimeIDsRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnimeIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnimeIDsRequest) ProtoMessage() {}
+
+func (x *GetAnimeIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnimeIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetAnimeIDsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{9}
+}
+
+type GetAnimeIDsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AnimeIds      []string               `protobuf:"bytes,1,rep,name=anime_ids,json=animeIds,proto3" json:"anime_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnimeIDsResponse) Reset() {
+	*x = GetAnimeIDsResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnimeIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnimeIDsResponse) ProtoMessage() {}
+
+func (x *GetAnimeIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnimeIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetAnimeIDsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetAnimeIDsResponse) GetAnimeIds() []string {
+	if x != nil {
+		return x.AnimeIds
+	}
+	return nil
+}
+
+type GetEpisodesByIDsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EpisodeIds    []string               `protobuf:"bytes,1,rep,name=episode_ids,json=episodeIds,proto3" json:"episode_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEpisodesByIDsRequest) Reset() {
+	*x = GetEpisodesByIDsRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEpisodesByIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpisodesByIDsRequest) ProtoMessage() {}
+
+func (x *GetEpisodesByIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpisodesByIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetEpisodesByIDsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetEpisodesByIDsRequest) GetEpisodeIds() []string {
+	if x != nil {
+		return x.EpisodeIds
+	}
+	return nil
+}
+
+type GetEpisodesByIDsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Episodes      []*Episode             `protobuf:"bytes,1,rep,name=episodes,proto3" json:"episodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEpisodesByIDsResponse) Reset() {
+	*x = GetEpisodesByIDsResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEpisodesByIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpisodesByIDsResponse) ProtoMessage() {}
+
+func (x *GetEpisodesByIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpisodesByIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetEpisodesByIDsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetEpisodesByIDsResponse) GetEpisodes() []*Episode {
+	if x != nil {
+		return x.Episodes
+	}
+	return nil
+}
+
+type GetProviderEpisodeIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EpisodeId     string                 `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	Provider      string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProviderEpisodeIDRequest) Reset() {
+	*x = GetProviderEpisodeIDRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProviderEpisodeIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProviderEpisodeIDRequest) ProtoMessage() {}
+
+func (x *GetProviderEpisodeIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProviderEpisodeIDRequest.ProtoReflect.Descriptor instead.
+func (*GetProviderEpisodeIDRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetProviderEpisodeIDRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
 	}
 	return ""
 }
@@ -539,7 +987,7 @@ type GetProviderEpisodeIDResponse struct {
 
 func (x *GetProviderEpisodeIDResponse) Reset() {
 	*x = GetProviderEpisodeIDResponse{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[9]
+	mi := &file_catalog_v1_catalog_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -551,7 +999,7 @@ func (x *GetProviderEpisodeIDResponse) String() string {
 func (*GetProviderEpisodeIDResponse) ProtoMessage() {}
 
 func (x *GetProviderEpisodeIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[9]
+	mi := &file_catalog_v1_catalog_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -564,7 +1012,7 @@ func (x *GetProviderEpisodeIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProviderEpisodeIDResponse.ProtoReflect.Descriptor instead.
 func (*GetProviderEpisodeIDResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{9}
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetProviderEpisodeIDResponse) GetProviderEpisodeId() string {
@@ -585,7 +1033,7 @@ type AttachExternalAnimeIDRequest struct {
 
 func (x *AttachExternalAnimeIDRequest) Reset() {
 	*x = AttachExternalAnimeIDRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[10]
+	mi := &file_catalog_v1_catalog_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -597,7 +1045,7 @@ func (x *AttachExternalAnimeIDRequest) String() string {
 func (*AttachExternalAnimeIDRequest) ProtoMessage() {}
 
 func (x *AttachExternalAnimeIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[10]
+	mi := &file_catalog_v1_catalog_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -610,7 +1058,7 @@ func (x *AttachExternalAnimeIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttachExternalAnimeIDRequest.ProtoReflect.Descriptor instead.
 func (*AttachExternalAnimeIDRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{10}
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *AttachExternalAnimeIDRequest) GetAnimeId() string {
@@ -642,7 +1090,7 @@ type AttachExternalAnimeIDResponse struct {
 
 func (x *AttachExternalAnimeIDResponse) Reset() {
 	*x = AttachExternalAnimeIDResponse{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[11]
+	mi := &file_catalog_v1_catalog_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -654,7 +1102,7 @@ func (x *AttachExternalAnimeIDResponse) String() string {
 func (*AttachExternalAnimeIDResponse) ProtoMessage() {}
 
 func (x *AttachExternalAnimeIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[11]
+	mi := &file_catalog_v1_catalog_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -667,7 +1115,7 @@ func (x *AttachExternalAnimeIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttachExternalAnimeIDResponse.ProtoReflect.Descriptor instead.
 func (*AttachExternalAnimeIDResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{11}
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{16}
 }
 
 type ResolveAnimeIDByExternalIDRequest struct {
@@ -680,7 +1128,7 @@ type ResolveAnimeIDByExternalIDRequest struct {
 
 func (x *ResolveAnimeIDByExternalIDRequest) Reset() {
 	*x = ResolveAnimeIDByExternalIDRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[12]
+	mi := &file_catalog_v1_catalog_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -692,7 +1140,7 @@ func (x *ResolveAnimeIDByExternalIDRequest) String() string {
 func (*ResolveAnimeIDByExternalIDRequest) ProtoMessage() {}
 
 func (x *ResolveAnimeIDByExternalIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[12]
+	mi := &file_catalog_v1_catalog_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -705,7 +1153,7 @@ func (x *ResolveAnimeIDByExternalIDRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ResolveAnimeIDByExternalIDRequest.ProtoReflect.Descriptor instead.
 func (*ResolveAnimeIDByExternalIDRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{12}
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ResolveAnimeIDByExternalIDRequest) GetProvider() string {
@@ -731,7 +1179,7 @@ type ResolveAnimeIDByExternalIDResponse struct {
 
 func (x *ResolveAnimeIDByExternalIDResponse) Reset() {
 	*x = ResolveAnimeIDByExternalIDResponse{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[13]
+	mi := &file_catalog_v1_catalog_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -743,7 +1191,7 @@ func (x *ResolveAnimeIDByExternalIDResponse) String() string {
 func (*ResolveAnimeIDByExternalIDResponse) ProtoMessage() {}
 
 func (x *ResolveAnimeIDByExternalIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[13]
+	mi := &file_catalog_v1_catalog_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -756,7 +1204,7 @@ func (x *ResolveAnimeIDByExternalIDResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ResolveAnimeIDByExternalIDResponse.ProtoReflect.Descriptor instead.
 func (*ResolveAnimeIDByExternalIDResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{13}
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ResolveAnimeIDByExternalIDResponse) GetAnimeId() string {
@@ -766,19 +1214,149 @@ func (x *ResolveAnimeIDByExternalIDResponse) GetAnimeId() string {
 	return ""
 }
 
-type HiAnimeEpisode struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	ProviderEpisodeId string                 `protobuf:"bytes,1,opt,name=provider_episode_id,json=providerEpisodeId,proto3" json:"provider_episode_id,omitempty"` // episodeId e.g. "steinsgate-3?ep=230"
-	Number            int32                  `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
-	Title             string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	IsFiller          bool                   `protobuf:"varint,4,opt,name=is_filler,json=isFiller,proto3" json:"is_filler,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// UpsertProviderMetadataRequest stores enrichment data for the anime that
+// external_id is attached to under provider (see AttachExternalAnimeID).
+type UpsertProviderMetadataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ExternalId    string                 `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	BannerImage   string                 `protobuf:"bytes,3,opt,name=banner_image,json=bannerImage,proto3" json:"banner_image,omitempty"`
+	Score         float32                `protobuf:"fixed32,4,opt,name=score,proto3" json:"score,omitempty"` // 0-10, 0 when unknown
+	ScoreCount    int32                  `protobuf:"varint,5,opt,name=score_count,json=scoreCount,proto3" json:"score_count,omitempty"`
+	Tags          []*Tag                 `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertProviderMetadataRequest) Reset() {
+	*x = UpsertProviderMetadataRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertProviderMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertProviderMetadataRequest) ProtoMessage() {}
+
+func (x *UpsertProviderMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertProviderMetadataRequest.ProtoReflect.Descriptor instead.
+func (*UpsertProviderMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpsertProviderMetadataRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *UpsertProviderMetadataRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *UpsertProviderMetadataRequest) GetBannerImage() string {
+	if x != nil {
+		return x.BannerImage
+	}
+	return ""
+}
+
+func (x *UpsertProviderMetadataRequest) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *UpsertProviderMetadataRequest) GetScoreCount() int32 {
+	if x != nil {
+		return x.ScoreCount
+	}
+	return 0
+}
+
+func (x *UpsertProviderMetadataRequest) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type UpsertProviderMetadataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AnimeId       string                 `protobuf:"bytes,1,opt,name=anime_id,json=animeId,proto3" json:"anime_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertProviderMetadataResponse) Reset() {
+	*x = UpsertProviderMetadataResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertProviderMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertProviderMetadataResponse) ProtoMessage() {}
+
+func (x *UpsertProviderMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertProviderMetadataResponse.ProtoReflect.Descriptor instead.
+func (*UpsertProviderMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpsertProviderMetadataResponse) GetAnimeId() string {
+	if x != nil {
+		return x.AnimeId
+	}
+	return ""
+}
+
+type HiAnimeEpisode struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProviderEpisodeId string                 `protobuf:"bytes,1,opt,name=provider_episode_id,json=providerEpisodeId,proto3" json:"provider_episode_id,omitempty"` // episodeId e.g. "steinsgate-3?ep=230"
+	Number            int32                  `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	Title             string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	IsFiller          bool                   `protobuf:"varint,4,opt,name=is_filler,json=isFiller,proto3" json:"is_filler,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *HiAnimeEpisode) Reset() {
 	*x = HiAnimeEpisode{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[14]
+	mi := &file_catalog_v1_catalog_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -790,7 +1368,7 @@ func (x *HiAnimeEpisode) String() string {
 func (*HiAnimeEpisode) ProtoMessage() {}
 
 func (x *HiAnimeEpisode) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[14]
+	mi := &file_catalog_v1_catalog_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -803,7 +1381,7 @@ func (x *HiAnimeEpisode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HiAnimeEpisode.ProtoReflect.Descriptor instead.
 func (*HiAnimeEpisode) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{14}
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *HiAnimeEpisode) GetProviderEpisodeId() string {
@@ -820,44 +1398,3942 @@ func (x *HiAnimeEpisode) GetNumber() int32 {
 	return 0
 }
 
-func (x *HiAnimeEpisode) GetTitle() string {
+func (x *HiAnimeEpisode) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *HiAnimeEpisode) GetIsFiller() bool {
+	if x != nil {
+		return x.IsFiller
+	}
+	return false
+}
+
+type UpsertHiAnimeEpisodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AnimeId       string                 `protobuf:"bytes,1,opt,name=anime_id,json=animeId,proto3" json:"anime_id,omitempty"`
+	HianimeSlug   string                 `protobuf:"bytes,2,opt,name=hianime_slug,json=hianimeSlug,proto3" json:"hianime_slug,omitempty"`
+	Episodes      []*HiAnimeEpisode      `protobuf:"bytes,3,rep,name=episodes,proto3" json:"episodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertHiAnimeEpisodesRequest) Reset() {
+	*x = UpsertHiAnimeEpisodesRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertHiAnimeEpisodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertHiAnimeEpisodesRequest) ProtoMessage() {}
+
+func (x *UpsertHiAnimeEpisodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertHiAnimeEpisodesRequest.ProtoReflect.Descriptor instead.
+func (*UpsertHiAnimeEpisodesRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpsertHiAnimeEpisodesRequest) GetAnimeId() string {
+	if x != nil {
+		return x.AnimeId
+	}
+	return ""
+}
+
+func (x *UpsertHiAnimeEpisodesRequest) GetHianimeSlug() string {
+	if x != nil {
+		return x.HianimeSlug
+	}
+	return ""
+}
+
+func (x *UpsertHiAnimeEpisodesRequest) GetEpisodes() []*HiAnimeEpisode {
+	if x != nil {
+		return x.Episodes
+	}
+	return nil
+}
+
+type UpsertHiAnimeEpisodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EpisodeIds    []string               `protobuf:"bytes,1,rep,name=episode_ids,json=episodeIds,proto3" json:"episode_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertHiAnimeEpisodesResponse) Reset() {
+	*x = UpsertHiAnimeEpisodesResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertHiAnimeEpisodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertHiAnimeEpisodesResponse) ProtoMessage() {}
+
+func (x *UpsertHiAnimeEpisodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertHiAnimeEpisodesResponse.ProtoReflect.Descriptor instead.
+func (*UpsertHiAnimeEpisodesResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpsertHiAnimeEpisodesResponse) GetEpisodeIds() []string {
+	if x != nil {
+		return x.EpisodeIds
+	}
+	return nil
+}
+
+// ProviderEpisode is a streaming provider's view of one episode. Episodes are
+// matched to catalog episodes by provider_episode_id first, then by number.
+type ProviderEpisode struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProviderEpisodeId string                 `protobuf:"bytes,1,opt,name=provider_episode_id,json=providerEpisodeId,proto3" json:"provider_episode_id,omitempty"`
+	Number            int32                  `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	Title             string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	IsFiller          bool                   `protobuf:"varint,4,opt,name=is_filler,json=isFiller,proto3" json:"is_filler,omitempty"`
+	HasSub            bool                   `protobuf:"varint,5,opt,name=has_sub,json=hasSub,proto3" json:"has_sub,omitempty"`
+	HasDub            bool                   `protobuf:"varint,6,opt,name=has_dub,json=hasDub,proto3" json:"has_dub,omitempty"`
+	EpisodeId         string                 `protobuf:"bytes,7,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"` // catalog episode; set in responses only
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ProviderEpisode) Reset() {
+	*x = ProviderEpisode{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderEpisode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderEpisode) ProtoMessage() {}
+
+func (x *ProviderEpisode) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderEpisode.ProtoReflect.Descriptor instead.
+func (*ProviderEpisode) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ProviderEpisode) GetProviderEpisodeId() string {
+	if x != nil {
+		return x.ProviderEpisodeId
+	}
+	return ""
+}
+
+func (x *ProviderEpisode) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *ProviderEpisode) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ProviderEpisode) GetIsFiller() bool {
+	if x != nil {
+		return x.IsFiller
+	}
+	return false
+}
+
+func (x *ProviderEpisode) GetHasSub() bool {
+	if x != nil {
+		return x.HasSub
+	}
+	return false
+}
+
+func (x *ProviderEpisode) GetHasDub() bool {
+	if x != nil {
+		return x.HasDub
+	}
+	return false
+}
+
+func (x *ProviderEpisode) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+type UpsertProviderEpisodesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Provider        string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"` // e.g. "hianime"
+	AnimeId         string                 `protobuf:"bytes,2,opt,name=anime_id,json=animeId,proto3" json:"anime_id,omitempty"`
+	ProviderAnimeId string                 `protobuf:"bytes,3,opt,name=provider_anime_id,json=providerAnimeId,proto3" json:"provider_anime_id,omitempty"` // provider's anime identifier (HiAnime slug)
+	Episodes        []*ProviderEpisode     `protobuf:"bytes,4,rep,name=episodes,proto3" json:"episodes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpsertProviderEpisodesRequest) Reset() {
+	*x = UpsertProviderEpisodesRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertProviderEpisodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertProviderEpisodesRequest) ProtoMessage() {}
+
+func (x *UpsertProviderEpisodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertProviderEpisodesRequest.ProtoReflect.Descriptor instead.
+func (*UpsertProviderEpisodesRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpsertProviderEpisodesRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *UpsertProviderEpisodesRequest) GetAnimeId() string {
+	if x != nil {
+		return x.AnimeId
+	}
+	return ""
+}
+
+func (x *UpsertProviderEpisodesRequest) GetProviderAnimeId() string {
+	if x != nil {
+		return x.ProviderAnimeId
+	}
+	return ""
+}
+
+func (x *UpsertProviderEpisodesRequest) GetEpisodes() []*ProviderEpisode {
+	if x != nil {
+		return x.Episodes
+	}
+	return nil
+}
+
+type UpsertProviderEpisodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EpisodeIds    []string               `protobuf:"bytes,1,rep,name=episode_ids,json=episodeIds,proto3" json:"episode_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertProviderEpisodesResponse) Reset() {
+	*x = UpsertProviderEpisodesResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertProviderEpisodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertProviderEpisodesResponse) ProtoMessage() {}
+
+func (x *UpsertProviderEpisodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertProviderEpisodesResponse.ProtoReflect.Descriptor instead.
+func (*UpsertProviderEpisodesResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpsertProviderEpisodesResponse) GetEpisodeIds() []string {
+	if x != nil {
+		return x.EpisodeIds
+	}
+	return nil
+}
+
+// RemoveProviderEpisodesRequest detaches episodes the provider no longer
+// lists. The catalog episodes stay; only the provider's mappings go.
+type RemoveProviderEpisodesRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Provider           string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	AnimeId            string                 `protobuf:"bytes,2,opt,name=anime_id,json=animeId,proto3" json:"anime_id,omitempty"`
+	ProviderEpisodeIds []string               `protobuf:"bytes,3,rep,name=provider_episode_ids,json=providerEpisodeIds,proto3" json:"provider_episode_ids,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RemoveProviderEpisodesRequest) Reset() {
+	*x = RemoveProviderEpisodesRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveProviderEpisodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveProviderEpisodesRequest) ProtoMessage() {}
+
+func (x *RemoveProviderEpisodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveProviderEpisodesRequest.ProtoReflect.Descriptor instead.
+func (*RemoveProviderEpisodesRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RemoveProviderEpisodesRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *RemoveProviderEpisodesRequest) GetAnimeId() string {
+	if x != nil {
+		return x.AnimeId
+	}
+	return ""
+}
+
+func (x *RemoveProviderEpisodesRequest) GetProviderEpisodeIds() []string {
+	if x != nil {
+		return x.ProviderEpisodeIds
+	}
+	return nil
+}
+
+type RemoveProviderEpisodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EpisodeIds    []string               `protobuf:"bytes,1,rep,name=episode_ids,json=episodeIds,proto3" json:"episode_ids,omitempty"` // episodes that lost a mapping
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveProviderEpisodesResponse) Reset() {
+	*x = RemoveProviderEpisodesResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveProviderEpisodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveProviderEpisodesResponse) ProtoMessage() {}
+
+func (x *RemoveProviderEpisodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveProviderEpisodesResponse.ProtoReflect.Descriptor instead.
+func (*RemoveProviderEpisodesResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RemoveProviderEpisodesResponse) GetEpisodeIds() []string {
+	if x != nil {
+		return x.EpisodeIds
+	}
+	return nil
+}
+
+type EpisodeProvider struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Provider          string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ProviderEpisodeId string                 `protobuf:"bytes,2,opt,name=provider_episode_id,json=providerEpisodeId,proto3" json:"provider_episode_id,omitempty"`
+	HasSub            bool                   `protobuf:"varint,3,opt,name=has_sub,json=hasSub,proto3" json:"has_sub,omitempty"`
+	HasDub            bool                   `protobuf:"varint,4,opt,name=has_dub,json=hasDub,proto3" json:"has_dub,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *EpisodeProvider) Reset() {
+	*x = EpisodeProvider{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EpisodeProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpisodeProvider) ProtoMessage() {}
+
+func (x *EpisodeProvider) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpisodeProvider.ProtoReflect.Descriptor instead.
+func (*EpisodeProvider) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *EpisodeProvider) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *EpisodeProvider) GetProviderEpisodeId() string {
+	if x != nil {
+		return x.ProviderEpisodeId
+	}
+	return ""
+}
+
+func (x *EpisodeProvider) GetHasSub() bool {
+	if x != nil {
+		return x.HasSub
+	}
+	return false
+}
+
+func (x *EpisodeProvider) GetHasDub() bool {
+	if x != nil {
+		return x.HasDub
+	}
+	return false
+}
+
+type ListEpisodeProvidersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EpisodeId     string                 `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEpisodeProvidersRequest) Reset() {
+	*x = ListEpisodeProvidersRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEpisodeProvidersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEpisodeProvidersRequest) ProtoMessage() {}
+
+func (x *ListEpisodeProvidersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEpisodeProvidersRequest.ProtoReflect.Descriptor instead.
+func (*ListEpisodeProvidersRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListEpisodeProvidersRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+type ListEpisodeProvidersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Providers     []*EpisodeProvider     `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEpisodeProvidersResponse) Reset() {
+	*x = ListEpisodeProvidersResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEpisodeProvidersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEpisodeProvidersResponse) ProtoMessage() {}
+
+func (x *ListEpisodeProvidersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEpisodeProvidersResponse.ProtoReflect.Descriptor instead.
+func (*ListEpisodeProvidersResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListEpisodeProvidersResponse) GetProviders() []*EpisodeProvider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+// JikanEpisode is per-episode metadata from Jikan /anime/{id}/episodes. Episodes are
+// matched to existing catalog episodes by number.
+type JikanEpisode struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Number          int32                  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	AiredAtRfc3339  string                 `protobuf:"bytes,3,opt,name=aired_at_rfc3339,json=airedAtRfc3339,proto3" json:"aired_at_rfc3339,omitempty"`
+	IsFiller        bool                   `protobuf:"varint,4,opt,name=is_filler,json=isFiller,proto3" json:"is_filler,omitempty"`
+	IsRecap         bool                   `protobuf:"varint,5,opt,name=is_recap,json=isRecap,proto3" json:"is_recap,omitempty"`
+	DurationSeconds int32                  `protobuf:"varint,6,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	Synopsis        string                 `protobuf:"bytes,7,opt,name=synopsis,proto3" json:"synopsis,omitempty"`
+	Thumbnail       string                 `protobuf:"bytes,8,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *JikanEpisode) Reset() {
+	*x = JikanEpisode{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JikanEpisode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JikanEpisode) ProtoMessage() {}
+
+func (x *JikanEpisode) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JikanEpisode.ProtoReflect.Descriptor instead.
+func (*JikanEpisode) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *JikanEpisode) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *JikanEpisode) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *JikanEpisode) GetAiredAtRfc3339() string {
+	if x != nil {
+		return x.AiredAtRfc3339
+	}
+	return ""
+}
+
+func (x *JikanEpisode) GetIsFiller() bool {
+	if x != nil {
+		return x.IsFiller
+	}
+	return false
+}
+
+func (x *JikanEpisode) GetIsRecap() bool {
+	if x != nil {
+		return x.IsRecap
+	}
+	return false
+}
+
+func (x *JikanEpisode) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *JikanEpisode) GetSynopsis() string {
+	if x != nil {
+		return x.Synopsis
+	}
+	return ""
+}
+
+func (x *JikanEpisode) GetThumbnail() string {
+	if x != nil {
+		return x.Thumbnail
+	}
+	return ""
+}
+
+type UpsertJikanEpisodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AnimeId       string                 `protobuf:"bytes,1,opt,name=anime_id,json=animeId,proto3" json:"anime_id,omitempty"`
+	Episodes      []*JikanEpisode        `protobuf:"bytes,2,rep,name=episodes,proto3" json:"episodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertJikanEpisodesRequest) Reset() {
+	*x = UpsertJikanEpisodesRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertJikanEpisodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertJikanEpisodesRequest) ProtoMessage() {}
+
+func (x *UpsertJikanEpisodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertJikanEpisodesRequest.ProtoReflect.Descriptor instead.
+func (*UpsertJikanEpisodesRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpsertJikanEpisodesRequest) GetAnimeId() string {
+	if x != nil {
+		return x.AnimeId
+	}
+	return ""
+}
+
+func (x *UpsertJikanEpisodesRequest) GetEpisodes() []*JikanEpisode {
+	if x != nil {
+		return x.Episodes
+	}
+	return nil
+}
+
+type UpsertJikanEpisodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EpisodeIds    []string               `protobuf:"bytes,1,rep,name=episode_ids,json=episodeIds,proto3" json:"episode_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertJikanEpisodesResponse) Reset() {
+	*x = UpsertJikanEpisodesResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertJikanEpisodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertJikanEpisodesResponse) ProtoMessage() {}
+
+func (x *UpsertJikanEpisodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertJikanEpisodesResponse.ProtoReflect.Descriptor instead.
+func (*UpsertJikanEpisodesResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpsertJikanEpisodesResponse) GetEpisodeIds() []string {
+	if x != nil {
+		return x.EpisodeIds
+	}
+	return nil
+}
+
+// JikanGenre is an entry from one of Jikan's typed genre lists.
+type JikanGenre struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MalId         int32                  `protobuf:"varint,1,opt,name=mal_id,json=malId,proto3" json:"mal_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JikanGenre) Reset() {
+	*x = JikanGenre{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JikanGenre) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JikanGenre) ProtoMessage() {}
+
+func (x *JikanGenre) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JikanGenre.ProtoReflect.Descriptor instead.
+func (*JikanGenre) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *JikanGenre) GetMalId() int32 {
+	if x != nil {
+		return x.MalId
+	}
+	return 0
+}
+
+func (x *JikanGenre) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type JikanAnime struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MalId         int32                  `protobuf:"varint,1,opt,name=mal_id,json=malId,proto3" json:"mal_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	TitleEnglish  string                 `protobuf:"bytes,3,opt,name=title_english,json=titleEnglish,proto3" json:"title_english,omitempty"`
+	TitleJapanese string                 `protobuf:"bytes,4,opt,name=title_japanese,json=titleJapanese,proto3" json:"title_japanese,omitempty"`
+	Synopsis      string                 `protobuf:"bytes,5,opt,name=synopsis,proto3" json:"synopsis,omitempty"`
+	Genres        []string               `protobuf:"bytes,6,rep,name=genres,proto3" json:"genres,omitempty"`
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Type          string                 `protobuf:"bytes,8,opt,name=type,proto3" json:"type,omitempty"`
+	Episodes      int32                  `protobuf:"varint,9,opt,name=episodes,proto3" json:"episodes,omitempty"`
+	Image         string                 `protobuf:"bytes,10,opt,name=image,proto3" json:"image,omitempty"`
+	Score         float32                `protobuf:"fixed32,11,opt,name=score,proto3" json:"score,omitempty"`
+	TypedGenres   []*JikanGenre          `protobuf:"bytes,12,rep,name=typed_genres,json=typedGenres,proto3" json:"typed_genres,omitempty"` // genres and explicit_genres with MAL ids
+	Themes        []*JikanGenre          `protobuf:"bytes,13,rep,name=themes,proto3" json:"themes,omitempty"`
+	Demographics  []*JikanGenre          `protobuf:"bytes,14,rep,name=demographics,proto3" json:"demographics,omitempty"`
+	// Related anime as reported by MAL; empty when the source endpoint omits relations.
+	Relations     []*JikanRelation `protobuf:"bytes,15,rep,name=relations,proto3" json:"relations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JikanAnime) Reset() {
+	*x = JikanAnime{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JikanAnime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JikanAnime) ProtoMessage() {}
+
+func (x *JikanAnime) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JikanAnime.ProtoReflect.Descriptor instead.
+func (*JikanAnime) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *JikanAnime) GetMalId() int32 {
+	if x != nil {
+		return x.MalId
+	}
+	return 0
+}
+
+func (x *JikanAnime) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *JikanAnime) GetTitleEnglish() string {
+	if x != nil {
+		return x.TitleEnglish
+	}
+	return ""
+}
+
+func (x *JikanAnime) GetTitleJapanese() string {
+	if x != nil {
+		return x.TitleJapanese
+	}
+	return ""
+}
+
+func (x *JikanAnime) GetSynopsis() string {
+	if x != nil {
+		return x.Synopsis
+	}
+	return ""
+}
+
+func (x *JikanAnime) GetGenres() []string {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *JikanAnime) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JikanAnime) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *JikanAnime) GetEpisodes() int32 {
+	if x != nil {
+		return x.Episodes
+	}
+	return 0
+}
+
+func (x *JikanAnime) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *JikanAnime) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *JikanAnime) GetTypedGenres() []*JikanGenre {
+	if x != nil {
+		return x.TypedGenres
+	}
+	return nil
+}
+
+func (x *JikanAnime) GetThemes() []*JikanGenre {
+	if x != nil {
+		return x.Themes
+	}
+	return nil
+}
+
+func (x *JikanAnime) GetDemographics() []*JikanGenre {
+	if x != nil {
+		return x.Demographics
+	}
+	return nil
+}
+
+func (x *JikanAnime) GetRelations() []*JikanRelation {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+type JikanRelation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relation      string                 `protobuf:"bytes,1,opt,name=relation,proto3" json:"relation,omitempty"` // MAL wording, e.g. "Sequel", "Side Story"
+	MalId         int32                  `protobuf:"varint,2,opt,name=mal_id,json=malId,proto3" json:"mal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JikanRelation) Reset() {
+	*x = JikanRelation{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JikanRelation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JikanRelation) ProtoMessage() {}
+
+func (x *JikanRelation) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JikanRelation.ProtoReflect.Descriptor instead.
+func (*JikanRelation) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *JikanRelation) GetRelation() string {
+	if x != nil {
+		return x.Relation
+	}
+	return ""
+}
+
+func (x *JikanRelation) GetMalId() int32 {
+	if x != nil {
+		return x.MalId
+	}
+	return 0
+}
+
+type GetEpisodesByAnimeIDRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	AnimeId string                 `protobuf:"bytes,1,opt,name=anime_id,json=animeId,proto3" json:"anime_id,omitempty"`
+	// provider, when set, also returns that provider's stored episode list in
+	// provider_episodes, including episodes that are not currently available.
+	Provider      string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEpisodesByAnimeIDRequest) Reset() {
+	*x = GetEpisodesByAnimeIDRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEpisodesByAnimeIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpisodesByAnimeIDRequest) ProtoMessage() {}
+
+func (x *GetEpisodesByAnimeIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpisodesByAnimeIDRequest.ProtoReflect.Descriptor instead.
+func (*GetEpisodesByAnimeIDRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetEpisodesByAnimeIDRequest) GetAnimeId() string {
+	if x != nil {
+		return x.AnimeId
+	}
+	return ""
+}
+
+func (x *GetEpisodesByAnimeIDRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+type GetEpisodesByAnimeIDResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Episodes         []*Episode             `protobuf:"bytes,1,rep,name=episodes,proto3" json:"episodes,omitempty"`
+	ProviderEpisodes []*ProviderEpisode     `protobuf:"bytes,2,rep,name=provider_episodes,json=providerEpisodes,proto3" json:"provider_episodes,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetEpisodesByAnimeIDResponse) Reset() {
+	*x = GetEpisodesByAnimeIDResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEpisodesByAnimeIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpisodesByAnimeIDResponse) ProtoMessage() {}
+
+func (x *GetEpisodesByAnimeIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpisodesByAnimeIDResponse.ProtoReflect.Descriptor instead.
+func (*GetEpisodesByAnimeIDResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetEpisodesByAnimeIDResponse) GetEpisodes() []*Episode {
+	if x != nil {
+		return x.Episodes
+	}
+	return nil
+}
+
+func (x *GetEpisodesByAnimeIDResponse) GetProviderEpisodes() []*ProviderEpisode {
+	if x != nil {
+		return x.ProviderEpisodes
+	}
+	return nil
+}
+
+// GetEpisodesByAnimeIDsRequest lists the available episodes of several anime
+// at once, e.g. every entry of a franchise.
+type GetEpisodesByAnimeIDsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AnimeIds      []string               `protobuf:"bytes,1,rep,name=anime_ids,json=animeIds,proto3" json:"anime_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEpisodesByAnimeIDsRequest) Reset() {
+	*x = GetEpisodesByAnimeIDsRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEpisodesByAnimeIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpisodesByAnimeIDsRequest) ProtoMessage() {}
+
+func (x *GetEpisodesByAnimeIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpisodesByAnimeIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetEpisodesByAnimeIDsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetEpisodesByAnimeIDsRequest) GetAnimeIds() []string {
+	if x != nil {
+		return x.AnimeIds
+	}
+	return nil
+}
+
+type GetEpisodesByAnimeIDsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Episodes ordered by anime_id and number; anime_id is the anime's current
+	// ID when a requested one has been merged away.
+	Episodes      []*Episode `protobuf:"bytes,1,rep,name=episodes,proto3" json:"episodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEpisodesByAnimeIDsResponse) Reset() {
+	*x = GetEpisodesByAnimeIDsResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEpisodesByAnimeIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpisodesByAnimeIDsResponse) ProtoMessage() {}
+
+func (x *GetEpisodesByAnimeIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpisodesByAnimeIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetEpisodesByAnimeIDsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetEpisodesByAnimeIDsResponse) GetEpisodes() []*Episode {
+	if x != nil {
+		return x.Episodes
+	}
+	return nil
+}
+
+type UpsertJikanAnimeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Anime         *JikanAnime            `protobuf:"bytes,1,opt,name=anime,proto3" json:"anime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertJikanAnimeRequest) Reset() {
+	*x = UpsertJikanAnimeRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertJikanAnimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertJikanAnimeRequest) ProtoMessage() {}
+
+func (x *UpsertJikanAnimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertJikanAnimeRequest.ProtoReflect.Descriptor instead.
+func (*UpsertJikanAnimeRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UpsertJikanAnimeRequest) GetAnime() *JikanAnime {
+	if x != nil {
+		return x.Anime
+	}
+	return nil
+}
+
+type UpsertJikanAnimeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AnimeId       string                 `protobuf:"bytes,1,opt,name=anime_id,json=animeId,proto3" json:"anime_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertJikanAnimeResponse) Reset() {
+	*x = UpsertJikanAnimeResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertJikanAnimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertJikanAnimeResponse) ProtoMessage() {}
+
+func (x *UpsertJikanAnimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertJikanAnimeResponse.ProtoReflect.Descriptor instead.
+func (*UpsertJikanAnimeResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UpsertJikanAnimeResponse) GetAnimeId() string {
+	if x != nil {
+		return x.AnimeId
+	}
+	return ""
+}
+
+// MergeAnimeRequest folds source_anime_id into target_anime_id. The source row
+// is deleted and a redirect is left behind so the old ID keeps resolving.
+type MergeAnimeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceAnimeId string                 `protobuf:"bytes,1,opt,name=source_anime_id,json=sourceAnimeId,proto3" json:"source_anime_id,omitempty"`
+	TargetAnimeId string                 `protobuf:"bytes,2,opt,name=target_anime_id,json=targetAnimeId,proto3" json:"target_anime_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeAnimeRequest) Reset() {
+	*x = MergeAnimeRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeAnimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeAnimeRequest) ProtoMessage() {}
+
+func (x *MergeAnimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeAnimeRequest.ProtoReflect.Descriptor instead.
+func (*MergeAnimeRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *MergeAnimeRequest) GetSourceAnimeId() string {
+	if x != nil {
+		return x.SourceAnimeId
+	}
+	return ""
+}
+
+func (x *MergeAnimeRequest) GetTargetAnimeId() string {
+	if x != nil {
+		return x.TargetAnimeId
+	}
+	return ""
+}
+
+type MergeAnimeResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TargetAnimeId    string                 `protobuf:"bytes,1,opt,name=target_anime_id,json=targetAnimeId,proto3" json:"target_anime_id,omitempty"`
+	MovedEpisodes    int32                  `protobuf:"varint,2,opt,name=moved_episodes,json=movedEpisodes,proto3" json:"moved_episodes,omitempty"`    // source episodes re-parented onto the target
+	MergedEpisodes   int32                  `protobuf:"varint,3,opt,name=merged_episodes,json=mergedEpisodes,proto3" json:"merged_episodes,omitempty"` // source episodes folded into an existing target episode with the same number
+	MovedExternalIds int32                  `protobuf:"varint,4,opt,name=moved_external_ids,json=movedExternalIds,proto3" json:"moved_external_ids,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MergeAnimeResponse) Reset() {
+	*x = MergeAnimeResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeAnimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeAnimeResponse) ProtoMessage() {}
+
+func (x *MergeAnimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeAnimeResponse.ProtoReflect.Descriptor instead.
+func (*MergeAnimeResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *MergeAnimeResponse) GetTargetAnimeId() string {
+	if x != nil {
+		return x.TargetAnimeId
+	}
+	return ""
+}
+
+func (x *MergeAnimeResponse) GetMovedEpisodes() int32 {
+	if x != nil {
+		return x.MovedEpisodes
+	}
+	return 0
+}
+
+func (x *MergeAnimeResponse) GetMergedEpisodes() int32 {
+	if x != nil {
+		return x.MergedEpisodes
+	}
+	return 0
+}
+
+func (x *MergeAnimeResponse) GetMovedExternalIds() int32 {
+	if x != nil {
+		return x.MovedExternalIds
+	}
+	return 0
+}
+
+// Availability controls whether an anime or episode is served. visibility is one
+// of "visible", "hidden" or "takedown". Country lists hold ISO 3166-1 alpha-2
+// codes; an empty allow list means available everywhere not explicitly blocked.
+// Regional rules are checked against the x-client-country request metadata.
+type Availability struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Visibility       string                 `protobuf:"bytes,1,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	Reason           string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	AllowedCountries []string               `protobuf:"bytes,3,rep,name=allowed_countries,json=allowedCountries,proto3" json:"allowed_countries,omitempty"`
+	BlockedCountries []string               `protobuf:"bytes,4,rep,name=blocked_countries,json=blockedCountries,proto3" json:"blocked_countries,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Availability) Reset() {
+	*x = Availability{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Availability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Availability) ProtoMessage() {}
+
+func (x *Availability) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Availability.ProtoReflect.Descriptor instead.
+func (*Availability) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Availability) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *Availability) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Availability) GetAllowedCountries() []string {
+	if x != nil {
+		return x.AllowedCountries
+	}
+	return nil
+}
+
+func (x *Availability) GetBlockedCountries() []string {
+	if x != nil {
+		return x.BlockedCountries
+	}
+	return nil
+}
+
+type SetAnimeAvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AnimeId       string                 `protobuf:"bytes,1,opt,name=anime_id,json=animeId,proto3" json:"anime_id,omitempty"`
+	Availability  *Availability          `protobuf:"bytes,2,opt,name=availability,proto3" json:"availability,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAnimeAvailabilityRequest) Reset() {
+	*x = SetAnimeAvailabilityRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAnimeAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAnimeAvailabilityRequest) ProtoMessage() {}
+
+func (x *SetAnimeAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAnimeAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*SetAnimeAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SetAnimeAvailabilityRequest) GetAnimeId() string {
+	if x != nil {
+		return x.AnimeId
+	}
+	return ""
+}
+
+func (x *SetAnimeAvailabilityRequest) GetAvailability() *Availability {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
+type SetAnimeAvailabilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAnimeAvailabilityResponse) Reset() {
+	*x = SetAnimeAvailabilityResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAnimeAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAnimeAvailabilityResponse) ProtoMessage() {}
+
+func (x *SetAnimeAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAnimeAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*SetAnimeAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{48}
+}
+
+type SetEpisodeAvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EpisodeId     string                 `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	Availability  *Availability          `protobuf:"bytes,2,opt,name=availability,proto3" json:"availability,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEpisodeAvailabilityRequest) Reset() {
+	*x = SetEpisodeAvailabilityRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEpisodeAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEpisodeAvailabilityRequest) ProtoMessage() {}
+
+func (x *SetEpisodeAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEpisodeAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*SetEpisodeAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SetEpisodeAvailabilityRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *SetEpisodeAvailabilityRequest) GetAvailability() *Availability {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
+type SetEpisodeAvailabilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEpisodeAvailabilityResponse) Reset() {
+	*x = SetEpisodeAvailabilityResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEpisodeAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEpisodeAvailabilityResponse) ProtoMessage() {}
+
+func (x *SetEpisodeAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEpisodeAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*SetEpisodeAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{50}
+}
+
+type GetEpisodeAvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EpisodeId     string                 `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEpisodeAvailabilityRequest) Reset() {
+	*x = GetEpisodeAvailabilityRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEpisodeAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpisodeAvailabilityRequest) ProtoMessage() {}
+
+func (x *GetEpisodeAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpisodeAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*GetEpisodeAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetEpisodeAvailabilityRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+type GetEpisodeAvailabilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Available     bool                   `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // "hidden", "takedown" or "region" when not available
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEpisodeAvailabilityResponse) Reset() {
+	*x = GetEpisodeAvailabilityResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEpisodeAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpisodeAvailabilityResponse) ProtoMessage() {}
+
+func (x *GetEpisodeAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpisodeAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*GetEpisodeAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetEpisodeAvailabilityResponse) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *GetEpisodeAvailabilityResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UpsertAnimeTranslationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AnimeId       string                 `protobuf:"bytes,1,opt,name=anime_id,json=animeId,proto3" json:"anime_id,omitempty"`
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Synopsis      string                 `protobuf:"bytes,4,opt,name=synopsis,proto3" json:"synopsis,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertAnimeTranslationRequest) Reset() {
+	*x = UpsertAnimeTranslationRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertAnimeTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertAnimeTranslationRequest) ProtoMessage() {}
+
+func (x *UpsertAnimeTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertAnimeTranslationRequest.ProtoReflect.Descriptor instead.
+func (*UpsertAnimeTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UpsertAnimeTranslationRequest) GetAnimeId() string {
+	if x != nil {
+		return x.AnimeId
+	}
+	return ""
+}
+
+func (x *UpsertAnimeTranslationRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *UpsertAnimeTranslationRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpsertAnimeTranslationRequest) GetSynopsis() string {
+	if x != nil {
+		return x.Synopsis
+	}
+	return ""
+}
+
+type UpsertAnimeTranslationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertAnimeTranslationResponse) Reset() {
+	*x = UpsertAnimeTranslationResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertAnimeTranslationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertAnimeTranslationResponse) ProtoMessage() {}
+
+func (x *UpsertAnimeTranslationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertAnimeTranslationResponse.ProtoReflect.Descriptor instead.
+func (*UpsertAnimeTranslationResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{54}
+}
+
+type ListGenresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // "genre", "theme", "demographic" or empty for all
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGenresRequest) Reset() {
+	*x = ListGenresRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGenresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGenresRequest) ProtoMessage() {}
+
+func (x *ListGenresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGenresRequest.ProtoReflect.Descriptor instead.
+func (*ListGenresRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListGenresRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type GenreCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	AnimeCount    int32                  `protobuf:"varint,4,opt,name=anime_count,json=animeCount,proto3" json:"anime_count,omitempty"` // visible anime only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenreCount) Reset() {
+	*x = GenreCount{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenreCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenreCount) ProtoMessage() {}
+
+func (x *GenreCount) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenreCount.ProtoReflect.Descriptor instead.
+func (*GenreCount) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GenreCount) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *GenreCount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GenreCount) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GenreCount) GetAnimeCount() int32 {
+	if x != nil {
+		return x.AnimeCount
+	}
+	return 0
+}
+
+type ListGenresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Genres        []*GenreCount          `protobuf:"bytes,1,rep,name=genres,proto3" json:"genres,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGenresResponse) Reset() {
+	*x = ListGenresResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGenresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGenresResponse) ProtoMessage() {}
+
+func (x *ListGenresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGenresResponse.ProtoReflect.Descriptor instead.
+func (*ListGenresResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListGenresResponse) GetGenres() []*GenreCount {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+type WatchCatalogChangesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Opaque cursor from a previous CatalogChange; empty replays the whole outbox.
+	SinceCursor string `protobuf:"bytes,1,opt,name=since_cursor,json=sinceCursor,proto3" json:"since_cursor,omitempty"`
+	// When set, since_cursor is ignored: the stream starts with a catalog.anime.upserted
+	// change (empty cursor) for every anime, then catalog.snapshot.completed carrying the
+	// cursor to resume from, then live changes.
+	Snapshot      bool `protobuf:"varint,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchCatalogChangesRequest) Reset() {
+	*x = WatchCatalogChangesRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchCatalogChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchCatalogChangesRequest) ProtoMessage() {}
+
+func (x *WatchCatalogChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchCatalogChangesRequest.ProtoReflect.Descriptor instead.
+func (*WatchCatalogChangesRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *WatchCatalogChangesRequest) GetSinceCursor() string {
+	if x != nil {
+		return x.SinceCursor
+	}
+	return ""
+}
+
+func (x *WatchCatalogChangesRequest) GetSnapshot() bool {
+	if x != nil {
+		return x.Snapshot
+	}
+	return false
+}
+
+// CatalogChange is one catalog_outbox event; payload is the JSON body also published to NATS.
+type CatalogChange struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Cursor           string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	EventType        string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Payload          []byte                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	CreatedAtRfc3339 string                 `protobuf:"bytes,4,opt,name=created_at_rfc3339,json=createdAtRfc3339,proto3" json:"created_at_rfc3339,omitempty"`
+	// Fully qualified payload message, e.g. "catalog.events.v1.AnimeUpserted".
+	Schema        string `protobuf:"bytes,5,opt,name=schema,proto3" json:"schema,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogChange) Reset() {
+	*x = CatalogChange{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogChange) ProtoMessage() {}
+
+func (x *CatalogChange) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogChange.ProtoReflect.Descriptor instead.
+func (*CatalogChange) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CatalogChange) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *CatalogChange) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *CatalogChange) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *CatalogChange) GetCreatedAtRfc3339() string {
+	if x != nil {
+		return x.CreatedAtRfc3339
+	}
+	return ""
+}
+
+func (x *CatalogChange) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+type WatchCatalogChangesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Change        *CatalogChange         `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchCatalogChangesResponse) Reset() {
+	*x = WatchCatalogChangesResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchCatalogChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchCatalogChangesResponse) ProtoMessage() {}
+
+func (x *WatchCatalogChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchCatalogChangesResponse.ProtoReflect.Descriptor instead.
+func (*WatchCatalogChangesResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *WatchCatalogChangesResponse) GetChange() *CatalogChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+type GetTrendingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Window        string                 `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"` // "24h", "7d" or "30d"; defaults to "7d"
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`  // defaults to 25, at most 1000
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrendingRequest) Reset() {
+	*x = GetTrendingRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrendingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendingRequest) ProtoMessage() {}
+
+func (x *GetTrendingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrendingRequest.ProtoReflect.Descriptor instead.
+func (*GetTrendingRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetTrendingRequest) GetWindow() string {
+	if x != nil {
+		return x.Window
+	}
+	return ""
+}
+
+func (x *GetTrendingRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TrendingAnime struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AnimeId       string                 `protobuf:"bytes,1,opt,name=anime_id,json=animeId,proto3" json:"anime_id,omitempty"`
+	Popularity    *Popularity            `protobuf:"bytes,2,opt,name=popularity,proto3" json:"popularity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingAnime) Reset() {
+	*x = TrendingAnime{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingAnime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingAnime) ProtoMessage() {}
+
+func (x *TrendingAnime) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingAnime.ProtoReflect.Descriptor instead.
+func (*TrendingAnime) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *TrendingAnime) GetAnimeId() string {
+	if x != nil {
+		return x.AnimeId
+	}
+	return ""
+}
+
+func (x *TrendingAnime) GetPopularity() *Popularity {
+	if x != nil {
+		return x.Popularity
+	}
+	return nil
+}
+
+type GetTrendingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Window        string                 `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	Anime         []*TrendingAnime       `protobuf:"bytes,2,rep,name=anime,proto3" json:"anime,omitempty"` // highest score for window first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrendingResponse) Reset() {
+	*x = GetTrendingResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrendingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendingResponse) ProtoMessage() {}
+
+func (x *GetTrendingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrendingResponse.ProtoReflect.Descriptor instead.
+func (*GetTrendingResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetTrendingResponse) GetWindow() string {
+	if x != nil {
+		return x.Window
+	}
+	return ""
+}
+
+func (x *GetTrendingResponse) GetAnime() []*TrendingAnime {
+	if x != nil {
+		return x.Anime
+	}
+	return nil
+}
+
+type GetAnimeHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AnimeId       string                 `protobuf:"bytes,1,opt,name=anime_id,json=animeId,proto3" json:"anime_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                                      // versions to return, newest first; defaults to 20, at most 200
+	BeforeVersion int32                  `protobuf:"varint,3,opt,name=before_version,json=beforeVersion,proto3" json:"before_version,omitempty"` // page: only versions older than this
+	// Point-in-time read: only versions recorded at or before this instant, so the
+	// first version returned is the state the anime had then.
+	AsOfRfc3339      string `protobuf:"bytes,4,opt,name=as_of_rfc3339,json=asOfRfc3339,proto3" json:"as_of_rfc3339,omitempty"`
+	IncludeSnapshots bool   `protobuf:"varint,5,opt,name=include_snapshots,json=includeSnapshots,proto3" json:"include_snapshots,omitempty"` // fill AnimeVersion.snapshot_json
+	IncludeEpisodes  bool   `protobuf:"varint,6,opt,name=include_episodes,json=includeEpisodes,proto3" json:"include_episodes,omitempty"`    // fill GetAnimeHistoryResponse.episodes
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetAnimeHistoryRequest) Reset() {
+	*x = GetAnimeHistoryRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnimeHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnimeHistoryRequest) ProtoMessage() {}
+
+func (x *GetAnimeHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnimeHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetAnimeHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetAnimeHistoryRequest) GetAnimeId() string {
+	if x != nil {
+		return x.AnimeId
+	}
+	return ""
+}
+
+func (x *GetAnimeHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetAnimeHistoryRequest) GetBeforeVersion() int32 {
+	if x != nil {
+		return x.BeforeVersion
+	}
+	return 0
+}
+
+func (x *GetAnimeHistoryRequest) GetAsOfRfc3339() string {
+	if x != nil {
+		return x.AsOfRfc3339
+	}
+	return ""
+}
+
+func (x *GetAnimeHistoryRequest) GetIncludeSnapshots() bool {
+	if x != nil {
+		return x.IncludeSnapshots
+	}
+	return false
+}
+
+func (x *GetAnimeHistoryRequest) GetIncludeEpisodes() bool {
+	if x != nil {
+		return x.IncludeEpisodes
+	}
+	return false
+}
+
+// FieldChange is one top-level field that differs from the previous version.
+// old_json is empty for the first version; either side is empty when the field
+// was absent.
+type FieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	OldJson       string                 `protobuf:"bytes,2,opt,name=old_json,json=oldJson,proto3" json:"old_json,omitempty"`
+	NewJson       string                 `protobuf:"bytes,3,opt,name=new_json,json=newJson,proto3" json:"new_json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetOldJson() string {
+	if x != nil {
+		return x.OldJson
+	}
+	return ""
+}
+
+func (x *FieldChange) GetNewJson() string {
+	if x != nil {
+		return x.NewJson
+	}
+	return ""
+}
+
+type AnimeVersion struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Version           int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	RecordedAtRfc3339 string                 `protobuf:"bytes,2,opt,name=recorded_at_rfc3339,json=recordedAtRfc3339,proto3" json:"recorded_at_rfc3339,omitempty"`
+	Reason            string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // e.g. "jikan_sync", "merge", "revert:v3"
+	Changes           []*FieldChange         `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
+	SnapshotJson      string                 `protobuf:"bytes,5,opt,name=snapshot_json,json=snapshotJson,proto3" json:"snapshot_json,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AnimeVersion) Reset() {
+	*x = AnimeVersion{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnimeVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnimeVersion) ProtoMessage() {}
+
+func (x *AnimeVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnimeVersion.ProtoReflect.Descriptor instead.
+func (*AnimeVersion) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *AnimeVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *AnimeVersion) GetRecordedAtRfc3339() string {
+	if x != nil {
+		return x.RecordedAtRfc3339
+	}
+	return ""
+}
+
+func (x *AnimeVersion) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AnimeVersion) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *AnimeVersion) GetSnapshotJson() string {
+	if x != nil {
+		return x.SnapshotJson
+	}
+	return ""
+}
+
+type EpisodeVersion struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	EpisodeId         string                 `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	Version           int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	RecordedAtRfc3339 string                 `protobuf:"bytes,3,opt,name=recorded_at_rfc3339,json=recordedAtRfc3339,proto3" json:"recorded_at_rfc3339,omitempty"`
+	Reason            string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Changes           []*FieldChange         `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes,omitempty"`
+	SnapshotJson      string                 `protobuf:"bytes,6,opt,name=snapshot_json,json=snapshotJson,proto3" json:"snapshot_json,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *EpisodeVersion) Reset() {
+	*x = EpisodeVersion{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EpisodeVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpisodeVersion) ProtoMessage() {}
+
+func (x *EpisodeVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpisodeVersion.ProtoReflect.Descriptor instead.
+func (*EpisodeVersion) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *EpisodeVersion) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *EpisodeVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *EpisodeVersion) GetRecordedAtRfc3339() string {
+	if x != nil {
+		return x.RecordedAtRfc3339
+	}
+	return ""
+}
+
+func (x *EpisodeVersion) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *EpisodeVersion) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *EpisodeVersion) GetSnapshotJson() string {
+	if x != nil {
+		return x.SnapshotJson
+	}
+	return ""
+}
+
+type GetAnimeHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      []*AnimeVersion        `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	Episodes      []*EpisodeVersion      `protobuf:"bytes,2,rep,name=episodes,proto3" json:"episodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnimeHistoryResponse) Reset() {
+	*x = GetAnimeHistoryResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnimeHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnimeHistoryResponse) ProtoMessage() {}
+
+func (x *GetAnimeHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnimeHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetAnimeHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetAnimeHistoryResponse) GetVersions() []*AnimeVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *GetAnimeHistoryResponse) GetEpisodes() []*EpisodeVersion {
+	if x != nil {
+		return x.Episodes
+	}
+	return nil
+}
+
+type RevertAnimeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AnimeId       string                 `protobuf:"bytes,1,opt,name=anime_id,json=animeId,proto3" json:"anime_id,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertAnimeRequest) Reset() {
+	*x = RevertAnimeRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertAnimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertAnimeRequest) ProtoMessage() {}
+
+func (x *RevertAnimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertAnimeRequest.ProtoReflect.Descriptor instead.
+func (*RevertAnimeRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RevertAnimeRequest) GetAnimeId() string {
+	if x != nil {
+		return x.AnimeId
+	}
+	return ""
+}
+
+func (x *RevertAnimeRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type RevertAnimeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Version describing the anime after the revert; equal to the current version
+	// when it already matched.
+	Version       int32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertAnimeResponse) Reset() {
+	*x = RevertAnimeResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertAnimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertAnimeResponse) ProtoMessage() {}
+
+func (x *RevertAnimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertAnimeResponse.ProtoReflect.Descriptor instead.
+func (*RevertAnimeResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *RevertAnimeResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Franchise groups anime entries (e.g. all of Monogatari) in an editorial order.
+type Franchise struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title            string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Image            string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Entries          []*FranchiseEntry      `protobuf:"bytes,5,rep,name=entries,proto3" json:"entries,omitempty"` // ordered by position
+	UpdatedAtRfc3339 string                 `protobuf:"bytes,6,opt,name=updated_at_rfc3339,json=updatedAtRfc3339,proto3" json:"updated_at_rfc3339,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Franchise) Reset() {
+	*x = Franchise{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Franchise) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Franchise) ProtoMessage() {}
+
+func (x *Franchise) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Franchise.ProtoReflect.Descriptor instead.
+func (*Franchise) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *Franchise) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Franchise) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Franchise) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *Franchise) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Franchise) GetEntries() []*FranchiseEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *Franchise) GetUpdatedAtRfc3339() string {
+	if x != nil {
+		return x.UpdatedAtRfc3339
+	}
+	return ""
+}
+
+type FranchiseEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AnimeId       string                 `protobuf:"bytes,1,opt,name=anime_id,json=animeId,proto3" json:"anime_id,omitempty"`
+	Position      int32                  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"` // 1-based
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FranchiseEntry) Reset() {
+	*x = FranchiseEntry{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FranchiseEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FranchiseEntry) ProtoMessage() {}
+
+func (x *FranchiseEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FranchiseEntry.ProtoReflect.Descriptor instead.
+func (*FranchiseEntry) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *FranchiseEntry) GetAnimeId() string {
+	if x != nil {
+		return x.AnimeId
+	}
+	return ""
+}
+
+func (x *FranchiseEntry) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type CreateFranchiseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Image         string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	AnimeIds      []string               `protobuf:"bytes,4,rep,name=anime_ids,json=animeIds,proto3" json:"anime_ids,omitempty"` // in franchise order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFranchiseRequest) Reset() {
+	*x = CreateFranchiseRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFranchiseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFranchiseRequest) ProtoMessage() {}
+
+func (x *CreateFranchiseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFranchiseRequest.ProtoReflect.Descriptor instead.
+func (*CreateFranchiseRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CreateFranchiseRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateFranchiseRequest) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *CreateFranchiseRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateFranchiseRequest) GetAnimeIds() []string {
+	if x != nil {
+		return x.AnimeIds
+	}
+	return nil
+}
+
+type CreateFranchiseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Franchise     *Franchise             `protobuf:"bytes,1,opt,name=franchise,proto3" json:"franchise,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFranchiseResponse) Reset() {
+	*x = CreateFranchiseResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFranchiseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFranchiseResponse) ProtoMessage() {}
+
+func (x *CreateFranchiseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFranchiseResponse.ProtoReflect.Descriptor instead.
+func (*CreateFranchiseResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CreateFranchiseResponse) GetFranchise() *Franchise {
+	if x != nil {
+		return x.Franchise
+	}
+	return nil
+}
+
+type UpdateFranchiseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FranchiseId   string                 `protobuf:"bytes,1,opt,name=franchise_id,json=franchiseId,proto3" json:"franchise_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFranchiseRequest) Reset() {
+	*x = UpdateFranchiseRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFranchiseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFranchiseRequest) ProtoMessage() {}
+
+func (x *UpdateFranchiseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFranchiseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFranchiseRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *UpdateFranchiseRequest) GetFranchiseId() string {
+	if x != nil {
+		return x.FranchiseId
+	}
+	return ""
+}
+
+func (x *UpdateFranchiseRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateFranchiseRequest) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *UpdateFranchiseRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type UpdateFranchiseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Franchise     *Franchise             `protobuf:"bytes,1,opt,name=franchise,proto3" json:"franchise,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFranchiseResponse) Reset() {
+	*x = UpdateFranchiseResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFranchiseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFranchiseResponse) ProtoMessage() {}
+
+func (x *UpdateFranchiseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFranchiseResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFranchiseResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *UpdateFranchiseResponse) GetFranchise() *Franchise {
+	if x != nil {
+		return x.Franchise
+	}
+	return nil
+}
+
+// SetFranchiseEntriesRequest replaces all entries; anime_ids is the new order.
+type SetFranchiseEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FranchiseId   string                 `protobuf:"bytes,1,opt,name=franchise_id,json=franchiseId,proto3" json:"franchise_id,omitempty"`
+	AnimeIds      []string               `protobuf:"bytes,2,rep,name=anime_ids,json=animeIds,proto3" json:"anime_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFranchiseEntriesRequest) Reset() {
+	*x = SetFranchiseEntriesRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFranchiseEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFranchiseEntriesRequest) ProtoMessage() {}
+
+func (x *SetFranchiseEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFranchiseEntriesRequest.ProtoReflect.Descriptor instead.
+func (*SetFranchiseEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SetFranchiseEntriesRequest) GetFranchiseId() string {
+	if x != nil {
+		return x.FranchiseId
+	}
+	return ""
+}
+
+func (x *SetFranchiseEntriesRequest) GetAnimeIds() []string {
+	if x != nil {
+		return x.AnimeIds
+	}
+	return nil
+}
+
+type SetFranchiseEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Franchise     *Franchise             `protobuf:"bytes,1,opt,name=franchise,proto3" json:"franchise,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFranchiseEntriesResponse) Reset() {
+	*x = SetFranchiseEntriesResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFranchiseEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFranchiseEntriesResponse) ProtoMessage() {}
+
+func (x *SetFranchiseEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFranchiseEntriesResponse.ProtoReflect.Descriptor instead.
+func (*SetFranchiseEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SetFranchiseEntriesResponse) GetFranchise() *Franchise {
+	if x != nil {
+		return x.Franchise
+	}
+	return nil
+}
+
+type DeleteFranchiseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FranchiseId   string                 `protobuf:"bytes,1,opt,name=franchise_id,json=franchiseId,proto3" json:"franchise_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFranchiseRequest) Reset() {
+	*x = DeleteFranchiseRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFranchiseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFranchiseRequest) ProtoMessage() {}
+
+func (x *DeleteFranchiseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFranchiseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFranchiseRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DeleteFranchiseRequest) GetFranchiseId() string {
+	if x != nil {
+		return x.FranchiseId
+	}
+	return ""
+}
+
+type DeleteFranchiseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFranchiseResponse) Reset() {
+	*x = DeleteFranchiseResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFranchiseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFranchiseResponse) ProtoMessage() {}
+
+func (x *DeleteFranchiseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFranchiseResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFranchiseResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{80}
+}
+
+type GetFranchiseRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	FranchiseId string                 `protobuf:"bytes,1,opt,name=franchise_id,json=franchiseId,proto3" json:"franchise_id,omitempty"`
+	// Admin reads set this to keep entries that are hidden or blocked in the
+	// caller's region.
+	IncludeUnavailable bool `protobuf:"varint,2,opt,name=include_unavailable,json=includeUnavailable,proto3" json:"include_unavailable,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetFranchiseRequest) Reset() {
+	*x = GetFranchiseRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFranchiseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFranchiseRequest) ProtoMessage() {}
+
+func (x *GetFranchiseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFranchiseRequest.ProtoReflect.Descriptor instead.
+func (*GetFranchiseRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetFranchiseRequest) GetFranchiseId() string {
+	if x != nil {
+		return x.FranchiseId
+	}
+	return ""
+}
+
+func (x *GetFranchiseRequest) GetIncludeUnavailable() bool {
+	if x != nil {
+		return x.IncludeUnavailable
+	}
+	return false
+}
+
+type GetFranchiseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Franchise     *Franchise             `protobuf:"bytes,1,opt,name=franchise,proto3" json:"franchise,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFranchiseResponse) Reset() {
+	*x = GetFranchiseResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFranchiseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFranchiseResponse) ProtoMessage() {}
+
+func (x *GetFranchiseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFranchiseResponse.ProtoReflect.Descriptor instead.
+func (*GetFranchiseResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetFranchiseResponse) GetFranchise() *Franchise {
+	if x != nil {
+		return x.Franchise
+	}
+	return nil
+}
+
+type ListFranchisesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AnimeId       string                 `protobuf:"bytes,1,opt,name=anime_id,json=animeId,proto3" json:"anime_id,omitempty"` // optional: only franchises containing this anime
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                   // defaults to 50, at most 200
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFranchisesRequest) Reset() {
+	*x = ListFranchisesRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFranchisesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFranchisesRequest) ProtoMessage() {}
+
+func (x *ListFranchisesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFranchisesRequest.ProtoReflect.Descriptor instead.
+func (*ListFranchisesRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListFranchisesRequest) GetAnimeId() string {
+	if x != nil {
+		return x.AnimeId
+	}
+	return ""
+}
+
+func (x *ListFranchisesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListFranchisesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListFranchisesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Franchises    []*Franchise           `protobuf:"bytes,1,rep,name=franchises,proto3" json:"franchises,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFranchisesResponse) Reset() {
+	*x = ListFranchisesResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFranchisesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFranchisesResponse) ProtoMessage() {}
+
+func (x *ListFranchisesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFranchisesResponse.ProtoReflect.Descriptor instead.
+func (*ListFranchisesResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListFranchisesResponse) GetFranchises() []*Franchise {
+	if x != nil {
+		return x.Franchises
+	}
+	return nil
+}
+
+type SuggestFranchisesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // defaults to 50, at most 200
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestFranchisesRequest) Reset() {
+	*x = SuggestFranchisesRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestFranchisesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestFranchisesRequest) ProtoMessage() {}
+
+func (x *SuggestFranchisesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestFranchisesRequest.ProtoReflect.Descriptor instead.
+func (*SuggestFranchisesRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SuggestFranchisesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// FranchiseSuggestion is a connected group of related anime in watch order.
+type FranchiseSuggestion struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Title    string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"` // title of the first entry
+	AnimeIds []string               `protobuf:"bytes,2,rep,name=anime_ids,json=animeIds,proto3" json:"anime_ids,omitempty"`
+	// Existing franchises that already hold some of the entries.
+	FranchiseIds  []string `protobuf:"bytes,3,rep,name=franchise_ids,json=franchiseIds,proto3" json:"franchise_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FranchiseSuggestion) Reset() {
+	*x = FranchiseSuggestion{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FranchiseSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FranchiseSuggestion) ProtoMessage() {}
+
+func (x *FranchiseSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FranchiseSuggestion.ProtoReflect.Descriptor instead.
+func (*FranchiseSuggestion) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *FranchiseSuggestion) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *FranchiseSuggestion) GetAnimeIds() []string {
+	if x != nil {
+		return x.AnimeIds
+	}
+	return nil
+}
+
+func (x *FranchiseSuggestion) GetFranchiseIds() []string {
+	if x != nil {
+		return x.FranchiseIds
+	}
+	return nil
+}
+
+type SuggestFranchisesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*FranchiseSuggestion `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"` // largest groups first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestFranchisesResponse) Reset() {
+	*x = SuggestFranchisesResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestFranchisesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestFranchisesResponse) ProtoMessage() {}
+
+func (x *SuggestFranchisesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestFranchisesResponse.ProtoReflect.Descriptor instead.
+func (*SuggestFranchisesResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SuggestFranchisesResponse) GetSuggestions() []*FranchiseSuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+// SkipSegment is a stretch of an episode players can skip, in seconds from
+// the start. Provider segments are recorded from playback data; user segments
+// are community submissions ranked by net votes.
+type SkipSegment struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EpisodeId        string                 `protobuf:"bytes,2,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	Kind             string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"` // intro|outro|recap|preview
+	Start            float32                `protobuf:"fixed32,4,opt,name=start,proto3" json:"start,omitempty"`
+	End              float32                `protobuf:"fixed32,5,opt,name=end,proto3" json:"end,omitempty"`
+	Source           string                 `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`                              // provider name, or "user"
+	SubmittedBy      string                 `protobuf:"bytes,7,opt,name=submitted_by,json=submittedBy,proto3" json:"submitted_by,omitempty"` // user segments only
+	Score            int32                  `protobuf:"varint,8,opt,name=score,proto3" json:"score,omitempty"`                               // net votes; user segments only
+	CreatedAtRfc3339 string                 `protobuf:"bytes,9,opt,name=created_at_rfc3339,json=createdAtRfc3339,proto3" json:"created_at_rfc3339,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SkipSegment) Reset() {
+	*x = SkipSegment{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipSegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipSegment) ProtoMessage() {}
+
+func (x *SkipSegment) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipSegment.ProtoReflect.Descriptor instead.
+func (*SkipSegment) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SkipSegment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SkipSegment) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *SkipSegment) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SkipSegment) GetStart() float32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *SkipSegment) GetEnd() float32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *SkipSegment) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *SkipSegment) GetSubmittedBy() string {
+	if x != nil {
+		return x.SubmittedBy
+	}
+	return ""
+}
+
+func (x *SkipSegment) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SkipSegment) GetCreatedAtRfc3339() string {
+	if x != nil {
+		return x.CreatedAtRfc3339
+	}
+	return ""
+}
+
+type SkipSegmentInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Start         float32                `protobuf:"fixed32,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           float32                `protobuf:"fixed32,3,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkipSegmentInput) Reset() {
+	*x = SkipSegmentInput{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipSegmentInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipSegmentInput) ProtoMessage() {}
+
+func (x *SkipSegmentInput) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipSegmentInput.ProtoReflect.Descriptor instead.
+func (*SkipSegmentInput) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SkipSegmentInput) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SkipSegmentInput) GetStart() float32 {
 	if x != nil {
-		return x.Title
+		return x.Start
 	}
-	return ""
+	return 0
 }
 
-func (x *HiAnimeEpisode) GetIsFiller() bool {
+func (x *SkipSegmentInput) GetEnd() float32 {
 	if x != nil {
-		return x.IsFiller
+		return x.End
 	}
-	return false
+	return 0
 }
 
-type UpsertHiAnimeEpisodesRequest struct {
+type UpsertProviderSkipSegmentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AnimeId       string                 `protobuf:"bytes,1,opt,name=anime_id,json=animeId,proto3" json:"anime_id,omitempty"`
-	HianimeSlug   string                 `protobuf:"bytes,2,opt,name=hianime_slug,json=hianimeSlug,proto3" json:"hianime_slug,omitempty"`
-	Episodes      []*HiAnimeEpisode      `protobuf:"bytes,3,rep,name=episodes,proto3" json:"episodes,omitempty"`
+	EpisodeId     string                 `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	Provider      string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"` // e.g. "hianime"
+	Segments      []*SkipSegmentInput    `protobuf:"bytes,3,rep,name=segments,proto3" json:"segments,omitempty"` // replaces the provider's segment of each kind given
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertHiAnimeEpisodesRequest) Reset() {
-	*x = UpsertHiAnimeEpisodesRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[15]
+func (x *UpsertProviderSkipSegmentsRequest) Reset() {
+	*x = UpsertProviderSkipSegmentsRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertHiAnimeEpisodesRequest) String() string {
+func (x *UpsertProviderSkipSegmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertHiAnimeEpisodesRequest) ProtoMessage() {}
+func (*UpsertProviderSkipSegmentsRequest) ProtoMessage() {}
 
-func (x *UpsertHiAnimeEpisodesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[15]
+func (x *UpsertProviderSkipSegmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -868,54 +5344,53 @@ func (x *UpsertHiAnimeEpisodesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertHiAnimeEpisodesRequest.ProtoReflect.Descriptor instead.
-func (*UpsertHiAnimeEpisodesRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use UpsertProviderSkipSegmentsRequest.ProtoReflect.Descriptor instead.
+func (*UpsertProviderSkipSegmentsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *UpsertHiAnimeEpisodesRequest) GetAnimeId() string {
+func (x *UpsertProviderSkipSegmentsRequest) GetEpisodeId() string {
 	if x != nil {
-		return x.AnimeId
+		return x.EpisodeId
 	}
 	return ""
 }
 
-func (x *UpsertHiAnimeEpisodesRequest) GetHianimeSlug() string {
+func (x *UpsertProviderSkipSegmentsRequest) GetProvider() string {
 	if x != nil {
-		return x.HianimeSlug
+		return x.Provider
 	}
 	return ""
 }
 
-func (x *UpsertHiAnimeEpisodesRequest) GetEpisodes() []*HiAnimeEpisode {
+func (x *UpsertProviderSkipSegmentsRequest) GetSegments() []*SkipSegmentInput {
 	if x != nil {
-		return x.Episodes
+		return x.Segments
 	}
 	return nil
 }
 
-type UpsertHiAnimeEpisodesResponse struct {
+type UpsertProviderSkipSegmentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EpisodeIds    []string               `protobuf:"bytes,1,rep,name=episode_ids,json=episodeIds,proto3" json:"episode_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertHiAnimeEpisodesResponse) Reset() {
-	*x = UpsertHiAnimeEpisodesResponse{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[16]
+func (x *UpsertProviderSkipSegmentsResponse) Reset() {
+	*x = UpsertProviderSkipSegmentsResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertHiAnimeEpisodesResponse) String() string {
+func (x *UpsertProviderSkipSegmentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertHiAnimeEpisodesResponse) ProtoMessage() {}
+func (*UpsertProviderSkipSegmentsResponse) ProtoMessage() {}
 
-func (x *UpsertHiAnimeEpisodesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[16]
+func (x *UpsertProviderSkipSegmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -926,50 +5401,33 @@ func (x *UpsertHiAnimeEpisodesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertHiAnimeEpisodesResponse.ProtoReflect.Descriptor instead.
-func (*UpsertHiAnimeEpisodesResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *UpsertHiAnimeEpisodesResponse) GetEpisodeIds() []string {
-	if x != nil {
-		return x.EpisodeIds
-	}
-	return nil
+// Deprecated: Use UpsertProviderSkipSegmentsResponse.ProtoReflect.Descriptor instead.
+func (*UpsertProviderSkipSegmentsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{91}
 }
 
-type JikanAnime struct {
+type ListSkipSegmentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MalId         int32                  `protobuf:"varint,1,opt,name=mal_id,json=malId,proto3" json:"mal_id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	TitleEnglish  string                 `protobuf:"bytes,3,opt,name=title_english,json=titleEnglish,proto3" json:"title_english,omitempty"`
-	TitleJapanese string                 `protobuf:"bytes,4,opt,name=title_japanese,json=titleJapanese,proto3" json:"title_japanese,omitempty"`
-	Synopsis      string                 `protobuf:"bytes,5,opt,name=synopsis,proto3" json:"synopsis,omitempty"`
-	Genres        []string               `protobuf:"bytes,6,rep,name=genres,proto3" json:"genres,omitempty"`
-	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
-	Type          string                 `protobuf:"bytes,8,opt,name=type,proto3" json:"type,omitempty"`
-	Episodes      int32                  `protobuf:"varint,9,opt,name=episodes,proto3" json:"episodes,omitempty"`
-	Image         string                 `protobuf:"bytes,10,opt,name=image,proto3" json:"image,omitempty"`
-	Score         float32                `protobuf:"fixed32,11,opt,name=score,proto3" json:"score,omitempty"`
+	EpisodeId     string                 `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JikanAnime) Reset() {
-	*x = JikanAnime{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[17]
+func (x *ListSkipSegmentsRequest) Reset() {
+	*x = ListSkipSegmentsRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JikanAnime) String() string {
+func (x *ListSkipSegmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JikanAnime) ProtoMessage() {}
+func (*ListSkipSegmentsRequest) ProtoMessage() {}
 
-func (x *JikanAnime) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[17]
+func (x *ListSkipSegmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -980,110 +5438,87 @@ func (x *JikanAnime) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JikanAnime.ProtoReflect.Descriptor instead.
-func (*JikanAnime) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *JikanAnime) GetMalId() int32 {
-	if x != nil {
-		return x.MalId
-	}
-	return 0
-}
-
-func (x *JikanAnime) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *JikanAnime) GetTitleEnglish() string {
-	if x != nil {
-		return x.TitleEnglish
-	}
-	return ""
+// Deprecated: Use ListSkipSegmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListSkipSegmentsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *JikanAnime) GetTitleJapanese() string {
+func (x *ListSkipSegmentsRequest) GetEpisodeId() string {
 	if x != nil {
-		return x.TitleJapanese
+		return x.EpisodeId
 	}
 	return ""
 }
 
-func (x *JikanAnime) GetSynopsis() string {
-	if x != nil {
-		return x.Synopsis
-	}
-	return ""
+type ListSkipSegmentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Provider segments first, then user segments by score.
+	Segments      []*SkipSegment `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JikanAnime) GetGenres() []string {
-	if x != nil {
-		return x.Genres
-	}
-	return nil
+func (x *ListSkipSegmentsResponse) Reset() {
+	*x = ListSkipSegmentsResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *JikanAnime) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+func (x *ListSkipSegmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *JikanAnime) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
+func (*ListSkipSegmentsResponse) ProtoMessage() {}
 
-func (x *JikanAnime) GetEpisodes() int32 {
+func (x *ListSkipSegmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[93]
 	if x != nil {
-		return x.Episodes
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *JikanAnime) GetImage() string {
-	if x != nil {
-		return x.Image
-	}
-	return ""
+// Deprecated: Use ListSkipSegmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListSkipSegmentsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *JikanAnime) GetScore() float32 {
+func (x *ListSkipSegmentsResponse) GetSegments() []*SkipSegment {
 	if x != nil {
-		return x.Score
+		return x.Segments
 	}
-	return 0
+	return nil
 }
 
-type GetEpisodesByAnimeIDRequest struct {
+type SubmitSkipSegmentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AnimeId       string                 `protobuf:"bytes,1,opt,name=anime_id,json=animeId,proto3" json:"anime_id,omitempty"`
+	EpisodeId     string                 `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Segment       *SkipSegmentInput      `protobuf:"bytes,3,opt,name=segment,proto3" json:"segment,omitempty"` // replaces the user's earlier submission of the same kind
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEpisodesByAnimeIDRequest) Reset() {
-	*x = GetEpisodesByAnimeIDRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[18]
+func (x *SubmitSkipSegmentRequest) Reset() {
+	*x = SubmitSkipSegmentRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEpisodesByAnimeIDRequest) String() string {
+func (x *SubmitSkipSegmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEpisodesByAnimeIDRequest) ProtoMessage() {}
+func (*SubmitSkipSegmentRequest) ProtoMessage() {}
 
-func (x *GetEpisodesByAnimeIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[18]
+func (x *SubmitSkipSegmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1094,40 +5529,54 @@ func (x *GetEpisodesByAnimeIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEpisodesByAnimeIDRequest.ProtoReflect.Descriptor instead.
-func (*GetEpisodesByAnimeIDRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use SubmitSkipSegmentRequest.ProtoReflect.Descriptor instead.
+func (*SubmitSkipSegmentRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *GetEpisodesByAnimeIDRequest) GetAnimeId() string {
+func (x *SubmitSkipSegmentRequest) GetEpisodeId() string {
 	if x != nil {
-		return x.AnimeId
+		return x.EpisodeId
 	}
 	return ""
 }
 
-type GetEpisodesByAnimeIDResponse struct {
+func (x *SubmitSkipSegmentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SubmitSkipSegmentRequest) GetSegment() *SkipSegmentInput {
+	if x != nil {
+		return x.Segment
+	}
+	return nil
+}
+
+type SubmitSkipSegmentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Episodes      []*Episode             `protobuf:"bytes,1,rep,name=episodes,proto3" json:"episodes,omitempty"`
+	Segment       *SkipSegment           `protobuf:"bytes,1,opt,name=segment,proto3" json:"segment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEpisodesByAnimeIDResponse) Reset() {
-	*x = GetEpisodesByAnimeIDResponse{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[19]
+func (x *SubmitSkipSegmentResponse) Reset() {
+	*x = SubmitSkipSegmentResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEpisodesByAnimeIDResponse) String() string {
+func (x *SubmitSkipSegmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEpisodesByAnimeIDResponse) ProtoMessage() {}
+func (*SubmitSkipSegmentResponse) ProtoMessage() {}
 
-func (x *GetEpisodesByAnimeIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[19]
+func (x *SubmitSkipSegmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1138,40 +5587,42 @@ func (x *GetEpisodesByAnimeIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEpisodesByAnimeIDResponse.ProtoReflect.Descriptor instead.
-func (*GetEpisodesByAnimeIDResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use SubmitSkipSegmentResponse.ProtoReflect.Descriptor instead.
+func (*SubmitSkipSegmentResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *GetEpisodesByAnimeIDResponse) GetEpisodes() []*Episode {
+func (x *SubmitSkipSegmentResponse) GetSegment() *SkipSegment {
 	if x != nil {
-		return x.Episodes
+		return x.Segment
 	}
 	return nil
 }
 
-type UpsertJikanAnimeRequest struct {
+type VoteSkipSegmentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Anime         *JikanAnime            `protobuf:"bytes,1,opt,name=anime,proto3" json:"anime,omitempty"`
+	SegmentId     string                 `protobuf:"bytes,1,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Vote          int32                  `protobuf:"varint,3,opt,name=vote,proto3" json:"vote,omitempty"` // 1 or -1
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertJikanAnimeRequest) Reset() {
-	*x = UpsertJikanAnimeRequest{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[20]
+func (x *VoteSkipSegmentRequest) Reset() {
+	*x = VoteSkipSegmentRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertJikanAnimeRequest) String() string {
+func (x *VoteSkipSegmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertJikanAnimeRequest) ProtoMessage() {}
+func (*VoteSkipSegmentRequest) ProtoMessage() {}
 
-func (x *UpsertJikanAnimeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[20]
+func (x *VoteSkipSegmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1182,40 +5633,54 @@ func (x *UpsertJikanAnimeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertJikanAnimeRequest.ProtoReflect.Descriptor instead.
-func (*UpsertJikanAnimeRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use VoteSkipSegmentRequest.ProtoReflect.Descriptor instead.
+func (*VoteSkipSegmentRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *UpsertJikanAnimeRequest) GetAnime() *JikanAnime {
+func (x *VoteSkipSegmentRequest) GetSegmentId() string {
 	if x != nil {
-		return x.Anime
+		return x.SegmentId
 	}
-	return nil
+	return ""
 }
 
-type UpsertJikanAnimeResponse struct {
+func (x *VoteSkipSegmentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VoteSkipSegmentRequest) GetVote() int32 {
+	if x != nil {
+		return x.Vote
+	}
+	return 0
+}
+
+type VoteSkipSegmentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AnimeId       string                 `protobuf:"bytes,1,opt,name=anime_id,json=animeId,proto3" json:"anime_id,omitempty"`
+	Segment       *SkipSegment           `protobuf:"bytes,1,opt,name=segment,proto3" json:"segment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertJikanAnimeResponse) Reset() {
-	*x = UpsertJikanAnimeResponse{}
-	mi := &file_catalog_v1_catalog_proto_msgTypes[21]
+func (x *VoteSkipSegmentResponse) Reset() {
+	*x = VoteSkipSegmentResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertJikanAnimeResponse) String() string {
+func (x *VoteSkipSegmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertJikanAnimeResponse) ProtoMessage() {}
+func (*VoteSkipSegmentResponse) ProtoMessage() {}
 
-func (x *UpsertJikanAnimeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_proto_msgTypes[21]
+func (x *VoteSkipSegmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1226,16 +5691,16 @@ func (x *UpsertJikanAnimeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertJikanAnimeResponse.ProtoReflect.Descriptor instead.
-func (*UpsertJikanAnimeResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use VoteSkipSegmentResponse.ProtoReflect.Descriptor instead.
+func (*VoteSkipSegmentResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *UpsertJikanAnimeResponse) GetAnimeId() string {
+func (x *VoteSkipSegmentResponse) GetSegment() *SkipSegment {
 	if x != nil {
-		return x.AnimeId
+		return x.Segment
 	}
-	return ""
+	return nil
 }
 
 var File_catalog_v1_catalog_proto protoreflect.FileDescriptor
@@ -1243,13 +5708,19 @@ var File_catalog_v1_catalog_proto protoreflect.FileDescriptor
 const file_catalog_v1_catalog_proto_rawDesc = "" +
 	"\n" +
 	"\x18catalog/v1/catalog.proto\x12\n" +
-	"catalog.v1\"\x8c\x01\n" +
+	"catalog.v1\"\xa9\x02\n" +
 	"\aEpisode\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\banime_id\x18\x02 \x01(\tR\aanimeId\x12\x16\n" +
 	"\x06number\x18\x03 \x01(\x05R\x06number\x12\x14\n" +
 	"\x05title\x18\x04 \x01(\tR\x05title\x12(\n" +
-	"\x10aired_at_rfc3339\x18\x05 \x01(\tR\x0eairedAtRfc3339\"\xb2\x02\n" +
+	"\x10aired_at_rfc3339\x18\x05 \x01(\tR\x0eairedAtRfc3339\x12\x1b\n" +
+	"\tis_filler\x18\x06 \x01(\bR\bisFiller\x12\x19\n" +
+	"\bis_recap\x18\a \x01(\bR\aisRecap\x12)\n" +
+	"\x10duration_seconds\x18\b \x01(\x05R\x0fdurationSeconds\x12\x1a\n" +
+	"\bsynopsis\x18\t \x01(\tR\bsynopsis\x12\x1c\n" +
+	"\tthumbnail\x18\n" +
+	" \x01(\tR\tthumbnail\"\xe3\x05\n" +
 	"\x05Anime\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12#\n" +
@@ -1262,9 +5733,46 @@ const file_catalog_v1_catalog_proto_rawDesc = "" +
 	"\x06status\x18\t \x01(\tR\x06status\x12\x12\n" +
 	"\x04type\x18\n" +
 	" \x01(\tR\x04type\x12%\n" +
-	"\x0etotal_episodes\x18\v \x01(\x05R\rtotalEpisodes\"3\n" +
+	"\x0etotal_episodes\x18\v \x01(\x05R\rtotalEpisodes\x12\x16\n" +
+	"\x06locale\x18\f \x01(\tR\x06locale\x120\n" +
+	"\n" +
+	"genre_tags\x18\r \x03(\v2\x11.catalog.v1.GenreR\tgenreTags\x12)\n" +
+	"\x06themes\x18\x0e \x03(\v2\x11.catalog.v1.GenreR\x06themes\x125\n" +
+	"\fdemographics\x18\x0f \x03(\v2\x11.catalog.v1.GenreR\fdemographics\x12?\n" +
+	"\x0eimage_variants\x18\x10 \x03(\v2\x18.catalog.v1.ImageVariantR\rimageVariants\x126\n" +
+	"\n" +
+	"popularity\x18\x11 \x01(\v2\x16.catalog.v1.PopularityR\n" +
+	"popularity\x12!\n" +
+	"\fbanner_image\x18\x12 \x01(\tR\vbannerImage\x12B\n" +
+	"\x0fprovider_scores\x18\x13 \x03(\v2\x19.catalog.v1.ProviderScoreR\x0eproviderScores\x12#\n" +
+	"\x04tags\x18\x14 \x03(\v2\x0f.catalog.v1.TagR\x04tags\"b\n" +
+	"\rProviderScore\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x02R\x05score\x12\x1f\n" +
+	"\vscore_count\x18\x03 \x01(\x05R\n" +
+	"scoreCount\"c\n" +
+	"\x03Tag\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04rank\x18\x03 \x01(\x05R\x04rank\x12\x18\n" +
+	"\aspoiler\x18\x04 \x01(\bR\aspoiler\"a\n" +
+	"\n" +
+	"Popularity\x12\x1b\n" +
+	"\tscore_24h\x18\x01 \x01(\x01R\bscore24h\x12\x19\n" +
+	"\bscore_7d\x18\x02 \x01(\x01R\ascore7d\x12\x1b\n" +
+	"\tscore_30d\x18\x03 \x01(\x01R\bscore30d\"z\n" +
+	"\fImageVariant\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05width\x18\x02 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x05R\x06height\x12\x16\n" +
+	"\x06format\x18\x04 \x01(\tR\x06format\x12\x10\n" +
+	"\x03key\x18\x05 \x01(\tR\x03key\"/\n" +
+	"\x05Genre\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"K\n" +
 	"\x14GetAnimeByIDsRequest\x12\x1b\n" +
-	"\tanime_ids\x18\x01 \x03(\tR\banimeIds\"@\n" +
+	"\tanime_ids\x18\x01 \x03(\tR\banimeIds\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\"@\n" +
 	"\x15GetAnimeByIDsResponse\x12'\n" +
 	"\x05anime\x18\x01 \x03(\v2\x11.catalog.v1.AnimeR\x05anime\"\x14\n" +
 	"\x12GetAnimeIDsRequest\"2\n" +
@@ -1292,6 +5800,17 @@ const file_catalog_v1_catalog_proto_rawDesc = "" +
 	"\vexternal_id\x18\x02 \x01(\tR\n" +
 	"externalId\"?\n" +
 	"\"ResolveAnimeIDByExternalIDResponse\x12\x19\n" +
+	"\banime_id\x18\x01 \x01(\tR\aanimeId\"\xdb\x01\n" +
+	"\x1dUpsertProviderMetadataRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x1f\n" +
+	"\vexternal_id\x18\x02 \x01(\tR\n" +
+	"externalId\x12!\n" +
+	"\fbanner_image\x18\x03 \x01(\tR\vbannerImage\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x02R\x05score\x12\x1f\n" +
+	"\vscore_count\x18\x05 \x01(\x05R\n" +
+	"scoreCount\x12#\n" +
+	"\x04tags\x18\x06 \x03(\v2\x0f.catalog.v1.TagR\x04tags\";\n" +
+	"\x1eUpsertProviderMetadataResponse\x12\x19\n" +
 	"\banime_id\x18\x01 \x01(\tR\aanimeId\"\x8b\x01\n" +
 	"\x0eHiAnimeEpisode\x12.\n" +
 	"\x13provider_episode_id\x18\x01 \x01(\tR\x11providerEpisodeId\x12\x16\n" +
@@ -1304,7 +5823,60 @@ const file_catalog_v1_catalog_proto_rawDesc = "" +
 	"\bepisodes\x18\x03 \x03(\v2\x1a.catalog.v1.HiAnimeEpisodeR\bepisodes\"@\n" +
 	"\x1dUpsertHiAnimeEpisodesResponse\x12\x1f\n" +
 	"\vepisode_ids\x18\x01 \x03(\tR\n" +
-	"episodeIds\"\xad\x02\n" +
+	"episodeIds\"\xdd\x01\n" +
+	"\x0fProviderEpisode\x12.\n" +
+	"\x13provider_episode_id\x18\x01 \x01(\tR\x11providerEpisodeId\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\x05R\x06number\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1b\n" +
+	"\tis_filler\x18\x04 \x01(\bR\bisFiller\x12\x17\n" +
+	"\ahas_sub\x18\x05 \x01(\bR\x06hasSub\x12\x17\n" +
+	"\ahas_dub\x18\x06 \x01(\bR\x06hasDub\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\a \x01(\tR\tepisodeId\"\xbb\x01\n" +
+	"\x1dUpsertProviderEpisodesRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x19\n" +
+	"\banime_id\x18\x02 \x01(\tR\aanimeId\x12*\n" +
+	"\x11provider_anime_id\x18\x03 \x01(\tR\x0fproviderAnimeId\x127\n" +
+	"\bepisodes\x18\x04 \x03(\v2\x1b.catalog.v1.ProviderEpisodeR\bepisodes\"A\n" +
+	"\x1eUpsertProviderEpisodesResponse\x12\x1f\n" +
+	"\vepisode_ids\x18\x01 \x03(\tR\n" +
+	"episodeIds\"\x88\x01\n" +
+	"\x1dRemoveProviderEpisodesRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x19\n" +
+	"\banime_id\x18\x02 \x01(\tR\aanimeId\x120\n" +
+	"\x14provider_episode_ids\x18\x03 \x03(\tR\x12providerEpisodeIds\"A\n" +
+	"\x1eRemoveProviderEpisodesResponse\x12\x1f\n" +
+	"\vepisode_ids\x18\x01 \x03(\tR\n" +
+	"episodeIds\"\x8f\x01\n" +
+	"\x0fEpisodeProvider\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12.\n" +
+	"\x13provider_episode_id\x18\x02 \x01(\tR\x11providerEpisodeId\x12\x17\n" +
+	"\ahas_sub\x18\x03 \x01(\bR\x06hasSub\x12\x17\n" +
+	"\ahas_dub\x18\x04 \x01(\bR\x06hasDub\"<\n" +
+	"\x1bListEpisodeProvidersRequest\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tR\tepisodeId\"Y\n" +
+	"\x1cListEpisodeProvidersResponse\x129\n" +
+	"\tproviders\x18\x01 \x03(\v2\x1b.catalog.v1.EpisodeProviderR\tproviders\"\x83\x02\n" +
+	"\fJikanEpisode\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\x05R\x06number\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12(\n" +
+	"\x10aired_at_rfc3339\x18\x03 \x01(\tR\x0eairedAtRfc3339\x12\x1b\n" +
+	"\tis_filler\x18\x04 \x01(\bR\bisFiller\x12\x19\n" +
+	"\bis_recap\x18\x05 \x01(\bR\aisRecap\x12)\n" +
+	"\x10duration_seconds\x18\x06 \x01(\x05R\x0fdurationSeconds\x12\x1a\n" +
+	"\bsynopsis\x18\a \x01(\tR\bsynopsis\x12\x1c\n" +
+	"\tthumbnail\x18\b \x01(\tR\tthumbnail\"m\n" +
+	"\x1aUpsertJikanEpisodesRequest\x12\x19\n" +
+	"\banime_id\x18\x01 \x01(\tR\aanimeId\x124\n" +
+	"\bepisodes\x18\x02 \x03(\v2\x18.catalog.v1.JikanEpisodeR\bepisodes\">\n" +
+	"\x1bUpsertJikanEpisodesResponse\x12\x1f\n" +
+	"\vepisode_ids\x18\x01 \x03(\tR\n" +
+	"episodeIds\"7\n" +
+	"\n" +
+	"JikanGenre\x12\x15\n" +
+	"\x06mal_id\x18\x01 \x01(\x05R\x05malId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x8d\x04\n" +
 	"\n" +
 	"JikanAnime\x12\x15\n" +
 	"\x06mal_id\x18\x01 \x01(\x05R\x05malId\x12\x14\n" +
@@ -1318,25 +5890,263 @@ const file_catalog_v1_catalog_proto_rawDesc = "" +
 	"\bepisodes\x18\t \x01(\x05R\bepisodes\x12\x14\n" +
 	"\x05image\x18\n" +
 	" \x01(\tR\x05image\x12\x14\n" +
-	"\x05score\x18\v \x01(\x02R\x05score\"8\n" +
+	"\x05score\x18\v \x01(\x02R\x05score\x129\n" +
+	"\ftyped_genres\x18\f \x03(\v2\x16.catalog.v1.JikanGenreR\vtypedGenres\x12.\n" +
+	"\x06themes\x18\r \x03(\v2\x16.catalog.v1.JikanGenreR\x06themes\x12:\n" +
+	"\fdemographics\x18\x0e \x03(\v2\x16.catalog.v1.JikanGenreR\fdemographics\x127\n" +
+	"\trelations\x18\x0f \x03(\v2\x19.catalog.v1.JikanRelationR\trelations\"B\n" +
+	"\rJikanRelation\x12\x1a\n" +
+	"\brelation\x18\x01 \x01(\tR\brelation\x12\x15\n" +
+	"\x06mal_id\x18\x02 \x01(\x05R\x05malId\"T\n" +
 	"\x1bGetEpisodesByAnimeIDRequest\x12\x19\n" +
-	"\banime_id\x18\x01 \x01(\tR\aanimeId\"O\n" +
+	"\banime_id\x18\x01 \x01(\tR\aanimeId\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\"\x99\x01\n" +
 	"\x1cGetEpisodesByAnimeIDResponse\x12/\n" +
+	"\bepisodes\x18\x01 \x03(\v2\x13.catalog.v1.EpisodeR\bepisodes\x12H\n" +
+	"\x11provider_episodes\x18\x02 \x03(\v2\x1b.catalog.v1.ProviderEpisodeR\x10providerEpisodes\";\n" +
+	"\x1cGetEpisodesByAnimeIDsRequest\x12\x1b\n" +
+	"\tanime_ids\x18\x01 \x03(\tR\banimeIds\"P\n" +
+	"\x1dGetEpisodesByAnimeIDsResponse\x12/\n" +
 	"\bepisodes\x18\x01 \x03(\v2\x13.catalog.v1.EpisodeR\bepisodes\"G\n" +
 	"\x17UpsertJikanAnimeRequest\x12,\n" +
 	"\x05anime\x18\x01 \x01(\v2\x16.catalog.v1.JikanAnimeR\x05anime\"5\n" +
 	"\x18UpsertJikanAnimeResponse\x12\x19\n" +
-	"\banime_id\x18\x01 \x01(\tR\aanimeId2\xa3\a\n" +
+	"\banime_id\x18\x01 \x01(\tR\aanimeId\"c\n" +
+	"\x11MergeAnimeRequest\x12&\n" +
+	"\x0fsource_anime_id\x18\x01 \x01(\tR\rsourceAnimeId\x12&\n" +
+	"\x0ftarget_anime_id\x18\x02 \x01(\tR\rtargetAnimeId\"\xba\x01\n" +
+	"\x12MergeAnimeResponse\x12&\n" +
+	"\x0ftarget_anime_id\x18\x01 \x01(\tR\rtargetAnimeId\x12%\n" +
+	"\x0emoved_episodes\x18\x02 \x01(\x05R\rmovedEpisodes\x12'\n" +
+	"\x0fmerged_episodes\x18\x03 \x01(\x05R\x0emergedEpisodes\x12,\n" +
+	"\x12moved_external_ids\x18\x04 \x01(\x05R\x10movedExternalIds\"\xa0\x01\n" +
+	"\fAvailability\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x01 \x01(\tR\n" +
+	"visibility\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12+\n" +
+	"\x11allowed_countries\x18\x03 \x03(\tR\x10allowedCountries\x12+\n" +
+	"\x11blocked_countries\x18\x04 \x03(\tR\x10blockedCountries\"v\n" +
+	"\x1bSetAnimeAvailabilityRequest\x12\x19\n" +
+	"\banime_id\x18\x01 \x01(\tR\aanimeId\x12<\n" +
+	"\favailability\x18\x02 \x01(\v2\x18.catalog.v1.AvailabilityR\favailability\"\x1e\n" +
+	"\x1cSetAnimeAvailabilityResponse\"|\n" +
+	"\x1dSetEpisodeAvailabilityRequest\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tR\tepisodeId\x12<\n" +
+	"\favailability\x18\x02 \x01(\v2\x18.catalog.v1.AvailabilityR\favailability\" \n" +
+	"\x1eSetEpisodeAvailabilityResponse\">\n" +
+	"\x1dGetEpisodeAvailabilityRequest\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tR\tepisodeId\"V\n" +
+	"\x1eGetEpisodeAvailabilityResponse\x12\x1c\n" +
+	"\tavailable\x18\x01 \x01(\bR\tavailable\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x84\x01\n" +
+	"\x1dUpsertAnimeTranslationRequest\x12\x19\n" +
+	"\banime_id\x18\x01 \x01(\tR\aanimeId\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1a\n" +
+	"\bsynopsis\x18\x04 \x01(\tR\bsynopsis\" \n" +
+	"\x1eUpsertAnimeTranslationResponse\"'\n" +
+	"\x11ListGenresRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\"i\n" +
+	"\n" +
+	"GenreCount\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x1f\n" +
+	"\vanime_count\x18\x04 \x01(\x05R\n" +
+	"animeCount\"D\n" +
+	"\x12ListGenresResponse\x12.\n" +
+	"\x06genres\x18\x01 \x03(\v2\x16.catalog.v1.GenreCountR\x06genres\"[\n" +
+	"\x1aWatchCatalogChangesRequest\x12!\n" +
+	"\fsince_cursor\x18\x01 \x01(\tR\vsinceCursor\x12\x1a\n" +
+	"\bsnapshot\x18\x02 \x01(\bR\bsnapshot\"\xa6\x01\n" +
+	"\rCatalogChange\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12\x18\n" +
+	"\apayload\x18\x03 \x01(\fR\apayload\x12,\n" +
+	"\x12created_at_rfc3339\x18\x04 \x01(\tR\x10createdAtRfc3339\x12\x16\n" +
+	"\x06schema\x18\x05 \x01(\tR\x06schema\"P\n" +
+	"\x1bWatchCatalogChangesResponse\x121\n" +
+	"\x06change\x18\x01 \x01(\v2\x19.catalog.v1.CatalogChangeR\x06change\"B\n" +
+	"\x12GetTrendingRequest\x12\x16\n" +
+	"\x06window\x18\x01 \x01(\tR\x06window\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"b\n" +
+	"\rTrendingAnime\x12\x19\n" +
+	"\banime_id\x18\x01 \x01(\tR\aanimeId\x126\n" +
+	"\n" +
+	"popularity\x18\x02 \x01(\v2\x16.catalog.v1.PopularityR\n" +
+	"popularity\"^\n" +
+	"\x13GetTrendingResponse\x12\x16\n" +
+	"\x06window\x18\x01 \x01(\tR\x06window\x12/\n" +
+	"\x05anime\x18\x02 \x03(\v2\x19.catalog.v1.TrendingAnimeR\x05anime\"\xec\x01\n" +
+	"\x16GetAnimeHistoryRequest\x12\x19\n" +
+	"\banime_id\x18\x01 \x01(\tR\aanimeId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12%\n" +
+	"\x0ebefore_version\x18\x03 \x01(\x05R\rbeforeVersion\x12\"\n" +
+	"\ras_of_rfc3339\x18\x04 \x01(\tR\vasOfRfc3339\x12+\n" +
+	"\x11include_snapshots\x18\x05 \x01(\bR\x10includeSnapshots\x12)\n" +
+	"\x10include_episodes\x18\x06 \x01(\bR\x0fincludeEpisodes\"Y\n" +
+	"\vFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x19\n" +
+	"\bold_json\x18\x02 \x01(\tR\aoldJson\x12\x19\n" +
+	"\bnew_json\x18\x03 \x01(\tR\anewJson\"\xc8\x01\n" +
+	"\fAnimeVersion\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12.\n" +
+	"\x13recorded_at_rfc3339\x18\x02 \x01(\tR\x11recordedAtRfc3339\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x121\n" +
+	"\achanges\x18\x04 \x03(\v2\x17.catalog.v1.FieldChangeR\achanges\x12#\n" +
+	"\rsnapshot_json\x18\x05 \x01(\tR\fsnapshotJson\"\xe9\x01\n" +
+	"\x0eEpisodeVersion\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tR\tepisodeId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12.\n" +
+	"\x13recorded_at_rfc3339\x18\x03 \x01(\tR\x11recordedAtRfc3339\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x121\n" +
+	"\achanges\x18\x05 \x03(\v2\x17.catalog.v1.FieldChangeR\achanges\x12#\n" +
+	"\rsnapshot_json\x18\x06 \x01(\tR\fsnapshotJson\"\x87\x01\n" +
+	"\x17GetAnimeHistoryResponse\x124\n" +
+	"\bversions\x18\x01 \x03(\v2\x18.catalog.v1.AnimeVersionR\bversions\x126\n" +
+	"\bepisodes\x18\x02 \x03(\v2\x1a.catalog.v1.EpisodeVersionR\bepisodes\"I\n" +
+	"\x12RevertAnimeRequest\x12\x19\n" +
+	"\banime_id\x18\x01 \x01(\tR\aanimeId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\"/\n" +
+	"\x13RevertAnimeResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\"\xcd\x01\n" +
+	"\tFranchise\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x124\n" +
+	"\aentries\x18\x05 \x03(\v2\x1a.catalog.v1.FranchiseEntryR\aentries\x12,\n" +
+	"\x12updated_at_rfc3339\x18\x06 \x01(\tR\x10updatedAtRfc3339\"G\n" +
+	"\x0eFranchiseEntry\x12\x19\n" +
+	"\banime_id\x18\x01 \x01(\tR\aanimeId\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\"\x83\x01\n" +
+	"\x16CreateFranchiseRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x14\n" +
+	"\x05image\x18\x02 \x01(\tR\x05image\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tanime_ids\x18\x04 \x03(\tR\banimeIds\"N\n" +
+	"\x17CreateFranchiseResponse\x123\n" +
+	"\tfranchise\x18\x01 \x01(\v2\x15.catalog.v1.FranchiseR\tfranchise\"\x89\x01\n" +
+	"\x16UpdateFranchiseRequest\x12!\n" +
+	"\ffranchise_id\x18\x01 \x01(\tR\vfranchiseId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\"N\n" +
+	"\x17UpdateFranchiseResponse\x123\n" +
+	"\tfranchise\x18\x01 \x01(\v2\x15.catalog.v1.FranchiseR\tfranchise\"\\\n" +
+	"\x1aSetFranchiseEntriesRequest\x12!\n" +
+	"\ffranchise_id\x18\x01 \x01(\tR\vfranchiseId\x12\x1b\n" +
+	"\tanime_ids\x18\x02 \x03(\tR\banimeIds\"R\n" +
+	"\x1bSetFranchiseEntriesResponse\x123\n" +
+	"\tfranchise\x18\x01 \x01(\v2\x15.catalog.v1.FranchiseR\tfranchise\";\n" +
+	"\x16DeleteFranchiseRequest\x12!\n" +
+	"\ffranchise_id\x18\x01 \x01(\tR\vfranchiseId\"\x19\n" +
+	"\x17DeleteFranchiseResponse\"i\n" +
+	"\x13GetFranchiseRequest\x12!\n" +
+	"\ffranchise_id\x18\x01 \x01(\tR\vfranchiseId\x12/\n" +
+	"\x13include_unavailable\x18\x02 \x01(\bR\x12includeUnavailable\"K\n" +
+	"\x14GetFranchiseResponse\x123\n" +
+	"\tfranchise\x18\x01 \x01(\v2\x15.catalog.v1.FranchiseR\tfranchise\"`\n" +
+	"\x15ListFranchisesRequest\x12\x19\n" +
+	"\banime_id\x18\x01 \x01(\tR\aanimeId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"O\n" +
+	"\x16ListFranchisesResponse\x125\n" +
+	"\n" +
+	"franchises\x18\x01 \x03(\v2\x15.catalog.v1.FranchiseR\n" +
+	"franchises\"0\n" +
+	"\x18SuggestFranchisesRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"m\n" +
+	"\x13FranchiseSuggestion\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1b\n" +
+	"\tanime_ids\x18\x02 \x03(\tR\banimeIds\x12#\n" +
+	"\rfranchise_ids\x18\x03 \x03(\tR\ffranchiseIds\"^\n" +
+	"\x19SuggestFranchisesResponse\x12A\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x1f.catalog.v1.FranchiseSuggestionR\vsuggestions\"\xf7\x01\n" +
+	"\vSkipSegment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x02 \x01(\tR\tepisodeId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05start\x18\x04 \x01(\x02R\x05start\x12\x10\n" +
+	"\x03end\x18\x05 \x01(\x02R\x03end\x12\x16\n" +
+	"\x06source\x18\x06 \x01(\tR\x06source\x12!\n" +
+	"\fsubmitted_by\x18\a \x01(\tR\vsubmittedBy\x12\x14\n" +
+	"\x05score\x18\b \x01(\x05R\x05score\x12,\n" +
+	"\x12created_at_rfc3339\x18\t \x01(\tR\x10createdAtRfc3339\"N\n" +
+	"\x10SkipSegmentInput\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\x02R\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\x02R\x03end\"\x98\x01\n" +
+	"!UpsertProviderSkipSegmentsRequest\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tR\tepisodeId\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x128\n" +
+	"\bsegments\x18\x03 \x03(\v2\x1c.catalog.v1.SkipSegmentInputR\bsegments\"$\n" +
+	"\"UpsertProviderSkipSegmentsResponse\"8\n" +
+	"\x17ListSkipSegmentsRequest\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tR\tepisodeId\"O\n" +
+	"\x18ListSkipSegmentsResponse\x123\n" +
+	"\bsegments\x18\x01 \x03(\v2\x17.catalog.v1.SkipSegmentR\bsegments\"\x8a\x01\n" +
+	"\x18SubmitSkipSegmentRequest\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tR\tepisodeId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x126\n" +
+	"\asegment\x18\x03 \x01(\v2\x1c.catalog.v1.SkipSegmentInputR\asegment\"N\n" +
+	"\x19SubmitSkipSegmentResponse\x121\n" +
+	"\asegment\x18\x01 \x01(\v2\x17.catalog.v1.SkipSegmentR\asegment\"d\n" +
+	"\x16VoteSkipSegmentRequest\x12\x1d\n" +
+	"\n" +
+	"segment_id\x18\x01 \x01(\tR\tsegmentId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04vote\x18\x03 \x01(\x05R\x04vote\"L\n" +
+	"\x17VoteSkipSegmentResponse\x121\n" +
+	"\asegment\x18\x01 \x01(\v2\x17.catalog.v1.SkipSegmentR\asegment2\x99\x1c\n" +
 	"\x0eCatalogService\x12]\n" +
 	"\x10GetEpisodesByIDs\x12#.catalog.v1.GetEpisodesByIDsRequest\x1a$.catalog.v1.GetEpisodesByIDsResponse\x12i\n" +
 	"\x14GetProviderEpisodeID\x12'.catalog.v1.GetProviderEpisodeIDRequest\x1a(.catalog.v1.GetProviderEpisodeIDResponse\x12T\n" +
 	"\rGetAnimeByIDs\x12 .catalog.v1.GetAnimeByIDsRequest\x1a!.catalog.v1.GetAnimeByIDsResponse\x12N\n" +
 	"\vGetAnimeIDs\x12\x1e.catalog.v1.GetAnimeIDsRequest\x1a\x1f.catalog.v1.GetAnimeIDsResponse\x12i\n" +
 	"\x14GetEpisodesByAnimeID\x12'.catalog.v1.GetEpisodesByAnimeIDRequest\x1a(.catalog.v1.GetEpisodesByAnimeIDResponse\x12l\n" +
+	"\x15GetEpisodesByAnimeIDs\x12(.catalog.v1.GetEpisodesByAnimeIDsRequest\x1a).catalog.v1.GetEpisodesByAnimeIDsResponse\x12l\n" +
 	"\x15AttachExternalAnimeID\x12(.catalog.v1.AttachExternalAnimeIDRequest\x1a).catalog.v1.AttachExternalAnimeIDResponse\x12{\n" +
-	"\x1aResolveAnimeIDByExternalID\x12-.catalog.v1.ResolveAnimeIDByExternalIDRequest\x1a..catalog.v1.ResolveAnimeIDByExternalIDResponse\x12l\n" +
-	"\x15UpsertHiAnimeEpisodes\x12(.catalog.v1.UpsertHiAnimeEpisodesRequest\x1a).catalog.v1.UpsertHiAnimeEpisodesResponse\x12]\n" +
-	"\x10UpsertJikanAnime\x12#.catalog.v1.UpsertJikanAnimeRequest\x1a$.catalog.v1.UpsertJikanAnimeResponseB\xa3\x01\n" +
+	"\x1aResolveAnimeIDByExternalID\x12-.catalog.v1.ResolveAnimeIDByExternalIDRequest\x1a..catalog.v1.ResolveAnimeIDByExternalIDResponse\x12o\n" +
+	"\x16UpsertProviderMetadata\x12).catalog.v1.UpsertProviderMetadataRequest\x1a*.catalog.v1.UpsertProviderMetadataResponse\x12i\n" +
+	"\x14ListEpisodeProviders\x12'.catalog.v1.ListEpisodeProvidersRequest\x1a(.catalog.v1.ListEpisodeProvidersResponse\x12l\n" +
+	"\x15UpsertHiAnimeEpisodes\x12(.catalog.v1.UpsertHiAnimeEpisodesRequest\x1a).catalog.v1.UpsertHiAnimeEpisodesResponse\x12o\n" +
+	"\x16UpsertProviderEpisodes\x12).catalog.v1.UpsertProviderEpisodesRequest\x1a*.catalog.v1.UpsertProviderEpisodesResponse\x12o\n" +
+	"\x16RemoveProviderEpisodes\x12).catalog.v1.RemoveProviderEpisodesRequest\x1a*.catalog.v1.RemoveProviderEpisodesResponse\x12{\n" +
+	"\x1aUpsertProviderSkipSegments\x12-.catalog.v1.UpsertProviderSkipSegmentsRequest\x1a..catalog.v1.UpsertProviderSkipSegmentsResponse\x12]\n" +
+	"\x10ListSkipSegments\x12#.catalog.v1.ListSkipSegmentsRequest\x1a$.catalog.v1.ListSkipSegmentsResponse\x12`\n" +
+	"\x11SubmitSkipSegment\x12$.catalog.v1.SubmitSkipSegmentRequest\x1a%.catalog.v1.SubmitSkipSegmentResponse\x12Z\n" +
+	"\x0fVoteSkipSegment\x12\".catalog.v1.VoteSkipSegmentRequest\x1a#.catalog.v1.VoteSkipSegmentResponse\x12f\n" +
+	"\x13UpsertJikanEpisodes\x12&.catalog.v1.UpsertJikanEpisodesRequest\x1a'.catalog.v1.UpsertJikanEpisodesResponse\x12]\n" +
+	"\x10UpsertJikanAnime\x12#.catalog.v1.UpsertJikanAnimeRequest\x1a$.catalog.v1.UpsertJikanAnimeResponse\x12K\n" +
+	"\n" +
+	"MergeAnime\x12\x1d.catalog.v1.MergeAnimeRequest\x1a\x1e.catalog.v1.MergeAnimeResponse\x12i\n" +
+	"\x14SetAnimeAvailability\x12'.catalog.v1.SetAnimeAvailabilityRequest\x1a(.catalog.v1.SetAnimeAvailabilityResponse\x12o\n" +
+	"\x16SetEpisodeAvailability\x12).catalog.v1.SetEpisodeAvailabilityRequest\x1a*.catalog.v1.SetEpisodeAvailabilityResponse\x12o\n" +
+	"\x16GetEpisodeAvailability\x12).catalog.v1.GetEpisodeAvailabilityRequest\x1a*.catalog.v1.GetEpisodeAvailabilityResponse\x12o\n" +
+	"\x16UpsertAnimeTranslation\x12).catalog.v1.UpsertAnimeTranslationRequest\x1a*.catalog.v1.UpsertAnimeTranslationResponse\x12K\n" +
+	"\n" +
+	"ListGenres\x12\x1d.catalog.v1.ListGenresRequest\x1a\x1e.catalog.v1.ListGenresResponse\x12N\n" +
+	"\vGetTrending\x12\x1e.catalog.v1.GetTrendingRequest\x1a\x1f.catalog.v1.GetTrendingResponse\x12Z\n" +
+	"\x0fGetAnimeHistory\x12\".catalog.v1.GetAnimeHistoryRequest\x1a#.catalog.v1.GetAnimeHistoryResponse\x12N\n" +
+	"\vRevertAnime\x12\x1e.catalog.v1.RevertAnimeRequest\x1a\x1f.catalog.v1.RevertAnimeResponse\x12Z\n" +
+	"\x0fCreateFranchise\x12\".catalog.v1.CreateFranchiseRequest\x1a#.catalog.v1.CreateFranchiseResponse\x12Z\n" +
+	"\x0fUpdateFranchise\x12\".catalog.v1.UpdateFranchiseRequest\x1a#.catalog.v1.UpdateFranchiseResponse\x12f\n" +
+	"\x13SetFranchiseEntries\x12&.catalog.v1.SetFranchiseEntriesRequest\x1a'.catalog.v1.SetFranchiseEntriesResponse\x12Z\n" +
+	"\x0fDeleteFranchise\x12\".catalog.v1.DeleteFranchiseRequest\x1a#.catalog.v1.DeleteFranchiseResponse\x12Q\n" +
+	"\fGetFranchise\x12\x1f.catalog.v1.GetFranchiseRequest\x1a .catalog.v1.GetFranchiseResponse\x12W\n" +
+	"\x0eListFranchises\x12!.catalog.v1.ListFranchisesRequest\x1a\".catalog.v1.ListFranchisesResponse\x12`\n" +
+	"\x11SuggestFranchises\x12$.catalog.v1.SuggestFranchisesRequest\x1a%.catalog.v1.SuggestFranchisesResponse\x12h\n" +
+	"\x13WatchCatalogChanges\x12&.catalog.v1.WatchCatalogChangesRequest\x1a'.catalog.v1.WatchCatalogChangesResponse0\x01B\xa3\x01\n" +
 	"\x0ecom.catalog.v1B\fCatalogProtoP\x01Z:github.com/example/anime-platform/gen/catalog/v1;catalogv1\xa2\x02\x03CXX\xaa\x02\n" +
 	"Catalog.V1\xca\x02\n" +
 	"Catalog\\V1\xe2\x02\x16Catalog\\V1\\GPBMetadata\xea\x02\vCatalog::V1b\x06proto3"
@@ -1353,60 +6163,229 @@ func file_catalog_v1_catalog_proto_rawDescGZIP() []byte {
 	return file_catalog_v1_catalog_proto_rawDescData
 }
 
-var file_catalog_v1_catalog_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_catalog_v1_catalog_proto_msgTypes = make([]protoimpl.MessageInfo, 98)
 var file_catalog_v1_catalog_proto_goTypes = []any{
 	(*Episode)(nil),                            // 0: catalog.v1.Episode
 	(*Anime)(nil),                              // 1: catalog.v1.Anime
-	(*GetAnimeByIDsRequest)(nil),               // 2: catalog.v1.GetAnimeByIDsRequest
-	(*GetAnimeByIDsResponse)(nil),              // 3: catalog.v1.GetAnimeByIDsResponse
-	(*GetAnimeIDsRequest)(nil),                 // 4: catalog.v1.GetAnimeIDsRequest
-	(*GetAnimeIDsResponse)(nil),                // 5: catalog.v1.GetAnimeIDsResponse
-	(*GetEpisodesByIDsRequest)(nil),            // 6: catalog.v1.GetEpisodesByIDsRequest
-	(*GetEpisodesByIDsResponse)(nil),           // 7: catalog.v1.GetEpisodesByIDsResponse
-	(*GetProviderEpisodeIDRequest)(nil),        // 8: catalog.v1.GetProviderEpisodeIDRequest
-	(*GetProviderEpisodeIDResponse)(nil),       // 9: catalog.v1.GetProviderEpisodeIDResponse
-	(*AttachExternalAnimeIDRequest)(nil),       // 10: catalog.v1.AttachExternalAnimeIDRequest
-	(*AttachExternalAnimeIDResponse)(nil),      // 11: catalog.v1.AttachExternalAnimeIDResponse
-	(*ResolveAnimeIDByExternalIDRequest)(nil),  // 12: catalog.v1.ResolveAnimeIDByExternalIDRequest
-	(*ResolveAnimeIDByExternalIDResponse)(nil), // 13: catalog.v1.ResolveAnimeIDByExternalIDResponse
-	(*HiAnimeEpisode)(nil),                     // 14: catalog.v1.HiAnimeEpisode
-	(*UpsertHiAnimeEpisodesRequest)(nil),       // 15: catalog.v1.UpsertHiAnimeEpisodesRequest
-	(*UpsertHiAnimeEpisodesResponse)(nil),      // 16: catalog.v1.UpsertHiAnimeEpisodesResponse
-	(*JikanAnime)(nil),                         // 17: catalog.v1.JikanAnime
-	(*GetEpisodesByAnimeIDRequest)(nil),        // 18: catalog.v1.GetEpisodesByAnimeIDRequest
-	(*GetEpisodesByAnimeIDResponse)(nil),       // 19: catalog.v1.GetEpisodesByAnimeIDResponse
-	(*UpsertJikanAnimeRequest)(nil),            // 20: catalog.v1.UpsertJikanAnimeRequest
-	(*UpsertJikanAnimeResponse)(nil),           // 21: catalog.v1.UpsertJikanAnimeResponse
+	(*ProviderScore)(nil),                      // 2: catalog.v1.ProviderScore
+	(*Tag)(nil),                                // 3: catalog.v1.Tag
+	(*Popularity)(nil),                         // 4: catalog.v1.Popularity
+	(*ImageVariant)(nil),                       // 5: catalog.v1.ImageVariant
+	(*Genre)(nil),                              // 6: catalog.v1.Genre
+	(*GetAnimeByIDsRequest)(nil),               // 7: catalog.v1.GetAnimeByIDsRequest
+	(*GetAnimeByIDsResponse)(nil),              // 8: catalog.v1.GetAnimeByIDsResponse
+	(*GetAnimeIDsRequest)(nil),                 // 9: catalog.v1.GetAnimeIDsRequest
+	(*GetAnimeIDsResponse)(nil),                // 10: catalog.v1.GetAnimeIDsResponse
+	(*GetEpisodesByIDsRequest)(nil),            // 11: catalog.v1.GetEpisodesByIDsRequest
+	(*GetEpisodesByIDsResponse)(nil),           // 12: catalog.v1.GetEpisodesByIDsResponse
+	(*GetProviderEpisodeIDRequest)(nil),        // 13: catalog.v1.GetProviderEpisodeIDRequest
+	(*GetProviderEpisodeIDResponse)(nil),       // 14: catalog.v1.GetProviderEpisodeIDResponse
+	(*AttachExternalAnimeIDRequest)(nil),       // 15: catalog.v1.AttachExternalAnimeIDRequest
+	(*AttachExternalAnimeIDResponse)(nil),      // 16: catalog.v1.AttachExternalAnimeIDResponse
+	(*ResolveAnimeIDByExternalIDRequest)(nil),  // 17: catalog.v1.ResolveAnimeIDByExternalIDRequest
+	(*ResolveAnimeIDByExternalIDResponse)(nil), // 18: catalog.v1.ResolveAnimeIDByExternalIDResponse
+	(*UpsertProviderMetadataRequest)(nil),      // 19: catalog.v1.UpsertProviderMetadataRequest
+	(*UpsertProviderMetadataResponse)(nil),     // 20: catalog.v1.UpsertProviderMetadataResponse
+	(*HiAnimeEpisode)(nil),                     // 21: catalog.v1.HiAnimeEpisode
+	(*UpsertHiAnimeEpisodesRequest)(nil),       // 22: catalog.v1.UpsertHiAnimeEpisodesRequest
+	(*UpsertHiAnimeEpisodesResponse)(nil),      // 23: catalog.v1.UpsertHiAnimeEpisodesResponse
+	(*ProviderEpisode)(nil),                    // 24: catalog.v1.ProviderEpisode
+	(*UpsertProviderEpisodesRequest)(nil),      // 25: catalog.v1.UpsertProviderEpisodesRequest
+	(*UpsertProviderEpisodesResponse)(nil),     // 26: catalog.v1.UpsertProviderEpisodesResponse
+	(*RemoveProviderEpisodesRequest)(nil),      // 27: catalog.v1.RemoveProviderEpisodesRequest
+	(*RemoveProviderEpisodesResponse)(nil),     // 28: catalog.v1.RemoveProviderEpisodesResponse
+	(*EpisodeProvider)(nil),                    // 29: catalog.v1.EpisodeProvider
+	(*ListEpisodeProvidersRequest)(nil),        // 30: catalog.v1.ListEpisodeProvidersRequest
+	(*ListEpisodeProvidersResponse)(nil),       // 31: catalog.v1.ListEpisodeProvidersResponse
+	(*JikanEpisode)(nil),                       // 32: catalog.v1.JikanEpisode
+	(*UpsertJikanEpisodesRequest)(nil),         // 33: catalog.v1.UpsertJikanEpisodesRequest
+	(*UpsertJikanEpisodesResponse)(nil),        // 34: catalog.v1.UpsertJikanEpisodesResponse
+	(*JikanGenre)(nil),                         // 35: catalog.v1.JikanGenre
+	(*JikanAnime)(nil),                         // 36: catalog.v1.JikanAnime
+	(*JikanRelation)(nil),                      // 37: catalog.v1.JikanRelation
+	(*GetEpisodesByAnimeIDRequest)(nil),        // 38: catalog.v1.GetEpisodesByAnimeIDRequest
+	(*GetEpisodesByAnimeIDResponse)(nil),       // 39: catalog.v1.GetEpisodesByAnimeIDResponse
+	(*GetEpisodesByAnimeIDsRequest)(nil),       // 40: catalog.v1.GetEpisodesByAnimeIDsRequest
+	(*GetEpisodesByAnimeIDsResponse)(nil),      // 41: catalog.v1.GetEpisodesByAnimeIDsResponse
+	(*UpsertJikanAnimeRequest)(nil),            // 42: catalog.v1.UpsertJikanAnimeRequest
+	(*UpsertJikanAnimeResponse)(nil),           // 43: catalog.v1.UpsertJikanAnimeResponse
+	(*MergeAnimeRequest)(nil),                  // 44: catalog.v1.MergeAnimeRequest
+	(*MergeAnimeResponse)(nil),                 // 45: catalog.v1.MergeAnimeResponse
+	(*Availability)(nil),                       // 46: catalog.v1.Availability
+	(*SetAnimeAvailabilityRequest)(nil),        // 47: catalog.v1.SetAnimeAvailabilityRequest
+	(*SetAnimeAvailabilityResponse)(nil),       // 48: catalog.v1.SetAnimeAvailabilityResponse
+	(*SetEpisodeAvailabilityRequest)(nil),      // 49: catalog.v1.SetEpisodeAvailabilityRequest
+	(*SetEpisodeAvailabilityResponse)(nil),     // 50: catalog.v1.SetEpisodeAvailabilityResponse
+	(*GetEpisodeAvailabilityRequest)(nil),      // 51: catalog.v1.GetEpisodeAvailabilityRequest
+	(*GetEpisodeAvailabilityResponse)(nil),     // 52: catalog.v1.GetEpisodeAvailabilityResponse
+	(*UpsertAnimeTranslationRequest)(nil),      // 53: catalog.v1.UpsertAnimeTranslationRequest
+	(*UpsertAnimeTranslationResponse)(nil),     // 54: catalog.v1.UpsertAnimeTranslationResponse
+	(*ListGenresRequest)(nil),                  // 55: catalog.v1.ListGenresRequest
+	(*GenreCount)(nil),                         // 56: catalog.v1.GenreCount
+	(*ListGenresResponse)(nil),                 // 57: catalog.v1.ListGenresResponse
+	(*WatchCatalogChangesRequest)(nil),         // 58: catalog.v1.WatchCatalogChangesRequest
+	(*CatalogChange)(nil),                      // 59: catalog.v1.CatalogChange
+	(*WatchCatalogChangesResponse)(nil),        // 60: catalog.v1.WatchCatalogChangesResponse
+	(*GetTrendingRequest)(nil),                 // 61: catalog.v1.GetTrendingRequest
+	(*TrendingAnime)(nil),                      // 62: catalog.v1.TrendingAnime
+	(*GetTrendingResponse)(nil),                // 63: catalog.v1.GetTrendingResponse
+	(*GetAnimeHistoryRequest)(nil),             // 64: catalog.v1.GetAnimeHistoryRequest
+	(*FieldChange)(nil),                        // 65: catalog.v1.FieldChange
+	(*AnimeVersion)(nil),                       // 66: catalog.v1.AnimeVersion
+	(*EpisodeVersion)(nil),                     // 67: catalog.v1.EpisodeVersion
+	(*GetAnimeHistoryResponse)(nil),            // 68: catalog.v1.GetAnimeHistoryResponse
+	(*RevertAnimeRequest)(nil),                 // 69: catalog.v1.RevertAnimeRequest
+	(*RevertAnimeResponse)(nil),                // 70: catalog.v1.RevertAnimeResponse
+	(*Franchise)(nil),                          // 71: catalog.v1.Franchise
+	(*FranchiseEntry)(nil),                     // 72: catalog.v1.FranchiseEntry
+	(*CreateFranchiseRequest)(nil),             // 73: catalog.v1.CreateFranchiseRequest
+	(*CreateFranchiseResponse)(nil),            // 74: catalog.v1.CreateFranchiseResponse
+	(*UpdateFranchiseRequest)(nil),             // 75: catalog.v1.UpdateFranchiseRequest
+	(*UpdateFranchiseResponse)(nil),            // 76: catalog.v1.UpdateFranchiseResponse
+	(*SetFranchiseEntriesRequest)(nil),         // 77: catalog.v1.SetFranchiseEntriesRequest
+	(*SetFranchiseEntriesResponse)(nil),        // 78: catalog.v1.SetFranchiseEntriesResponse
+	(*DeleteFranchiseRequest)(nil),             // 79: catalog.v1.DeleteFranchiseRequest
+	(*DeleteFranchiseResponse)(nil),            // 80: catalog.v1.DeleteFranchiseResponse
+	(*GetFranchiseRequest)(nil),                // 81: catalog.v1.GetFranchiseRequest
+	(*GetFranchiseResponse)(nil),               // 82: catalog.v1.GetFranchiseResponse
+	(*ListFranchisesRequest)(nil),              // 83: catalog.v1.ListFranchisesRequest
+	(*ListFranchisesResponse)(nil),             // 84: catalog.v1.ListFranchisesResponse
+	(*SuggestFranchisesRequest)(nil),           // 85: catalog.v1.SuggestFranchisesRequest
+	(*FranchiseSuggestion)(nil),                // 86: catalog.v1.FranchiseSuggestion
+	(*SuggestFranchisesResponse)(nil),          // 87: catalog.v1.SuggestFranchisesResponse
+	(*SkipSegment)(nil),                        // 88: catalog.v1.SkipSegment
+	(*SkipSegmentInput)(nil),                   // 89: catalog.v1.SkipSegmentInput
+	(*UpsertProviderSkipSegmentsRequest)(nil),  // 90: catalog.v1.UpsertProviderSkipSegmentsRequest
+	(*UpsertProviderSkipSegmentsResponse)(nil), // 91: catalog.v1.UpsertProviderSkipSegmentsResponse
+	(*ListSkipSegmentsRequest)(nil),            // 92: catalog.v1.ListSkipSegmentsRequest
+	(*ListSkipSegmentsResponse)(nil),           // 93: catalog.v1.ListSkipSegmentsResponse
+	(*SubmitSkipSegmentRequest)(nil),           // 94: catalog.v1.SubmitSkipSegmentRequest
+	(*SubmitSkipSegmentResponse)(nil),          // 95: catalog.v1.SubmitSkipSegmentResponse
+	(*VoteSkipSegmentRequest)(nil),             // 96: catalog.v1.VoteSkipSegmentRequest
+	(*VoteSkipSegmentResponse)(nil),            // 97: catalog.v1.VoteSkipSegmentResponse
 }
 var file_catalog_v1_catalog_proto_depIdxs = []int32{
-	1,  // 0: catalog.v1.GetAnimeByIDsResponse.anime:type_name -> catalog.v1.Anime
-	0,  // 1: catalog.v1.GetEpisodesByIDsResponse.episodes:type_name -> catalog.v1.Episode
-	14, // 2: catalog.v1.UpsertHiAnimeEpisodesRequest.episodes:type_name -> catalog.v1.HiAnimeEpisode
-	0,  // 3: catalog.v1.GetEpisodesByAnimeIDResponse.episodes:type_name -> catalog.v1.Episode
-	17, // 4: catalog.v1.UpsertJikanAnimeRequest.anime:type_name -> catalog.v1.JikanAnime
-	6,  // 5: catalog.v1.CatalogService.GetEpisodesByIDs:input_type -> catalog.v1.GetEpisodesByIDsRequest
-	8,  // 6: catalog.v1.CatalogService.GetProviderEpisodeID:input_type -> catalog.v1.GetProviderEpisodeIDRequest
-	2,  // 7: catalog.v1.CatalogService.GetAnimeByIDs:input_type -> catalog.v1.GetAnimeByIDsRequest
-	4,  // 8: catalog.v1.CatalogService.GetAnimeIDs:input_type -> catalog.v1.GetAnimeIDsRequest
-	18, // 9: catalog.v1.CatalogService.GetEpisodesByAnimeID:input_type -> catalog.v1.GetEpisodesByAnimeIDRequest
-	10, // 10: catalog.v1.CatalogService.AttachExternalAnimeID:input_type -> catalog.v1.AttachExternalAnimeIDRequest
-	12, // 11: catalog.v1.CatalogService.ResolveAnimeIDByExternalID:input_type -> catalog.v1.ResolveAnimeIDByExternalIDRequest
-	15, // 12: catalog.v1.CatalogService.UpsertHiAnimeEpisodes:input_type -> catalog.v1.UpsertHiAnimeEpisodesRequest
-	20, // 13: catalog.v1.CatalogService.UpsertJikanAnime:input_type -> catalog.v1.UpsertJikanAnimeRequest
-	7,  // 14: catalog.v1.CatalogService.GetEpisodesByIDs:output_type -> catalog.v1.GetEpisodesByIDsResponse
-	9,  // 15: catalog.v1.CatalogService.GetProviderEpisodeID:output_type -> catalog.v1.GetProviderEpisodeIDResponse
-	3,  // 16: catalog.v1.CatalogService.GetAnimeByIDs:output_type -> catalog.v1.GetAnimeByIDsResponse
-	5,  // 17: catalog.v1.CatalogService.GetAnimeIDs:output_type -> catalog.v1.GetAnimeIDsResponse
-	19, // 18: catalog.v1.CatalogService.GetEpisodesByAnimeID:output_type -> catalog.v1.GetEpisodesByAnimeIDResponse
-	11, // 19: catalog.v1.CatalogService.AttachExternalAnimeID:output_type -> catalog.v1.AttachExternalAnimeIDResponse
-	13, // 20: catalog.v1.CatalogService.ResolveAnimeIDByExternalID:output_type -> catalog.v1.ResolveAnimeIDByExternalIDResponse
-	16, // 21: catalog.v1.CatalogService.UpsertHiAnimeEpisodes:output_type -> catalog.v1.UpsertHiAnimeEpisodesResponse
-	21, // 22: catalog.v1.CatalogService.UpsertJikanAnime:output_type -> catalog.v1.UpsertJikanAnimeResponse
-	14, // [14:23] is the sub-list for method output_type
-	5,  // [5:14] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	6,  // 0: catalog.v1.Anime.genre_tags:type_name -> catalog.v1.Genre
+	6,  // 1: catalog.v1.Anime.themes:type_name -> catalog.v1.Genre
+	6,  // 2: catalog.v1.Anime.demographics:type_name -> catalog.v1.Genre
+	5,  // 3: catalog.v1.Anime.image_variants:type_name -> catalog.v1.ImageVariant
+	4,  // 4: catalog.v1.Anime.popularity:type_name -> catalog.v1.Popularity
+	2,  // 5: catalog.v1.Anime.provider_scores:type_name -> catalog.v1.ProviderScore
+	3,  // 6: catalog.v1.Anime.tags:type_name -> catalog.v1.Tag
+	1,  // 7: catalog.v1.GetAnimeByIDsResponse.anime:type_name -> catalog.v1.Anime
+	0,  // 8: catalog.v1.GetEpisodesByIDsResponse.episodes:type_name -> catalog.v1.Episode
+	3,  // 9: catalog.v1.UpsertProviderMetadataRequest.tags:type_name -> catalog.v1.Tag
+	21, // 10: catalog.v1.UpsertHiAnimeEpisodesRequest.episodes:type_name -> catalog.v1.HiAnimeEpisode
+	24, // 11: catalog.v1.UpsertProviderEpisodesRequest.episodes:type_name -> catalog.v1.ProviderEpisode
+	29, // 12: catalog.v1.ListEpisodeProvidersResponse.providers:type_name -> catalog.v1.EpisodeProvider
+	32, // 13: catalog.v1.UpsertJikanEpisodesRequest.episodes:type_name -> catalog.v1.JikanEpisode
+	35, // 14: catalog.v1.JikanAnime.typed_genres:type_name -> catalog.v1.JikanGenre
+	35, // 15: catalog.v1.JikanAnime.themes:type_name -> catalog.v1.JikanGenre
+	35, // 16: catalog.v1.JikanAnime.demographics:type_name -> catalog.v1.JikanGenre
+	37, // 17: catalog.v1.JikanAnime.relations:type_name -> catalog.v1.JikanRelation
+	0,  // 18: catalog.v1.GetEpisodesByAnimeIDResponse.episodes:type_name -> catalog.v1.Episode
+	24, // 19: catalog.v1.GetEpisodesByAnimeIDResponse.provider_episodes:type_name -> catalog.v1.ProviderEpisode
+	0,  // 20: catalog.v1.GetEpisodesByAnimeIDsResponse.episodes:type_name -> catalog.v1.Episode
+	36, // 21: catalog.v1.UpsertJikanAnimeRequest.anime:type_name -> catalog.v1.JikanAnime
+	46, // 22: catalog.v1.SetAnimeAvailabilityRequest.availability:type_name -> catalog.v1.Availability
+	46, // 23: catalog.v1.SetEpisodeAvailabilityRequest.availability:type_name -> catalog.v1.Availability
+	56, // 24: catalog.v1.ListGenresResponse.genres:type_name -> catalog.v1.GenreCount
+	59, // 25: catalog.v1.WatchCatalogChangesResponse.change:type_name -> catalog.v1.CatalogChange
+	4,  // 26: catalog.v1.TrendingAnime.popularity:type_name -> catalog.v1.Popularity
+	62, // 27: catalog.v1.GetTrendingResponse.anime:type_name -> catalog.v1.TrendingAnime
+	65, // 28: catalog.v1.AnimeVersion.changes:type_name -> catalog.v1.FieldChange
+	65, // 29: catalog.v1.EpisodeVersion.changes:type_name -> catalog.v1.FieldChange
+	66, // 30: catalog.v1.GetAnimeHistoryResponse.versions:type_name -> catalog.v1.AnimeVersion
+	67, // 31: catalog.v1.GetAnimeHistoryResponse.episodes:type_name -> catalog.v1.EpisodeVersion
+	72, // 32: catalog.v1.Franchise.entries:type_name -> catalog.v1.FranchiseEntry
+	71, // 33: catalog.v1.CreateFranchiseResponse.franchise:type_name -> catalog.v1.Franchise
+	71, // 34: catalog.v1.UpdateFranchiseResponse.franchise:type_name -> catalog.v1.Franchise
+	71, // 35: catalog.v1.SetFranchiseEntriesResponse.franchise:type_name -> catalog.v1.Franchise
+	71, // 36: catalog.v1.GetFranchiseResponse.franchise:type_name -> catalog.v1.Franchise
+	71, // 37: catalog.v1.ListFranchisesResponse.franchises:type_name -> catalog.v1.Franchise
+	86, // 38: catalog.v1.SuggestFranchisesResponse.suggestions:type_name -> catalog.v1.FranchiseSuggestion
+	89, // 39: catalog.v1.UpsertProviderSkipSegmentsRequest.segments:type_name -> catalog.v1.SkipSegmentInput
+	88, // 40: catalog.v1.ListSkipSegmentsResponse.segments:type_name -> catalog.v1.SkipSegment
+	89, // 41: catalog.v1.SubmitSkipSegmentRequest.segment:type_name -> catalog.v1.SkipSegmentInput
+	88, // 42: catalog.v1.SubmitSkipSegmentResponse.segment:type_name -> catalog.v1.SkipSegment
+	88, // 43: catalog.v1.VoteSkipSegmentResponse.segment:type_name -> catalog.v1.SkipSegment
+	11, // 44: catalog.v1.CatalogService.GetEpisodesByIDs:input_type -> catalog.v1.GetEpisodesByIDsRequest
+	13, // 45: catalog.v1.CatalogService.GetProviderEpisodeID:input_type -> catalog.v1.GetProviderEpisodeIDRequest
+	7,  // 46: catalog.v1.CatalogService.GetAnimeByIDs:input_type -> catalog.v1.GetAnimeByIDsRequest
+	9,  // 47: catalog.v1.CatalogService.GetAnimeIDs:input_type -> catalog.v1.GetAnimeIDsRequest
+	38, // 48: catalog.v1.CatalogService.GetEpisodesByAnimeID:input_type -> catalog.v1.GetEpisodesByAnimeIDRequest
+	40, // 49: catalog.v1.CatalogService.GetEpisodesByAnimeIDs:input_type -> catalog.v1.GetEpisodesByAnimeIDsRequest
+	15, // 50: catalog.v1.CatalogService.AttachExternalAnimeID:input_type -> catalog.v1.AttachExternalAnimeIDRequest
+	17, // 51: catalog.v1.CatalogService.ResolveAnimeIDByExternalID:input_type -> catalog.v1.ResolveAnimeIDByExternalIDRequest
+	19, // 52: catalog.v1.CatalogService.UpsertProviderMetadata:input_type -> catalog.v1.UpsertProviderMetadataRequest
+	30, // 53: catalog.v1.CatalogService.ListEpisodeProviders:input_type -> catalog.v1.ListEpisodeProvidersRequest
+	22, // 54: catalog.v1.CatalogService.UpsertHiAnimeEpisodes:input_type -> catalog.v1.UpsertHiAnimeEpisodesRequest
+	25, // 55: catalog.v1.CatalogService.UpsertProviderEpisodes:input_type -> catalog.v1.UpsertProviderEpisodesRequest
+	27, // 56: catalog.v1.CatalogService.RemoveProviderEpisodes:input_type -> catalog.v1.RemoveProviderEpisodesRequest
+	90, // 57: catalog.v1.CatalogService.UpsertProviderSkipSegments:input_type -> catalog.v1.UpsertProviderSkipSegmentsRequest
+	92, // 58: catalog.v1.CatalogService.ListSkipSegments:input_type -> catalog.v1.ListSkipSegmentsRequest
+	94, // 59: catalog.v1.CatalogService.SubmitSkipSegment:input_type -> catalog.v1.SubmitSkipSegmentRequest
+	96, // 60: catalog.v1.CatalogService.VoteSkipSegment:input_type -> catalog.v1.VoteSkipSegmentRequest
+	33, // 61: catalog.v1.CatalogService.UpsertJikanEpisodes:input_type -> catalog.v1.UpsertJikanEpisodesRequest
+	42, // 62: catalog.v1.CatalogService.UpsertJikanAnime:input_type -> catalog.v1.UpsertJikanAnimeRequest
+	44, // 63: catalog.v1.CatalogService.MergeAnime:input_type -> catalog.v1.MergeAnimeRequest
+	47, // 64: catalog.v1.CatalogService.SetAnimeAvailability:input_type -> catalog.v1.SetAnimeAvailabilityRequest
+	49, // 65: catalog.v1.CatalogService.SetEpisodeAvailability:input_type -> catalog.v1.SetEpisodeAvailabilityRequest
+	51, // 66: catalog.v1.CatalogService.GetEpisodeAvailability:input_type -> catalog.v1.GetEpisodeAvailabilityRequest
+	53, // 67: catalog.v1.CatalogService.UpsertAnimeTranslation:input_type -> catalog.v1.UpsertAnimeTranslationRequest
+	55, // 68: catalog.v1.CatalogService.ListGenres:input_type -> catalog.v1.ListGenresRequest
+	61, // 69: catalog.v1.CatalogService.GetTrending:input_type -> catalog.v1.GetTrendingRequest
+	64, // 70: catalog.v1.CatalogService.GetAnimeHistory:input_type -> catalog.v1.GetAnimeHistoryRequest
+	69, // 71: catalog.v1.CatalogService.RevertAnime:input_type -> catalog.v1.RevertAnimeRequest
+	73, // 72: catalog.v1.CatalogService.CreateFranchise:input_type -> catalog.v1.CreateFranchiseRequest
+	75, // 73: catalog.v1.CatalogService.UpdateFranchise:input_type -> catalog.v1.UpdateFranchiseRequest
+	77, // 74: catalog.v1.CatalogService.SetFranchiseEntries:input_type -> catalog.v1.SetFranchiseEntriesRequest
+	79, // 75: catalog.v1.CatalogService.DeleteFranchise:input_type -> catalog.v1.DeleteFranchiseRequest
+	81, // 76: catalog.v1.CatalogService.GetFranchise:input_type -> catalog.v1.GetFranchiseRequest
+	83, // 77: catalog.v1.CatalogService.ListFranchises:input_type -> catalog.v1.ListFranchisesRequest
+	85, // 78: catalog.v1.CatalogService.SuggestFranchises:input_type -> catalog.v1.SuggestFranchisesRequest
+	58, // 79: catalog.v1.CatalogService.WatchCatalogChanges:input_type -> catalog.v1.WatchCatalogChangesRequest
+	12, // 80: catalog.v1.CatalogService.GetEpisodesByIDs:output_type -> catalog.v1.GetEpisodesByIDsResponse
+	14, // 81: catalog.v1.CatalogService.GetProviderEpisodeID:output_type -> catalog.v1.GetProviderEpisodeIDResponse
+	8,  // 82: catalog.v1.CatalogService.GetAnimeByIDs:output_type -> catalog.v1.GetAnimeByIDsResponse
+	10, // 83: catalog.v1.CatalogService.GetAnimeIDs:output_type -> catalog.v1.GetAnimeIDsResponse
+	39, // 84: catalog.v1.CatalogService.GetEpisodesByAnimeID:output_type -> catalog.v1.GetEpisodesByAnimeIDResponse
+	41, // 85: catalog.v1.CatalogService.GetEpisodesByAnimeIDs:output_type -> catalog.v1.GetEpisodesByAnimeIDsResponse
+	16, // 86: catalog.v1.CatalogService.AttachExternalAnimeID:output_type -> catalog.v1.AttachExternalAnimeIDResponse
+	18, // 87: catalog.v1.CatalogService.ResolveAnimeIDByExternalID:output_type -> catalog.v1.ResolveAnimeIDByExternalIDResponse
+	20, // 88: catalog.v1.CatalogService.UpsertProviderMetadata:output_type -> catalog.v1.UpsertProviderMetadataResponse
+	31, // 89: catalog.v1.CatalogService.ListEpisodeProviders:output_type -> catalog.v1.ListEpisodeProvidersResponse
+	23, // 90: catalog.v1.CatalogService.UpsertHiAnimeEpisodes:output_type -> catalog.v1.UpsertHiAnimeEpisodesResponse
+	26, // 91: catalog.v1.CatalogService.UpsertProviderEpisodes:output_type -> catalog.v1.UpsertProviderEpisodesResponse
+	28, // 92: catalog.v1.CatalogService.RemoveProviderEpisodes:output_type -> catalog.v1.RemoveProviderEpisodesResponse
+	91, // 93: catalog.v1.CatalogService.UpsertProviderSkipSegments:output_type -> catalog.v1.UpsertProviderSkipSegmentsResponse
+	93, // 94: catalog.v1.CatalogService.ListSkipSegments:output_type -> catalog.v1.ListSkipSegmentsResponse
+	95, // 95: catalog.v1.CatalogService.SubmitSkipSegment:output_type -> catalog.v1.SubmitSkipSegmentResponse
+	97, // 96: catalog.v1.CatalogService.VoteSkipSegment:output_type -> catalog.v1.VoteSkipSegmentResponse
+	34, // 97: catalog.v1.CatalogService.UpsertJikanEpisodes:output_type -> catalog.v1.UpsertJikanEpisodesResponse
+	43, // 98: catalog.v1.CatalogService.UpsertJikanAnime:output_type -> catalog.v1.UpsertJikanAnimeResponse
+	45, // 99: catalog.v1.CatalogService.MergeAnime:output_type -> catalog.v1.MergeAnimeResponse
+	48, // 100: catalog.v1.CatalogService.SetAnimeAvailability:output_type -> catalog.v1.SetAnimeAvailabilityResponse
+	50, // 101: catalog.v1.CatalogService.SetEpisodeAvailability:output_type -> catalog.v1.SetEpisodeAvailabilityResponse
+	52, // 102: catalog.v1.CatalogService.GetEpisodeAvailability:output_type -> catalog.v1.GetEpisodeAvailabilityResponse
+	54, // 103: catalog.v1.CatalogService.UpsertAnimeTranslation:output_type -> catalog.v1.UpsertAnimeTranslationResponse
+	57, // 104: catalog.v1.CatalogService.ListGenres:output_type -> catalog.v1.ListGenresResponse
+	63, // 105: catalog.v1.CatalogService.GetTrending:output_type -> catalog.v1.GetTrendingResponse
+	68, // 106: catalog.v1.CatalogService.GetAnimeHistory:output_type -> catalog.v1.GetAnimeHistoryResponse
+	70, // 107: catalog.v1.CatalogService.RevertAnime:output_type -> catalog.v1.RevertAnimeResponse
+	74, // 108: catalog.v1.CatalogService.CreateFranchise:output_type -> catalog.v1.CreateFranchiseResponse
+	76, // 109: catalog.v1.CatalogService.UpdateFranchise:output_type -> catalog.v1.UpdateFranchiseResponse
+	78, // 110: catalog.v1.CatalogService.SetFranchiseEntries:output_type -> catalog.v1.SetFranchiseEntriesResponse
+	80, // 111: catalog.v1.CatalogService.DeleteFranchise:output_type -> catalog.v1.DeleteFranchiseResponse
+	82, // 112: catalog.v1.CatalogService.GetFranchise:output_type -> catalog.v1.GetFranchiseResponse
+	84, // 113: catalog.v1.CatalogService.ListFranchises:output_type -> catalog.v1.ListFranchisesResponse
+	87, // 114: catalog.v1.CatalogService.SuggestFranchises:output_type -> catalog.v1.SuggestFranchisesResponse
+	60, // 115: catalog.v1.CatalogService.WatchCatalogChanges:output_type -> catalog.v1.WatchCatalogChangesResponse
+	80, // [80:116] is the sub-list for method output_type
+	44, // [44:80] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_catalog_v1_catalog_proto_init() }
@@ -1420,7 +6399,7 @@ func file_catalog_v1_catalog_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_catalog_v1_catalog_proto_rawDesc), len(file_catalog_v1_catalog_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   98,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
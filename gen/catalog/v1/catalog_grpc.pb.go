@@ -25,10 +25,37 @@ const (
 	CatalogService_GetAnimeByIDs_FullMethodName              = "/catalog.v1.CatalogService/GetAnimeByIDs"
 	CatalogService_GetAnimeIDs_FullMethodName                = "/catalog.v1.CatalogService/GetAnimeIDs"
 	CatalogService_GetEpisodesByAnimeID_FullMethodName       = "/catalog.v1.CatalogService/GetEpisodesByAnimeID"
+	CatalogService_GetEpisodesByAnimeIDs_FullMethodName      = "/catalog.v1.CatalogService/GetEpisodesByAnimeIDs"
 	CatalogService_AttachExternalAnimeID_FullMethodName      = "/catalog.v1.CatalogService/AttachExternalAnimeID"
 	CatalogService_ResolveAnimeIDByExternalID_FullMethodName = "/catalog.v1.CatalogService/ResolveAnimeIDByExternalID"
+	CatalogService_UpsertProviderMetadata_FullMethodName     = "/catalog.v1.CatalogService/UpsertProviderMetadata"
+	CatalogService_ListEpisodeProviders_FullMethodName       = "/catalog.v1.CatalogService/ListEpisodeProviders"
 	CatalogService_UpsertHiAnimeEpisodes_FullMethodName      = "/catalog.v1.CatalogService/UpsertHiAnimeEpisodes"
+	CatalogService_UpsertProviderEpisodes_FullMethodName     = "/catalog.v1.CatalogService/UpsertProviderEpisodes"
+	CatalogService_RemoveProviderEpisodes_FullMethodName     = "/catalog.v1.CatalogService/RemoveProviderEpisodes"
+	CatalogService_UpsertProviderSkipSegments_FullMethodName = "/catalog.v1.CatalogService/UpsertProviderSkipSegments"
+	CatalogService_ListSkipSegments_FullMethodName           = "/catalog.v1.CatalogService/ListSkipSegments"
+	CatalogService_SubmitSkipSegment_FullMethodName          = "/catalog.v1.CatalogService/SubmitSkipSegment"
+	CatalogService_VoteSkipSegment_FullMethodName            = "/catalog.v1.CatalogService/VoteSkipSegment"
+	CatalogService_UpsertJikanEpisodes_FullMethodName        = "/catalog.v1.CatalogService/UpsertJikanEpisodes"
 	CatalogService_UpsertJikanAnime_FullMethodName           = "/catalog.v1.CatalogService/UpsertJikanAnime"
+	CatalogService_MergeAnime_FullMethodName                 = "/catalog.v1.CatalogService/MergeAnime"
+	CatalogService_SetAnimeAvailability_FullMethodName       = "/catalog.v1.CatalogService/SetAnimeAvailability"
+	CatalogService_SetEpisodeAvailability_FullMethodName     = "/catalog.v1.CatalogService/SetEpisodeAvailability"
+	CatalogService_GetEpisodeAvailability_FullMethodName     = "/catalog.v1.CatalogService/GetEpisodeAvailability"
+	CatalogService_UpsertAnimeTranslation_FullMethodName     = "/catalog.v1.CatalogService/UpsertAnimeTranslation"
+	CatalogService_ListGenres_FullMethodName                 = "/catalog.v1.CatalogService/ListGenres"
+	CatalogService_GetTrending_FullMethodName                = "/catalog.v1.CatalogService/GetTrending"
+	CatalogService_GetAnimeHistory_FullMethodName            = "/catalog.v1.CatalogService/GetAnimeHistory"
+	CatalogService_RevertAnime_FullMethodName                = "/catalog.v1.CatalogService/RevertAnime"
+	CatalogService_CreateFranchise_FullMethodName            = "/catalog.v1.CatalogService/CreateFranchise"
+	CatalogService_UpdateFranchise_FullMethodName            = "/catalog.v1.CatalogService/UpdateFranchise"
+	CatalogService_SetFranchiseEntries_FullMethodName        = "/catalog.v1.CatalogService/SetFranchiseEntries"
+	CatalogService_DeleteFranchise_FullMethodName            = "/catalog.v1.CatalogService/DeleteFranchise"
+	CatalogService_GetFranchise_FullMethodName               = "/catalog.v1.CatalogService/GetFranchise"
+	CatalogService_ListFranchises_FullMethodName             = "/catalog.v1.CatalogService/ListFranchises"
+	CatalogService_SuggestFranchises_FullMethodName          = "/catalog.v1.CatalogService/SuggestFranchises"
+	CatalogService_WatchCatalogChanges_FullMethodName        = "/catalog.v1.CatalogService/WatchCatalogChanges"
 )
 
 // CatalogServiceClient is the client API for CatalogService service.
@@ -40,10 +67,50 @@ type CatalogServiceClient interface {
 	GetAnimeByIDs(ctx context.Context, in *GetAnimeByIDsRequest, opts ...grpc.CallOption) (*GetAnimeByIDsResponse, error)
 	GetAnimeIDs(ctx context.Context, in *GetAnimeIDsRequest, opts ...grpc.CallOption) (*GetAnimeIDsResponse, error)
 	GetEpisodesByAnimeID(ctx context.Context, in *GetEpisodesByAnimeIDRequest, opts ...grpc.CallOption) (*GetEpisodesByAnimeIDResponse, error)
+	GetEpisodesByAnimeIDs(ctx context.Context, in *GetEpisodesByAnimeIDsRequest, opts ...grpc.CallOption) (*GetEpisodesByAnimeIDsResponse, error)
 	AttachExternalAnimeID(ctx context.Context, in *AttachExternalAnimeIDRequest, opts ...grpc.CallOption) (*AttachExternalAnimeIDResponse, error)
 	ResolveAnimeIDByExternalID(ctx context.Context, in *ResolveAnimeIDByExternalIDRequest, opts ...grpc.CallOption) (*ResolveAnimeIDByExternalIDResponse, error)
+	UpsertProviderMetadata(ctx context.Context, in *UpsertProviderMetadataRequest, opts ...grpc.CallOption) (*UpsertProviderMetadataResponse, error)
+	ListEpisodeProviders(ctx context.Context, in *ListEpisodeProvidersRequest, opts ...grpc.CallOption) (*ListEpisodeProvidersResponse, error)
+	// UpsertHiAnimeEpisodes is kept for older ingestion builds; prefer UpsertProviderEpisodes.
 	UpsertHiAnimeEpisodes(ctx context.Context, in *UpsertHiAnimeEpisodesRequest, opts ...grpc.CallOption) (*UpsertHiAnimeEpisodesResponse, error)
+	UpsertProviderEpisodes(ctx context.Context, in *UpsertProviderEpisodesRequest, opts ...grpc.CallOption) (*UpsertProviderEpisodesResponse, error)
+	RemoveProviderEpisodes(ctx context.Context, in *RemoveProviderEpisodesRequest, opts ...grpc.CallOption) (*RemoveProviderEpisodesResponse, error)
+	// UpsertProviderSkipSegments records intro/outro times a provider reported
+	// during playback.
+	UpsertProviderSkipSegments(ctx context.Context, in *UpsertProviderSkipSegmentsRequest, opts ...grpc.CallOption) (*UpsertProviderSkipSegmentsResponse, error)
+	ListSkipSegments(ctx context.Context, in *ListSkipSegmentsRequest, opts ...grpc.CallOption) (*ListSkipSegmentsResponse, error)
+	SubmitSkipSegment(ctx context.Context, in *SubmitSkipSegmentRequest, opts ...grpc.CallOption) (*SubmitSkipSegmentResponse, error)
+	VoteSkipSegment(ctx context.Context, in *VoteSkipSegmentRequest, opts ...grpc.CallOption) (*VoteSkipSegmentResponse, error)
+	UpsertJikanEpisodes(ctx context.Context, in *UpsertJikanEpisodesRequest, opts ...grpc.CallOption) (*UpsertJikanEpisodesResponse, error)
 	UpsertJikanAnime(ctx context.Context, in *UpsertJikanAnimeRequest, opts ...grpc.CallOption) (*UpsertJikanAnimeResponse, error)
+	MergeAnime(ctx context.Context, in *MergeAnimeRequest, opts ...grpc.CallOption) (*MergeAnimeResponse, error)
+	SetAnimeAvailability(ctx context.Context, in *SetAnimeAvailabilityRequest, opts ...grpc.CallOption) (*SetAnimeAvailabilityResponse, error)
+	SetEpisodeAvailability(ctx context.Context, in *SetEpisodeAvailabilityRequest, opts ...grpc.CallOption) (*SetEpisodeAvailabilityResponse, error)
+	GetEpisodeAvailability(ctx context.Context, in *GetEpisodeAvailabilityRequest, opts ...grpc.CallOption) (*GetEpisodeAvailabilityResponse, error)
+	UpsertAnimeTranslation(ctx context.Context, in *UpsertAnimeTranslationRequest, opts ...grpc.CallOption) (*UpsertAnimeTranslationResponse, error)
+	ListGenres(ctx context.Context, in *ListGenresRequest, opts ...grpc.CallOption) (*ListGenresResponse, error)
+	// GetTrending ranks anime available in the caller's region by recent platform activity.
+	GetTrending(ctx context.Context, in *GetTrendingRequest, opts ...grpc.CallOption) (*GetTrendingResponse, error)
+	// GetAnimeHistory lists recorded versions of an anime with per-field diffs.
+	GetAnimeHistory(ctx context.Context, in *GetAnimeHistoryRequest, opts ...grpc.CallOption) (*GetAnimeHistoryResponse, error)
+	// RevertAnime restores an anime's content, taxonomy and translations to a
+	// recorded version.
+	// Availability is not reverted.
+	RevertAnime(ctx context.Context, in *RevertAnimeRequest, opts ...grpc.CallOption) (*RevertAnimeResponse, error)
+	CreateFranchise(ctx context.Context, in *CreateFranchiseRequest, opts ...grpc.CallOption) (*CreateFranchiseResponse, error)
+	UpdateFranchise(ctx context.Context, in *UpdateFranchiseRequest, opts ...grpc.CallOption) (*UpdateFranchiseResponse, error)
+	SetFranchiseEntries(ctx context.Context, in *SetFranchiseEntriesRequest, opts ...grpc.CallOption) (*SetFranchiseEntriesResponse, error)
+	DeleteFranchise(ctx context.Context, in *DeleteFranchiseRequest, opts ...grpc.CallOption) (*DeleteFranchiseResponse, error)
+	GetFranchise(ctx context.Context, in *GetFranchiseRequest, opts ...grpc.CallOption) (*GetFranchiseResponse, error)
+	ListFranchises(ctx context.Context, in *ListFranchisesRequest, opts ...grpc.CallOption) (*ListFranchisesResponse, error)
+	// SuggestFranchises groups anime linked by sequel, side story and similar
+	// relations that no single franchise covers yet.
+	SuggestFranchises(ctx context.Context, in *SuggestFranchisesRequest, opts ...grpc.CallOption) (*SuggestFranchisesResponse, error)
+	// WatchCatalogChanges replays catalog changes after a cursor, then tails new ones.
+	// A cursor older than the outbox retention window fails with FAILED_PRECONDITION;
+	// resync with snapshot=true.
+	WatchCatalogChanges(ctx context.Context, in *WatchCatalogChangesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchCatalogChangesResponse], error)
 }
 
 type catalogServiceClient struct {
@@ -104,6 +171,16 @@ func (c *catalogServiceClient) GetEpisodesByAnimeID(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *catalogServiceClient) GetEpisodesByAnimeIDs(ctx context.Context, in *GetEpisodesByAnimeIDsRequest, opts ...grpc.CallOption) (*GetEpisodesByAnimeIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEpisodesByAnimeIDsResponse)
+	err := c.cc.Invoke(ctx, CatalogService_GetEpisodesByAnimeIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *catalogServiceClient) AttachExternalAnimeID(ctx context.Context, in *AttachExternalAnimeIDRequest, opts ...grpc.CallOption) (*AttachExternalAnimeIDResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AttachExternalAnimeIDResponse)
@@ -124,6 +201,26 @@ func (c *catalogServiceClient) ResolveAnimeIDByExternalID(ctx context.Context, i
 	return out, nil
 }
 
+func (c *catalogServiceClient) UpsertProviderMetadata(ctx context.Context, in *UpsertProviderMetadataRequest, opts ...grpc.CallOption) (*UpsertProviderMetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpsertProviderMetadataResponse)
+	err := c.cc.Invoke(ctx, CatalogService_UpsertProviderMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListEpisodeProviders(ctx context.Context, in *ListEpisodeProvidersRequest, opts ...grpc.CallOption) (*ListEpisodeProvidersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEpisodeProvidersResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ListEpisodeProviders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *catalogServiceClient) UpsertHiAnimeEpisodes(ctx context.Context, in *UpsertHiAnimeEpisodesRequest, opts ...grpc.CallOption) (*UpsertHiAnimeEpisodesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpsertHiAnimeEpisodesResponse)
@@ -134,6 +231,76 @@ func (c *catalogServiceClient) UpsertHiAnimeEpisodes(ctx context.Context, in *Up
 	return out, nil
 }
 
+func (c *catalogServiceClient) UpsertProviderEpisodes(ctx context.Context, in *UpsertProviderEpisodesRequest, opts ...grpc.CallOption) (*UpsertProviderEpisodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpsertProviderEpisodesResponse)
+	err := c.cc.Invoke(ctx, CatalogService_UpsertProviderEpisodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) RemoveProviderEpisodes(ctx context.Context, in *RemoveProviderEpisodesRequest, opts ...grpc.CallOption) (*RemoveProviderEpisodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveProviderEpisodesResponse)
+	err := c.cc.Invoke(ctx, CatalogService_RemoveProviderEpisodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) UpsertProviderSkipSegments(ctx context.Context, in *UpsertProviderSkipSegmentsRequest, opts ...grpc.CallOption) (*UpsertProviderSkipSegmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpsertProviderSkipSegmentsResponse)
+	err := c.cc.Invoke(ctx, CatalogService_UpsertProviderSkipSegments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListSkipSegments(ctx context.Context, in *ListSkipSegmentsRequest, opts ...grpc.CallOption) (*ListSkipSegmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSkipSegmentsResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ListSkipSegments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) SubmitSkipSegment(ctx context.Context, in *SubmitSkipSegmentRequest, opts ...grpc.CallOption) (*SubmitSkipSegmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitSkipSegmentResponse)
+	err := c.cc.Invoke(ctx, CatalogService_SubmitSkipSegment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) VoteSkipSegment(ctx context.Context, in *VoteSkipSegmentRequest, opts ...grpc.CallOption) (*VoteSkipSegmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoteSkipSegmentResponse)
+	err := c.cc.Invoke(ctx, CatalogService_VoteSkipSegment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) UpsertJikanEpisodes(ctx context.Context, in *UpsertJikanEpisodesRequest, opts ...grpc.CallOption) (*UpsertJikanEpisodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpsertJikanEpisodesResponse)
+	err := c.cc.Invoke(ctx, CatalogService_UpsertJikanEpisodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *catalogServiceClient) UpsertJikanAnime(ctx context.Context, in *UpsertJikanAnimeRequest, opts ...grpc.CallOption) (*UpsertJikanAnimeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpsertJikanAnimeResponse)
@@ -144,6 +311,185 @@ func (c *catalogServiceClient) UpsertJikanAnime(ctx context.Context, in *UpsertJ
 	return out, nil
 }
 
+func (c *catalogServiceClient) MergeAnime(ctx context.Context, in *MergeAnimeRequest, opts ...grpc.CallOption) (*MergeAnimeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeAnimeResponse)
+	err := c.cc.Invoke(ctx, CatalogService_MergeAnime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) SetAnimeAvailability(ctx context.Context, in *SetAnimeAvailabilityRequest, opts ...grpc.CallOption) (*SetAnimeAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAnimeAvailabilityResponse)
+	err := c.cc.Invoke(ctx, CatalogService_SetAnimeAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) SetEpisodeAvailability(ctx context.Context, in *SetEpisodeAvailabilityRequest, opts ...grpc.CallOption) (*SetEpisodeAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetEpisodeAvailabilityResponse)
+	err := c.cc.Invoke(ctx, CatalogService_SetEpisodeAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetEpisodeAvailability(ctx context.Context, in *GetEpisodeAvailabilityRequest, opts ...grpc.CallOption) (*GetEpisodeAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEpisodeAvailabilityResponse)
+	err := c.cc.Invoke(ctx, CatalogService_GetEpisodeAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) UpsertAnimeTranslation(ctx context.Context, in *UpsertAnimeTranslationRequest, opts ...grpc.CallOption) (*UpsertAnimeTranslationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpsertAnimeTranslationResponse)
+	err := c.cc.Invoke(ctx, CatalogService_UpsertAnimeTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListGenres(ctx context.Context, in *ListGenresRequest, opts ...grpc.CallOption) (*ListGenresResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGenresResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ListGenres_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetTrending(ctx context.Context, in *GetTrendingRequest, opts ...grpc.CallOption) (*GetTrendingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTrendingResponse)
+	err := c.cc.Invoke(ctx, CatalogService_GetTrending_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetAnimeHistory(ctx context.Context, in *GetAnimeHistoryRequest, opts ...grpc.CallOption) (*GetAnimeHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAnimeHistoryResponse)
+	err := c.cc.Invoke(ctx, CatalogService_GetAnimeHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) RevertAnime(ctx context.Context, in *RevertAnimeRequest, opts ...grpc.CallOption) (*RevertAnimeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevertAnimeResponse)
+	err := c.cc.Invoke(ctx, CatalogService_RevertAnime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) CreateFranchise(ctx context.Context, in *CreateFranchiseRequest, opts ...grpc.CallOption) (*CreateFranchiseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateFranchiseResponse)
+	err := c.cc.Invoke(ctx, CatalogService_CreateFranchise_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) UpdateFranchise(ctx context.Context, in *UpdateFranchiseRequest, opts ...grpc.CallOption) (*UpdateFranchiseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateFranchiseResponse)
+	err := c.cc.Invoke(ctx, CatalogService_UpdateFranchise_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) SetFranchiseEntries(ctx context.Context, in *SetFranchiseEntriesRequest, opts ...grpc.CallOption) (*SetFranchiseEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetFranchiseEntriesResponse)
+	err := c.cc.Invoke(ctx, CatalogService_SetFranchiseEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) DeleteFranchise(ctx context.Context, in *DeleteFranchiseRequest, opts ...grpc.CallOption) (*DeleteFranchiseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteFranchiseResponse)
+	err := c.cc.Invoke(ctx, CatalogService_DeleteFranchise_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetFranchise(ctx context.Context, in *GetFranchiseRequest, opts ...grpc.CallOption) (*GetFranchiseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFranchiseResponse)
+	err := c.cc.Invoke(ctx, CatalogService_GetFranchise_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListFranchises(ctx context.Context, in *ListFranchisesRequest, opts ...grpc.CallOption) (*ListFranchisesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFranchisesResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ListFranchises_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) SuggestFranchises(ctx context.Context, in *SuggestFranchisesRequest, opts ...grpc.CallOption) (*SuggestFranchisesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestFranchisesResponse)
+	err := c.cc.Invoke(ctx, CatalogService_SuggestFranchises_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) WatchCatalogChanges(ctx context.Context, in *WatchCatalogChangesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchCatalogChangesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CatalogService_ServiceDesc.Streams[0], CatalogService_WatchCatalogChanges_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchCatalogChangesRequest, WatchCatalogChangesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CatalogService_WatchCatalogChangesClient = grpc.ServerStreamingClient[WatchCatalogChangesResponse]
+
 // CatalogServiceServer is the server API for CatalogService service.
 // All implementations must embed UnimplementedCatalogServiceServer
 // for forward compatibility.
@@ -153,10 +499,50 @@ type CatalogServiceServer interface {
 	GetAnimeByIDs(context.Context, *GetAnimeByIDsRequest) (*GetAnimeByIDsResponse, error)
 	GetAnimeIDs(context.Context, *GetAnimeIDsRequest) (*GetAnimeIDsResponse, error)
 	GetEpisodesByAnimeID(context.Context, *GetEpisodesByAnimeIDRequest) (*GetEpisodesByAnimeIDResponse, error)
+	GetEpisodesByAnimeIDs(context.Context, *GetEpisodesByAnimeIDsRequest) (*GetEpisodesByAnimeIDsResponse, error)
 	AttachExternalAnimeID(context.Context, *AttachExternalAnimeIDRequest) (*AttachExternalAnimeIDResponse, error)
 	ResolveAnimeIDByExternalID(context.Context, *ResolveAnimeIDByExternalIDRequest) (*ResolveAnimeIDByExternalIDResponse, error)
+	UpsertProviderMetadata(context.Context, *UpsertProviderMetadataRequest) (*UpsertProviderMetadataResponse, error)
+	ListEpisodeProviders(context.Context, *ListEpisodeProvidersRequest) (*ListEpisodeProvidersResponse, error)
+	// UpsertHiAnimeEpisodes is kept for older ingestion builds; prefer UpsertProviderEpisodes.
 	UpsertHiAnimeEpisodes(context.Context, *UpsertHiAnimeEpisodesRequest) (*UpsertHiAnimeEpisodesResponse, error)
+	UpsertProviderEpisodes(context.Context, *UpsertProviderEpisodesRequest) (*UpsertProviderEpisodesResponse, error)
+	RemoveProviderEpisodes(context.Context, *RemoveProviderEpisodesRequest) (*RemoveProviderEpisodesResponse, error)
+	// UpsertProviderSkipSegments records intro/outro times a provider reported
+	// during playback.
+	UpsertProviderSkipSegments(context.Context, *UpsertProviderSkipSegmentsRequest) (*UpsertProviderSkipSegmentsResponse, error)
+	ListSkipSegments(context.Context, *ListSkipSegmentsRequest) (*ListSkipSegmentsResponse, error)
+	SubmitSkipSegment(context.Context, *SubmitSkipSegmentRequest) (*SubmitSkipSegmentResponse, error)
+	VoteSkipSegment(context.Context, *VoteSkipSegmentRequest) (*VoteSkipSegmentResponse, error)
+	UpsertJikanEpisodes(context.Context, *UpsertJikanEpisodesRequest) (*UpsertJikanEpisodesResponse, error)
 	UpsertJikanAnime(context.Context, *UpsertJikanAnimeRequest) (*UpsertJikanAnimeResponse, error)
+	MergeAnime(context.Context, *MergeAnimeRequest) (*MergeAnimeResponse, error)
+	SetAnimeAvailability(context.Context, *SetAnimeAvailabilityRequest) (*SetAnimeAvailabilityResponse, error)
+	SetEpisodeAvailability(context.Context, *SetEpisodeAvailabilityRequest) (*SetEpisodeAvailabilityResponse, error)
+	GetEpisodeAvailability(context.Context, *GetEpisodeAvailabilityRequest) (*GetEpisodeAvailabilityResponse, error)
+	UpsertAnimeTranslation(context.Context, *UpsertAnimeTranslationRequest) (*UpsertAnimeTranslationResponse, error)
+	ListGenres(context.Context, *ListGenresRequest) (*ListGenresResponse, error)
+	// GetTrending ranks anime available in the caller's region by recent platform activity.
+	GetTrending(context.Context, *GetTrendingRequest) (*GetTrendingResponse, error)
+	// GetAnimeHistory lists recorded versions of an anime with per-field diffs.
+	GetAnimeHistory(context.Context, *GetAnimeHistoryRequest) (*GetAnimeHistoryResponse, error)
+	// RevertAnime restores an anime's content, taxonomy and translations to a
+	// recorded version.
+	// Availability is not reverted.
+	RevertAnime(context.Context, *RevertAnimeRequest) (*RevertAnimeResponse, error)
+	CreateFranchise(context.Context, *CreateFranchiseRequest) (*CreateFranchiseResponse, error)
+	UpdateFranchise(context.Context, *UpdateFranchiseRequest) (*UpdateFranchiseResponse, error)
+	SetFranchiseEntries(context.Context, *SetFranchiseEntriesRequest) (*SetFranchiseEntriesResponse, error)
+	DeleteFranchise(context.Context, *DeleteFranchiseRequest) (*DeleteFranchiseResponse, error)
+	GetFranchise(context.Context, *GetFranchiseRequest) (*GetFranchiseResponse, error)
+	ListFranchises(context.Context, *ListFranchisesRequest) (*ListFranchisesResponse, error)
+	// SuggestFranchises groups anime linked by sequel, side story and similar
+	// relations that no single franchise covers yet.
+	SuggestFranchises(context.Context, *SuggestFranchisesRequest) (*SuggestFranchisesResponse, error)
+	// WatchCatalogChanges replays catalog changes after a cursor, then tails new ones.
+	// A cursor older than the outbox retention window fails with FAILED_PRECONDITION;
+	// resync with snapshot=true.
+	WatchCatalogChanges(*WatchCatalogChangesRequest, grpc.ServerStreamingServer[WatchCatalogChangesResponse]) error
 	mustEmbedUnimplementedCatalogServiceServer()
 }
 
@@ -182,18 +568,99 @@ func (UnimplementedCatalogServiceServer) GetAnimeIDs(context.Context, *GetAnimeI
 func (UnimplementedCatalogServiceServer) GetEpisodesByAnimeID(context.Context, *GetEpisodesByAnimeIDRequest) (*GetEpisodesByAnimeIDResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetEpisodesByAnimeID not implemented")
 }
+func (UnimplementedCatalogServiceServer) GetEpisodesByAnimeIDs(context.Context, *GetEpisodesByAnimeIDsRequest) (*GetEpisodesByAnimeIDsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEpisodesByAnimeIDs not implemented")
+}
 func (UnimplementedCatalogServiceServer) AttachExternalAnimeID(context.Context, *AttachExternalAnimeIDRequest) (*AttachExternalAnimeIDResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method AttachExternalAnimeID not implemented")
 }
 func (UnimplementedCatalogServiceServer) ResolveAnimeIDByExternalID(context.Context, *ResolveAnimeIDByExternalIDRequest) (*ResolveAnimeIDByExternalIDResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ResolveAnimeIDByExternalID not implemented")
 }
+func (UnimplementedCatalogServiceServer) UpsertProviderMetadata(context.Context, *UpsertProviderMetadataRequest) (*UpsertProviderMetadataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpsertProviderMetadata not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListEpisodeProviders(context.Context, *ListEpisodeProvidersRequest) (*ListEpisodeProvidersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListEpisodeProviders not implemented")
+}
 func (UnimplementedCatalogServiceServer) UpsertHiAnimeEpisodes(context.Context, *UpsertHiAnimeEpisodesRequest) (*UpsertHiAnimeEpisodesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpsertHiAnimeEpisodes not implemented")
 }
+func (UnimplementedCatalogServiceServer) UpsertProviderEpisodes(context.Context, *UpsertProviderEpisodesRequest) (*UpsertProviderEpisodesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpsertProviderEpisodes not implemented")
+}
+func (UnimplementedCatalogServiceServer) RemoveProviderEpisodes(context.Context, *RemoveProviderEpisodesRequest) (*RemoveProviderEpisodesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveProviderEpisodes not implemented")
+}
+func (UnimplementedCatalogServiceServer) UpsertProviderSkipSegments(context.Context, *UpsertProviderSkipSegmentsRequest) (*UpsertProviderSkipSegmentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpsertProviderSkipSegments not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListSkipSegments(context.Context, *ListSkipSegmentsRequest) (*ListSkipSegmentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSkipSegments not implemented")
+}
+func (UnimplementedCatalogServiceServer) SubmitSkipSegment(context.Context, *SubmitSkipSegmentRequest) (*SubmitSkipSegmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitSkipSegment not implemented")
+}
+func (UnimplementedCatalogServiceServer) VoteSkipSegment(context.Context, *VoteSkipSegmentRequest) (*VoteSkipSegmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VoteSkipSegment not implemented")
+}
+func (UnimplementedCatalogServiceServer) UpsertJikanEpisodes(context.Context, *UpsertJikanEpisodesRequest) (*UpsertJikanEpisodesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpsertJikanEpisodes not implemented")
+}
 func (UnimplementedCatalogServiceServer) UpsertJikanAnime(context.Context, *UpsertJikanAnimeRequest) (*UpsertJikanAnimeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpsertJikanAnime not implemented")
 }
+func (UnimplementedCatalogServiceServer) MergeAnime(context.Context, *MergeAnimeRequest) (*MergeAnimeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MergeAnime not implemented")
+}
+func (UnimplementedCatalogServiceServer) SetAnimeAvailability(context.Context, *SetAnimeAvailabilityRequest) (*SetAnimeAvailabilityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetAnimeAvailability not implemented")
+}
+func (UnimplementedCatalogServiceServer) SetEpisodeAvailability(context.Context, *SetEpisodeAvailabilityRequest) (*SetEpisodeAvailabilityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetEpisodeAvailability not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetEpisodeAvailability(context.Context, *GetEpisodeAvailabilityRequest) (*GetEpisodeAvailabilityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEpisodeAvailability not implemented")
+}
+func (UnimplementedCatalogServiceServer) UpsertAnimeTranslation(context.Context, *UpsertAnimeTranslationRequest) (*UpsertAnimeTranslationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpsertAnimeTranslation not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListGenres(context.Context, *ListGenresRequest) (*ListGenresResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGenres not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetTrending(context.Context, *GetTrendingRequest) (*GetTrendingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTrending not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetAnimeHistory(context.Context, *GetAnimeHistoryRequest) (*GetAnimeHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAnimeHistory not implemented")
+}
+func (UnimplementedCatalogServiceServer) RevertAnime(context.Context, *RevertAnimeRequest) (*RevertAnimeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevertAnime not implemented")
+}
+func (UnimplementedCatalogServiceServer) CreateFranchise(context.Context, *CreateFranchiseRequest) (*CreateFranchiseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateFranchise not implemented")
+}
+func (UnimplementedCatalogServiceServer) UpdateFranchise(context.Context, *UpdateFranchiseRequest) (*UpdateFranchiseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateFranchise not implemented")
+}
+func (UnimplementedCatalogServiceServer) SetFranchiseEntries(context.Context, *SetFranchiseEntriesRequest) (*SetFranchiseEntriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetFranchiseEntries not implemented")
+}
+func (UnimplementedCatalogServiceServer) DeleteFranchise(context.Context, *DeleteFranchiseRequest) (*DeleteFranchiseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteFranchise not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetFranchise(context.Context, *GetFranchiseRequest) (*GetFranchiseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFranchise not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListFranchises(context.Context, *ListFranchisesRequest) (*ListFranchisesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFranchises not implemented")
+}
+func (UnimplementedCatalogServiceServer) SuggestFranchises(context.Context, *SuggestFranchisesRequest) (*SuggestFranchisesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SuggestFranchises not implemented")
+}
+func (UnimplementedCatalogServiceServer) WatchCatalogChanges(*WatchCatalogChangesRequest, grpc.ServerStreamingServer[WatchCatalogChangesResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchCatalogChanges not implemented")
+}
 func (UnimplementedCatalogServiceServer) mustEmbedUnimplementedCatalogServiceServer() {}
 func (UnimplementedCatalogServiceServer) testEmbeddedByValue()                        {}
 
@@ -305,6 +772,24 @@ func _CatalogService_GetEpisodesByAnimeID_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CatalogService_GetEpisodesByAnimeIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEpisodesByAnimeIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetEpisodesByAnimeIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetEpisodesByAnimeIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetEpisodesByAnimeIDs(ctx, req.(*GetEpisodesByAnimeIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CatalogService_AttachExternalAnimeID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AttachExternalAnimeIDRequest)
 	if err := dec(in); err != nil {
@@ -341,55 +826,516 @@ func _CatalogService_ResolveAnimeIDByExternalID_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CatalogService_UpsertHiAnimeEpisodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpsertHiAnimeEpisodesRequest)
+func _CatalogService_UpsertProviderMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertProviderMetadataRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CatalogServiceServer).UpsertHiAnimeEpisodes(ctx, in)
+		return srv.(CatalogServiceServer).UpsertProviderMetadata(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CatalogService_UpsertHiAnimeEpisodes_FullMethodName,
+		FullMethod: CatalogService_UpsertProviderMetadata_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CatalogServiceServer).UpsertHiAnimeEpisodes(ctx, req.(*UpsertHiAnimeEpisodesRequest))
+		return srv.(CatalogServiceServer).UpsertProviderMetadata(ctx, req.(*UpsertProviderMetadataRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CatalogService_UpsertJikanAnime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpsertJikanAnimeRequest)
+func _CatalogService_ListEpisodeProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEpisodeProvidersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CatalogServiceServer).UpsertJikanAnime(ctx, in)
+		return srv.(CatalogServiceServer).ListEpisodeProviders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CatalogService_UpsertJikanAnime_FullMethodName,
+		FullMethod: CatalogService_ListEpisodeProviders_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CatalogServiceServer).UpsertJikanAnime(ctx, req.(*UpsertJikanAnimeRequest))
+		return srv.(CatalogServiceServer).ListEpisodeProviders(ctx, req.(*ListEpisodeProvidersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// CatalogService_ServiceDesc is the grpc.ServiceDesc for CatalogService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var CatalogService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "catalog.v1.CatalogService",
-	HandlerType: (*CatalogServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetEpisodesByIDs",
-			Handler:    _CatalogService_GetEpisodesByIDs_Handler,
-		},
-		{
-			MethodName: "GetProviderEpisodeID",
+func _CatalogService_UpsertHiAnimeEpisodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertHiAnimeEpisodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).UpsertHiAnimeEpisodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_UpsertHiAnimeEpisodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).UpsertHiAnimeEpisodes(ctx, req.(*UpsertHiAnimeEpisodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_UpsertProviderEpisodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertProviderEpisodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).UpsertProviderEpisodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_UpsertProviderEpisodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).UpsertProviderEpisodes(ctx, req.(*UpsertProviderEpisodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_RemoveProviderEpisodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveProviderEpisodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).RemoveProviderEpisodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_RemoveProviderEpisodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).RemoveProviderEpisodes(ctx, req.(*RemoveProviderEpisodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_UpsertProviderSkipSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertProviderSkipSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).UpsertProviderSkipSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_UpsertProviderSkipSegments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).UpsertProviderSkipSegments(ctx, req.(*UpsertProviderSkipSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListSkipSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSkipSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListSkipSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ListSkipSegments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListSkipSegments(ctx, req.(*ListSkipSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_SubmitSkipSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitSkipSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).SubmitSkipSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_SubmitSkipSegment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).SubmitSkipSegment(ctx, req.(*SubmitSkipSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_VoteSkipSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteSkipSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).VoteSkipSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_VoteSkipSegment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).VoteSkipSegment(ctx, req.(*VoteSkipSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_UpsertJikanEpisodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertJikanEpisodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).UpsertJikanEpisodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_UpsertJikanEpisodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).UpsertJikanEpisodes(ctx, req.(*UpsertJikanEpisodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_UpsertJikanAnime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertJikanAnimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).UpsertJikanAnime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_UpsertJikanAnime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).UpsertJikanAnime(ctx, req.(*UpsertJikanAnimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_MergeAnime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeAnimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).MergeAnime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_MergeAnime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).MergeAnime(ctx, req.(*MergeAnimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_SetAnimeAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAnimeAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).SetAnimeAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_SetAnimeAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).SetAnimeAvailability(ctx, req.(*SetAnimeAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_SetEpisodeAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEpisodeAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).SetEpisodeAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_SetEpisodeAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).SetEpisodeAvailability(ctx, req.(*SetEpisodeAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetEpisodeAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEpisodeAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetEpisodeAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetEpisodeAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetEpisodeAvailability(ctx, req.(*GetEpisodeAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_UpsertAnimeTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertAnimeTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).UpsertAnimeTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_UpsertAnimeTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).UpsertAnimeTranslation(ctx, req.(*UpsertAnimeTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListGenres_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGenresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListGenres(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ListGenres_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListGenres(ctx, req.(*ListGenresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetTrending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrendingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetTrending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetTrending_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetTrending(ctx, req.(*GetTrendingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetAnimeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnimeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetAnimeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetAnimeHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetAnimeHistory(ctx, req.(*GetAnimeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_RevertAnime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertAnimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).RevertAnime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_RevertAnime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).RevertAnime(ctx, req.(*RevertAnimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_CreateFranchise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFranchiseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).CreateFranchise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_CreateFranchise_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).CreateFranchise(ctx, req.(*CreateFranchiseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_UpdateFranchise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFranchiseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).UpdateFranchise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_UpdateFranchise_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).UpdateFranchise(ctx, req.(*UpdateFranchiseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_SetFranchiseEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFranchiseEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).SetFranchiseEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_SetFranchiseEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).SetFranchiseEntries(ctx, req.(*SetFranchiseEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_DeleteFranchise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFranchiseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).DeleteFranchise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_DeleteFranchise_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).DeleteFranchise(ctx, req.(*DeleteFranchiseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetFranchise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFranchiseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetFranchise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetFranchise_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetFranchise(ctx, req.(*GetFranchiseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListFranchises_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFranchisesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListFranchises(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ListFranchises_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListFranchises(ctx, req.(*ListFranchisesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_SuggestFranchises_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestFranchisesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).SuggestFranchises(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_SuggestFranchises_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).SuggestFranchises(ctx, req.(*SuggestFranchisesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_WatchCatalogChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchCatalogChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CatalogServiceServer).WatchCatalogChanges(m, &grpc.GenericServerStream[WatchCatalogChangesRequest, WatchCatalogChangesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CatalogService_WatchCatalogChangesServer = grpc.ServerStreamingServer[WatchCatalogChangesResponse]
+
+// CatalogService_ServiceDesc is the grpc.ServiceDesc for CatalogService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CatalogService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "catalog.v1.CatalogService",
+	HandlerType: (*CatalogServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetEpisodesByIDs",
+			Handler:    _CatalogService_GetEpisodesByIDs_Handler,
+		},
+		{
+			MethodName: "GetProviderEpisodeID",
 			Handler:    _CatalogService_GetProviderEpisodeID_Handler,
 		},
 		{
@@ -404,6 +1350,10 @@ var CatalogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEpisodesByAnimeID",
 			Handler:    _CatalogService_GetEpisodesByAnimeID_Handler,
 		},
+		{
+			MethodName: "GetEpisodesByAnimeIDs",
+			Handler:    _CatalogService_GetEpisodesByAnimeIDs_Handler,
+		},
 		{
 			MethodName: "AttachExternalAnimeID",
 			Handler:    _CatalogService_AttachExternalAnimeID_Handler,
@@ -412,15 +1362,121 @@ var CatalogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResolveAnimeIDByExternalID",
 			Handler:    _CatalogService_ResolveAnimeIDByExternalID_Handler,
 		},
+		{
+			MethodName: "UpsertProviderMetadata",
+			Handler:    _CatalogService_UpsertProviderMetadata_Handler,
+		},
+		{
+			MethodName: "ListEpisodeProviders",
+			Handler:    _CatalogService_ListEpisodeProviders_Handler,
+		},
 		{
 			MethodName: "UpsertHiAnimeEpisodes",
 			Handler:    _CatalogService_UpsertHiAnimeEpisodes_Handler,
 		},
+		{
+			MethodName: "UpsertProviderEpisodes",
+			Handler:    _CatalogService_UpsertProviderEpisodes_Handler,
+		},
+		{
+			MethodName: "RemoveProviderEpisodes",
+			Handler:    _CatalogService_RemoveProviderEpisodes_Handler,
+		},
+		{
+			MethodName: "UpsertProviderSkipSegments",
+			Handler:    _CatalogService_UpsertProviderSkipSegments_Handler,
+		},
+		{
+			MethodName: "ListSkipSegments",
+			Handler:    _CatalogService_ListSkipSegments_Handler,
+		},
+		{
+			MethodName: "SubmitSkipSegment",
+			Handler:    _CatalogService_SubmitSkipSegment_Handler,
+		},
+		{
+			MethodName: "VoteSkipSegment",
+			Handler:    _CatalogService_VoteSkipSegment_Handler,
+		},
+		{
+			MethodName: "UpsertJikanEpisodes",
+			Handler:    _CatalogService_UpsertJikanEpisodes_Handler,
+		},
 		{
 			MethodName: "UpsertJikanAnime",
 			Handler:    _CatalogService_UpsertJikanAnime_Handler,
 		},
+		{
+			MethodName: "MergeAnime",
+			Handler:    _CatalogService_MergeAnime_Handler,
+		},
+		{
+			MethodName: "SetAnimeAvailability",
+			Handler:    _CatalogService_SetAnimeAvailability_Handler,
+		},
+		{
+			MethodName: "SetEpisodeAvailability",
+			Handler:    _CatalogService_SetEpisodeAvailability_Handler,
+		},
+		{
+			MethodName: "GetEpisodeAvailability",
+			Handler:    _CatalogService_GetEpisodeAvailability_Handler,
+		},
+		{
+			MethodName: "UpsertAnimeTranslation",
+			Handler:    _CatalogService_UpsertAnimeTranslation_Handler,
+		},
+		{
+			MethodName: "ListGenres",
+			Handler:    _CatalogService_ListGenres_Handler,
+		},
+		{
+			MethodName: "GetTrending",
+			Handler:    _CatalogService_GetTrending_Handler,
+		},
+		{
+			MethodName: "GetAnimeHistory",
+			Handler:    _CatalogService_GetAnimeHistory_Handler,
+		},
+		{
+			MethodName: "RevertAnime",
+			Handler:    _CatalogService_RevertAnime_Handler,
+		},
+		{
+			MethodName: "CreateFranchise",
+			Handler:    _CatalogService_CreateFranchise_Handler,
+		},
+		{
+			MethodName: "UpdateFranchise",
+			Handler:    _CatalogService_UpdateFranchise_Handler,
+		},
+		{
+			MethodName: "SetFranchiseEntries",
+			Handler:    _CatalogService_SetFranchiseEntries_Handler,
+		},
+		{
+			MethodName: "DeleteFranchise",
+			Handler:    _CatalogService_DeleteFranchise_Handler,
+		},
+		{
+			MethodName: "GetFranchise",
+			Handler:    _CatalogService_GetFranchise_Handler,
+		},
+		{
+			MethodName: "ListFranchises",
+			Handler:    _CatalogService_ListFranchises_Handler,
+		},
+		{
+			MethodName: "SuggestFranchises",
+			Handler:    _CatalogService_SuggestFranchises_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchCatalogChanges",
+			Handler:       _CatalogService_WatchCatalogChanges_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "catalog/v1/catalog.proto",
 }
@@ -0,0 +1,3206 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: ingestion/v1/ingestion.proto
+
+package ingestionv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Schedule is a recurring ingestion job fired from a cron expression (UTC).
+type Schedule struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Cron             string                 `protobuf:"bytes,2,opt,name=cron,proto3" json:"cron,omitempty"`
+	Job              string                 `protobuf:"bytes,3,opt,name=job,proto3" json:"job,omitempty"` // runner name, e.g. "jikan.top"
+	ArgsJson         string                 `protobuf:"bytes,4,opt,name=args_json,json=argsJson,proto3" json:"args_json,omitempty"`
+	Paused           bool                   `protobuf:"varint,5,opt,name=paused,proto3" json:"paused,omitempty"`
+	NextRunAtRfc3339 string                 `protobuf:"bytes,6,opt,name=next_run_at_rfc3339,json=nextRunAtRfc3339,proto3" json:"next_run_at_rfc3339,omitempty"` // empty when the schedule never fires
+	LastRunAtRfc3339 string                 `protobuf:"bytes,7,opt,name=last_run_at_rfc3339,json=lastRunAtRfc3339,proto3" json:"last_run_at_rfc3339,omitempty"`
+	LastDurationMs   int64                  `protobuf:"varint,8,opt,name=last_duration_ms,json=lastDurationMs,proto3" json:"last_duration_ms,omitempty"`
+	LastStatus       string                 `protobuf:"bytes,9,opt,name=last_status,json=lastStatus,proto3" json:"last_status,omitempty"` // "ok", "failed" or empty before the first run
+	LastError        string                 `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule) ProtoMessage() {}
+
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Schedule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Schedule) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *Schedule) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+func (x *Schedule) GetArgsJson() string {
+	if x != nil {
+		return x.ArgsJson
+	}
+	return ""
+}
+
+func (x *Schedule) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *Schedule) GetNextRunAtRfc3339() string {
+	if x != nil {
+		return x.NextRunAtRfc3339
+	}
+	return ""
+}
+
+func (x *Schedule) GetLastRunAtRfc3339() string {
+	if x != nil {
+		return x.LastRunAtRfc3339
+	}
+	return ""
+}
+
+func (x *Schedule) GetLastDurationMs() int64 {
+	if x != nil {
+		return x.LastDurationMs
+	}
+	return 0
+}
+
+func (x *Schedule) GetLastStatus() string {
+	if x != nil {
+		return x.LastStatus
+	}
+	return ""
+}
+
+func (x *Schedule) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+type ListSchedulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesRequest) Reset() {
+	*x = ListSchedulesRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesRequest) ProtoMessage() {}
+
+func (x *ListSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{1}
+}
+
+type ListSchedulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedules     []*Schedule            `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesResponse) Reset() {
+	*x = ListSchedulesResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesResponse) ProtoMessage() {}
+
+func (x *ListSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListSchedulesResponse) GetSchedules() []*Schedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+type UpdateScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Cron          string                 `protobuf:"bytes,2,opt,name=cron,proto3" json:"cron,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateScheduleRequest) Reset() {
+	*x = UpdateScheduleRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScheduleRequest) ProtoMessage() {}
+
+func (x *UpdateScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateScheduleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+type UpdateScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateScheduleResponse) Reset() {
+	*x = UpdateScheduleResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScheduleResponse) ProtoMessage() {}
+
+func (x *UpdateScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScheduleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateScheduleResponse) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type PauseScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseScheduleRequest) Reset() {
+	*x = PauseScheduleRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseScheduleRequest) ProtoMessage() {}
+
+func (x *PauseScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseScheduleRequest.ProtoReflect.Descriptor instead.
+func (*PauseScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PauseScheduleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PauseScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseScheduleResponse) Reset() {
+	*x = PauseScheduleResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseScheduleResponse) ProtoMessage() {}
+
+func (x *PauseScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseScheduleResponse.ProtoReflect.Descriptor instead.
+func (*PauseScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PauseScheduleResponse) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+// ResumeScheduleRequest resumes a paused schedule from its next cron time;
+// runs missed while paused are skipped.
+type ResumeScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeScheduleRequest) Reset() {
+	*x = ResumeScheduleRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeScheduleRequest) ProtoMessage() {}
+
+func (x *ResumeScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeScheduleRequest.ProtoReflect.Descriptor instead.
+func (*ResumeScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ResumeScheduleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ResumeScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeScheduleResponse) Reset() {
+	*x = ResumeScheduleResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeScheduleResponse) ProtoMessage() {}
+
+func (x *ResumeScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeScheduleResponse.ProtoReflect.Descriptor instead.
+func (*ResumeScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ResumeScheduleResponse) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+// TriggerScheduleRequest runs a schedule on the next scheduler check, even if paused.
+type TriggerScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerScheduleRequest) Reset() {
+	*x = TriggerScheduleRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerScheduleRequest) ProtoMessage() {}
+
+func (x *TriggerScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerScheduleRequest.ProtoReflect.Descriptor instead.
+func (*TriggerScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TriggerScheduleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type TriggerScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerScheduleResponse) Reset() {
+	*x = TriggerScheduleResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerScheduleResponse) ProtoMessage() {}
+
+func (x *TriggerScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerScheduleResponse.ProtoReflect.Descriptor instead.
+func (*TriggerScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TriggerScheduleResponse) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+// Job is one enqueued ingestion job. type is the NATS subject without the
+// "ingestion." prefix, e.g. "jikan.sync".
+type Job struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type              string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	PayloadJson       string                 `protobuf:"bytes,3,opt,name=payload_json,json=payloadJson,proto3" json:"payload_json,omitempty"`
+	Status            string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // queued, running, retrying, succeeded or failed
+	Attempts          int32                  `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError         string                 `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	EnqueuedAtRfc3339 string                 `protobuf:"bytes,7,opt,name=enqueued_at_rfc3339,json=enqueuedAtRfc3339,proto3" json:"enqueued_at_rfc3339,omitempty"`
+	StartedAtRfc3339  string                 `protobuf:"bytes,8,opt,name=started_at_rfc3339,json=startedAtRfc3339,proto3" json:"started_at_rfc3339,omitempty"`
+	FinishedAtRfc3339 string                 `protobuf:"bytes,9,opt,name=finished_at_rfc3339,json=finishedAtRfc3339,proto3" json:"finished_at_rfc3339,omitempty"`
+	UpdatedAtRfc3339  string                 `protobuf:"bytes,10,opt,name=updated_at_rfc3339,json=updatedAtRfc3339,proto3" json:"updated_at_rfc3339,omitempty"`
+	ReplayOfDlqId     string                 `protobuf:"bytes,11,opt,name=replay_of_dlq_id,json=replayOfDlqId,proto3" json:"replay_of_dlq_id,omitempty"` // set when the job replays a dead-letter entry
+	ReportJson        string                 `protobuf:"bytes,12,opt,name=report_json,json=reportJson,proto3" json:"report_json,omitempty"`              // what a dry-run job would have changed
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Job) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Job) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Job) GetPayloadJson() string {
+	if x != nil {
+		return x.PayloadJson
+	}
+	return ""
+}
+
+func (x *Job) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Job) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Job) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Job) GetEnqueuedAtRfc3339() string {
+	if x != nil {
+		return x.EnqueuedAtRfc3339
+	}
+	return ""
+}
+
+func (x *Job) GetStartedAtRfc3339() string {
+	if x != nil {
+		return x.StartedAtRfc3339
+	}
+	return ""
+}
+
+func (x *Job) GetFinishedAtRfc3339() string {
+	if x != nil {
+		return x.FinishedAtRfc3339
+	}
+	return ""
+}
+
+func (x *Job) GetUpdatedAtRfc3339() string {
+	if x != nil {
+		return x.UpdatedAtRfc3339
+	}
+	return ""
+}
+
+func (x *Job) GetReplayOfDlqId() string {
+	if x != nil {
+		return x.ReplayOfDlqId
+	}
+	return ""
+}
+
+func (x *Job) GetReportJson() string {
+	if x != nil {
+		return x.ReportJson
+	}
+	return ""
+}
+
+// JobAttempt is one delivery of a job to its handler.
+type JobAttempt struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Attempt           int32                  `protobuf:"varint,1,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	StartedAtRfc3339  string                 `protobuf:"bytes,2,opt,name=started_at_rfc3339,json=startedAtRfc3339,proto3" json:"started_at_rfc3339,omitempty"`
+	FinishedAtRfc3339 string                 `protobuf:"bytes,3,opt,name=finished_at_rfc3339,json=finishedAtRfc3339,proto3" json:"finished_at_rfc3339,omitempty"`
+	Error             string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *JobAttempt) Reset() {
+	*x = JobAttempt{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobAttempt) ProtoMessage() {}
+
+func (x *JobAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobAttempt.ProtoReflect.Descriptor instead.
+func (*JobAttempt) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *JobAttempt) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *JobAttempt) GetStartedAtRfc3339() string {
+	if x != nil {
+		return x.StartedAtRfc3339
+	}
+	return ""
+}
+
+func (x *JobAttempt) GetFinishedAtRfc3339() string {
+	if x != nil {
+		return x.FinishedAtRfc3339
+	}
+	return ""
+}
+
+func (x *JobAttempt) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ListJobsRequest filters jobs by enqueue time, newest first. Empty fields
+// match everything.
+type ListJobsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	MalId         int32                  `protobuf:"varint,3,opt,name=mal_id,json=malId,proto3" json:"mal_id,omitempty"`
+	SinceRfc3339  string                 `protobuf:"bytes,4,opt,name=since_rfc3339,json=sinceRfc3339,proto3" json:"since_rfc3339,omitempty"`
+	UntilRfc3339  string                 `protobuf:"bytes,5,opt,name=until_rfc3339,json=untilRfc3339,proto3" json:"until_rfc3339,omitempty"`
+	Limit         int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"` // default 50, max 500
+	Offset        int32                  `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobsRequest) Reset() {
+	*x = ListJobsRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsRequest) ProtoMessage() {}
+
+func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListJobsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListJobsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListJobsRequest) GetMalId() int32 {
+	if x != nil {
+		return x.MalId
+	}
+	return 0
+}
+
+func (x *ListJobsRequest) GetSinceRfc3339() string {
+	if x != nil {
+		return x.SinceRfc3339
+	}
+	return ""
+}
+
+func (x *ListJobsRequest) GetUntilRfc3339() string {
+	if x != nil {
+		return x.UntilRfc3339
+	}
+	return ""
+}
+
+func (x *ListJobsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListJobsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListJobsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jobs          []*Job                 `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobsResponse) Reset() {
+	*x = ListJobsResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsResponse) ProtoMessage() {}
+
+func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListJobsResponse) GetJobs() []*Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type GetJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobRequest) Reset() {
+	*x = GetJobRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobRequest) ProtoMessage() {}
+
+func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
+func (*GetJobRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *Job                   `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Attempts      []*JobAttempt          `protobuf:"bytes,2,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobResponse) Reset() {
+	*x = GetJobResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobResponse) ProtoMessage() {}
+
+func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobResponse.ProtoReflect.Descriptor instead.
+func (*GetJobResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetJobResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *GetJobResponse) GetAttempts() []*JobAttempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+// DLQEntry is a job that exhausted its deliveries. job_id is the failed job
+// and replayed_job_id the job created when the entry was replayed.
+type DLQEntry struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Subject           string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"` // original subject, e.g. "ingestion.hianime.sync"
+	Type              string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Reason            string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	PayloadJson       string                 `protobuf:"bytes,5,opt,name=payload_json,json=payloadJson,proto3" json:"payload_json,omitempty"`
+	JobId             string                 `protobuf:"bytes,6,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Status            string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // pending, replayed or discarded
+	ReplayedJobId     string                 `protobuf:"bytes,8,opt,name=replayed_job_id,json=replayedJobId,proto3" json:"replayed_job_id,omitempty"`
+	FailedAtRfc3339   string                 `protobuf:"bytes,9,opt,name=failed_at_rfc3339,json=failedAtRfc3339,proto3" json:"failed_at_rfc3339,omitempty"`
+	ResolvedAtRfc3339 string                 `protobuf:"bytes,10,opt,name=resolved_at_rfc3339,json=resolvedAtRfc3339,proto3" json:"resolved_at_rfc3339,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DLQEntry) Reset() {
+	*x = DLQEntry{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DLQEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DLQEntry) ProtoMessage() {}
+
+func (x *DLQEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DLQEntry.ProtoReflect.Descriptor instead.
+func (*DLQEntry) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DLQEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DLQEntry) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *DLQEntry) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DLQEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DLQEntry) GetPayloadJson() string {
+	if x != nil {
+		return x.PayloadJson
+	}
+	return ""
+}
+
+func (x *DLQEntry) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *DLQEntry) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DLQEntry) GetReplayedJobId() string {
+	if x != nil {
+		return x.ReplayedJobId
+	}
+	return ""
+}
+
+func (x *DLQEntry) GetFailedAtRfc3339() string {
+	if x != nil {
+		return x.FailedAtRfc3339
+	}
+	return ""
+}
+
+func (x *DLQEntry) GetResolvedAtRfc3339() string {
+	if x != nil {
+		return x.ResolvedAtRfc3339
+	}
+	return ""
+}
+
+// DLQFilter selects pending entries for bulk replay or discard by job type
+// and failure time. At least one field must be set.
+type DLQFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	SinceRfc3339  string                 `protobuf:"bytes,2,opt,name=since_rfc3339,json=sinceRfc3339,proto3" json:"since_rfc3339,omitempty"`
+	UntilRfc3339  string                 `protobuf:"bytes,3,opt,name=until_rfc3339,json=untilRfc3339,proto3" json:"until_rfc3339,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DLQFilter) Reset() {
+	*x = DLQFilter{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DLQFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DLQFilter) ProtoMessage() {}
+
+func (x *DLQFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DLQFilter.ProtoReflect.Descriptor instead.
+func (*DLQFilter) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DLQFilter) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DLQFilter) GetSinceRfc3339() string {
+	if x != nil {
+		return x.SinceRfc3339
+	}
+	return ""
+}
+
+func (x *DLQFilter) GetUntilRfc3339() string {
+	if x != nil {
+		return x.UntilRfc3339
+	}
+	return ""
+}
+
+type ListDLQEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	SinceRfc3339  string                 `protobuf:"bytes,3,opt,name=since_rfc3339,json=sinceRfc3339,proto3" json:"since_rfc3339,omitempty"`
+	UntilRfc3339  string                 `protobuf:"bytes,4,opt,name=until_rfc3339,json=untilRfc3339,proto3" json:"until_rfc3339,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"` // default 50, max 500
+	Offset        int32                  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDLQEntriesRequest) Reset() {
+	*x = ListDLQEntriesRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDLQEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDLQEntriesRequest) ProtoMessage() {}
+
+func (x *ListDLQEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDLQEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListDLQEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListDLQEntriesRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListDLQEntriesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListDLQEntriesRequest) GetSinceRfc3339() string {
+	if x != nil {
+		return x.SinceRfc3339
+	}
+	return ""
+}
+
+func (x *ListDLQEntriesRequest) GetUntilRfc3339() string {
+	if x != nil {
+		return x.UntilRfc3339
+	}
+	return ""
+}
+
+func (x *ListDLQEntriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListDLQEntriesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListDLQEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*DLQEntry            `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDLQEntriesResponse) Reset() {
+	*x = ListDLQEntriesResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDLQEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDLQEntriesResponse) ProtoMessage() {}
+
+func (x *ListDLQEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDLQEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListDLQEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListDLQEntriesResponse) GetEntries() []*DLQEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type ReplayDLQEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDLQEntryRequest) Reset() {
+	*x = ReplayDLQEntryRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDLQEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDLQEntryRequest) ProtoMessage() {}
+
+func (x *ReplayDLQEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDLQEntryRequest.ProtoReflect.Descriptor instead.
+func (*ReplayDLQEntryRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReplayDLQEntryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ReplayDLQEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *DLQEntry              `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDLQEntryResponse) Reset() {
+	*x = ReplayDLQEntryResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDLQEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDLQEntryResponse) ProtoMessage() {}
+
+func (x *ReplayDLQEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDLQEntryResponse.ProtoReflect.Descriptor instead.
+func (*ReplayDLQEntryResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ReplayDLQEntryResponse) GetEntry() *DLQEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+// ReplayDLQEntriesRequest replays up to limit (default 100, max 1000) pending
+// entries matching filter.
+type ReplayDLQEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *DLQFilter             `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDLQEntriesRequest) Reset() {
+	*x = ReplayDLQEntriesRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDLQEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDLQEntriesRequest) ProtoMessage() {}
+
+func (x *ReplayDLQEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDLQEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ReplayDLQEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReplayDLQEntriesRequest) GetFilter() *DLQFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ReplayDLQEntriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ReplayDLQEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Replayed      []*DLQEntry            `protobuf:"bytes,1,rep,name=replayed,proto3" json:"replayed,omitempty"`
+	Failed        int32                  `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"` // entries that could not be replayed and stay pending
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDLQEntriesResponse) Reset() {
+	*x = ReplayDLQEntriesResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDLQEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDLQEntriesResponse) ProtoMessage() {}
+
+func (x *ReplayDLQEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDLQEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ReplayDLQEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReplayDLQEntriesResponse) GetReplayed() []*DLQEntry {
+	if x != nil {
+		return x.Replayed
+	}
+	return nil
+}
+
+func (x *ReplayDLQEntriesResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type DiscardDLQEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscardDLQEntryRequest) Reset() {
+	*x = DiscardDLQEntryRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscardDLQEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardDLQEntryRequest) ProtoMessage() {}
+
+func (x *DiscardDLQEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardDLQEntryRequest.ProtoReflect.Descriptor instead.
+func (*DiscardDLQEntryRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DiscardDLQEntryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DiscardDLQEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscardDLQEntryResponse) Reset() {
+	*x = DiscardDLQEntryResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscardDLQEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardDLQEntryResponse) ProtoMessage() {}
+
+func (x *DiscardDLQEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardDLQEntryResponse.ProtoReflect.Descriptor instead.
+func (*DiscardDLQEntryResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{26}
+}
+
+// DiscardDLQEntriesRequest discards up to limit (default 100, max 1000)
+// pending entries matching filter.
+type DiscardDLQEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *DLQFilter             `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscardDLQEntriesRequest) Reset() {
+	*x = DiscardDLQEntriesRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscardDLQEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardDLQEntriesRequest) ProtoMessage() {}
+
+func (x *DiscardDLQEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardDLQEntriesRequest.ProtoReflect.Descriptor instead.
+func (*DiscardDLQEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DiscardDLQEntriesRequest) GetFilter() *DLQFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *DiscardDLQEntriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type DiscardDLQEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Discarded     int32                  `protobuf:"varint,1,opt,name=discarded,proto3" json:"discarded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscardDLQEntriesResponse) Reset() {
+	*x = DiscardDLQEntriesResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscardDLQEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardDLQEntriesResponse) ProtoMessage() {}
+
+func (x *DiscardDLQEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardDLQEntriesResponse.ProtoReflect.Descriptor instead.
+func (*DiscardDLQEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DiscardDLQEntriesResponse) GetDiscarded() int32 {
+	if x != nil {
+		return x.Discarded
+	}
+	return 0
+}
+
+// HiAnimeMapping pins a MAL ID to a HiAnime slug; syncs for the MAL ID use
+// the slug instead of title matching.
+type HiAnimeMapping struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MalId            int32                  `protobuf:"varint,1,opt,name=mal_id,json=malId,proto3" json:"mal_id,omitempty"`
+	Slug             string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Note             string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAtRfc3339 string                 `protobuf:"bytes,4,opt,name=created_at_rfc3339,json=createdAtRfc3339,proto3" json:"created_at_rfc3339,omitempty"`
+	UpdatedAtRfc3339 string                 `protobuf:"bytes,5,opt,name=updated_at_rfc3339,json=updatedAtRfc3339,proto3" json:"updated_at_rfc3339,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *HiAnimeMapping) Reset() {
+	*x = HiAnimeMapping{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HiAnimeMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HiAnimeMapping) ProtoMessage() {}
+
+func (x *HiAnimeMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HiAnimeMapping.ProtoReflect.Descriptor instead.
+func (*HiAnimeMapping) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *HiAnimeMapping) GetMalId() int32 {
+	if x != nil {
+		return x.MalId
+	}
+	return 0
+}
+
+func (x *HiAnimeMapping) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *HiAnimeMapping) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *HiAnimeMapping) GetCreatedAtRfc3339() string {
+	if x != nil {
+		return x.CreatedAtRfc3339
+	}
+	return ""
+}
+
+func (x *HiAnimeMapping) GetUpdatedAtRfc3339() string {
+	if x != nil {
+		return x.UpdatedAtRfc3339
+	}
+	return ""
+}
+
+type ListHiAnimeMappingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // default 50, max 500
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHiAnimeMappingsRequest) Reset() {
+	*x = ListHiAnimeMappingsRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHiAnimeMappingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHiAnimeMappingsRequest) ProtoMessage() {}
+
+func (x *ListHiAnimeMappingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHiAnimeMappingsRequest.ProtoReflect.Descriptor instead.
+func (*ListHiAnimeMappingsRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListHiAnimeMappingsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListHiAnimeMappingsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListHiAnimeMappingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mappings      []*HiAnimeMapping      `protobuf:"bytes,1,rep,name=mappings,proto3" json:"mappings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHiAnimeMappingsResponse) Reset() {
+	*x = ListHiAnimeMappingsResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHiAnimeMappingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHiAnimeMappingsResponse) ProtoMessage() {}
+
+func (x *ListHiAnimeMappingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHiAnimeMappingsResponse.ProtoReflect.Descriptor instead.
+func (*ListHiAnimeMappingsResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListHiAnimeMappingsResponse) GetMappings() []*HiAnimeMapping {
+	if x != nil {
+		return x.Mappings
+	}
+	return nil
+}
+
+// SetHiAnimeMappingRequest creates or replaces the mapping for mal_id. The
+// slug must exist on HiAnime. With sync set, a hianime.sync job is enqueued
+// for the MAL ID once the mapping is stored.
+type SetHiAnimeMappingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MalId         int32                  `protobuf:"varint,1,opt,name=mal_id,json=malId,proto3" json:"mal_id,omitempty"`
+	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	Sync          bool                   `protobuf:"varint,4,opt,name=sync,proto3" json:"sync,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetHiAnimeMappingRequest) Reset() {
+	*x = SetHiAnimeMappingRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetHiAnimeMappingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHiAnimeMappingRequest) ProtoMessage() {}
+
+func (x *SetHiAnimeMappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHiAnimeMappingRequest.ProtoReflect.Descriptor instead.
+func (*SetHiAnimeMappingRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetHiAnimeMappingRequest) GetMalId() int32 {
+	if x != nil {
+		return x.MalId
+	}
+	return 0
+}
+
+func (x *SetHiAnimeMappingRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *SetHiAnimeMappingRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *SetHiAnimeMappingRequest) GetSync() bool {
+	if x != nil {
+		return x.Sync
+	}
+	return false
+}
+
+type SetHiAnimeMappingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mapping       *HiAnimeMapping        `protobuf:"bytes,1,opt,name=mapping,proto3" json:"mapping,omitempty"`
+	HianimeName   string                 `protobuf:"bytes,2,opt,name=hianime_name,json=hianimeName,proto3" json:"hianime_name,omitempty"`
+	HianimeMalId  int32                  `protobuf:"varint,3,opt,name=hianime_mal_id,json=hianimeMalId,proto3" json:"hianime_mal_id,omitempty"` // HiAnime's own MAL ID for the slug, 0 if unknown
+	JobId         string                 `protobuf:"bytes,4,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                         // set when sync was requested
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetHiAnimeMappingResponse) Reset() {
+	*x = SetHiAnimeMappingResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetHiAnimeMappingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHiAnimeMappingResponse) ProtoMessage() {}
+
+func (x *SetHiAnimeMappingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHiAnimeMappingResponse.ProtoReflect.Descriptor instead.
+func (*SetHiAnimeMappingResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SetHiAnimeMappingResponse) GetMapping() *HiAnimeMapping {
+	if x != nil {
+		return x.Mapping
+	}
+	return nil
+}
+
+func (x *SetHiAnimeMappingResponse) GetHianimeName() string {
+	if x != nil {
+		return x.HianimeName
+	}
+	return ""
+}
+
+func (x *SetHiAnimeMappingResponse) GetHianimeMalId() int32 {
+	if x != nil {
+		return x.HianimeMalId
+	}
+	return 0
+}
+
+func (x *SetHiAnimeMappingResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type DeleteHiAnimeMappingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MalId         int32                  `protobuf:"varint,1,opt,name=mal_id,json=malId,proto3" json:"mal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHiAnimeMappingRequest) Reset() {
+	*x = DeleteHiAnimeMappingRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHiAnimeMappingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHiAnimeMappingRequest) ProtoMessage() {}
+
+func (x *DeleteHiAnimeMappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHiAnimeMappingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteHiAnimeMappingRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteHiAnimeMappingRequest) GetMalId() int32 {
+	if x != nil {
+		return x.MalId
+	}
+	return 0
+}
+
+type DeleteHiAnimeMappingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHiAnimeMappingResponse) Reset() {
+	*x = DeleteHiAnimeMappingResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHiAnimeMappingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHiAnimeMappingResponse) ProtoMessage() {}
+
+func (x *DeleteHiAnimeMappingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHiAnimeMappingResponse.ProtoReflect.Descriptor instead.
+func (*DeleteHiAnimeMappingResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{35}
+}
+
+// EpisodeSyncResult is the latest episode sync of one anime from one
+// provider. The counts describe what that sync changed; error is set when it
+// failed, in which case the other fields are from the last successful sync.
+type EpisodeSyncResult struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Provider            string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	MalId               int32                  `protobuf:"varint,2,opt,name=mal_id,json=malId,proto3" json:"mal_id,omitempty"`
+	AnimeId             string                 `protobuf:"bytes,3,opt,name=anime_id,json=animeId,proto3" json:"anime_id,omitempty"`
+	ProviderAnimeId     string                 `protobuf:"bytes,4,opt,name=provider_anime_id,json=providerAnimeId,proto3" json:"provider_anime_id,omitempty"`
+	Airing              bool                   `protobuf:"varint,5,opt,name=airing,proto3" json:"airing,omitempty"`
+	Episodes            int32                  `protobuf:"varint,6,opt,name=episodes,proto3" json:"episodes,omitempty"`
+	Added               int32                  `protobuf:"varint,7,opt,name=added,proto3" json:"added,omitempty"`
+	Renamed             int32                  `protobuf:"varint,8,opt,name=renamed,proto3" json:"renamed,omitempty"`
+	Renumbered          int32                  `protobuf:"varint,9,opt,name=renumbered,proto3" json:"renumbered,omitempty"`
+	FillerChanged       int32                  `protobuf:"varint,10,opt,name=filler_changed,json=fillerChanged,proto3" json:"filler_changed,omitempty"`
+	AvailabilityChanged int32                  `protobuf:"varint,11,opt,name=availability_changed,json=availabilityChanged,proto3" json:"availability_changed,omitempty"`
+	Removed             int32                  `protobuf:"varint,12,opt,name=removed,proto3" json:"removed,omitempty"`
+	Error               string                 `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
+	SyncedAtRfc3339     string                 `protobuf:"bytes,14,opt,name=synced_at_rfc3339,json=syncedAtRfc3339,proto3" json:"synced_at_rfc3339,omitempty"`
+	ChangedAtRfc3339    string                 `protobuf:"bytes,15,opt,name=changed_at_rfc3339,json=changedAtRfc3339,proto3" json:"changed_at_rfc3339,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *EpisodeSyncResult) Reset() {
+	*x = EpisodeSyncResult{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EpisodeSyncResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpisodeSyncResult) ProtoMessage() {}
+
+func (x *EpisodeSyncResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpisodeSyncResult.ProtoReflect.Descriptor instead.
+func (*EpisodeSyncResult) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *EpisodeSyncResult) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *EpisodeSyncResult) GetMalId() int32 {
+	if x != nil {
+		return x.MalId
+	}
+	return 0
+}
+
+func (x *EpisodeSyncResult) GetAnimeId() string {
+	if x != nil {
+		return x.AnimeId
+	}
+	return ""
+}
+
+func (x *EpisodeSyncResult) GetProviderAnimeId() string {
+	if x != nil {
+		return x.ProviderAnimeId
+	}
+	return ""
+}
+
+func (x *EpisodeSyncResult) GetAiring() bool {
+	if x != nil {
+		return x.Airing
+	}
+	return false
+}
+
+func (x *EpisodeSyncResult) GetEpisodes() int32 {
+	if x != nil {
+		return x.Episodes
+	}
+	return 0
+}
+
+func (x *EpisodeSyncResult) GetAdded() int32 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+func (x *EpisodeSyncResult) GetRenamed() int32 {
+	if x != nil {
+		return x.Renamed
+	}
+	return 0
+}
+
+func (x *EpisodeSyncResult) GetRenumbered() int32 {
+	if x != nil {
+		return x.Renumbered
+	}
+	return 0
+}
+
+func (x *EpisodeSyncResult) GetFillerChanged() int32 {
+	if x != nil {
+		return x.FillerChanged
+	}
+	return 0
+}
+
+func (x *EpisodeSyncResult) GetAvailabilityChanged() int32 {
+	if x != nil {
+		return x.AvailabilityChanged
+	}
+	return 0
+}
+
+func (x *EpisodeSyncResult) GetRemoved() int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+func (x *EpisodeSyncResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *EpisodeSyncResult) GetSyncedAtRfc3339() string {
+	if x != nil {
+		return x.SyncedAtRfc3339
+	}
+	return ""
+}
+
+func (x *EpisodeSyncResult) GetChangedAtRfc3339() string {
+	if x != nil {
+		return x.ChangedAtRfc3339
+	}
+	return ""
+}
+
+// ListEpisodeSyncResultsRequest lists results, most recently synced first.
+// Empty fields match everything.
+type ListEpisodeSyncResultsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	MalId         int32                  `protobuf:"varint,2,opt,name=mal_id,json=malId,proto3" json:"mal_id,omitempty"`
+	AiringOnly    bool                   `protobuf:"varint,3,opt,name=airing_only,json=airingOnly,proto3" json:"airing_only,omitempty"`
+	FailedOnly    bool                   `protobuf:"varint,4,opt,name=failed_only,json=failedOnly,proto3" json:"failed_only,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"` // default 50, max 500
+	Offset        int32                  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEpisodeSyncResultsRequest) Reset() {
+	*x = ListEpisodeSyncResultsRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEpisodeSyncResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEpisodeSyncResultsRequest) ProtoMessage() {}
+
+func (x *ListEpisodeSyncResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEpisodeSyncResultsRequest.ProtoReflect.Descriptor instead.
+func (*ListEpisodeSyncResultsRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListEpisodeSyncResultsRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ListEpisodeSyncResultsRequest) GetMalId() int32 {
+	if x != nil {
+		return x.MalId
+	}
+	return 0
+}
+
+func (x *ListEpisodeSyncResultsRequest) GetAiringOnly() bool {
+	if x != nil {
+		return x.AiringOnly
+	}
+	return false
+}
+
+func (x *ListEpisodeSyncResultsRequest) GetFailedOnly() bool {
+	if x != nil {
+		return x.FailedOnly
+	}
+	return false
+}
+
+func (x *ListEpisodeSyncResultsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListEpisodeSyncResultsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListEpisodeSyncResultsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*EpisodeSyncResult   `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEpisodeSyncResultsResponse) Reset() {
+	*x = ListEpisodeSyncResultsResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEpisodeSyncResultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEpisodeSyncResultsResponse) ProtoMessage() {}
+
+func (x *ListEpisodeSyncResultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEpisodeSyncResultsResponse.ProtoReflect.Descriptor instead.
+func (*ListEpisodeSyncResultsResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListEpisodeSyncResultsResponse) GetResults() []*EpisodeSyncResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// FieldChange is a catalog field a sync would overwrite. Lists are joined
+// with ", ".
+type FieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *FieldChange) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+// DryRunTitle is what a Jikan sync would do to one anime.
+type DryRunTitle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MalId         int32                  `protobuf:"varint,1,opt,name=mal_id,json=malId,proto3" json:"mal_id,omitempty"`
+	AnimeId       string                 `protobuf:"bytes,2,opt,name=anime_id,json=animeId,proto3" json:"anime_id,omitempty"` // empty for new titles
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Changes       []*FieldChange         `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DryRunTitle) Reset() {
+	*x = DryRunTitle{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DryRunTitle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DryRunTitle) ProtoMessage() {}
+
+func (x *DryRunTitle) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DryRunTitle.ProtoReflect.Descriptor instead.
+func (*DryRunTitle) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DryRunTitle) GetMalId() int32 {
+	if x != nil {
+		return x.MalId
+	}
+	return 0
+}
+
+func (x *DryRunTitle) GetAnimeId() string {
+	if x != nil {
+		return x.AnimeId
+	}
+	return ""
+}
+
+func (x *DryRunTitle) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DryRunTitle) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// DryRunSlug is what a HiAnime sync would do for one anime.
+type DryRunSlug struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MalId           int32                  `protobuf:"varint,1,opt,name=mal_id,json=malId,proto3" json:"mal_id,omitempty"`
+	AnimeId         string                 `protobuf:"bytes,2,opt,name=anime_id,json=animeId,proto3" json:"anime_id,omitempty"`
+	Slug            string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Source          string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"` // pinned, known or match
+	Error           string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`   // why no slug matched
+	Episodes        int32                  `protobuf:"varint,6,opt,name=episodes,proto3" json:"episodes,omitempty"`
+	EpisodesAdded   int32                  `protobuf:"varint,7,opt,name=episodes_added,json=episodesAdded,proto3" json:"episodes_added,omitempty"`
+	EpisodesChanged int32                  `protobuf:"varint,8,opt,name=episodes_changed,json=episodesChanged,proto3" json:"episodes_changed,omitempty"`
+	EpisodesRemoved int32                  `protobuf:"varint,9,opt,name=episodes_removed,json=episodesRemoved,proto3" json:"episodes_removed,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DryRunSlug) Reset() {
+	*x = DryRunSlug{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DryRunSlug) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DryRunSlug) ProtoMessage() {}
+
+func (x *DryRunSlug) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DryRunSlug.ProtoReflect.Descriptor instead.
+func (*DryRunSlug) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DryRunSlug) GetMalId() int32 {
+	if x != nil {
+		return x.MalId
+	}
+	return 0
+}
+
+func (x *DryRunSlug) GetAnimeId() string {
+	if x != nil {
+		return x.AnimeId
+	}
+	return ""
+}
+
+func (x *DryRunSlug) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *DryRunSlug) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *DryRunSlug) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DryRunSlug) GetEpisodes() int32 {
+	if x != nil {
+		return x.Episodes
+	}
+	return 0
+}
+
+func (x *DryRunSlug) GetEpisodesAdded() int32 {
+	if x != nil {
+		return x.EpisodesAdded
+	}
+	return 0
+}
+
+func (x *DryRunSlug) GetEpisodesChanged() int32 {
+	if x != nil {
+		return x.EpisodesChanged
+	}
+	return 0
+}
+
+func (x *DryRunSlug) GetEpisodesRemoved() int32 {
+	if x != nil {
+		return x.EpisodesRemoved
+	}
+	return 0
+}
+
+// DryRunReport aggregates the jobs of one dry run. It fills in as the jobs
+// finish; pending counts jobs that have not.
+type DryRunReport struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Jobs              int32                  `protobuf:"varint,2,opt,name=jobs,proto3" json:"jobs,omitempty"`
+	Pending           int32                  `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
+	Failed            int32                  `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	NewTitles         []*DryRunTitle         `protobuf:"bytes,5,rep,name=new_titles,json=newTitles,proto3" json:"new_titles,omitempty"`
+	ChangedTitles     []*DryRunTitle         `protobuf:"bytes,6,rep,name=changed_titles,json=changedTitles,proto3" json:"changed_titles,omitempty"`
+	UnchangedTitles   int32                  `protobuf:"varint,7,opt,name=unchanged_titles,json=unchangedTitles,proto3" json:"unchanged_titles,omitempty"`
+	UnavailableMalIds []int32                `protobuf:"varint,8,rep,packed,name=unavailable_mal_ids,json=unavailableMalIds,proto3" json:"unavailable_mal_ids,omitempty"` // in the catalog but hidden, not compared
+	UnmatchedSlugs    []*DryRunSlug          `protobuf:"bytes,9,rep,name=unmatched_slugs,json=unmatchedSlugs,proto3" json:"unmatched_slugs,omitempty"`
+	EpisodeChanges    []*DryRunSlug          `protobuf:"bytes,10,rep,name=episode_changes,json=episodeChanges,proto3" json:"episode_changes,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DryRunReport) Reset() {
+	*x = DryRunReport{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DryRunReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DryRunReport) ProtoMessage() {}
+
+func (x *DryRunReport) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DryRunReport.ProtoReflect.Descriptor instead.
+func (*DryRunReport) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DryRunReport) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DryRunReport) GetJobs() int32 {
+	if x != nil {
+		return x.Jobs
+	}
+	return 0
+}
+
+func (x *DryRunReport) GetPending() int32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *DryRunReport) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *DryRunReport) GetNewTitles() []*DryRunTitle {
+	if x != nil {
+		return x.NewTitles
+	}
+	return nil
+}
+
+func (x *DryRunReport) GetChangedTitles() []*DryRunTitle {
+	if x != nil {
+		return x.ChangedTitles
+	}
+	return nil
+}
+
+func (x *DryRunReport) GetUnchangedTitles() int32 {
+	if x != nil {
+		return x.UnchangedTitles
+	}
+	return 0
+}
+
+func (x *DryRunReport) GetUnavailableMalIds() []int32 {
+	if x != nil {
+		return x.UnavailableMalIds
+	}
+	return nil
+}
+
+func (x *DryRunReport) GetUnmatchedSlugs() []*DryRunSlug {
+	if x != nil {
+		return x.UnmatchedSlugs
+	}
+	return nil
+}
+
+func (x *DryRunReport) GetEpisodeChanges() []*DryRunSlug {
+	if x != nil {
+		return x.EpisodeChanges
+	}
+	return nil
+}
+
+type GetDryRunReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDryRunReportRequest) Reset() {
+	*x = GetDryRunReportRequest{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDryRunReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDryRunReportRequest) ProtoMessage() {}
+
+func (x *GetDryRunReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDryRunReportRequest.ProtoReflect.Descriptor instead.
+func (*GetDryRunReportRequest) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetDryRunReportRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetDryRunReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *DryRunReport          `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDryRunReportResponse) Reset() {
+	*x = GetDryRunReportResponse{}
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDryRunReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDryRunReportResponse) ProtoMessage() {}
+
+func (x *GetDryRunReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingestion_v1_ingestion_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDryRunReportResponse.ProtoReflect.Descriptor instead.
+func (*GetDryRunReportResponse) Descriptor() ([]byte, []int) {
+	return file_ingestion_v1_ingestion_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetDryRunReportResponse) GetReport() *DryRunReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+var File_ingestion_v1_ingestion_proto protoreflect.FileDescriptor
+
+const file_ingestion_v1_ingestion_proto_rawDesc = "" +
+	"\n" +
+	"\x1cingestion/v1/ingestion.proto\x12\fingestion.v1\"\xc1\x02\n" +
+	"\bSchedule\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04cron\x18\x02 \x01(\tR\x04cron\x12\x10\n" +
+	"\x03job\x18\x03 \x01(\tR\x03job\x12\x1b\n" +
+	"\targs_json\x18\x04 \x01(\tR\bargsJson\x12\x16\n" +
+	"\x06paused\x18\x05 \x01(\bR\x06paused\x12-\n" +
+	"\x13next_run_at_rfc3339\x18\x06 \x01(\tR\x10nextRunAtRfc3339\x12-\n" +
+	"\x13last_run_at_rfc3339\x18\a \x01(\tR\x10lastRunAtRfc3339\x12(\n" +
+	"\x10last_duration_ms\x18\b \x01(\x03R\x0elastDurationMs\x12\x1f\n" +
+	"\vlast_status\x18\t \x01(\tR\n" +
+	"lastStatus\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\n" +
+	" \x01(\tR\tlastError\"\x16\n" +
+	"\x14ListSchedulesRequest\"M\n" +
+	"\x15ListSchedulesResponse\x124\n" +
+	"\tschedules\x18\x01 \x03(\v2\x16.ingestion.v1.ScheduleR\tschedules\"?\n" +
+	"\x15UpdateScheduleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04cron\x18\x02 \x01(\tR\x04cron\"L\n" +
+	"\x16UpdateScheduleResponse\x122\n" +
+	"\bschedule\x18\x01 \x01(\v2\x16.ingestion.v1.ScheduleR\bschedule\"*\n" +
+	"\x14PauseScheduleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"K\n" +
+	"\x15PauseScheduleResponse\x122\n" +
+	"\bschedule\x18\x01 \x01(\v2\x16.ingestion.v1.ScheduleR\bschedule\"+\n" +
+	"\x15ResumeScheduleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"L\n" +
+	"\x16ResumeScheduleResponse\x122\n" +
+	"\bschedule\x18\x01 \x01(\v2\x16.ingestion.v1.ScheduleR\bschedule\",\n" +
+	"\x16TriggerScheduleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"M\n" +
+	"\x17TriggerScheduleResponse\x122\n" +
+	"\bschedule\x18\x01 \x01(\v2\x16.ingestion.v1.ScheduleR\bschedule\"\xa5\x03\n" +
+	"\x03Job\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12!\n" +
+	"\fpayload_json\x18\x03 \x01(\tR\vpayloadJson\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\x05 \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tR\tlastError\x12.\n" +
+	"\x13enqueued_at_rfc3339\x18\a \x01(\tR\x11enqueuedAtRfc3339\x12,\n" +
+	"\x12started_at_rfc3339\x18\b \x01(\tR\x10startedAtRfc3339\x12.\n" +
+	"\x13finished_at_rfc3339\x18\t \x01(\tR\x11finishedAtRfc3339\x12,\n" +
+	"\x12updated_at_rfc3339\x18\n" +
+	" \x01(\tR\x10updatedAtRfc3339\x12'\n" +
+	"\x10replay_of_dlq_id\x18\v \x01(\tR\rreplayOfDlqId\x12\x1f\n" +
+	"\vreport_json\x18\f \x01(\tR\n" +
+	"reportJson\"\x9a\x01\n" +
+	"\n" +
+	"JobAttempt\x12\x18\n" +
+	"\aattempt\x18\x01 \x01(\x05R\aattempt\x12,\n" +
+	"\x12started_at_rfc3339\x18\x02 \x01(\tR\x10startedAtRfc3339\x12.\n" +
+	"\x13finished_at_rfc3339\x18\x03 \x01(\tR\x11finishedAtRfc3339\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\xcc\x01\n" +
+	"\x0fListJobsRequest\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x15\n" +
+	"\x06mal_id\x18\x03 \x01(\x05R\x05malId\x12#\n" +
+	"\rsince_rfc3339\x18\x04 \x01(\tR\fsinceRfc3339\x12#\n" +
+	"\runtil_rfc3339\x18\x05 \x01(\tR\funtilRfc3339\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\a \x01(\x05R\x06offset\"9\n" +
+	"\x10ListJobsResponse\x12%\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x11.ingestion.v1.JobR\x04jobs\"\x1f\n" +
+	"\rGetJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"k\n" +
+	"\x0eGetJobResponse\x12#\n" +
+	"\x03job\x18\x01 \x01(\v2\x11.ingestion.v1.JobR\x03job\x124\n" +
+	"\battempts\x18\x02 \x03(\v2\x18.ingestion.v1.JobAttemptR\battempts\"\xb6\x02\n" +
+	"\bDLQEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12!\n" +
+	"\fpayload_json\x18\x05 \x01(\tR\vpayloadJson\x12\x15\n" +
+	"\x06job_id\x18\x06 \x01(\tR\x05jobId\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12&\n" +
+	"\x0freplayed_job_id\x18\b \x01(\tR\rreplayedJobId\x12*\n" +
+	"\x11failed_at_rfc3339\x18\t \x01(\tR\x0ffailedAtRfc3339\x12.\n" +
+	"\x13resolved_at_rfc3339\x18\n" +
+	" \x01(\tR\x11resolvedAtRfc3339\"i\n" +
+	"\tDLQFilter\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12#\n" +
+	"\rsince_rfc3339\x18\x02 \x01(\tR\fsinceRfc3339\x12#\n" +
+	"\runtil_rfc3339\x18\x03 \x01(\tR\funtilRfc3339\"\xbb\x01\n" +
+	"\x15ListDLQEntriesRequest\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rsince_rfc3339\x18\x03 \x01(\tR\fsinceRfc3339\x12#\n" +
+	"\runtil_rfc3339\x18\x04 \x01(\tR\funtilRfc3339\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x06 \x01(\x05R\x06offset\"J\n" +
+	"\x16ListDLQEntriesResponse\x120\n" +
+	"\aentries\x18\x01 \x03(\v2\x16.ingestion.v1.DLQEntryR\aentries\"'\n" +
+	"\x15ReplayDLQEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"F\n" +
+	"\x16ReplayDLQEntryResponse\x12,\n" +
+	"\x05entry\x18\x01 \x01(\v2\x16.ingestion.v1.DLQEntryR\x05entry\"`\n" +
+	"\x17ReplayDLQEntriesRequest\x12/\n" +
+	"\x06filter\x18\x01 \x01(\v2\x17.ingestion.v1.DLQFilterR\x06filter\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"f\n" +
+	"\x18ReplayDLQEntriesResponse\x122\n" +
+	"\breplayed\x18\x01 \x03(\v2\x16.ingestion.v1.DLQEntryR\breplayed\x12\x16\n" +
+	"\x06failed\x18\x02 \x01(\x05R\x06failed\"(\n" +
+	"\x16DiscardDLQEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x19\n" +
+	"\x17DiscardDLQEntryResponse\"a\n" +
+	"\x18DiscardDLQEntriesRequest\x12/\n" +
+	"\x06filter\x18\x01 \x01(\v2\x17.ingestion.v1.DLQFilterR\x06filter\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"9\n" +
+	"\x19DiscardDLQEntriesResponse\x12\x1c\n" +
+	"\tdiscarded\x18\x01 \x01(\x05R\tdiscarded\"\xab\x01\n" +
+	"\x0eHiAnimeMapping\x12\x15\n" +
+	"\x06mal_id\x18\x01 \x01(\x05R\x05malId\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\x12,\n" +
+	"\x12created_at_rfc3339\x18\x04 \x01(\tR\x10createdAtRfc3339\x12,\n" +
+	"\x12updated_at_rfc3339\x18\x05 \x01(\tR\x10updatedAtRfc3339\"J\n" +
+	"\x1aListHiAnimeMappingsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\"W\n" +
+	"\x1bListHiAnimeMappingsResponse\x128\n" +
+	"\bmappings\x18\x01 \x03(\v2\x1c.ingestion.v1.HiAnimeMappingR\bmappings\"m\n" +
+	"\x18SetHiAnimeMappingRequest\x12\x15\n" +
+	"\x06mal_id\x18\x01 \x01(\x05R\x05malId\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\x12\x12\n" +
+	"\x04sync\x18\x04 \x01(\bR\x04sync\"\xb3\x01\n" +
+	"\x19SetHiAnimeMappingResponse\x126\n" +
+	"\amapping\x18\x01 \x01(\v2\x1c.ingestion.v1.HiAnimeMappingR\amapping\x12!\n" +
+	"\fhianime_name\x18\x02 \x01(\tR\vhianimeName\x12$\n" +
+	"\x0ehianime_mal_id\x18\x03 \x01(\x05R\fhianimeMalId\x12\x15\n" +
+	"\x06job_id\x18\x04 \x01(\tR\x05jobId\"4\n" +
+	"\x1bDeleteHiAnimeMappingRequest\x12\x15\n" +
+	"\x06mal_id\x18\x01 \x01(\x05R\x05malId\"\x1e\n" +
+	"\x1cDeleteHiAnimeMappingResponse\"\xf5\x03\n" +
+	"\x11EpisodeSyncResult\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x15\n" +
+	"\x06mal_id\x18\x02 \x01(\x05R\x05malId\x12\x19\n" +
+	"\banime_id\x18\x03 \x01(\tR\aanimeId\x12*\n" +
+	"\x11provider_anime_id\x18\x04 \x01(\tR\x0fproviderAnimeId\x12\x16\n" +
+	"\x06airing\x18\x05 \x01(\bR\x06airing\x12\x1a\n" +
+	"\bepisodes\x18\x06 \x01(\x05R\bepisodes\x12\x14\n" +
+	"\x05added\x18\a \x01(\x05R\x05added\x12\x18\n" +
+	"\arenamed\x18\b \x01(\x05R\arenamed\x12\x1e\n" +
+	"\n" +
+	"renumbered\x18\t \x01(\x05R\n" +
+	"renumbered\x12%\n" +
+	"\x0efiller_changed\x18\n" +
+	" \x01(\x05R\rfillerChanged\x121\n" +
+	"\x14availability_changed\x18\v \x01(\x05R\x13availabilityChanged\x12\x18\n" +
+	"\aremoved\x18\f \x01(\x05R\aremoved\x12\x14\n" +
+	"\x05error\x18\r \x01(\tR\x05error\x12*\n" +
+	"\x11synced_at_rfc3339\x18\x0e \x01(\tR\x0fsyncedAtRfc3339\x12,\n" +
+	"\x12changed_at_rfc3339\x18\x0f \x01(\tR\x10changedAtRfc3339\"\xc2\x01\n" +
+	"\x1dListEpisodeSyncResultsRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x15\n" +
+	"\x06mal_id\x18\x02 \x01(\x05R\x05malId\x12\x1f\n" +
+	"\vairing_only\x18\x03 \x01(\bR\n" +
+	"airingOnly\x12\x1f\n" +
+	"\vfailed_only\x18\x04 \x01(\bR\n" +
+	"failedOnly\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x06 \x01(\x05R\x06offset\"[\n" +
+	"\x1eListEpisodeSyncResultsResponse\x129\n" +
+	"\aresults\x18\x01 \x03(\v2\x1f.ingestion.v1.EpisodeSyncResultR\aresults\"G\n" +
+	"\vFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\"\x8a\x01\n" +
+	"\vDryRunTitle\x12\x15\n" +
+	"\x06mal_id\x18\x01 \x01(\x05R\x05malId\x12\x19\n" +
+	"\banime_id\x18\x02 \x01(\tR\aanimeId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x123\n" +
+	"\achanges\x18\x04 \x03(\v2\x19.ingestion.v1.FieldChangeR\achanges\"\x99\x02\n" +
+	"\n" +
+	"DryRunSlug\x12\x15\n" +
+	"\x06mal_id\x18\x01 \x01(\x05R\x05malId\x12\x19\n" +
+	"\banime_id\x18\x02 \x01(\tR\aanimeId\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12\x1a\n" +
+	"\bepisodes\x18\x06 \x01(\x05R\bepisodes\x12%\n" +
+	"\x0eepisodes_added\x18\a \x01(\x05R\repisodesAdded\x12)\n" +
+	"\x10episodes_changed\x18\b \x01(\x05R\x0fepisodesChanged\x12)\n" +
+	"\x10episodes_removed\x18\t \x01(\x05R\x0fepisodesRemoved\"\xc1\x03\n" +
+	"\fDryRunReport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04jobs\x18\x02 \x01(\x05R\x04jobs\x12\x18\n" +
+	"\apending\x18\x03 \x01(\x05R\apending\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x05R\x06failed\x128\n" +
+	"\n" +
+	"new_titles\x18\x05 \x03(\v2\x19.ingestion.v1.DryRunTitleR\tnewTitles\x12@\n" +
+	"\x0echanged_titles\x18\x06 \x03(\v2\x19.ingestion.v1.DryRunTitleR\rchangedTitles\x12)\n" +
+	"\x10unchanged_titles\x18\a \x01(\x05R\x0funchangedTitles\x12.\n" +
+	"\x13unavailable_mal_ids\x18\b \x03(\x05R\x11unavailableMalIds\x12A\n" +
+	"\x0funmatched_slugs\x18\t \x03(\v2\x18.ingestion.v1.DryRunSlugR\x0eunmatchedSlugs\x12A\n" +
+	"\x0fepisode_changes\x18\n" +
+	" \x03(\v2\x18.ingestion.v1.DryRunSlugR\x0eepisodeChanges\"(\n" +
+	"\x16GetDryRunReportRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"M\n" +
+	"\x17GetDryRunReportResponse\x122\n" +
+	"\x06report\x18\x01 \x01(\v2\x1a.ingestion.v1.DryRunReportR\x06report2\xee\f\n" +
+	"\x15IngestionAdminService\x12X\n" +
+	"\rListSchedules\x12\".ingestion.v1.ListSchedulesRequest\x1a#.ingestion.v1.ListSchedulesResponse\x12[\n" +
+	"\x0eUpdateSchedule\x12#.ingestion.v1.UpdateScheduleRequest\x1a$.ingestion.v1.UpdateScheduleResponse\x12X\n" +
+	"\rPauseSchedule\x12\".ingestion.v1.PauseScheduleRequest\x1a#.ingestion.v1.PauseScheduleResponse\x12[\n" +
+	"\x0eResumeSchedule\x12#.ingestion.v1.ResumeScheduleRequest\x1a$.ingestion.v1.ResumeScheduleResponse\x12^\n" +
+	"\x0fTriggerSchedule\x12$.ingestion.v1.TriggerScheduleRequest\x1a%.ingestion.v1.TriggerScheduleResponse\x12I\n" +
+	"\bListJobs\x12\x1d.ingestion.v1.ListJobsRequest\x1a\x1e.ingestion.v1.ListJobsResponse\x12C\n" +
+	"\x06GetJob\x12\x1b.ingestion.v1.GetJobRequest\x1a\x1c.ingestion.v1.GetJobResponse\x12[\n" +
+	"\x0eListDLQEntries\x12#.ingestion.v1.ListDLQEntriesRequest\x1a$.ingestion.v1.ListDLQEntriesResponse\x12[\n" +
+	"\x0eReplayDLQEntry\x12#.ingestion.v1.ReplayDLQEntryRequest\x1a$.ingestion.v1.ReplayDLQEntryResponse\x12a\n" +
+	"\x10ReplayDLQEntries\x12%.ingestion.v1.ReplayDLQEntriesRequest\x1a&.ingestion.v1.ReplayDLQEntriesResponse\x12^\n" +
+	"\x0fDiscardDLQEntry\x12$.ingestion.v1.DiscardDLQEntryRequest\x1a%.ingestion.v1.DiscardDLQEntryResponse\x12d\n" +
+	"\x11DiscardDLQEntries\x12&.ingestion.v1.DiscardDLQEntriesRequest\x1a'.ingestion.v1.DiscardDLQEntriesResponse\x12j\n" +
+	"\x13ListHiAnimeMappings\x12(.ingestion.v1.ListHiAnimeMappingsRequest\x1a).ingestion.v1.ListHiAnimeMappingsResponse\x12d\n" +
+	"\x11SetHiAnimeMapping\x12&.ingestion.v1.SetHiAnimeMappingRequest\x1a'.ingestion.v1.SetHiAnimeMappingResponse\x12m\n" +
+	"\x14DeleteHiAnimeMapping\x12).ingestion.v1.DeleteHiAnimeMappingRequest\x1a*.ingestion.v1.DeleteHiAnimeMappingResponse\x12s\n" +
+	"\x16ListEpisodeSyncResults\x12+.ingestion.v1.ListEpisodeSyncResultsRequest\x1a,.ingestion.v1.ListEpisodeSyncResultsResponse\x12^\n" +
+	"\x0fGetDryRunReport\x12$.ingestion.v1.GetDryRunReportRequest\x1a%.ingestion.v1.GetDryRunReportResponseB\xb3\x01\n" +
+	"\x10com.ingestion.v1B\x0eIngestionProtoP\x01Z>github.com/example/anime-platform/gen/ingestion/v1;ingestionv1\xa2\x02\x03IXX\xaa\x02\fIngestion.V1\xca\x02\fIngestion\\V1\xe2\x02\x18Ingestion\\V1\\GPBMetadata\xea\x02\rIngestion::V1b\x06proto3"
+
+var (
+	file_ingestion_v1_ingestion_proto_rawDescOnce sync.Once
+	file_ingestion_v1_ingestion_proto_rawDescData []byte
+)
+
+func file_ingestion_v1_ingestion_proto_rawDescGZIP() []byte {
+	file_ingestion_v1_ingestion_proto_rawDescOnce.Do(func() {
+		file_ingestion_v1_ingestion_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ingestion_v1_ingestion_proto_rawDesc), len(file_ingestion_v1_ingestion_proto_rawDesc)))
+	})
+	return file_ingestion_v1_ingestion_proto_rawDescData
+}
+
+var file_ingestion_v1_ingestion_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
+var file_ingestion_v1_ingestion_proto_goTypes = []any{
+	(*Schedule)(nil),                       // 0: ingestion.v1.Schedule
+	(*ListSchedulesRequest)(nil),           // 1: ingestion.v1.ListSchedulesRequest
+	(*ListSchedulesResponse)(nil),          // 2: ingestion.v1.ListSchedulesResponse
+	(*UpdateScheduleRequest)(nil),          // 3: ingestion.v1.UpdateScheduleRequest
+	(*UpdateScheduleResponse)(nil),         // 4: ingestion.v1.UpdateScheduleResponse
+	(*PauseScheduleRequest)(nil),           // 5: ingestion.v1.PauseScheduleRequest
+	(*PauseScheduleResponse)(nil),          // 6: ingestion.v1.PauseScheduleResponse
+	(*ResumeScheduleRequest)(nil),          // 7: ingestion.v1.ResumeScheduleRequest
+	(*ResumeScheduleResponse)(nil),         // 8: ingestion.v1.ResumeScheduleResponse
+	(*TriggerScheduleRequest)(nil),         // 9: ingestion.v1.TriggerScheduleRequest
+	(*TriggerScheduleResponse)(nil),        // 10: ingestion.v1.TriggerScheduleResponse
+	(*Job)(nil),                            // 11: ingestion.v1.Job
+	(*JobAttempt)(nil),                     // 12: ingestion.v1.JobAttempt
+	(*ListJobsRequest)(nil),                // 13: ingestion.v1.ListJobsRequest
+	(*ListJobsResponse)(nil),               // 14: ingestion.v1.ListJobsResponse
+	(*GetJobRequest)(nil),                  // 15: ingestion.v1.GetJobRequest
+	(*GetJobResponse)(nil),                 // 16: ingestion.v1.GetJobResponse
+	(*DLQEntry)(nil),                       // 17: ingestion.v1.DLQEntry
+	(*DLQFilter)(nil),                      // 18: ingestion.v1.DLQFilter
+	(*ListDLQEntriesRequest)(nil),          // 19: ingestion.v1.ListDLQEntriesRequest
+	(*ListDLQEntriesResponse)(nil),         // 20: ingestion.v1.ListDLQEntriesResponse
+	(*ReplayDLQEntryRequest)(nil),          // 21: ingestion.v1.ReplayDLQEntryRequest
+	(*ReplayDLQEntryResponse)(nil),         // 22: ingestion.v1.ReplayDLQEntryResponse
+	(*ReplayDLQEntriesRequest)(nil),        // 23: ingestion.v1.ReplayDLQEntriesRequest
+	(*ReplayDLQEntriesResponse)(nil),       // 24: ingestion.v1.ReplayDLQEntriesResponse
+	(*DiscardDLQEntryRequest)(nil),         // 25: ingestion.v1.DiscardDLQEntryRequest
+	(*DiscardDLQEntryResponse)(nil),        // 26: ingestion.v1.DiscardDLQEntryResponse
+	(*DiscardDLQEntriesRequest)(nil),       // 27: ingestion.v1.DiscardDLQEntriesRequest
+	(*DiscardDLQEntriesResponse)(nil),      // 28: ingestion.v1.DiscardDLQEntriesResponse
+	(*HiAnimeMapping)(nil),                 // 29: ingestion.v1.HiAnimeMapping
+	(*ListHiAnimeMappingsRequest)(nil),     // 30: ingestion.v1.ListHiAnimeMappingsRequest
+	(*ListHiAnimeMappingsResponse)(nil),    // 31: ingestion.v1.ListHiAnimeMappingsResponse
+	(*SetHiAnimeMappingRequest)(nil),       // 32: ingestion.v1.SetHiAnimeMappingRequest
+	(*SetHiAnimeMappingResponse)(nil),      // 33: ingestion.v1.SetHiAnimeMappingResponse
+	(*DeleteHiAnimeMappingRequest)(nil),    // 34: ingestion.v1.DeleteHiAnimeMappingRequest
+	(*DeleteHiAnimeMappingResponse)(nil),   // 35: ingestion.v1.DeleteHiAnimeMappingResponse
+	(*EpisodeSyncResult)(nil),              // 36: ingestion.v1.EpisodeSyncResult
+	(*ListEpisodeSyncResultsRequest)(nil),  // 37: ingestion.v1.ListEpisodeSyncResultsRequest
+	(*ListEpisodeSyncResultsResponse)(nil), // 38: ingestion.v1.ListEpisodeSyncResultsResponse
+	(*FieldChange)(nil),                    // 39: ingestion.v1.FieldChange
+	(*DryRunTitle)(nil),                    // 40: ingestion.v1.DryRunTitle
+	(*DryRunSlug)(nil),                     // 41: ingestion.v1.DryRunSlug
+	(*DryRunReport)(nil),                   // 42: ingestion.v1.DryRunReport
+	(*GetDryRunReportRequest)(nil),         // 43: ingestion.v1.GetDryRunReportRequest
+	(*GetDryRunReportResponse)(nil),        // 44: ingestion.v1.GetDryRunReportResponse
+}
+var file_ingestion_v1_ingestion_proto_depIdxs = []int32{
+	0,  // 0: ingestion.v1.ListSchedulesResponse.schedules:type_name -> ingestion.v1.Schedule
+	0,  // 1: ingestion.v1.UpdateScheduleResponse.schedule:type_name -> ingestion.v1.Schedule
+	0,  // 2: ingestion.v1.PauseScheduleResponse.schedule:type_name -> ingestion.v1.Schedule
+	0,  // 3: ingestion.v1.ResumeScheduleResponse.schedule:type_name -> ingestion.v1.Schedule
+	0,  // 4: ingestion.v1.TriggerScheduleResponse.schedule:type_name -> ingestion.v1.Schedule
+	11, // 5: ingestion.v1.ListJobsResponse.jobs:type_name -> ingestion.v1.Job
+	11, // 6: ingestion.v1.GetJobResponse.job:type_name -> ingestion.v1.Job
+	12, // 7: ingestion.v1.GetJobResponse.attempts:type_name -> ingestion.v1.JobAttempt
+	17, // 8: ingestion.v1.ListDLQEntriesResponse.entries:type_name -> ingestion.v1.DLQEntry
+	17, // 9: ingestion.v1.ReplayDLQEntryResponse.entry:type_name -> ingestion.v1.DLQEntry
+	18, // 10: ingestion.v1.ReplayDLQEntriesRequest.filter:type_name -> ingestion.v1.DLQFilter
+	17, // 11: ingestion.v1.ReplayDLQEntriesResponse.replayed:type_name -> ingestion.v1.DLQEntry
+	18, // 12: ingestion.v1.DiscardDLQEntriesRequest.filter:type_name -> ingestion.v1.DLQFilter
+	29, // 13: ingestion.v1.ListHiAnimeMappingsResponse.mappings:type_name -> ingestion.v1.HiAnimeMapping
+	29, // 14: ingestion.v1.SetHiAnimeMappingResponse.mapping:type_name -> ingestion.v1.HiAnimeMapping
+	36, // 15: ingestion.v1.ListEpisodeSyncResultsResponse.results:type_name -> ingestion.v1.EpisodeSyncResult
+	39, // 16: ingestion.v1.DryRunTitle.changes:type_name -> ingestion.v1.FieldChange
+	40, // 17: ingestion.v1.DryRunReport.new_titles:type_name -> ingestion.v1.DryRunTitle
+	40, // 18: ingestion.v1.DryRunReport.changed_titles:type_name -> ingestion.v1.DryRunTitle
+	41, // 19: ingestion.v1.DryRunReport.unmatched_slugs:type_name -> ingestion.v1.DryRunSlug
+	41, // 20: ingestion.v1.DryRunReport.episode_changes:type_name -> ingestion.v1.DryRunSlug
+	42, // 21: ingestion.v1.GetDryRunReportResponse.report:type_name -> ingestion.v1.DryRunReport
+	1,  // 22: ingestion.v1.IngestionAdminService.ListSchedules:input_type -> ingestion.v1.ListSchedulesRequest
+	3,  // 23: ingestion.v1.IngestionAdminService.UpdateSchedule:input_type -> ingestion.v1.UpdateScheduleRequest
+	5,  // 24: ingestion.v1.IngestionAdminService.PauseSchedule:input_type -> ingestion.v1.PauseScheduleRequest
+	7,  // 25: ingestion.v1.IngestionAdminService.ResumeSchedule:input_type -> ingestion.v1.ResumeScheduleRequest
+	9,  // 26: ingestion.v1.IngestionAdminService.TriggerSchedule:input_type -> ingestion.v1.TriggerScheduleRequest
+	13, // 27: ingestion.v1.IngestionAdminService.ListJobs:input_type -> ingestion.v1.ListJobsRequest
+	15, // 28: ingestion.v1.IngestionAdminService.GetJob:input_type -> ingestion.v1.GetJobRequest
+	19, // 29: ingestion.v1.IngestionAdminService.ListDLQEntries:input_type -> ingestion.v1.ListDLQEntriesRequest
+	21, // 30: ingestion.v1.IngestionAdminService.ReplayDLQEntry:input_type -> ingestion.v1.ReplayDLQEntryRequest
+	23, // 31: ingestion.v1.IngestionAdminService.ReplayDLQEntries:input_type -> ingestion.v1.ReplayDLQEntriesRequest
+	25, // 32: ingestion.v1.IngestionAdminService.DiscardDLQEntry:input_type -> ingestion.v1.DiscardDLQEntryRequest
+	27, // 33: ingestion.v1.IngestionAdminService.DiscardDLQEntries:input_type -> ingestion.v1.DiscardDLQEntriesRequest
+	30, // 34: ingestion.v1.IngestionAdminService.ListHiAnimeMappings:input_type -> ingestion.v1.ListHiAnimeMappingsRequest
+	32, // 35: ingestion.v1.IngestionAdminService.SetHiAnimeMapping:input_type -> ingestion.v1.SetHiAnimeMappingRequest
+	34, // 36: ingestion.v1.IngestionAdminService.DeleteHiAnimeMapping:input_type -> ingestion.v1.DeleteHiAnimeMappingRequest
+	37, // 37: ingestion.v1.IngestionAdminService.ListEpisodeSyncResults:input_type -> ingestion.v1.ListEpisodeSyncResultsRequest
+	43, // 38: ingestion.v1.IngestionAdminService.GetDryRunReport:input_type -> ingestion.v1.GetDryRunReportRequest
+	2,  // 39: ingestion.v1.IngestionAdminService.ListSchedules:output_type -> ingestion.v1.ListSchedulesResponse
+	4,  // 40: ingestion.v1.IngestionAdminService.UpdateSchedule:output_type -> ingestion.v1.UpdateScheduleResponse
+	6,  // 41: ingestion.v1.IngestionAdminService.PauseSchedule:output_type -> ingestion.v1.PauseScheduleResponse
+	8,  // 42: ingestion.v1.IngestionAdminService.ResumeSchedule:output_type -> ingestion.v1.ResumeScheduleResponse
+	10, // 43: ingestion.v1.IngestionAdminService.TriggerSchedule:output_type -> ingestion.v1.TriggerScheduleResponse
+	14, // 44: ingestion.v1.IngestionAdminService.ListJobs:output_type -> ingestion.v1.ListJobsResponse
+	16, // 45: ingestion.v1.IngestionAdminService.GetJob:output_type -> ingestion.v1.GetJobResponse
+	20, // 46: ingestion.v1.IngestionAdminService.ListDLQEntries:output_type -> ingestion.v1.ListDLQEntriesResponse
+	22, // 47: ingestion.v1.IngestionAdminService.ReplayDLQEntry:output_type -> ingestion.v1.ReplayDLQEntryResponse
+	24, // 48: ingestion.v1.IngestionAdminService.ReplayDLQEntries:output_type -> ingestion.v1.ReplayDLQEntriesResponse
+	26, // 49: ingestion.v1.IngestionAdminService.DiscardDLQEntry:output_type -> ingestion.v1.DiscardDLQEntryResponse
+	28, // 50: ingestion.v1.IngestionAdminService.DiscardDLQEntries:output_type -> ingestion.v1.DiscardDLQEntriesResponse
+	31, // 51: ingestion.v1.IngestionAdminService.ListHiAnimeMappings:output_type -> ingestion.v1.ListHiAnimeMappingsResponse
+	33, // 52: ingestion.v1.IngestionAdminService.SetHiAnimeMapping:output_type -> ingestion.v1.SetHiAnimeMappingResponse
+	35, // 53: ingestion.v1.IngestionAdminService.DeleteHiAnimeMapping:output_type -> ingestion.v1.DeleteHiAnimeMappingResponse
+	38, // 54: ingestion.v1.IngestionAdminService.ListEpisodeSyncResults:output_type -> ingestion.v1.ListEpisodeSyncResultsResponse
+	44, // 55: ingestion.v1.IngestionAdminService.GetDryRunReport:output_type -> ingestion.v1.GetDryRunReportResponse
+	39, // [39:56] is the sub-list for method output_type
+	22, // [22:39] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
+}
+
+func init() { file_ingestion_v1_ingestion_proto_init() }
+func file_ingestion_v1_ingestion_proto_init() {
+	if File_ingestion_v1_ingestion_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ingestion_v1_ingestion_proto_rawDesc), len(file_ingestion_v1_ingestion_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   45,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ingestion_v1_ingestion_proto_goTypes,
+		DependencyIndexes: file_ingestion_v1_ingestion_proto_depIdxs,
+		MessageInfos:      file_ingestion_v1_ingestion_proto_msgTypes,
+	}.Build()
+	File_ingestion_v1_ingestion_proto = out.File
+	file_ingestion_v1_ingestion_proto_goTypes = nil
+	file_ingestion_v1_ingestion_proto_depIdxs = nil
+}
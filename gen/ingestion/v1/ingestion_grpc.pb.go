@@ -0,0 +1,730 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.1
+// - protoc             (unknown)
+// source: ingestion/v1/ingestion.proto
+
+package ingestionv1
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	IngestionAdminService_ListSchedules_FullMethodName          = "/ingestion.v1.IngestionAdminService/ListSchedules"
+	IngestionAdminService_UpdateSchedule_FullMethodName         = "/ingestion.v1.IngestionAdminService/UpdateSchedule"
+	IngestionAdminService_PauseSchedule_FullMethodName          = "/ingestion.v1.IngestionAdminService/PauseSchedule"
+	IngestionAdminService_ResumeSchedule_FullMethodName         = "/ingestion.v1.IngestionAdminService/ResumeSchedule"
+	IngestionAdminService_TriggerSchedule_FullMethodName        = "/ingestion.v1.IngestionAdminService/TriggerSchedule"
+	IngestionAdminService_ListJobs_FullMethodName               = "/ingestion.v1.IngestionAdminService/ListJobs"
+	IngestionAdminService_GetJob_FullMethodName                 = "/ingestion.v1.IngestionAdminService/GetJob"
+	IngestionAdminService_ListDLQEntries_FullMethodName         = "/ingestion.v1.IngestionAdminService/ListDLQEntries"
+	IngestionAdminService_ReplayDLQEntry_FullMethodName         = "/ingestion.v1.IngestionAdminService/ReplayDLQEntry"
+	IngestionAdminService_ReplayDLQEntries_FullMethodName       = "/ingestion.v1.IngestionAdminService/ReplayDLQEntries"
+	IngestionAdminService_DiscardDLQEntry_FullMethodName        = "/ingestion.v1.IngestionAdminService/DiscardDLQEntry"
+	IngestionAdminService_DiscardDLQEntries_FullMethodName      = "/ingestion.v1.IngestionAdminService/DiscardDLQEntries"
+	IngestionAdminService_ListHiAnimeMappings_FullMethodName    = "/ingestion.v1.IngestionAdminService/ListHiAnimeMappings"
+	IngestionAdminService_SetHiAnimeMapping_FullMethodName      = "/ingestion.v1.IngestionAdminService/SetHiAnimeMapping"
+	IngestionAdminService_DeleteHiAnimeMapping_FullMethodName   = "/ingestion.v1.IngestionAdminService/DeleteHiAnimeMapping"
+	IngestionAdminService_ListEpisodeSyncResults_FullMethodName = "/ingestion.v1.IngestionAdminService/ListEpisodeSyncResults"
+	IngestionAdminService_GetDryRunReport_FullMethodName        = "/ingestion.v1.IngestionAdminService/GetDryRunReport"
+)
+
+// IngestionAdminServiceClient is the client API for IngestionAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type IngestionAdminServiceClient interface {
+	ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error)
+	UpdateSchedule(ctx context.Context, in *UpdateScheduleRequest, opts ...grpc.CallOption) (*UpdateScheduleResponse, error)
+	PauseSchedule(ctx context.Context, in *PauseScheduleRequest, opts ...grpc.CallOption) (*PauseScheduleResponse, error)
+	ResumeSchedule(ctx context.Context, in *ResumeScheduleRequest, opts ...grpc.CallOption) (*ResumeScheduleResponse, error)
+	TriggerSchedule(ctx context.Context, in *TriggerScheduleRequest, opts ...grpc.CallOption) (*TriggerScheduleResponse, error)
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
+	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error)
+	ListDLQEntries(ctx context.Context, in *ListDLQEntriesRequest, opts ...grpc.CallOption) (*ListDLQEntriesResponse, error)
+	ReplayDLQEntry(ctx context.Context, in *ReplayDLQEntryRequest, opts ...grpc.CallOption) (*ReplayDLQEntryResponse, error)
+	ReplayDLQEntries(ctx context.Context, in *ReplayDLQEntriesRequest, opts ...grpc.CallOption) (*ReplayDLQEntriesResponse, error)
+	DiscardDLQEntry(ctx context.Context, in *DiscardDLQEntryRequest, opts ...grpc.CallOption) (*DiscardDLQEntryResponse, error)
+	DiscardDLQEntries(ctx context.Context, in *DiscardDLQEntriesRequest, opts ...grpc.CallOption) (*DiscardDLQEntriesResponse, error)
+	ListHiAnimeMappings(ctx context.Context, in *ListHiAnimeMappingsRequest, opts ...grpc.CallOption) (*ListHiAnimeMappingsResponse, error)
+	SetHiAnimeMapping(ctx context.Context, in *SetHiAnimeMappingRequest, opts ...grpc.CallOption) (*SetHiAnimeMappingResponse, error)
+	DeleteHiAnimeMapping(ctx context.Context, in *DeleteHiAnimeMappingRequest, opts ...grpc.CallOption) (*DeleteHiAnimeMappingResponse, error)
+	ListEpisodeSyncResults(ctx context.Context, in *ListEpisodeSyncResultsRequest, opts ...grpc.CallOption) (*ListEpisodeSyncResultsResponse, error)
+	GetDryRunReport(ctx context.Context, in *GetDryRunReportRequest, opts ...grpc.CallOption) (*GetDryRunReportResponse, error)
+}
+
+type ingestionAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIngestionAdminServiceClient(cc grpc.ClientConnInterface) IngestionAdminServiceClient {
+	return &ingestionAdminServiceClient{cc}
+}
+
+func (c *ingestionAdminServiceClient) ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSchedulesResponse)
+	err := c.cc.Invoke(ctx, IngestionAdminService_ListSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionAdminServiceClient) UpdateSchedule(ctx context.Context, in *UpdateScheduleRequest, opts ...grpc.CallOption) (*UpdateScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateScheduleResponse)
+	err := c.cc.Invoke(ctx, IngestionAdminService_UpdateSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionAdminServiceClient) PauseSchedule(ctx context.Context, in *PauseScheduleRequest, opts ...grpc.CallOption) (*PauseScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PauseScheduleResponse)
+	err := c.cc.Invoke(ctx, IngestionAdminService_PauseSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionAdminServiceClient) ResumeSchedule(ctx context.Context, in *ResumeScheduleRequest, opts ...grpc.CallOption) (*ResumeScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeScheduleResponse)
+	err := c.cc.Invoke(ctx, IngestionAdminService_ResumeSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionAdminServiceClient) TriggerSchedule(ctx context.Context, in *TriggerScheduleRequest, opts ...grpc.CallOption) (*TriggerScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TriggerScheduleResponse)
+	err := c.cc.Invoke(ctx, IngestionAdminService_TriggerSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionAdminServiceClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobsResponse)
+	err := c.cc.Invoke(ctx, IngestionAdminService_ListJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionAdminServiceClient) GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJobResponse)
+	err := c.cc.Invoke(ctx, IngestionAdminService_GetJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionAdminServiceClient) ListDLQEntries(ctx context.Context, in *ListDLQEntriesRequest, opts ...grpc.CallOption) (*ListDLQEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDLQEntriesResponse)
+	err := c.cc.Invoke(ctx, IngestionAdminService_ListDLQEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionAdminServiceClient) ReplayDLQEntry(ctx context.Context, in *ReplayDLQEntryRequest, opts ...grpc.CallOption) (*ReplayDLQEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayDLQEntryResponse)
+	err := c.cc.Invoke(ctx, IngestionAdminService_ReplayDLQEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionAdminServiceClient) ReplayDLQEntries(ctx context.Context, in *ReplayDLQEntriesRequest, opts ...grpc.CallOption) (*ReplayDLQEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayDLQEntriesResponse)
+	err := c.cc.Invoke(ctx, IngestionAdminService_ReplayDLQEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionAdminServiceClient) DiscardDLQEntry(ctx context.Context, in *DiscardDLQEntryRequest, opts ...grpc.CallOption) (*DiscardDLQEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscardDLQEntryResponse)
+	err := c.cc.Invoke(ctx, IngestionAdminService_DiscardDLQEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionAdminServiceClient) DiscardDLQEntries(ctx context.Context, in *DiscardDLQEntriesRequest, opts ...grpc.CallOption) (*DiscardDLQEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscardDLQEntriesResponse)
+	err := c.cc.Invoke(ctx, IngestionAdminService_DiscardDLQEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionAdminServiceClient) ListHiAnimeMappings(ctx context.Context, in *ListHiAnimeMappingsRequest, opts ...grpc.CallOption) (*ListHiAnimeMappingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHiAnimeMappingsResponse)
+	err := c.cc.Invoke(ctx, IngestionAdminService_ListHiAnimeMappings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionAdminServiceClient) SetHiAnimeMapping(ctx context.Context, in *SetHiAnimeMappingRequest, opts ...grpc.CallOption) (*SetHiAnimeMappingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetHiAnimeMappingResponse)
+	err := c.cc.Invoke(ctx, IngestionAdminService_SetHiAnimeMapping_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionAdminServiceClient) DeleteHiAnimeMapping(ctx context.Context, in *DeleteHiAnimeMappingRequest, opts ...grpc.CallOption) (*DeleteHiAnimeMappingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteHiAnimeMappingResponse)
+	err := c.cc.Invoke(ctx, IngestionAdminService_DeleteHiAnimeMapping_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionAdminServiceClient) ListEpisodeSyncResults(ctx context.Context, in *ListEpisodeSyncResultsRequest, opts ...grpc.CallOption) (*ListEpisodeSyncResultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEpisodeSyncResultsResponse)
+	err := c.cc.Invoke(ctx, IngestionAdminService_ListEpisodeSyncResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionAdminServiceClient) GetDryRunReport(ctx context.Context, in *GetDryRunReportRequest, opts ...grpc.CallOption) (*GetDryRunReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDryRunReportResponse)
+	err := c.cc.Invoke(ctx, IngestionAdminService_GetDryRunReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IngestionAdminServiceServer is the server API for IngestionAdminService service.
+// All implementations must embed UnimplementedIngestionAdminServiceServer
+// for forward compatibility.
+type IngestionAdminServiceServer interface {
+	ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error)
+	UpdateSchedule(context.Context, *UpdateScheduleRequest) (*UpdateScheduleResponse, error)
+	PauseSchedule(context.Context, *PauseScheduleRequest) (*PauseScheduleResponse, error)
+	ResumeSchedule(context.Context, *ResumeScheduleRequest) (*ResumeScheduleResponse, error)
+	TriggerSchedule(context.Context, *TriggerScheduleRequest) (*TriggerScheduleResponse, error)
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
+	GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error)
+	ListDLQEntries(context.Context, *ListDLQEntriesRequest) (*ListDLQEntriesResponse, error)
+	ReplayDLQEntry(context.Context, *ReplayDLQEntryRequest) (*ReplayDLQEntryResponse, error)
+	ReplayDLQEntries(context.Context, *ReplayDLQEntriesRequest) (*ReplayDLQEntriesResponse, error)
+	DiscardDLQEntry(context.Context, *DiscardDLQEntryRequest) (*DiscardDLQEntryResponse, error)
+	DiscardDLQEntries(context.Context, *DiscardDLQEntriesRequest) (*DiscardDLQEntriesResponse, error)
+	ListHiAnimeMappings(context.Context, *ListHiAnimeMappingsRequest) (*ListHiAnimeMappingsResponse, error)
+	SetHiAnimeMapping(context.Context, *SetHiAnimeMappingRequest) (*SetHiAnimeMappingResponse, error)
+	DeleteHiAnimeMapping(context.Context, *DeleteHiAnimeMappingRequest) (*DeleteHiAnimeMappingResponse, error)
+	ListEpisodeSyncResults(context.Context, *ListEpisodeSyncResultsRequest) (*ListEpisodeSyncResultsResponse, error)
+	GetDryRunReport(context.Context, *GetDryRunReportRequest) (*GetDryRunReportResponse, error)
+	mustEmbedUnimplementedIngestionAdminServiceServer()
+}
+
+// UnimplementedIngestionAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedIngestionAdminServiceServer struct{}
+
+func (UnimplementedIngestionAdminServiceServer) ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSchedules not implemented")
+}
+func (UnimplementedIngestionAdminServiceServer) UpdateSchedule(context.Context, *UpdateScheduleRequest) (*UpdateScheduleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSchedule not implemented")
+}
+func (UnimplementedIngestionAdminServiceServer) PauseSchedule(context.Context, *PauseScheduleRequest) (*PauseScheduleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PauseSchedule not implemented")
+}
+func (UnimplementedIngestionAdminServiceServer) ResumeSchedule(context.Context, *ResumeScheduleRequest) (*ResumeScheduleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeSchedule not implemented")
+}
+func (UnimplementedIngestionAdminServiceServer) TriggerSchedule(context.Context, *TriggerScheduleRequest) (*TriggerScheduleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TriggerSchedule not implemented")
+}
+func (UnimplementedIngestionAdminServiceServer) ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedIngestionAdminServiceServer) GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetJob not implemented")
+}
+func (UnimplementedIngestionAdminServiceServer) ListDLQEntries(context.Context, *ListDLQEntriesRequest) (*ListDLQEntriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDLQEntries not implemented")
+}
+func (UnimplementedIngestionAdminServiceServer) ReplayDLQEntry(context.Context, *ReplayDLQEntryRequest) (*ReplayDLQEntryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReplayDLQEntry not implemented")
+}
+func (UnimplementedIngestionAdminServiceServer) ReplayDLQEntries(context.Context, *ReplayDLQEntriesRequest) (*ReplayDLQEntriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReplayDLQEntries not implemented")
+}
+func (UnimplementedIngestionAdminServiceServer) DiscardDLQEntry(context.Context, *DiscardDLQEntryRequest) (*DiscardDLQEntryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiscardDLQEntry not implemented")
+}
+func (UnimplementedIngestionAdminServiceServer) DiscardDLQEntries(context.Context, *DiscardDLQEntriesRequest) (*DiscardDLQEntriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiscardDLQEntries not implemented")
+}
+func (UnimplementedIngestionAdminServiceServer) ListHiAnimeMappings(context.Context, *ListHiAnimeMappingsRequest) (*ListHiAnimeMappingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListHiAnimeMappings not implemented")
+}
+func (UnimplementedIngestionAdminServiceServer) SetHiAnimeMapping(context.Context, *SetHiAnimeMappingRequest) (*SetHiAnimeMappingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetHiAnimeMapping not implemented")
+}
+func (UnimplementedIngestionAdminServiceServer) DeleteHiAnimeMapping(context.Context, *DeleteHiAnimeMappingRequest) (*DeleteHiAnimeMappingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteHiAnimeMapping not implemented")
+}
+func (UnimplementedIngestionAdminServiceServer) ListEpisodeSyncResults(context.Context, *ListEpisodeSyncResultsRequest) (*ListEpisodeSyncResultsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListEpisodeSyncResults not implemented")
+}
+func (UnimplementedIngestionAdminServiceServer) GetDryRunReport(context.Context, *GetDryRunReportRequest) (*GetDryRunReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDryRunReport not implemented")
+}
+func (UnimplementedIngestionAdminServiceServer) mustEmbedUnimplementedIngestionAdminServiceServer() {}
+func (UnimplementedIngestionAdminServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeIngestionAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IngestionAdminServiceServer will
+// result in compilation errors.
+type UnsafeIngestionAdminServiceServer interface {
+	mustEmbedUnimplementedIngestionAdminServiceServer()
+}
+
+func RegisterIngestionAdminServiceServer(s grpc.ServiceRegistrar, srv IngestionAdminServiceServer) {
+	// If the following call panics, it indicates UnimplementedIngestionAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&IngestionAdminService_ServiceDesc, srv)
+}
+
+func _IngestionAdminService_ListSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionAdminServiceServer).ListSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionAdminService_ListSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionAdminServiceServer).ListSchedules(ctx, req.(*ListSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionAdminService_UpdateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionAdminServiceServer).UpdateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionAdminService_UpdateSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionAdminServiceServer).UpdateSchedule(ctx, req.(*UpdateScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionAdminService_PauseSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionAdminServiceServer).PauseSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionAdminService_PauseSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionAdminServiceServer).PauseSchedule(ctx, req.(*PauseScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionAdminService_ResumeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionAdminServiceServer).ResumeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionAdminService_ResumeSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionAdminServiceServer).ResumeSchedule(ctx, req.(*ResumeScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionAdminService_TriggerSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionAdminServiceServer).TriggerSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionAdminService_TriggerSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionAdminServiceServer).TriggerSchedule(ctx, req.(*TriggerScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionAdminService_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionAdminServiceServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionAdminService_ListJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionAdminServiceServer).ListJobs(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionAdminService_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionAdminServiceServer).GetJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionAdminService_GetJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionAdminServiceServer).GetJob(ctx, req.(*GetJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionAdminService_ListDLQEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDLQEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionAdminServiceServer).ListDLQEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionAdminService_ListDLQEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionAdminServiceServer).ListDLQEntries(ctx, req.(*ListDLQEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionAdminService_ReplayDLQEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDLQEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionAdminServiceServer).ReplayDLQEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionAdminService_ReplayDLQEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionAdminServiceServer).ReplayDLQEntry(ctx, req.(*ReplayDLQEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionAdminService_ReplayDLQEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDLQEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionAdminServiceServer).ReplayDLQEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionAdminService_ReplayDLQEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionAdminServiceServer).ReplayDLQEntries(ctx, req.(*ReplayDLQEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionAdminService_DiscardDLQEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardDLQEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionAdminServiceServer).DiscardDLQEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionAdminService_DiscardDLQEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionAdminServiceServer).DiscardDLQEntry(ctx, req.(*DiscardDLQEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionAdminService_DiscardDLQEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardDLQEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionAdminServiceServer).DiscardDLQEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionAdminService_DiscardDLQEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionAdminServiceServer).DiscardDLQEntries(ctx, req.(*DiscardDLQEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionAdminService_ListHiAnimeMappings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHiAnimeMappingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionAdminServiceServer).ListHiAnimeMappings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionAdminService_ListHiAnimeMappings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionAdminServiceServer).ListHiAnimeMappings(ctx, req.(*ListHiAnimeMappingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionAdminService_SetHiAnimeMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetHiAnimeMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionAdminServiceServer).SetHiAnimeMapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionAdminService_SetHiAnimeMapping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionAdminServiceServer).SetHiAnimeMapping(ctx, req.(*SetHiAnimeMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionAdminService_DeleteHiAnimeMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHiAnimeMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionAdminServiceServer).DeleteHiAnimeMapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionAdminService_DeleteHiAnimeMapping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionAdminServiceServer).DeleteHiAnimeMapping(ctx, req.(*DeleteHiAnimeMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionAdminService_ListEpisodeSyncResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEpisodeSyncResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionAdminServiceServer).ListEpisodeSyncResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionAdminService_ListEpisodeSyncResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionAdminServiceServer).ListEpisodeSyncResults(ctx, req.(*ListEpisodeSyncResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionAdminService_GetDryRunReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDryRunReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionAdminServiceServer).GetDryRunReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionAdminService_GetDryRunReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionAdminServiceServer).GetDryRunReport(ctx, req.(*GetDryRunReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// IngestionAdminService_ServiceDesc is the grpc.ServiceDesc for IngestionAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var IngestionAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ingestion.v1.IngestionAdminService",
+	HandlerType: (*IngestionAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSchedules",
+			Handler:    _IngestionAdminService_ListSchedules_Handler,
+		},
+		{
+			MethodName: "UpdateSchedule",
+			Handler:    _IngestionAdminService_UpdateSchedule_Handler,
+		},
+		{
+			MethodName: "PauseSchedule",
+			Handler:    _IngestionAdminService_PauseSchedule_Handler,
+		},
+		{
+			MethodName: "ResumeSchedule",
+			Handler:    _IngestionAdminService_ResumeSchedule_Handler,
+		},
+		{
+			MethodName: "TriggerSchedule",
+			Handler:    _IngestionAdminService_TriggerSchedule_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _IngestionAdminService_ListJobs_Handler,
+		},
+		{
+			MethodName: "GetJob",
+			Handler:    _IngestionAdminService_GetJob_Handler,
+		},
+		{
+			MethodName: "ListDLQEntries",
+			Handler:    _IngestionAdminService_ListDLQEntries_Handler,
+		},
+		{
+			MethodName: "ReplayDLQEntry",
+			Handler:    _IngestionAdminService_ReplayDLQEntry_Handler,
+		},
+		{
+			MethodName: "ReplayDLQEntries",
+			Handler:    _IngestionAdminService_ReplayDLQEntries_Handler,
+		},
+		{
+			MethodName: "DiscardDLQEntry",
+			Handler:    _IngestionAdminService_DiscardDLQEntry_Handler,
+		},
+		{
+			MethodName: "DiscardDLQEntries",
+			Handler:    _IngestionAdminService_DiscardDLQEntries_Handler,
+		},
+		{
+			MethodName: "ListHiAnimeMappings",
+			Handler:    _IngestionAdminService_ListHiAnimeMappings_Handler,
+		},
+		{
+			MethodName: "SetHiAnimeMapping",
+			Handler:    _IngestionAdminService_SetHiAnimeMapping_Handler,
+		},
+		{
+			MethodName: "DeleteHiAnimeMapping",
+			Handler:    _IngestionAdminService_DeleteHiAnimeMapping_Handler,
+		},
+		{
+			MethodName: "ListEpisodeSyncResults",
+			Handler:    _IngestionAdminService_ListEpisodeSyncResults_Handler,
+		},
+		{
+			MethodName: "GetDryRunReport",
+			Handler:    _IngestionAdminService_GetDryRunReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ingestion/v1/ingestion.proto",
+}
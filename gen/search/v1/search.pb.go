@@ -23,15 +23,20 @@ const (
 )
 
 type SearchAnimeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
-	Genres        []string               `protobuf:"bytes,4,rep,name=genres,proto3" json:"genres,omitempty"`
-	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	Type          string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
-	MinScore      float32                `protobuf:"fixed32,7,opt,name=min_score,json=minScore,proto3" json:"min_score,omitempty"`
-	MaxScore      float32                `protobuf:"fixed32,8,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Query        string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit        int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset       int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Genres       []string               `protobuf:"bytes,4,rep,name=genres,proto3" json:"genres,omitempty"`
+	Status       string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Type         string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	MinScore     float32                `protobuf:"fixed32,7,opt,name=min_score,json=minScore,proto3" json:"min_score,omitempty"`
+	MaxScore     float32                `protobuf:"fixed32,8,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	Themes       []string               `protobuf:"bytes,9,rep,name=themes,proto3" json:"themes,omitempty"`              // theme slugs or names
+	Demographics []string               `protobuf:"bytes,10,rep,name=demographics,proto3" json:"demographics,omitempty"` // demographic slugs or names
+	// Descending sort: "score", "trending_24h", "trending_7d" or "trending_30d".
+	// Empty sorts by relevance.
+	Sort          string `protobuf:"bytes,11,opt,name=sort,proto3" json:"sort,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -122,6 +127,27 @@ func (x *SearchAnimeRequest) GetMaxScore() float32 {
 	return 0
 }
 
+func (x *SearchAnimeRequest) GetThemes() []string {
+	if x != nil {
+		return x.Themes
+	}
+	return nil
+}
+
+func (x *SearchAnimeRequest) GetDemographics() []string {
+	if x != nil {
+		return x.Demographics
+	}
+	return nil
+}
+
+func (x *SearchAnimeRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
 type SearchAnimeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Hits          []*AnimeHit            `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
@@ -187,6 +213,8 @@ type AnimeHit struct {
 	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
 	Type          string                 `protobuf:"bytes,10,opt,name=type,proto3" json:"type,omitempty"`
 	TotalEpisodes int32                  `protobuf:"varint,11,opt,name=total_episodes,json=totalEpisodes,proto3" json:"total_episodes,omitempty"`
+	Themes        []string               `protobuf:"bytes,12,rep,name=themes,proto3" json:"themes,omitempty"`
+	Demographics  []string               `protobuf:"bytes,13,rep,name=demographics,proto3" json:"demographics,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -298,11 +326,25 @@ func (x *AnimeHit) GetTotalEpisodes() int32 {
 	return 0
 }
 
+func (x *AnimeHit) GetThemes() []string {
+	if x != nil {
+		return x.Themes
+	}
+	return nil
+}
+
+func (x *AnimeHit) GetDemographics() []string {
+	if x != nil {
+		return x.Demographics
+	}
+	return nil
+}
+
 var File_search_v1_search_proto protoreflect.FileDescriptor
 
 const file_search_v1_search_proto_rawDesc = "" +
 	"\n" +
-	"\x16search/v1/search.proto\x12\tsearch.v1\"\xd6\x01\n" +
+	"\x16search/v1/search.proto\x12\tsearch.v1\"\xa6\x02\n" +
 	"\x12SearchAnimeRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
@@ -311,10 +353,14 @@ const file_search_v1_search_proto_rawDesc = "" +
 	"\x06status\x18\x05 \x01(\tR\x06status\x12\x12\n" +
 	"\x04type\x18\x06 \x01(\tR\x04type\x12\x1b\n" +
 	"\tmin_score\x18\a \x01(\x02R\bminScore\x12\x1b\n" +
-	"\tmax_score\x18\b \x01(\x02R\bmaxScore\"T\n" +
+	"\tmax_score\x18\b \x01(\x02R\bmaxScore\x12\x16\n" +
+	"\x06themes\x18\t \x03(\tR\x06themes\x12\"\n" +
+	"\fdemographics\x18\n" +
+	" \x03(\tR\fdemographics\x12\x12\n" +
+	"\x04sort\x18\v \x01(\tR\x04sort\"T\n" +
 	"\x13SearchAnimeResponse\x12'\n" +
 	"\x04hits\x18\x01 \x03(\v2\x13.search.v1.AnimeHitR\x04hits\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"\xc0\x02\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xfc\x02\n" +
 	"\bAnimeHit\x12\x19\n" +
 	"\banime_id\x18\x01 \x01(\tR\aanimeId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12#\n" +
@@ -327,7 +373,9 @@ const file_search_v1_search_proto_rawDesc = "" +
 	"\x06status\x18\t \x01(\tR\x06status\x12\x12\n" +
 	"\x04type\x18\n" +
 	" \x01(\tR\x04type\x12%\n" +
-	"\x0etotal_episodes\x18\v \x01(\x05R\rtotalEpisodes2]\n" +
+	"\x0etotal_episodes\x18\v \x01(\x05R\rtotalEpisodes\x12\x16\n" +
+	"\x06themes\x18\f \x03(\tR\x06themes\x12\"\n" +
+	"\fdemographics\x18\r \x03(\tR\fdemographics2]\n" +
 	"\rSearchService\x12L\n" +
 	"\vSearchAnime\x12\x1d.search.v1.SearchAnimeRequest\x1a\x1e.search.v1.SearchAnimeResponseB\x9b\x01\n" +
 	"\rcom.search.v1B\vSearchProtoP\x01Z8github.com/example/anime-platform/gen/search/v1;searchv1\xa2\x02\x03SXX\xaa\x02\tSearch.V1\xca\x02\tSearch\\V1\xe2\x02\x15Search\\V1\\GPBMetadata\xea\x02\n" +
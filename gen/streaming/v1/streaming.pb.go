@@ -315,15 +315,23 @@ func (x *PlaybackHeaders) GetHeaders() map[string]string {
 }
 
 type GetPlaybackResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Sources           []*PlaybackSource      `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
-	Tracks            []*PlaybackTrack       `protobuf:"bytes,2,rep,name=tracks,proto3" json:"tracks,omitempty"`
-	Intro             *PlaybackIntroOutro    `protobuf:"bytes,3,opt,name=intro,proto3" json:"intro,omitempty"`
-	Outro             *PlaybackIntroOutro    `protobuf:"bytes,4,opt,name=outro,proto3" json:"outro,omitempty"`
-	Headers           *PlaybackHeaders       `protobuf:"bytes,5,opt,name=headers,proto3" json:"headers,omitempty"`
-	ProviderEpisodeId string                 `protobuf:"bytes,6,opt,name=provider_episode_id,json=providerEpisodeId,proto3" json:"provider_episode_id,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Sources []*PlaybackSource      `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	Tracks  []*PlaybackTrack       `protobuf:"bytes,2,rep,name=tracks,proto3" json:"tracks,omitempty"`
+	// Skip segments come from the provider when it reports them, otherwise from
+	// the catalog's stored provider or community segments.
+	Intro             *PlaybackIntroOutro `protobuf:"bytes,3,opt,name=intro,proto3" json:"intro,omitempty"`
+	Outro             *PlaybackIntroOutro `protobuf:"bytes,4,opt,name=outro,proto3" json:"outro,omitempty"`
+	Headers           *PlaybackHeaders    `protobuf:"bytes,5,opt,name=headers,proto3" json:"headers,omitempty"`
+	ProviderEpisodeId string              `protobuf:"bytes,6,opt,name=provider_episode_id,json=providerEpisodeId,proto3" json:"provider_episode_id,omitempty"`
+	Recap             *PlaybackIntroOutro `protobuf:"bytes,7,opt,name=recap,proto3" json:"recap,omitempty"`
+	Preview           *PlaybackIntroOutro `protobuf:"bytes,8,opt,name=preview,proto3" json:"preview,omitempty"`
+	// provider is the upstream that served the sources, e.g. "hianime". The
+	// resolver falls through its provider chain for the category, so this may
+	// not be the first provider configured.
+	Provider      string `protobuf:"bytes,9,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetPlaybackResponse) Reset() {
@@ -398,6 +406,27 @@ func (x *GetPlaybackResponse) GetProviderEpisodeId() string {
 	return ""
 }
 
+func (x *GetPlaybackResponse) GetRecap() *PlaybackIntroOutro {
+	if x != nil {
+		return x.Recap
+	}
+	return nil
+}
+
+func (x *GetPlaybackResponse) GetPreview() *PlaybackIntroOutro {
+	if x != nil {
+		return x.Preview
+	}
+	return nil
+}
+
+func (x *GetPlaybackResponse) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
 var File_streaming_v1_streaming_proto protoreflect.FileDescriptor
 
 const file_streaming_v1_streaming_proto_rawDesc = "" +
@@ -426,14 +455,17 @@ const file_streaming_v1_streaming_proto_rawDesc = "" +
 	"\aheaders\x18\x01 \x03(\v2*.streaming.v1.PlaybackHeaders.HeadersEntryR\aheaders\x1a:\n" +
 	"\fHeadersEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xdb\x02\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xeb\x03\n" +
 	"\x13GetPlaybackResponse\x126\n" +
 	"\asources\x18\x01 \x03(\v2\x1c.streaming.v1.PlaybackSourceR\asources\x123\n" +
 	"\x06tracks\x18\x02 \x03(\v2\x1b.streaming.v1.PlaybackTrackR\x06tracks\x126\n" +
 	"\x05intro\x18\x03 \x01(\v2 .streaming.v1.PlaybackIntroOutroR\x05intro\x126\n" +
 	"\x05outro\x18\x04 \x01(\v2 .streaming.v1.PlaybackIntroOutroR\x05outro\x127\n" +
 	"\aheaders\x18\x05 \x01(\v2\x1d.streaming.v1.PlaybackHeadersR\aheaders\x12.\n" +
-	"\x13provider_episode_id\x18\x06 \x01(\tR\x11providerEpisodeId2n\n" +
+	"\x13provider_episode_id\x18\x06 \x01(\tR\x11providerEpisodeId\x126\n" +
+	"\x05recap\x18\a \x01(\v2 .streaming.v1.PlaybackIntroOutroR\x05recap\x12:\n" +
+	"\apreview\x18\b \x01(\v2 .streaming.v1.PlaybackIntroOutroR\apreview\x12\x1a\n" +
+	"\bprovider\x18\t \x01(\tR\bprovider2n\n" +
 	"\x18StreamingResolverService\x12R\n" +
 	"\vGetPlayback\x12 .streaming.v1.GetPlaybackRequest\x1a!.streaming.v1.GetPlaybackResponseB\xb3\x01\n" +
 	"\x10com.streaming.v1B\x0eStreamingProtoP\x01Z>github.com/example/anime-platform/gen/streaming/v1;streamingv1\xa2\x02\x03SXX\xaa\x02\fStreaming.V1\xca\x02\fStreaming\\V1\xe2\x02\x18Streaming\\V1\\GPBMetadata\xea\x02\rStreaming::V1b\x06proto3"
@@ -467,13 +499,15 @@ var file_streaming_v1_streaming_proto_depIdxs = []int32{
 	3, // 3: streaming.v1.GetPlaybackResponse.intro:type_name -> streaming.v1.PlaybackIntroOutro
 	3, // 4: streaming.v1.GetPlaybackResponse.outro:type_name -> streaming.v1.PlaybackIntroOutro
 	4, // 5: streaming.v1.GetPlaybackResponse.headers:type_name -> streaming.v1.PlaybackHeaders
-	0, // 6: streaming.v1.StreamingResolverService.GetPlayback:input_type -> streaming.v1.GetPlaybackRequest
-	5, // 7: streaming.v1.StreamingResolverService.GetPlayback:output_type -> streaming.v1.GetPlaybackResponse
-	7, // [7:8] is the sub-list for method output_type
-	6, // [6:7] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	3, // 6: streaming.v1.GetPlaybackResponse.recap:type_name -> streaming.v1.PlaybackIntroOutro
+	3, // 7: streaming.v1.GetPlaybackResponse.preview:type_name -> streaming.v1.PlaybackIntroOutro
+	0, // 8: streaming.v1.StreamingResolverService.GetPlayback:input_type -> streaming.v1.GetPlaybackRequest
+	5, // 9: streaming.v1.StreamingResolverService.GetPlayback:output_type -> streaming.v1.GetPlaybackResponse
+	9, // [9:10] is the sub-list for method output_type
+	8, // [8:9] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_streaming_v1_streaming_proto_init() }
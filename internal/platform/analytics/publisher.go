@@ -18,6 +18,7 @@ const (
 	SubjectStreamingStarted   = "analytics.streaming.started"
 	SubjectCatalogAnimeViewed = "analytics.catalog.anime_viewed"
 	SubjectSearchPerformed    = "analytics.search.performed"
+	SubjectSocialAnimeRated   = "analytics.social.anime_rated"
 )
 
 // Event is the canonical envelope sent to all analytics.* subjects.
@@ -165,6 +165,54 @@ func TestRequireUser_InjectsRoleIntoContext(t *testing.T) {
 	}
 }
 
+// ─── OptionalUser middleware tests ───────────────────────────────────────────
+
+func callOptionalUser(req *http.Request) *httptest.ResponseRecorder {
+	rr := httptest.NewRecorder()
+	OptionalUser(newVerifier())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uid, ok := UserIDFromContext(r.Context())
+		if !ok {
+			uid = "anonymous"
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(uid))
+	})).ServeHTTP(rr, req)
+	return rr
+}
+
+func TestOptionalUser_NoHeaderIsAnonymous(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rr := callOptionalUser(req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rr.Code)
+	}
+	if rr.Body.String() != "anonymous" {
+		t.Fatalf("expected no user_id in context, got %q", rr.Body.String())
+	}
+}
+
+func TestOptionalUser_ValidBearer(t *testing.T) {
+	tok := makeToken("user-7", "user", time.Now().Add(time.Hour))
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer "+tok)
+	rr := callOptionalUser(req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rr.Code)
+	}
+	if rr.Body.String() != "user-7" {
+		t.Fatalf("expected 'user-7' in body, got %q", rr.Body.String())
+	}
+}
+
+func TestOptionalUser_InvalidTokenRejected(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer invalid.token.here")
+	rr := callOptionalUser(req)
+	if rr.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", rr.Code)
+	}
+}
+
 // ─── RequireAdmin middleware tests ───────────────────────────────────────────
 
 func callRequireAdmin(ctx context.Context) *httptest.ResponseRecorder {
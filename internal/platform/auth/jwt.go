@@ -62,21 +62,51 @@ func RequireUser(verifier JWTVerifier) func(next http.Handler) http.Handler {
 				w.WriteHeader(http.StatusUnauthorized)
 				return
 			}
-			parts := strings.SplitN(authz, " ", 2)
-			if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
+			ctx, ok := withBearer(r.Context(), verifier, authz)
+			if !ok {
 				w.WriteHeader(http.StatusUnauthorized)
 				return
 			}
-			claims, err := verifier.Parse(strings.TrimSpace(parts[1]))
-			if err != nil || strings.TrimSpace(claims.Subject) == "" {
-				w.WriteHeader(http.StatusUnauthorized)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// OptionalUser middleware is RequireUser for routes that also serve anonymous
+// callers: a request without an Authorization header passes through with no
+// user_id in context, while a malformed or invalid token is still rejected.
+func OptionalUser(verifier JWTVerifier) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authz := strings.TrimSpace(r.Header.Get("Authorization"))
+			if authz == "" {
+				next.ServeHTTP(w, r)
 				return
 			}
-			ctx := context.WithValue(r.Context(), ctxKeyUserID{}, claims.Subject)
-			if strings.TrimSpace(claims.Role) != "" {
-				ctx = context.WithValue(ctx, ctxKeyRole{}, claims.Role)
+			ctx, ok := withBearer(r.Context(), verifier, authz)
+			if !ok {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
 			}
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
+
+// withBearer verifies a "Bearer <token>" Authorization value and returns ctx
+// carrying the token's user_id and role.
+func withBearer(ctx context.Context, verifier JWTVerifier, authz string) (context.Context, bool) {
+	parts := strings.SplitN(authz, " ", 2)
+	if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
+		return ctx, false
+	}
+	claims, err := verifier.Parse(strings.TrimSpace(parts[1]))
+	if err != nil || strings.TrimSpace(claims.Subject) == "" {
+		return ctx, false
+	}
+	ctx = context.WithValue(ctx, ctxKeyUserID{}, claims.Subject)
+	if strings.TrimSpace(claims.Role) != "" {
+		ctx = context.WithValue(ctx, ctxKeyRole{}, claims.Role)
+	}
+	return ctx, true
+}
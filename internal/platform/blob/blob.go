@@ -0,0 +1,94 @@
+// Package blob is a minimal object store abstraction with a local filesystem
+// backend for development and an S3-compatible backend (AWS, MinIO, R2) for
+// production.
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+var (
+	ErrNotFound   = errors.New("blob: not found")
+	ErrInvalidKey = errors.New("blob: invalid key")
+)
+
+// Object is an open blob. Callers must close Body.
+type Object struct {
+	Body        io.ReadCloser
+	ContentType string
+	Size        int64
+}
+
+// Store puts and gets immutable objects by key. Keys are slash-separated and
+// must not be absolute or contain "." / ".." segments.
+type Store interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	Get(ctx context.Context, key string) (*Object, error)
+}
+
+type Config struct {
+	// Backend is "local" or "s3".
+	Backend  string
+	LocalDir string
+
+	S3Endpoint  string
+	S3Region    string
+	S3Bucket    string
+	S3AccessKey string
+	S3SecretKey string
+}
+
+func LoadConfig() Config {
+	cfg := Config{
+		Backend:     strings.ToLower(strings.TrimSpace(os.Getenv("BLOB_BACKEND"))),
+		LocalDir:    strings.TrimSpace(os.Getenv("BLOB_LOCAL_DIR")),
+		S3Endpoint:  strings.TrimSpace(os.Getenv("S3_ENDPOINT")),
+		S3Region:    strings.TrimSpace(os.Getenv("S3_REGION")),
+		S3Bucket:    strings.TrimSpace(os.Getenv("S3_BUCKET")),
+		S3AccessKey: strings.TrimSpace(os.Getenv("S3_ACCESS_KEY_ID")),
+		S3SecretKey: strings.TrimSpace(os.Getenv("S3_SECRET_ACCESS_KEY")),
+	}
+	if cfg.Backend == "" {
+		cfg.Backend = "local"
+	}
+	if cfg.LocalDir == "" {
+		cfg.LocalDir = os.TempDir() + "/blobs"
+	}
+	if cfg.S3Region == "" {
+		cfg.S3Region = "us-east-1"
+	}
+	return cfg
+}
+
+// Open returns the Store selected by cfg.Backend.
+func Open(cfg Config) (Store, error) {
+	switch cfg.Backend {
+	case "local":
+		return NewLocal(cfg.LocalDir)
+	case "s3":
+		if cfg.S3Endpoint == "" || cfg.S3Bucket == "" || cfg.S3AccessKey == "" || cfg.S3SecretKey == "" {
+			return nil, errors.New("blob: S3_ENDPOINT, S3_BUCKET, S3_ACCESS_KEY_ID and S3_SECRET_ACCESS_KEY are required")
+		}
+		return NewS3(cfg.S3Endpoint, cfg.S3Region, cfg.S3Bucket, cfg.S3AccessKey, cfg.S3SecretKey), nil
+	default:
+		return nil, fmt.Errorf("blob: unknown backend %q", cfg.Backend)
+	}
+}
+
+// ValidKey reports whether key is a relative, clean object key.
+func ValidKey(key string) bool {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, `\`) {
+		return false
+	}
+	for _, seg := range strings.Split(key, "/") {
+		if seg == "" || seg == "." || seg == ".." {
+			return false
+		}
+	}
+	return true
+}
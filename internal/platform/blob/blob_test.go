@@ -0,0 +1,136 @@
+package blob
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestValidKey(t *testing.T) {
+	for key, want := range map[string]bool{
+		"anime/abc/thumb.jpg": true,
+		"a":                   true,
+		"":                    false,
+		"/abs":                false,
+		"a/../b":              false,
+		"a//b":                false,
+		"./a":                 false,
+		`a\b`:                 false,
+	} {
+		if got := ValidKey(key); got != want {
+			t.Errorf("ValidKey(%q) = %v, want %v", key, got, want)
+		}
+	}
+}
+
+func TestLocal_PutGet(t *testing.T) {
+	l, err := NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if err := l.Put(ctx, "anime/1/thumb.jpg", []byte("jpeg"), "image/jpeg"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	obj, err := l.Get(ctx, "anime/1/thumb.jpg")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	defer obj.Body.Close()
+	body, _ := io.ReadAll(obj.Body)
+	if string(body) != "jpeg" || obj.ContentType != "image/jpeg" || obj.Size != 4 {
+		t.Fatalf("got %q %q %d", body, obj.ContentType, obj.Size)
+	}
+	if _, err := l.Get(ctx, "anime/2/thumb.jpg"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("missing key: got %v, want ErrNotFound", err)
+	}
+	if err := l.Put(ctx, "../escape", []byte("x"), ""); !errors.Is(err, ErrInvalidKey) {
+		t.Fatalf("escape key: got %v, want ErrInvalidKey", err)
+	}
+}
+
+// fakeS3 is an in-memory path-style bucket that checks the SigV4 envelope.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	types   map[string]string
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AK/20240102/eu-west-1/s3/aws4_request, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=") {
+		http.Error(w, "bad auth "+auth, http.StatusForbidden)
+		return
+	}
+	if r.Header.Get("X-Amz-Date") != "20240102T030405Z" {
+		http.Error(w, "bad date", http.StatusForbidden)
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		sum := sha256.Sum256(body)
+		if r.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(sum[:]) {
+			http.Error(w, "payload hash mismatch", http.StatusBadRequest)
+			return
+		}
+		f.objects[r.URL.Path] = body
+		f.types[r.URL.Path] = r.Header.Get("Content-Type")
+	case http.MethodGet:
+		body, ok := f.objects[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", f.types[r.URL.Path])
+		_, _ = w.Write(body)
+	}
+}
+
+func TestS3_PutGet(t *testing.T) {
+	fake := &fakeS3{objects: map[string][]byte{}, types: map[string]string{}}
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+
+	s := NewS3(srv.URL+"/", "eu-west-1", "images", "AK", "SECRET")
+	s.now = func() time.Time { return time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC) }
+	ctx := context.Background()
+
+	if err := s.Put(ctx, "anime/1/large.jpg", []byte("data"), "image/jpeg"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if _, ok := fake.objects["/images/anime/1/large.jpg"]; !ok {
+		t.Fatalf("object not stored path-style: %v", fake.objects)
+	}
+	obj, err := s.Get(ctx, "anime/1/large.jpg")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	defer obj.Body.Close()
+	body, _ := io.ReadAll(obj.Body)
+	if string(body) != "data" || obj.ContentType != "image/jpeg" {
+		t.Fatalf("got %q %q", body, obj.ContentType)
+	}
+	if _, err := s.Get(ctx, "anime/2/large.jpg"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("missing key: got %v, want ErrNotFound", err)
+	}
+}
+
+func TestURIEncode(t *testing.T) {
+	if got := uriEncode("a b/c~d+e", true); got != "a%20b/c~d%2Be" {
+		t.Fatalf("uriEncode = %q", got)
+	}
+	if got := uriEncode("a/b", false); got != "a%2Fb" {
+		t.Fatalf("uriEncode = %q", got)
+	}
+}
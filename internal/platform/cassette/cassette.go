@@ -0,0 +1,253 @@
+// Package cassette records upstream HTTP exchanges to disk and replays them,
+// so sync pipelines can run against fixed fixtures without network access.
+//
+// A cassette is a directory with one JSON file per distinct request. Requests
+// are keyed by method, path, sorted query and body; the host is ignored so a
+// cassette recorded against one base URL replays against any other.
+package cassette
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Mode selects what a Transport does with requests.
+type Mode string
+
+const (
+	// Off passes requests through untouched.
+	Off Mode = ""
+	// Record sends requests upstream and saves every response, overwriting
+	// earlier recordings of the same request.
+	Record Mode = "record"
+	// Replay answers from the cassette and never touches the network.
+	Replay Mode = "replay"
+)
+
+// ErrNotRecorded is returned in Replay mode for a request the cassette has no
+// recording of.
+var ErrNotRecorded = errors.New("cassette: request not recorded")
+
+// Config selects the mode and the directory holding one cassette per
+// upstream.
+type Config struct {
+	Mode Mode
+	Dir  string
+}
+
+// FromEnv reads HTTP_CASSETTE_MODE (record or replay; empty disables) and
+// HTTP_CASSETTE_DIR (default "testdata/cassettes").
+func FromEnv() (Config, error) {
+	cfg := Config{
+		Mode: Mode(strings.ToLower(strings.TrimSpace(os.Getenv("HTTP_CASSETTE_MODE")))),
+		Dir:  strings.TrimSpace(os.Getenv("HTTP_CASSETTE_DIR")),
+	}
+	switch cfg.Mode {
+	case Off, Record, Replay:
+	default:
+		return Config{}, fmt.Errorf("HTTP_CASSETTE_MODE must be record or replay, got %q", cfg.Mode)
+	}
+	if cfg.Dir == "" {
+		cfg.Dir = "testdata/cassettes"
+	}
+	return cfg, nil
+}
+
+// Client returns a copy of hc whose requests go through the cassette for
+// upstream name, or hc itself when cassettes are off. Wrap the rate-limited
+// client so replays skip the limiter and recordings still respect it.
+func (c Config) Client(hc *http.Client, name string) *http.Client {
+	if c.Mode == Off {
+		return hc
+	}
+	out := *hc
+	out.Transport = &Transport{Base: hc.Transport, Dir: filepath.Join(c.Dir, name), Mode: c.Mode}
+	return &out
+}
+
+// Transport records or replays requests under Dir.
+type Transport struct {
+	Base http.RoundTripper
+	Dir  string
+	Mode Mode
+}
+
+type recording struct {
+	Request  recordedRequest  `json:"request"`
+	Response recordedResponse `json:"response"`
+}
+
+type recordedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// recordedResponse keeps JSON bodies as JSON so cassettes stay readable and
+// can be edited by hand.
+type recordedResponse struct {
+	Status   int             `json:"status"`
+	Header   http.Header     `json:"header,omitempty"`
+	BodyJSON json.RawMessage `json:"body_json,omitempty"`
+	Body     string          `json:"body,omitempty"`
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = b
+		req.Body = io.NopCloser(bytes.NewReader(b))
+	}
+	path := filepath.Join(t.Dir, fileName(req, body))
+
+	switch t.Mode {
+	case Replay:
+		return replay(req, path)
+	case Record:
+		return t.record(req, body, path)
+	default:
+		return t.base().RoundTrip(req)
+	}
+}
+
+func (t *Transport) base() http.RoundTripper {
+	if t.Base == nil {
+		return http.DefaultTransport
+	}
+	return t.Base
+}
+
+func replay(req *http.Request, path string) (*http.Response, error) {
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s %s (%s)", ErrNotRecorded, req.Method, req.URL.RequestURI(), path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var rec recording
+	if err := json.Unmarshal(b, &rec); err != nil {
+		return nil, fmt.Errorf("cassette %s: %w", path, err)
+	}
+	body := []byte(rec.Response.Body)
+	if len(rec.Response.BodyJSON) > 0 {
+		// Undo the indentation added when the cassette was written.
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, rec.Response.BodyJSON); err != nil {
+			return nil, fmt.Errorf("cassette %s: %w", path, err)
+		}
+		body = buf.Bytes()
+	}
+	header := rec.Response.Header
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", rec.Response.Status, http.StatusText(rec.Response.Status)),
+		StatusCode:    rec.Response.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+func (t *Transport) record(req *http.Request, reqBody []byte, path string) (*http.Response, error) {
+	resp, err := t.base().RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+
+	rec := recording{
+		Request:  recordedRequest{Method: req.Method, URL: req.URL.RequestURI(), Body: string(reqBody)},
+		Response: recordedResponse{Status: resp.StatusCode, Header: recordedHeader(resp.Header)},
+	}
+	if json.Valid(body) {
+		rec.Response.BodyJSON = body
+	} else {
+		rec.Response.Body = string(body)
+	}
+	if err := writeFile(path, rec); err != nil {
+		return nil, fmt.Errorf("cassette record: %w", err)
+	}
+	return resp, nil
+}
+
+// recordedHeader drops headers that describe the original transfer rather
+// than the response, plus cookies.
+func recordedHeader(h http.Header) http.Header {
+	out := h.Clone()
+	for _, k := range []string{"Content-Length", "Transfer-Encoding", "Connection", "Date", "Set-Cookie"} {
+		out.Del(k)
+	}
+	return out
+}
+
+// writeFile replaces path atomically so concurrent recordings of the same
+// request never leave a torn file.
+func writeFile(path string, rec recording) error {
+	b, err := json.MarshalIndent(rec, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(path), ".record-*")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(b, '\n')); err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		_ = os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+var unsafeChars = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+// fileName is a readable prefix from the method and path plus a hash of
+// everything that identifies the request, e.g. GET-v4-anime-52991-full-1a2b3c4d5e6f.json.
+// Query parameters are sorted by url.Values.Encode.
+func fileName(req *http.Request, body []byte) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\n%s\n%s\n", req.Method, req.URL.EscapedPath(), req.URL.Query().Encode())
+	h.Write(body)
+	sum := hex.EncodeToString(h.Sum(nil))[:12]
+
+	prefix := strings.Trim(unsafeChars.ReplaceAllString(req.Method+"-"+req.URL.Path, "-"), "-")
+	if len(prefix) > 80 {
+		prefix = prefix[:80]
+	}
+	return prefix + "-" + sum + ".json"
+}
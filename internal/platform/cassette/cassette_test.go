@@ -0,0 +1,56 @@
+package cassette
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRecordThenReplay(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"data":{"mal_id":`+r.URL.Query().Get("id")+`}}`)
+	}))
+	dir := t.TempDir()
+
+	rec := Config{Mode: Record, Dir: dir}.Client(srv.Client(), "jikan")
+	if got := get(t, rec, srv.URL+"/anime?id=1&page=2"); got != `{"data":{"mal_id":1}}` {
+		t.Fatalf("recorded body = %s", got)
+	}
+	srv.Close()
+
+	// Replay ignores the host and the order of query parameters.
+	play := Config{Mode: Replay, Dir: dir}.Client(http.DefaultClient, "jikan")
+	if got := get(t, play, "http://offline.invalid/anime?page=2&id=1"); got != `{"data":{"mal_id":1}}` {
+		t.Fatalf("replayed body = %s", got)
+	}
+	if calls != 1 {
+		t.Fatalf("upstream called %d times", calls)
+	}
+
+	_, err := play.Get("http://offline.invalid/anime?id=2")
+	if !errors.Is(err, ErrNotRecorded) {
+		t.Fatalf("expected ErrNotRecorded, got %v", err)
+	}
+}
+
+func get(t *testing.T, c *http.Client, url string) string {
+	t.Helper()
+	resp, err := c.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "application/json" {
+		t.Fatalf("status %d, content type %q", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+	return string(b)
+}
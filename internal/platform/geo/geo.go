@@ -0,0 +1,178 @@
+// Package geo carries the client's country from the edge (BFF) to backend
+// services so they can enforce regional availability.
+package geo
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey is the gRPC metadata key holding the ISO 3166-1 alpha-2 country code.
+const MetadataKey = "x-client-country"
+
+// Anywhere is the country internal callers send to read content regardless of
+// regional rules, e.g. to index or sync it. It is never read from a client:
+// Normalize only accepts two-letter codes.
+const Anywhere = "*"
+
+// DefaultTrustedHeader is set by Cloudflare from the client's IP address.
+const DefaultTrustedHeader = "CF-IPCountry"
+
+// devHeaders let clients pick their own country. They are only honoured with
+// Headers.Dev, for testing regional rules locally without an edge proxy.
+var devHeaders = []string{"X-Client-Country", "X-Country-Code"}
+
+// Headers selects where the client country is read from. Only Trusted, which
+// the edge proxy must overwrite on every request, is believed by default;
+// anything a client can set itself would let it dodge regional blocks.
+type Headers struct {
+	Trusted string
+	Dev     bool
+}
+
+type ctxKeyCountry struct{}
+
+// Normalize upper-cases a country code and returns "" for anything that is not
+// a two-letter code, including Cloudflare's "XX" (unknown) and "T1" (Tor).
+func Normalize(code string) string {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if len(code) != 2 || code == "XX" {
+		return ""
+	}
+	for _, c := range code {
+		if c < 'A' || c > 'Z' {
+			return ""
+		}
+	}
+	return code
+}
+
+// NormalizeList normalizes and de-duplicates a list of country codes, dropping invalid ones.
+func NormalizeList(codes []string) []string {
+	out := make([]string, 0, len(codes))
+	seen := make(map[string]struct{}, len(codes))
+	for _, c := range codes {
+		c = Normalize(c)
+		if c == "" {
+			continue
+		}
+		if _, ok := seen[c]; ok {
+			continue
+		}
+		seen[c] = struct{}{}
+		out = append(out, c)
+	}
+	return out
+}
+
+// FromRequest returns the client country from the trusted header, then, in
+// dev mode, from the dev headers.
+func (h Headers) FromRequest(r *http.Request) string {
+	trusted := h.Trusted
+	if trusted == "" {
+		trusted = DefaultTrustedHeader
+	}
+	if c := Normalize(r.Header.Get(trusted)); c != "" {
+		return c
+	}
+	if !h.Dev {
+		return ""
+	}
+	for _, name := range devHeaders {
+		if c := Normalize(r.Header.Get(name)); c != "" {
+			return c
+		}
+	}
+	return ""
+}
+
+func WithCountry(ctx context.Context, country string) context.Context {
+	return context.WithValue(ctx, ctxKeyCountry{}, Normalize(country))
+}
+
+func FromContext(ctx context.Context) string {
+	v, _ := ctx.Value(ctxKeyCountry{}).(string)
+	return v
+}
+
+// FromIncoming reads the country a caller attached to an incoming gRPC request.
+func FromIncoming(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	vals := md.Get(MetadataKey)
+	if len(vals) == 0 {
+		return ""
+	}
+	if vals[0] == Anywhere {
+		return Anywhere
+	}
+	return Normalize(vals[0])
+}
+
+// Unrestricted marks an outgoing gRPC request as made by a backend job on
+// behalf of no client, so regional rules do not hide content from it.
+func Unrestricted(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, MetadataKey, Anywhere)
+}
+
+// Middleware stores the request's country in the context for
+// UnaryClientInterceptor. Outside dev mode it drops the dev headers, so no
+// handler can read a client-chosen country by mistake.
+func (h Headers) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !h.Dev {
+			for _, name := range devHeaders {
+				r.Header.Del(name)
+			}
+		}
+		if c := h.FromRequest(r); c != "" {
+			r = r.WithContext(WithCountry(r.Context(), c))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// UnaryClientInterceptor forwards the context's country as outgoing gRPC metadata.
+// It runs after handlers build their outgoing context, so it is not clobbered by
+// metadata.NewOutgoingContext.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if c := FromContext(ctx); c != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, MetadataKey, c)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// Allowed reports whether content with the given allow/deny lists may be served
+// to country. An empty allow list means "everywhere". An unknown country
+// passes deny lists but fails allow lists: hiding one's location must not
+// unlock content licensed to a few countries.
+func Allowed(country string, allowed, blocked []string) bool {
+	if country == Anywhere {
+		return true
+	}
+	if country == "" {
+		return len(allowed) == 0
+	}
+	for _, c := range blocked {
+		if c == country {
+			return false
+		}
+	}
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, c := range allowed {
+		if c == country {
+			return true
+		}
+	}
+	return false
+}
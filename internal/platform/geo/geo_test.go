@@ -0,0 +1,113 @@
+package geo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestNormalize(t *testing.T) {
+	cases := map[string]string{
+		"us":   "US",
+		" de ": "DE",
+		"XX":   "",
+		"T1":   "",
+		"USA":  "",
+		"":     "",
+	}
+	for in, want := range cases {
+		if got := Normalize(in); got != want {
+			t.Fatalf("Normalize(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestFromRequest_HeaderPrecedence(t *testing.T) {
+	r := httptest.NewRequest("GET", "/", nil)
+	r.Header.Set("X-Client-Country", "fr")
+	r.Header.Set("CF-IPCountry", "jp")
+	if got := (Headers{Dev: true}).FromRequest(r); got != "JP" {
+		t.Fatalf("expected JP, got %q", got)
+	}
+}
+
+func TestFromRequest_IgnoresClientHeaders(t *testing.T) {
+	r := httptest.NewRequest("GET", "/", nil)
+	r.Header.Set("X-Client-Country", "us")
+	r.Header.Set("X-Country-Code", "us")
+	if got := (Headers{}).FromRequest(r); got != "" {
+		t.Fatalf("client-supplied country trusted: %q", got)
+	}
+	if got := (Headers{Dev: true}).FromRequest(r); got != "US" {
+		t.Fatalf("dev mode: expected US, got %q", got)
+	}
+
+	r.Header.Set("X-Edge-Country", "de")
+	if got := (Headers{Trusted: "X-Edge-Country"}).FromRequest(r); got != "DE" {
+		t.Fatalf("trusted header: expected DE, got %q", got)
+	}
+}
+
+func TestMiddleware_StripsDevHeaders(t *testing.T) {
+	r := httptest.NewRequest("GET", "/", nil)
+	r.Header.Set("X-Client-Country", "us")
+	var got string
+	h := Headers{}.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = FromContext(r.Context()) + r.Header.Get("X-Client-Country")
+	}))
+	h.ServeHTTP(httptest.NewRecorder(), r)
+	if got != "" {
+		t.Fatalf("client header leaked through: %q", got)
+	}
+}
+
+func TestAllowed(t *testing.T) {
+	if !Allowed("", nil, []string{"US"}) {
+		t.Fatal("unknown country should pass a deny list")
+	}
+	if Allowed("US", nil, []string{"US"}) {
+		t.Fatal("blocked country allowed")
+	}
+	if Allowed("DE", []string{"JP"}, nil) {
+		t.Fatal("country outside allow list allowed")
+	}
+	if !Allowed("JP", []string{"JP"}, nil) {
+		t.Fatal("country in allow list denied")
+	}
+}
+
+func TestAllowed_UnknownCountryFailsAllowList(t *testing.T) {
+	// Normalize maps Cloudflare's XX and Tor's T1 to "", as it does a missing header.
+	for _, raw := range []string{"", "XX", "T1"} {
+		if Allowed(Normalize(raw), []string{"JP"}, nil) {
+			t.Fatalf("unknown country %q served allow-listed content", raw)
+		}
+	}
+}
+
+func TestFromIncoming(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, "br"))
+	if got := FromIncoming(ctx); got != "BR" {
+		t.Fatalf("expected BR, got %q", got)
+	}
+}
+
+func TestUnrestricted(t *testing.T) {
+	out, _ := metadata.FromOutgoingContext(Unrestricted(context.Background()))
+	ctx := metadata.NewIncomingContext(context.Background(), out)
+	if got := FromIncoming(ctx); got != Anywhere {
+		t.Fatalf("expected %q, got %q", Anywhere, got)
+	}
+	if !Allowed(Anywhere, []string{"JP"}, []string{"US"}) {
+		t.Fatal("unrestricted caller was refused")
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set(DefaultTrustedHeader, Anywhere)
+	if got := (Headers{}).FromRequest(r); got != "" {
+		t.Fatalf("client header produced %q", got)
+	}
+}
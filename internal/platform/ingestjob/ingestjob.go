@@ -0,0 +1,42 @@
+// Package ingestjob is the message contract for ingestion jobs, shared by the
+// ingestion worker and every service that enqueues jobs for it.
+package ingestjob
+
+import "strings"
+
+// IDHeader carries the job record id on ingestion messages. The worker
+// records the job under it on first delivery.
+const IDHeader = "Ingestion-Job-Id"
+
+// Priority lanes. Each job subject exists once per lane, so a job a user is
+// waiting on does not queue behind a bulk backfill of the same kind.
+const (
+	// LaneInteractive is for jobs a user is waiting on, e.g. a search miss.
+	LaneInteractive = "interactive"
+	// LaneScheduled is for cron-triggered jobs. It is the default lane and
+	// uses the plain subject, so jobs from publishers that predate lanes keep
+	// their place.
+	LaneScheduled = "scheduled"
+	// LaneBackfill is for bulk imports.
+	LaneBackfill = "backfill"
+)
+
+// LaneSubject returns the subject for base jobs in lane, e.g.
+// "ingestion.jikan.sync.interactive". The scheduled lane, and an empty lane,
+// use base itself.
+func LaneSubject(base, lane string) string {
+	if lane == "" || lane == LaneScheduled {
+		return base
+	}
+	return base + "." + lane
+}
+
+// SplitLane splits a job subject into its base subject and lane.
+func SplitLane(subject string) (base, lane string) {
+	for _, l := range []string{LaneInteractive, LaneBackfill} {
+		if b, ok := strings.CutSuffix(subject, "."+l); ok {
+			return b, l
+		}
+	}
+	return subject, LaneScheduled
+}
@@ -0,0 +1,19 @@
+package ingestjob
+
+import "testing"
+
+func TestSplitLane(t *testing.T) {
+	for _, tc := range []struct{ subject, base, lane string }{
+		{"ingestion.jikan.sync", "ingestion.jikan.sync", LaneScheduled},
+		{"ingestion.jikan.sync.interactive", "ingestion.jikan.sync", LaneInteractive},
+		{"ingestion.hianime.sync.backfill", "ingestion.hianime.sync", LaneBackfill},
+	} {
+		base, lane := SplitLane(tc.subject)
+		if base != tc.base || lane != tc.lane {
+			t.Fatalf("SplitLane(%q) = %q, %q", tc.subject, base, lane)
+		}
+		if got := LaneSubject(base, lane); got != tc.subject {
+			t.Fatalf("LaneSubject(%q, %q) = %q", base, lane, got)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Package locale normalizes BCP 47 language tags and builds the fallback chains
+// used to pick localized catalog content.
+package locale
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Default is the locale of the catalog's base (untranslated) fields.
+const Default = "en"
+
+// Normalize canonicalizes the case of a language tag ("PT-br" → "pt-BR",
+// "zh-hant-tw" → "zh-Hant-TW"). It returns "" for anything that is not a
+// plausible tag.
+func Normalize(tag string) string {
+	tag = strings.ReplaceAll(strings.TrimSpace(tag), "_", "-")
+	if tag == "" {
+		return ""
+	}
+	parts := strings.Split(tag, "-")
+	for i, p := range parts {
+		if p == "" || len(p) > 8 || !isAlnum(p) {
+			return ""
+		}
+		switch {
+		case i == 0:
+			if len(p) < 2 || len(p) > 3 {
+				return ""
+			}
+			parts[i] = strings.ToLower(p)
+		case len(p) == 4:
+			parts[i] = strings.ToUpper(p[:1]) + strings.ToLower(p[1:])
+		case len(p) == 2:
+			parts[i] = strings.ToUpper(p)
+		default:
+			parts[i] = strings.ToLower(p)
+		}
+	}
+	return strings.Join(parts, "-")
+}
+
+// FallbackChain returns the locales to try in order, ending with Default:
+// "pt-BR" → [pt-BR pt en]. An empty or invalid tag yields [en].
+func FallbackChain(tag string) []string {
+	tag = Normalize(tag)
+	var chain []string
+	for tag != "" {
+		chain = append(chain, tag)
+		i := strings.LastIndex(tag, "-")
+		if i < 0 {
+			break
+		}
+		tag = tag[:i]
+	}
+	if len(chain) == 0 || chain[len(chain)-1] != Default {
+		chain = append(chain, Default)
+	}
+	return chain
+}
+
+// FromAcceptLanguage picks the highest-weighted tag from an Accept-Language
+// header, ignoring wildcards. It returns "" when nothing usable is present.
+func FromAcceptLanguage(header string) string {
+	type weighted struct {
+		tag string
+		q   float64
+	}
+	var tags []weighted
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		tag := Normalize(fields[0])
+		if tag == "" {
+			continue
+		}
+		q := 1.0
+		for _, f := range fields[1:] {
+			f = strings.TrimSpace(f)
+			if v, ok := strings.CutPrefix(f, "q="); ok {
+				if parsed, err := strconv.ParseFloat(v, 64); err == nil {
+					q = parsed
+				}
+			}
+		}
+		if q <= 0 {
+			continue
+		}
+		tags = append(tags, weighted{tag: tag, q: q})
+	}
+	if len(tags) == 0 {
+		return ""
+	}
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].q > tags[j].q })
+	return tags[0].tag
+}
+
+func isAlnum(s string) bool {
+	for _, c := range s {
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,50 @@
+package locale
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	cases := map[string]string{
+		"PT-br":      "pt-BR",
+		"es_419":     "es-419",
+		"zh-hant-tw": "zh-Hant-TW",
+		"*":          "",
+		"x":          "",
+	}
+	for in, want := range cases {
+		if got := Normalize(in); got != want {
+			t.Fatalf("Normalize(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestFallbackChain(t *testing.T) {
+	cases := map[string][]string{
+		"pt-BR": {"pt-BR", "pt", "en"},
+		"en-US": {"en-US", "en"},
+		"ru":    {"ru", "en"},
+		"":      {"en"},
+	}
+	for in, want := range cases {
+		if got := FallbackChain(in); !reflect.DeepEqual(got, want) {
+			t.Fatalf("FallbackChain(%q) = %v, want %v", in, got, want)
+		}
+	}
+}
+
+func TestFromAcceptLanguage(t *testing.T) {
+	cases := map[string]string{
+		"pt-BR,pt;q=0.9,en;q=0.8": "pt-BR",
+		"en;q=0.5, ru;q=0.9":      "ru",
+		"*":                       "",
+		"es;q=0, fr":              "fr",
+		"":                        "",
+	}
+	for in, want := range cases {
+		if got := FromAcceptLanguage(in); got != want {
+			t.Fatalf("FromAcceptLanguage(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
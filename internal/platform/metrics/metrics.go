@@ -0,0 +1,127 @@
+// Package metrics is a minimal in-process registry rendered in the Prometheus
+// text exposition format. It covers the gauges and counters services need today
+// without pulling in a client library.
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+type metric interface {
+	write(w io.Writer)
+}
+
+// Registry holds named metrics. The zero value is not usable; use NewRegistry.
+type Registry struct {
+	mu      sync.Mutex
+	metrics map[string]metric
+}
+
+func NewRegistry() *Registry {
+	return &Registry{metrics: map[string]metric{}}
+}
+
+// Default is the process-wide registry used by the package-level constructors.
+var Default = NewRegistry()
+
+// Gauge is a value that can go up and down.
+type Gauge struct {
+	name, help string
+	bits       atomic.Uint64
+}
+
+func (g *Gauge) Set(v float64) { g.bits.Store(math.Float64bits(v)) }
+
+func (g *Gauge) Value() float64 { return math.Float64frombits(g.bits.Load()) }
+
+func (g *Gauge) write(w io.Writer) {
+	writeHeader(w, g.name, g.help, "gauge")
+	fmt.Fprintf(w, "%s %s\n", g.name, formatValue(g.Value()))
+}
+
+// Counter is a monotonically increasing value.
+type Counter struct {
+	name, help string
+	n          atomic.Uint64
+}
+
+func (c *Counter) Add(n uint64) { c.n.Add(n) }
+
+func (c *Counter) Inc() { c.n.Add(1) }
+
+func (c *Counter) Value() uint64 { return c.n.Load() }
+
+func (c *Counter) write(w io.Writer) {
+	writeHeader(w, c.name, c.help, "counter")
+	fmt.Fprintf(w, "%s %d\n", c.name, c.Value())
+}
+
+// NewGauge registers a gauge, or returns the existing one with the same name.
+func (r *Registry) NewGauge(name, help string) *Gauge {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if g, ok := r.metrics[name].(*Gauge); ok {
+		return g
+	}
+	g := &Gauge{name: name, help: help}
+	r.metrics[name] = g
+	return g
+}
+
+// NewCounter registers a counter, or returns the existing one with the same name.
+func (r *Registry) NewCounter(name, help string) *Counter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if c, ok := r.metrics[name].(*Counter); ok {
+		return c
+	}
+	c := &Counter{name: name, help: help}
+	r.metrics[name] = c
+	return c
+}
+
+// Handler serves the registry in the Prometheus text format.
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		r.mu.Lock()
+		names := make([]string, 0, len(r.metrics))
+		for name := range r.metrics {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		ms := make([]metric, 0, len(names))
+		for _, name := range names {
+			ms = append(ms, r.metrics[name])
+		}
+		r.mu.Unlock()
+
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		for _, m := range ms {
+			m.write(w)
+		}
+	})
+}
+
+func NewGauge(name, help string) *Gauge { return Default.NewGauge(name, help) }
+
+func NewCounter(name, help string) *Counter { return Default.NewCounter(name, help) }
+
+func Handler() http.Handler { return Default.Handler() }
+
+func writeHeader(w io.Writer, name, help, typ string) {
+	if help != "" {
+		fmt.Fprintf(w, "# HELP %s %s\n", name, help)
+	}
+	fmt.Fprintf(w, "# TYPE %s %s\n", name, typ)
+}
+
+func formatValue(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
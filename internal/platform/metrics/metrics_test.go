@@ -0,0 +1,33 @@
+package metrics
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandler_RendersPrometheusText(t *testing.T) {
+	r := NewRegistry()
+	g := r.NewGauge("queue_oldest_seconds", "Age of the oldest item.")
+	c := r.NewCounter("jobs_total", "")
+	g.Set(12.5)
+	c.Inc()
+	c.Add(2)
+
+	if r.NewGauge("queue_oldest_seconds", "") != g {
+		t.Fatal("expected the same gauge for the same name")
+	}
+
+	rr := httptest.NewRecorder()
+	r.Handler().ServeHTTP(rr, httptest.NewRequest("GET", "/metrics", nil))
+	body := rr.Body.String()
+
+	want := "# TYPE jobs_total counter\njobs_total 3\n" +
+		"# HELP queue_oldest_seconds Age of the oldest item.\n# TYPE queue_oldest_seconds gauge\nqueue_oldest_seconds 12.5\n"
+	if body != want {
+		t.Fatalf("unexpected body:\n%s", body)
+	}
+	if !strings.HasPrefix(rr.Header().Get("Content-Type"), "text/plain") {
+		t.Fatalf("unexpected content type %q", rr.Header().Get("Content-Type"))
+	}
+}
@@ -0,0 +1,151 @@
+// Package ratelimit limits outbound calls to external APIs. Limits are keyed
+// per upstream; the Redis backend shares each upstream's budget across every
+// process using the same Redis, the memory backend is per process (tests and
+// single-replica development).
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// UpstreamJikan is the shared key for api.jikan.moe. Every service calling
+// Jikan must use it so their requests count against one budget.
+const UpstreamJikan = "jikan"
+
+// UpstreamHiAnime is the key for the HiAnime API used by ingestion.
+const UpstreamHiAnime = "hianime"
+
+// UpstreamAniList is the key for the AniList GraphQL API used by ingestion's
+// metadata provider.
+const UpstreamAniList = "anilist"
+
+// Limit allows Rate requests per second with bursts of up to Burst requests.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+func (l Limit) interval() time.Duration {
+	if l.Rate <= 0 {
+		return 0
+	}
+	return time.Duration(float64(time.Second) / l.Rate)
+}
+
+// tolerance is how far ahead of now the theoretical arrival time may run
+// (GCRA's burst allowance).
+func (l Limit) tolerance() time.Duration {
+	if l.Burst <= 1 {
+		return 0
+	}
+	return l.interval() * time.Duration(l.Burst-1)
+}
+
+// Limiter throttles requests per upstream key.
+type Limiter interface {
+	// Wait blocks until a request to key is allowed or ctx is done. Keys
+	// without a configured Limit are only held back by Backoff.
+	Wait(ctx context.Context, key string) error
+	// Backoff holds back all requests to key for d, e.g. after a 429.
+	// A shorter backoff never shortens one already in effect.
+	Backoff(ctx context.Context, key string, d time.Duration) error
+}
+
+// New returns a Redis-backed limiter when redisURL is set and a memory
+// limiter otherwise.
+func New(redisURL string, limits map[string]Limit) (Limiter, error) {
+	if redisURL == "" {
+		return NewMemory(limits), nil
+	}
+	return NewRedis(redisURL, limits)
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// Memory is an in-process GCRA limiter.
+type Memory struct {
+	limits map[string]Limit
+	// now is the clock; tests replace it.
+	now func() time.Time
+
+	mu    sync.Mutex
+	state map[string]*memoryState
+}
+
+type memoryState struct {
+	tat          time.Time
+	blockedUntil time.Time
+}
+
+func NewMemory(limits map[string]Limit) *Memory {
+	return &Memory{limits: limits, now: time.Now, state: map[string]*memoryState{}}
+}
+
+func (m *Memory) Wait(ctx context.Context, key string) error {
+	for {
+		d := m.reserve(key)
+		if d <= 0 {
+			return nil
+		}
+		if err := sleep(ctx, d); err != nil {
+			return err
+		}
+	}
+}
+
+func (m *Memory) Backoff(_ context.Context, key string, d time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s := m.stateFor(key)
+	if until := m.now().Add(d); until.After(s.blockedUntil) {
+		s.blockedUntil = until
+	}
+	return nil
+}
+
+// reserve takes a slot for key if one is free and otherwise returns how long
+// to wait before trying again.
+func (m *Memory) reserve(key string) time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.now()
+	s := m.stateFor(key)
+	if now.Before(s.blockedUntil) {
+		return s.blockedUntil.Sub(now)
+	}
+	l := m.limits[key]
+	interval := l.interval()
+	if interval == 0 {
+		return 0
+	}
+	tat := s.tat
+	if tat.Before(now) {
+		tat = now
+	}
+	if allowAt := tat.Add(-l.tolerance()); now.Before(allowAt) {
+		return allowAt.Sub(now)
+	}
+	s.tat = tat.Add(interval)
+	return 0
+}
+
+func (m *Memory) stateFor(key string) *memoryState {
+	s, ok := m.state[key]
+	if !ok {
+		s = &memoryState{}
+		m.state[key] = s
+	}
+	return s
+}
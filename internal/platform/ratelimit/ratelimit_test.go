@@ -0,0 +1,108 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestMemoryReserve(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	m := NewMemory(map[string]Limit{"jikan": {Rate: 2, Burst: 2}})
+	m.now = func() time.Time { return now }
+
+	// A burst of two, then one request every 500ms.
+	for i := 0; i < 2; i++ {
+		if d := m.reserve("jikan"); d != 0 {
+			t.Fatalf("request %d: wait %v", i, d)
+		}
+	}
+	if d := m.reserve("jikan"); d != 500*time.Millisecond {
+		t.Fatalf("third request: wait %v", d)
+	}
+	now = now.Add(500 * time.Millisecond)
+	if d := m.reserve("jikan"); d != 0 {
+		t.Fatalf("after interval: wait %v", d)
+	}
+
+	// Unconfigured keys are unlimited but still honour backoff.
+	if d := m.reserve("other"); d != 0 {
+		t.Fatalf("unlimited key: wait %v", d)
+	}
+	_ = m.Backoff(context.Background(), "other", 3*time.Second)
+	_ = m.Backoff(context.Background(), "other", time.Second)
+	if d := m.reserve("other"); d != 3*time.Second {
+		t.Fatalf("backoff: wait %v", d)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		in   string
+		want time.Duration
+		ok   bool
+	}{
+		{"", 0, false},
+		{"7", 7 * time.Second, true},
+		{"-1", 0, false},
+		{now.Add(30 * time.Second).Format(http.TimeFormat), 30 * time.Second, true},
+		{"soon", 0, false},
+	}
+	for _, c := range cases {
+		got, ok := RetryAfter(c.in, now)
+		if got != c.want || ok != c.ok {
+			t.Fatalf("RetryAfter(%q) = %v, %v; want %v, %v", c.in, got, ok, c.want, c.ok)
+		}
+	}
+}
+
+type recordingLimiter struct {
+	waits    int
+	backoffs []time.Duration
+}
+
+func (r *recordingLimiter) Wait(context.Context, string) error {
+	r.waits++
+	return nil
+}
+
+func (r *recordingLimiter) Backoff(_ context.Context, _ string, d time.Duration) error {
+	r.backoffs = append(r.backoffs, d)
+	return nil
+}
+
+func TestTransportBacksOffOn429(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/limited":
+			w.Header().Set("Retry-After", "4")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case "/limited-no-header":
+			w.WriteHeader(http.StatusTooManyRequests)
+		case "/unavailable":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer srv.Close()
+
+	l := &recordingLimiter{}
+	hc := NewHTTPClient(l, UpstreamJikan, 5*time.Second)
+	for _, p := range []string{"/ok", "/limited", "/limited-no-header", "/unavailable"} {
+		resp, err := hc.Get(srv.URL + p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	if l.waits != 4 {
+		t.Fatalf("waits = %d", l.waits)
+	}
+	if len(l.backoffs) != 2 || l.backoffs[0] != 4*time.Second || l.backoffs[1] != DefaultBackoff {
+		t.Fatalf("backoffs = %v", l.backoffs)
+	}
+}
@@ -0,0 +1,102 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// gcraScript takes a slot for one upstream and returns 0, or the milliseconds
+// to wait before trying again. It uses the Redis clock so replicas with skewed
+// clocks still share one schedule.
+//
+// KEYS[1] theoretical arrival time (ms), KEYS[2] backoff marker.
+// ARGV[1] emission interval (ms, 0 = unlimited), ARGV[2] burst tolerance (ms).
+var gcraScript = redis.NewScript(`
+local blocked = redis.call('PTTL', KEYS[2])
+if blocked > 0 then
+  return blocked
+end
+local interval = tonumber(ARGV[1])
+if interval <= 0 then
+  return 0
+end
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+local tat = tonumber(redis.call('GET', KEYS[1]) or now)
+if tat < now then
+  tat = now
+end
+local allow_at = tat - tonumber(ARGV[2])
+if now < allow_at then
+  return allow_at - now
+end
+local new_tat = tat + interval
+redis.call('SET', KEYS[1], new_tat, 'PX', new_tat - now + 1000)
+return 0
+`)
+
+// backoffScript extends the backoff marker to ARGV[1] ms unless a longer one is set.
+var backoffScript = redis.NewScript(`
+local cur = redis.call('PTTL', KEYS[1])
+if cur < tonumber(ARGV[1]) then
+  redis.call('SET', KEYS[1], '1', 'PX', ARGV[1])
+end
+return 0
+`)
+
+// Redis is a GCRA limiter shared through Redis. When Redis is unreachable it
+// falls back to a per-process memory limiter with the same limits, so callers
+// keep working at a per-replica rate.
+type Redis struct {
+	Client   *redis.Client
+	limits   map[string]Limit
+	fallback *Memory
+}
+
+func NewRedis(url string, limits map[string]Limit) (*Redis, error) {
+	opt, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, err
+	}
+	return &Redis{Client: redis.NewClient(opt), limits: limits, fallback: NewMemory(limits)}, nil
+}
+
+func redisKeys(key string) []string {
+	// The hash tag keeps both keys in one Redis Cluster slot for the scripts.
+	return []string{"ratelimit:{" + key + "}:tat", "ratelimit:{" + key + "}:backoff"}
+}
+
+func (r *Redis) Wait(ctx context.Context, key string) error {
+	l := r.limits[key]
+	keys := redisKeys(key)
+	for {
+		ms, err := gcraScript.Run(ctx, r.Client, keys, l.interval().Milliseconds(), l.tolerance().Milliseconds()).Int64()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return r.fallback.Wait(ctx, key)
+		}
+		if ms <= 0 {
+			return nil
+		}
+		if err := sleep(ctx, time.Duration(ms)*time.Millisecond); err != nil {
+			return err
+		}
+	}
+}
+
+func (r *Redis) Backoff(ctx context.Context, key string, d time.Duration) error {
+	_ = r.fallback.Backoff(ctx, key, d)
+	ms := d.Milliseconds()
+	if ms <= 0 {
+		return nil
+	}
+	return backoffScript.Run(ctx, r.Client, redisKeys(key)[1:], ms).Err()
+}
+
+func (r *Redis) Close() error {
+	return r.Client.Close()
+}
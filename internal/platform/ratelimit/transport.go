@@ -0,0 +1,81 @@
+package ratelimit
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultBackoff is used for a 429 without a usable Retry-After.
+	DefaultBackoff = 2 * time.Second
+	// MaxBackoff caps Retry-After so a bad header cannot stall an upstream for long.
+	MaxBackoff = 5 * time.Minute
+)
+
+// Transport waits for Limiter before every request to Key and backs the whole
+// upstream off when it answers 429, or 503 with Retry-After. The response is
+// still returned to the caller.
+type Transport struct {
+	Base    http.RoundTripper
+	Limiter Limiter
+	Key     string
+}
+
+// NewHTTPClient returns an http.Client whose requests are limited under key.
+func NewHTTPClient(l Limiter, key string, timeout time.Duration) *http.Client {
+	return &http.Client{Timeout: timeout, Transport: &Transport{Limiter: l, Key: key}}
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if err := t.Limiter.Wait(req.Context(), t.Key); err != nil {
+		return nil, err
+	}
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	if d, ok := backoffFor(resp, time.Now()); ok {
+		_ = t.Limiter.Backoff(req.Context(), t.Key, d)
+	}
+	return resp, nil
+}
+
+// backoffFor reports how long to back off after resp, if at all.
+func backoffFor(resp *http.Response, now time.Time) (time.Duration, bool) {
+	d, hasHeader := RetryAfter(resp.Header.Get("Retry-After"), now)
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		if !hasHeader {
+			d = DefaultBackoff
+		}
+	case resp.StatusCode == http.StatusServiceUnavailable && hasHeader:
+	default:
+		return 0, false
+	}
+	return min(d, MaxBackoff), true
+}
+
+// RetryAfter parses a Retry-After header given as seconds or an HTTP date.
+func RetryAfter(v string, now time.Time) (time.Duration, bool) {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return 0, false
+	}
+	if n, err := strconv.Atoi(v); err == nil {
+		if n < 0 {
+			return 0, false
+		}
+		return time.Duration(n) * time.Second, true
+	}
+	t, err := http.ParseTime(v)
+	if err != nil {
+		return 0, false
+	}
+	return max(t.Sub(now), 0), true
+}
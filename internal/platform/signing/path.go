@@ -0,0 +1,48 @@
+package signing
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SignPath signs an object path that is served publicly (not bound to a user),
+// such as a mirrored image. exp is signed as given; callers that want URLs
+// browsers and CDNs can cache should round it to a fixed bucket first.
+func (s *Signer) SignPath(path string, exp time.Time) Signed {
+	return s.Sign(path, "", exp)
+}
+
+// VerifyPath checks a signature produced by SignPath.
+func (s *Signer) VerifyPath(path string, exp int64, sig string) bool {
+	return s.Verify(path, "", exp, sig)
+}
+
+// BuildSignedPathURL appends the signed path to base and adds exp and sig.
+func BuildSignedPathURL(base string, signed Signed) (string, error) {
+	u, err := url.Parse(strings.TrimRight(base, "/") + "/" + strings.TrimLeft(signed.URL, "/"))
+	if err != nil {
+		return "", err
+	}
+	q := url.Values{}
+	q.Set("exp", strconv.FormatInt(signed.Exp, 10))
+	q.Set("sig", signed.Sig)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// ExtractSignedPath reads exp and sig added by BuildSignedPathURL.
+func ExtractSignedPath(query url.Values) (int64, string, error) {
+	expStr := strings.TrimSpace(query.Get("exp"))
+	sig := strings.TrimSpace(query.Get("sig"))
+	if expStr == "" || sig == "" {
+		return 0, "", fmt.Errorf("missing signed params")
+	}
+	exp, err := strconv.ParseInt(expStr, 10, 64)
+	if err != nil {
+		return 0, "", err
+	}
+	return exp, sig, nil
+}
@@ -0,0 +1,39 @@
+package signing
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSignPath_Roundtrip(t *testing.T) {
+	s := newSigner()
+	signed := s.SignPath("anime/1/abc/large.jpg", time.Now().Add(time.Hour))
+
+	raw, err := BuildSignedPathURL("https://img.example.com/images/", signed)
+	if err != nil {
+		t.Fatalf("BuildSignedPathURL: %v", err)
+	}
+	u, _ := url.Parse(raw)
+	if u.Path != "/images/anime/1/abc/large.jpg" {
+		t.Fatalf("path = %q", u.Path)
+	}
+	exp, sig, err := ExtractSignedPath(u.Query())
+	if err != nil {
+		t.Fatalf("ExtractSignedPath: %v", err)
+	}
+	key := strings.TrimPrefix(u.Path, "/images/")
+	if !s.VerifyPath(key, exp, sig) {
+		t.Fatal("expected VerifyPath to succeed")
+	}
+	if s.VerifyPath("anime/1/abc/thumb.jpg", exp, sig) {
+		t.Fatal("expected VerifyPath to fail for a different path")
+	}
+}
+
+func TestExtractSignedPath_Missing(t *testing.T) {
+	if _, _, err := ExtractSignedPath(url.Values{"exp": {"1"}}); err == nil {
+		t.Fatal("expected error for missing sig")
+	}
+}
@@ -0,0 +1,43 @@
+// Package taxonomy holds the shared rules for genre, theme and demographic slugs
+// so catalog storage and search filters agree on identifiers.
+package taxonomy
+
+import "strings"
+
+// Kinds of taxonomy entries.
+const (
+	KindGenre       = "genre"
+	KindTheme       = "theme"
+	KindDemographic = "demographic"
+)
+
+// Slug derives a stable identifier from a display name: ASCII letters and digits
+// are kept lower-cased, every other run of characters becomes a single dash.
+// "Sci-Fi", "Sci Fi" and "sci_fi" all map to "sci-fi". The catalog backfill
+// migration applies the same rule in SQL.
+func Slug(name string) string {
+	var b strings.Builder
+	b.Grow(len(name))
+	dash := false
+	for _, c := range strings.ToLower(name) {
+		if (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') {
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(c)
+			dash = false
+			continue
+		}
+		dash = true
+	}
+	return b.String()
+}
+
+// ValidKind reports whether kind is one of the known taxonomy kinds.
+func ValidKind(kind string) bool {
+	switch kind {
+	case KindGenre, KindTheme, KindDemographic:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,19 @@
+package taxonomy
+
+import "testing"
+
+func TestSlug(t *testing.T) {
+	cases := map[string]string{
+		"Sci-Fi":        "sci-fi",
+		"Sci Fi":        "sci-fi",
+		" sci_fi ":      "sci-fi",
+		"Slice of Life": "slice-of-life",
+		"Boys Love":     "boys-love",
+		"--":            "",
+	}
+	for in, want := range cases {
+		if got := Slug(in); got != want {
+			t.Fatalf("Slug(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
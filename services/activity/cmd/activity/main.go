@@ -66,6 +66,7 @@ func main() {
 		log.Error("nats connect", zap.Error(err))
 	} else {
 		go worker.StartProgressConsumer(ctx, nc, pool, log)
+		go worker.StartCatalogMergeConsumer(ctx, nc, pool, log)
 		defer nc.Close()
 	}
 
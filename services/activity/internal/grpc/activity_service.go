@@ -77,6 +77,37 @@ func (s *ActivityService) GetContinueWatching(ctx context.Context, req *activity
 	return resp, nil
 }
 
+// maxProgressLookup bounds GetEpisodesProgress requests.
+const maxProgressLookup = 1000
+
+func (s *ActivityService) GetEpisodesProgress(ctx context.Context, req *activityv1.GetEpisodesProgressRequest) (*activityv1.GetEpisodesProgressResponse, error) {
+	userID, err := uuid.Parse(strings.TrimSpace(req.GetUserId()))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+	if len(req.GetEpisodeIds()) > maxProgressLookup {
+		return nil, status.Error(codes.InvalidArgument, "too many episode_ids")
+	}
+	ids := make([]uuid.UUID, 0, len(req.GetEpisodeIds()))
+	for _, raw := range req.GetEpisodeIds() {
+		id, err := uuid.Parse(strings.TrimSpace(raw))
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid episode_id")
+		}
+		ids = append(ids, id)
+	}
+
+	records, err := s.Progress.GetMany(ctx, userID, ids)
+	if err != nil {
+		return nil, err
+	}
+	resp := &activityv1.GetEpisodesProgressResponse{Progress: make([]*activityv1.EpisodeProgress, 0, len(records))}
+	for _, r := range records {
+		resp.Progress = append(resp.Progress, toProtoProgress(r))
+	}
+	return resp, nil
+}
+
 func toProtoProgress(r store.ProgressRecord) *activityv1.EpisodeProgress {
 	return &activityv1.EpisodeProgress{
 		UserId:          r.UserID.String(),
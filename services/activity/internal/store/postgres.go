@@ -98,3 +98,26 @@ func (r *PostgresProgressRepository) List(ctx context.Context, userID uuid.UUID,
 	}
 	return out, nil
 }
+
+func (r *PostgresProgressRepository) GetMany(ctx context.Context, userID uuid.UUID, episodeIDs []uuid.UUID) ([]ProgressRecord, error) {
+	if len(episodeIDs) == 0 {
+		return nil, nil
+	}
+	rows, err := r.db.Query(ctx, `SELECT episode_id, position_seconds, duration_seconds, completed, client_ts_ms, updated_at
+	      FROM user_episode_progress WHERE user_id=$1 AND episode_id = ANY($2::uuid[])`, userID, episodeIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db")
+	}
+	defer rows.Close()
+
+	var out []ProgressRecord
+	for rows.Next() {
+		var rec ProgressRecord
+		rec.UserID = userID
+		if err := rows.Scan(&rec.EpisodeID, &rec.PositionSeconds, &rec.DurationSeconds, &rec.Completed, &rec.ClientTsMs, &rec.UpdatedAt); err != nil {
+			return nil, status.Error(codes.Internal, "db")
+		}
+		out = append(out, rec)
+	}
+	return out, nil
+}
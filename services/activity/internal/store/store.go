@@ -32,4 +32,7 @@ type ProgressRepository interface {
 	// List returns up to limit records ordered by updated_at DESC.
 	// cursor, if non-nil, acts as an exclusive lower bound for keyset pagination.
 	List(ctx context.Context, userID uuid.UUID, limit int, cursor *ProgressCursor) ([]ProgressRecord, error)
+	// GetMany returns the user's records for the given episodes; episodes without
+	// progress are absent.
+	GetMany(ctx context.Context, userID uuid.UUID, episodeIDs []uuid.UUID) ([]ProgressRecord, error)
 }
@@ -0,0 +1,128 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+)
+
+// AnimeMergedEvent is published by catalog when a duplicate anime is folded into another.
+// EpisodeIDMap maps episode IDs that no longer exist to the surviving episode ID.
+type AnimeMergedEvent struct {
+	SourceAnimeID string            `json:"source_anime_id"`
+	TargetAnimeID string            `json:"target_anime_id"`
+	EpisodeIDMap  map[string]string `json:"episode_id_map"`
+}
+
+// StartCatalogMergeConsumer subscribes to catalog.anime.merged and re-keys watch progress
+// recorded against collapsed episode IDs onto the surviving episode.
+func StartCatalogMergeConsumer(ctx context.Context, nc *nats.Conn, pool *pgxpool.Pool, log *zap.Logger) {
+	js, err := nc.JetStream()
+	if err != nil {
+		log.Error("catalog_merge_consumer: jetstream error", zap.Error(err))
+		return
+	}
+
+	// CATALOG_EVENTS is owned by the catalog outbox publisher; make sure it exists before subscribing.
+	_, err = js.AddStream(&nats.StreamConfig{
+		Name:     "CATALOG_EVENTS",
+		Subjects: []string{"catalog.>"},
+		Storage:  nats.FileStorage,
+		MaxAge:   7 * 24 * time.Hour,
+	})
+	if err != nil && !errors.Is(err, nats.ErrStreamNameAlreadyInUse) {
+		log.Error("catalog_merge_consumer: add stream error", zap.Error(err))
+		return
+	}
+
+	sub, err := js.PullSubscribe("catalog.anime.merged", "activity_catalog_merged")
+	if err != nil {
+		log.Error("catalog_merge_consumer: subscribe error", zap.Error(err))
+		return
+	}
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+
+			msgs, err := sub.Fetch(10, nats.MaxWait(2*time.Second))
+			if err != nil {
+				if errors.Is(err, nats.ErrTimeout) {
+					continue
+				}
+				log.Error("catalog_merge_consumer: fetch error", zap.Error(err))
+				time.Sleep(1 * time.Second)
+				continue
+			}
+
+			for _, m := range msgs {
+				var ev AnimeMergedEvent
+				if err := json.Unmarshal(m.Data, &ev); err != nil {
+					log.Error("catalog_merge_consumer: invalid json", zap.Error(err))
+					_ = m.Term()
+					continue
+				}
+				if err := applyEpisodeRekey(ctx, pool, ev.EpisodeIDMap); err != nil {
+					log.Error("catalog_merge_consumer: rekey failed", zap.String("source_anime_id", ev.SourceAnimeID), zap.Error(err))
+					_ = m.Nak()
+					continue
+				}
+				if err := m.Ack(); err != nil {
+					log.Warn("catalog_merge_consumer: ack error", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// applyEpisodeRekey moves progress rows from old to new episode IDs. When a user has progress
+// on both, the row with the newer client timestamp wins. Replays are harmless: once the old
+// rows are gone there is nothing left to move.
+func applyEpisodeRekey(ctx context.Context, pool *pgxpool.Pool, episodeIDMap map[string]string) error {
+	if len(episodeIDMap) == 0 {
+		return nil
+	}
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	for oldID, newID := range episodeIDMap {
+		if err := rekeyEpisode(ctx, tx, oldID, newID); err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+func rekeyEpisode(ctx context.Context, tx pgx.Tx, oldID, newID string) error {
+	q := `
+INSERT INTO user_episode_progress (user_id, episode_id, position_seconds, duration_seconds, completed, client_ts_ms, updated_at)
+SELECT user_id, $2, position_seconds, duration_seconds, completed, client_ts_ms, updated_at
+FROM user_episode_progress WHERE episode_id = $1
+ON CONFLICT (user_id, episode_id)
+DO UPDATE SET
+	position_seconds = EXCLUDED.position_seconds,
+	duration_seconds = EXCLUDED.duration_seconds,
+	completed = EXCLUDED.completed,
+	client_ts_ms = EXCLUDED.client_ts_ms,
+	updated_at = EXCLUDED.updated_at
+WHERE user_episode_progress.client_ts_ms < EXCLUDED.client_ts_ms;
+`
+	if _, err := tx.Exec(ctx, q, oldID, newID); err != nil {
+		return err
+	}
+	_, err := tx.Exec(ctx, `DELETE FROM user_episode_progress WHERE episode_id = $1`, oldID)
+	return err
+}
@@ -39,6 +39,8 @@ func (d *Dispatcher) Dispatch(msg *nats.Msg) {
 		d.handleAnimeViewed(msg)
 	case subj == "analytics.search.performed":
 		d.handleSearchPerformed(msg)
+	case subj == "analytics.social.anime_rated":
+		d.handleAnimeRated(msg)
 	case subj == "activity.progress":
 		d.handleActivityProgress(msg)
 	case strings.HasPrefix(subj, "social.comments."):
@@ -179,6 +181,23 @@ func (d *Dispatcher) handleActivityProgress(msg *nats.Msg) {
 
 // ── social events ─────────────────────────────────────────────────────────────
 
+func (d *Dispatcher) handleAnimeRated(msg *nats.Msg) {
+	var ev struct {
+		UserID     string `json:"user_id"`
+		Properties struct {
+			AnimeID string `json:"anime_id"`
+			Score   int    `json:"score"`
+		} `json:"properties"`
+	}
+	if !unmarshal(d.log, msg, &ev) {
+		return
+	}
+	d.ph.Capture(ev.UserID, "anime_rated", map[string]any{
+		"anime_id": ev.Properties.AnimeID,
+		"score":    ev.Properties.Score,
+	})
+}
+
 func (d *Dispatcher) handleSocialComment(msg *nats.Msg) {
 	action := strings.TrimPrefix(msg.Subject, "social.comments.")
 	var ev struct {
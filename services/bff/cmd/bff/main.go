@@ -3,16 +3,20 @@ package main
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"go.uber.org/zap"
 
 	"github.com/example/anime-platform/internal/platform/analytics"
 	"github.com/example/anime-platform/internal/platform/auth"
+	"github.com/example/anime-platform/internal/platform/cassette"
 	"github.com/example/anime-platform/internal/platform/config"
+	"github.com/example/anime-platform/internal/platform/geo"
 	"github.com/example/anime-platform/internal/platform/httpserver"
 	"github.com/example/anime-platform/internal/platform/logging"
 	"github.com/example/anime-platform/internal/platform/natsconn"
+	"github.com/example/anime-platform/internal/platform/ratelimit"
 	"github.com/example/anime-platform/internal/platform/run"
 	"github.com/example/anime-platform/services/bff/internal/admin"
 	bffconfig "github.com/example/anime-platform/services/bff/internal/config"
@@ -41,6 +45,7 @@ func main() {
 
 	r := chi.NewRouter()
 	httpserver.SetupRouter(r, httpserver.RouterConfig{Logger: log})
+	r.Use(geo.Headers{Trusted: bffCfg.GeoTrustedHeader, Dev: bffCfg.GeoDevHeaders}.Middleware)
 
 	verifier := auth.JWTVerifier{Secret: bffCfg.JWTSecret}
 
@@ -56,6 +61,21 @@ func main() {
 		run.Exit(1)
 	}
 
+	// Jikan calls share one rate limit with the ingestion replicas via Redis.
+	jikanLimiter, err := ratelimit.New(bffCfg.RedisURL, map[string]ratelimit.Limit{
+		ratelimit.UpstreamJikan: {Rate: float64(bffCfg.JikanRPS), Burst: 1},
+	})
+	if err != nil {
+		log.Error("rate limiter", zap.Error(err))
+		run.Exit(1)
+	}
+	// HTTP_CASSETTE_MODE records Jikan responses or replays them offline.
+	cas, err := cassette.FromEnv()
+	if err != nil {
+		log.Error("cassette", zap.Error(err))
+		run.Exit(1)
+	}
+
 	// init bff cache with NATS invalidation
 	bffCache := bffhandlers.NewTTLCache(bffCfg.CacheTTLSeconds, nc, bffCfg.CacheInvalidationSubj)
 	eventPublisher := bffhandlers.NewEventPublisher(js)
@@ -102,6 +122,13 @@ func main() {
 	}
 	defer socialc.Conn.Close()
 
+	ingestionc, err := grpcclient.NewIngestionClient(bffCfg.IngestionGRPCAddr)
+	if err != nil {
+		log.Error("init ingestion grpc client", zap.Error(err))
+		run.Exit(1)
+	}
+	defer ingestionc.Conn.Close()
+
 	// Example route: in real BFF you aggregate from other services.
 	r.Get("/", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -118,18 +145,25 @@ func main() {
 		r.Post("/v1/auth/logout", bffhandlers.Logout(authc.Client))
 	})
 
+	imageURLs := bffhandlers.NewImageURLs(bffCfg.ImageBaseURL, bffCfg.ImageSigningSecret)
+
 	// Public rate limiter for unauthenticated read endpoints (50 req/s, burst 100)
 	publicLimiter := bffhttp.NewRateLimiter(50, 100)
 
 	r.Group(func(r chi.Router) {
 		r.Use(publicLimiter.Middleware)
-		r.Get("/v1/search", bffhandlers.Search(searchc.Client, bffCache, bffhandlers.NewJikanFallback(bffCfg.JikanBaseURL, js)))
-		r.Get("/v1/anime", bffhandlers.ListAnime(catalogc.Client, bffCache))
-		r.Get("/v1/anime/{anime_id}", bffhandlers.GetAnime(catalogc.Client, analyticsPublisher))
+		r.Get("/v1/search", bffhandlers.Search(searchc.Client, bffCache, bffhandlers.NewJikanFallback(bffCfg.JikanBaseURL, js, jikanLimiter, cas)))
+		r.Get("/v1/anime", bffhandlers.ListAnime(catalogc.Client, bffCache, imageURLs))
+		r.Get("/v1/genres", bffhandlers.ListGenres(catalogc.Client, bffCache))
+		r.Get("/v1/trending", bffhandlers.GetTrending(catalogc.Client, bffCache, imageURLs))
+		r.Get("/v1/anime/{anime_id}", bffhandlers.GetAnime(catalogc.Client, analyticsPublisher, imageURLs))
 		r.Get("/v1/anime/{anime_id}/episodes", bffhandlers.GetEpisodesByAnime(catalogc.Client))
 		r.Get("/v1/anime/{anime_id}/rating", bffhandlers.GetRating(socialc.Client))
 		r.Get("/v1/episodes/{episode_id}", bffhandlers.GetEpisode(catalogc.Client))
+		r.Get("/v1/episodes/{episode_id}/providers", bffhandlers.GetEpisodeProviders(catalogc.Client))
+		r.Get("/v1/episodes/{episode_id}/skip-segments", bffhandlers.ListSkipSegments(catalogc.Client))
 		r.Get("/v1/comments/{anime_id}", bffhandlers.ListComments(socialc.Client))
+		r.With(auth.OptionalUser(verifier)).Get("/v1/franchises/{franchise_id}", bffhandlers.GetFranchise(catalogc.Client, activityc.Client, imageURLs))
 	})
 
 	r.Group(func(r chi.Router) {
@@ -140,7 +174,13 @@ func main() {
 	r.Route("/v1/admin", func(r chi.Router) {
 		r.Use(auth.RequireUser(verifier))
 		r.Use(auth.RequireAdmin)
-		admin.BackfillHandler{JikanBaseURL: bffCfg.JikanBaseURL, JS: js}.Register(r)
+		admin.BackfillHandler{
+			JikanBaseURL: bffCfg.JikanBaseURL,
+			HTTPClient:   cas.Client(ratelimit.NewHTTPClient(jikanLimiter, ratelimit.UpstreamJikan, 15*time.Second), "jikan"),
+			JS:           js,
+		}.Register(r)
+		admin.CatalogHandler{Catalog: catalogc.Client}.Register(r)
+		admin.IngestionHandler{Ingestion: ingestionc.Client}.Register(r)
 	})
 
 	r.Group(func(r chi.Router) {
@@ -153,10 +193,12 @@ func main() {
 
 		r.Post("/v1/comments/{anime_id}", bffhandlers.CreateComment(socialc.Client, eventPublisher))
 		r.Post("/v1/comments/{comment_id}/vote", bffhandlers.VoteComment(socialc.Client, eventPublisher))
+		r.Post("/v1/episodes/{episode_id}/skip-segments", bffhandlers.SubmitSkipSegment(catalogc.Client))
+		r.Post("/v1/skip-segments/{segment_id}/vote", bffhandlers.VoteSkipSegment(catalogc.Client))
 		r.Put("/v1/comments/{comment_id}", bffhandlers.UpdateComment(socialc.Client, eventPublisher))
 		r.Delete("/v1/comments/{comment_id}", bffhandlers.DeleteComment(socialc.Client, eventPublisher))
 
-		r.Post("/v1/anime/{anime_id}/rating", bffhandlers.RateAnime(socialc.Client))
+		r.Post("/v1/anime/{anime_id}/rating", bffhandlers.RateAnime(socialc.Client, analyticsPublisher))
 	})
 
 	srv := httpserver.New(httpserver.Options{Addr: cfg.HTTP.Addr, ServiceName: cfg.ServiceName, Logger: log, Router: r})
@@ -0,0 +1,294 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	catalogv1 "github.com/example/anime-platform/gen/catalog/v1"
+	"github.com/example/anime-platform/internal/platform/api"
+	"github.com/example/anime-platform/internal/platform/httpserver"
+)
+
+// CatalogHandler exposes catalog maintenance operations to admins.
+type CatalogHandler struct {
+	Catalog catalogv1.CatalogServiceClient
+}
+
+type mergeAnimeRequest struct {
+	TargetAnimeID string `json:"target_anime_id"`
+}
+
+type mergeAnimeResponse struct {
+	TargetAnimeID    string `json:"target_anime_id"`
+	MovedEpisodes    int32  `json:"moved_episodes"`
+	MergedEpisodes   int32  `json:"merged_episodes"`
+	MovedExternalIDs int32  `json:"moved_external_ids"`
+}
+
+type translationRequest struct {
+	Title    string `json:"title"`
+	Synopsis string `json:"synopsis"`
+}
+
+type availabilityRequest struct {
+	Visibility       string   `json:"visibility"`
+	Reason           string   `json:"reason"`
+	AllowedCountries []string `json:"allowed_countries"`
+	BlockedCountries []string `json:"blocked_countries"`
+}
+
+func (b availabilityRequest) toProto() *catalogv1.Availability {
+	return &catalogv1.Availability{
+		Visibility:       strings.TrimSpace(b.Visibility),
+		Reason:           strings.TrimSpace(b.Reason),
+		AllowedCountries: b.AllowedCountries,
+		BlockedCountries: b.BlockedCountries,
+	}
+}
+
+type fieldChange struct {
+	Field string          `json:"field"`
+	Old   json.RawMessage `json:"old,omitempty"`
+	New   json.RawMessage `json:"new,omitempty"`
+}
+
+type animeVersion struct {
+	Version    int32           `json:"version"`
+	RecordedAt string          `json:"recorded_at"`
+	Reason     string          `json:"reason"`
+	Changes    []fieldChange   `json:"changes"`
+	Snapshot   json.RawMessage `json:"snapshot,omitempty"`
+}
+
+type episodeVersion struct {
+	EpisodeID  string          `json:"episode_id"`
+	Version    int32           `json:"version"`
+	RecordedAt string          `json:"recorded_at"`
+	Reason     string          `json:"reason"`
+	Changes    []fieldChange   `json:"changes"`
+	Snapshot   json.RawMessage `json:"snapshot,omitempty"`
+}
+
+type animeHistoryResponse struct {
+	Versions []animeVersion   `json:"versions"`
+	Episodes []episodeVersion `json:"episodes,omitempty"`
+}
+
+type revertAnimeRequest struct {
+	Version int32 `json:"version"`
+}
+
+type revertAnimeResponse struct {
+	Version int32 `json:"version"`
+}
+
+func (h CatalogHandler) Register(r chi.Router) {
+	r.Post("/anime/{anime_id}/merge", h.handleMerge)
+	r.Put("/anime/{anime_id}/availability", h.handleAnimeAvailability)
+	r.Put("/episodes/{episode_id}/availability", h.handleEpisodeAvailability)
+	r.Put("/anime/{anime_id}/translations/{locale}", h.handleUpsertTranslation)
+	r.Get("/anime/{anime_id}/history", h.handleAnimeHistory)
+	r.Post("/anime/{anime_id}/revert", h.handleRevertAnime)
+	h.registerFranchises(r)
+}
+
+func (h CatalogHandler) handleMerge(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+	sourceID := strings.TrimSpace(chi.URLParam(r, "anime_id"))
+
+	var body mergeAnimeRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20)).Decode(&body); err != nil {
+		api.BadRequest(w, "INVALID_JSON", "Invalid JSON", rid, nil)
+		return
+	}
+	targetID := strings.TrimSpace(body.TargetAnimeID)
+	if sourceID == "" || targetID == "" {
+		api.BadRequest(w, "VALIDATION_ANIME_ID", "anime_id and target_anime_id are required", rid, nil)
+		return
+	}
+
+	resp, err := h.Catalog.MergeAnime(r.Context(), &catalogv1.MergeAnimeRequest{SourceAnimeId: sourceID, TargetAnimeId: targetID})
+	if err != nil {
+		writeCatalogError(w, rid, err)
+		return
+	}
+	api.WriteJSON(w, http.StatusOK, mergeAnimeResponse{
+		TargetAnimeID:    resp.GetTargetAnimeId(),
+		MovedEpisodes:    resp.GetMovedEpisodes(),
+		MergedEpisodes:   resp.GetMergedEpisodes(),
+		MovedExternalIDs: resp.GetMovedExternalIds(),
+	})
+}
+
+func (h CatalogHandler) handleAnimeAvailability(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+	animeID := strings.TrimSpace(chi.URLParam(r, "anime_id"))
+
+	var body availabilityRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20)).Decode(&body); err != nil {
+		api.BadRequest(w, "INVALID_JSON", "Invalid JSON", rid, nil)
+		return
+	}
+	if animeID == "" {
+		api.BadRequest(w, "VALIDATION_ANIME_ID", "anime_id is required", rid, nil)
+		return
+	}
+
+	if _, err := h.Catalog.SetAnimeAvailability(r.Context(), &catalogv1.SetAnimeAvailabilityRequest{AnimeId: animeID, Availability: body.toProto()}); err != nil {
+		writeCatalogError(w, rid, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h CatalogHandler) handleEpisodeAvailability(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+	episodeID := strings.TrimSpace(chi.URLParam(r, "episode_id"))
+
+	var body availabilityRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20)).Decode(&body); err != nil {
+		api.BadRequest(w, "INVALID_JSON", "Invalid JSON", rid, nil)
+		return
+	}
+	if episodeID == "" {
+		api.BadRequest(w, "VALIDATION_EPISODE_ID", "episode_id is required", rid, nil)
+		return
+	}
+
+	if _, err := h.Catalog.SetEpisodeAvailability(r.Context(), &catalogv1.SetEpisodeAvailabilityRequest{EpisodeId: episodeID, Availability: body.toProto()}); err != nil {
+		writeCatalogError(w, rid, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h CatalogHandler) handleUpsertTranslation(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+	animeID := strings.TrimSpace(chi.URLParam(r, "anime_id"))
+	loc := strings.TrimSpace(chi.URLParam(r, "locale"))
+
+	var body translationRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20)).Decode(&body); err != nil {
+		api.BadRequest(w, "INVALID_JSON", "Invalid JSON", rid, nil)
+		return
+	}
+	if animeID == "" || loc == "" {
+		api.BadRequest(w, "VALIDATION_ANIME_ID", "anime_id and locale are required", rid, nil)
+		return
+	}
+
+	_, err := h.Catalog.UpsertAnimeTranslation(r.Context(), &catalogv1.UpsertAnimeTranslationRequest{
+		AnimeId:  animeID,
+		Locale:   loc,
+		Title:    body.Title,
+		Synopsis: body.Synopsis,
+	})
+	if err != nil {
+		writeCatalogError(w, rid, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleAnimeHistory lists recorded versions of an anime. Query parameters:
+// limit, before_version, as_of (RFC 3339, for point-in-time reads), and
+// snapshots=true / episodes=true to include full snapshots and episode history.
+func (h CatalogHandler) handleAnimeHistory(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+	animeID := strings.TrimSpace(chi.URLParam(r, "anime_id"))
+	if animeID == "" {
+		api.BadRequest(w, "VALIDATION_ANIME_ID", "anime_id is required", rid, nil)
+		return
+	}
+	q := r.URL.Query()
+
+	resp, err := h.Catalog.GetAnimeHistory(r.Context(), &catalogv1.GetAnimeHistoryRequest{
+		AnimeId:          animeID,
+		Limit:            int32(parseIntDefault(q.Get("limit"), 0)),
+		BeforeVersion:    int32(parseIntDefault(q.Get("before_version"), 0)),
+		AsOfRfc3339:      strings.TrimSpace(q.Get("as_of")),
+		IncludeSnapshots: q.Get("snapshots") == "true",
+		IncludeEpisodes:  q.Get("episodes") == "true",
+	})
+	if err != nil {
+		writeCatalogError(w, rid, err)
+		return
+	}
+	out := animeHistoryResponse{Versions: make([]animeVersion, 0, len(resp.GetVersions()))}
+	for _, v := range resp.GetVersions() {
+		out.Versions = append(out.Versions, animeVersion{
+			Version:    v.GetVersion(),
+			RecordedAt: v.GetRecordedAtRfc3339(),
+			Reason:     v.GetReason(),
+			Changes:    fieldChangesFromProto(v.GetChanges()),
+			Snapshot:   rawJSON(v.GetSnapshotJson()),
+		})
+	}
+	for _, v := range resp.GetEpisodes() {
+		out.Episodes = append(out.Episodes, episodeVersion{
+			EpisodeID:  v.GetEpisodeId(),
+			Version:    v.GetVersion(),
+			RecordedAt: v.GetRecordedAtRfc3339(),
+			Reason:     v.GetReason(),
+			Changes:    fieldChangesFromProto(v.GetChanges()),
+			Snapshot:   rawJSON(v.GetSnapshotJson()),
+		})
+	}
+	api.WriteJSON(w, http.StatusOK, out)
+}
+
+func fieldChangesFromProto(in []*catalogv1.FieldChange) []fieldChange {
+	out := make([]fieldChange, 0, len(in))
+	for _, c := range in {
+		out = append(out, fieldChange{Field: c.GetField(), Old: rawJSON(c.GetOldJson()), New: rawJSON(c.GetNewJson())})
+	}
+	return out
+}
+
+func rawJSON(s string) json.RawMessage {
+	if s == "" {
+		return nil
+	}
+	return json.RawMessage(s)
+}
+
+func (h CatalogHandler) handleRevertAnime(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+	animeID := strings.TrimSpace(chi.URLParam(r, "anime_id"))
+
+	var body revertAnimeRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20)).Decode(&body); err != nil {
+		api.BadRequest(w, "INVALID_JSON", "Invalid JSON", rid, nil)
+		return
+	}
+	if animeID == "" || body.Version <= 0 {
+		api.BadRequest(w, "VALIDATION_VERSION", "anime_id and a positive version are required", rid, nil)
+		return
+	}
+
+	resp, err := h.Catalog.RevertAnime(r.Context(), &catalogv1.RevertAnimeRequest{AnimeId: animeID, Version: body.Version})
+	if err != nil {
+		writeCatalogError(w, rid, err)
+		return
+	}
+	api.WriteJSON(w, http.StatusOK, revertAnimeResponse{Version: resp.GetVersion()})
+}
+
+func writeCatalogError(w http.ResponseWriter, rid string, err error) {
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		api.BadRequest(w, "VALIDATION_FAILED", st.Message(), rid, nil)
+	case codes.NotFound:
+		api.NotFound(w, "NOT_FOUND", st.Message(), rid)
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		api.Conflict(w, "CONFLICT", st.Message(), rid, nil)
+	default:
+		api.WriteError(w, http.StatusBadGateway, "CATALOG_FAILED", st.Message(), rid, nil)
+	}
+}
@@ -0,0 +1,225 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	catalogv1 "github.com/example/anime-platform/gen/catalog/v1"
+	"github.com/example/anime-platform/internal/platform/api"
+	"github.com/example/anime-platform/internal/platform/httpserver"
+)
+
+type franchiseRequest struct {
+	Title       string   `json:"title"`
+	Image       string   `json:"image"`
+	Description string   `json:"description"`
+	AnimeIDs    []string `json:"anime_ids"`
+}
+
+type franchiseEntriesRequest struct {
+	AnimeIDs []string `json:"anime_ids"`
+}
+
+type franchiseEntry struct {
+	AnimeID  string `json:"anime_id"`
+	Position int32  `json:"position"`
+}
+
+type franchise struct {
+	ID          string           `json:"id"`
+	Title       string           `json:"title"`
+	Image       string           `json:"image,omitempty"`
+	Description string           `json:"description,omitempty"`
+	Entries     []franchiseEntry `json:"entries"`
+	UpdatedAt   string           `json:"updated_at"`
+}
+
+type franchisesResponse struct {
+	Franchises []franchise `json:"franchises"`
+}
+
+type franchiseSuggestion struct {
+	Title        string   `json:"title"`
+	AnimeIDs     []string `json:"anime_ids"`
+	FranchiseIDs []string `json:"franchise_ids,omitempty"`
+}
+
+type franchiseSuggestionsResponse struct {
+	Suggestions []franchiseSuggestion `json:"suggestions"`
+}
+
+func (h CatalogHandler) registerFranchises(r chi.Router) {
+	r.Get("/franchises", h.handleListFranchises)
+	r.Post("/franchises", h.handleCreateFranchise)
+	r.Get("/franchises/suggestions", h.handleSuggestFranchises)
+	r.Get("/franchises/{franchise_id}", h.handleGetFranchise)
+	r.Put("/franchises/{franchise_id}", h.handleUpdateFranchise)
+	r.Put("/franchises/{franchise_id}/entries", h.handleSetFranchiseEntries)
+	r.Delete("/franchises/{franchise_id}", h.handleDeleteFranchise)
+}
+
+func (h CatalogHandler) handleListFranchises(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+	q := r.URL.Query()
+
+	resp, err := h.Catalog.ListFranchises(r.Context(), &catalogv1.ListFranchisesRequest{
+		AnimeId: strings.TrimSpace(q.Get("anime_id")),
+		Limit:   int32(parseIntDefault(q.Get("limit"), 0)),
+		Offset:  int32(parseIntDefault(q.Get("offset"), 0)),
+	})
+	if err != nil {
+		writeCatalogError(w, rid, err)
+		return
+	}
+	out := franchisesResponse{Franchises: make([]franchise, 0, len(resp.GetFranchises()))}
+	for _, f := range resp.GetFranchises() {
+		out.Franchises = append(out.Franchises, franchiseFromProto(f))
+	}
+	api.WriteJSON(w, http.StatusOK, out)
+}
+
+func (h CatalogHandler) handleCreateFranchise(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+
+	var body franchiseRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20)).Decode(&body); err != nil {
+		api.BadRequest(w, "INVALID_JSON", "Invalid JSON", rid, nil)
+		return
+	}
+	if strings.TrimSpace(body.Title) == "" {
+		api.BadRequest(w, "VALIDATION_TITLE", "title is required", rid, nil)
+		return
+	}
+
+	resp, err := h.Catalog.CreateFranchise(r.Context(), &catalogv1.CreateFranchiseRequest{
+		Title:       body.Title,
+		Image:       body.Image,
+		Description: body.Description,
+		AnimeIds:    body.AnimeIDs,
+	})
+	if err != nil {
+		writeCatalogError(w, rid, err)
+		return
+	}
+	api.WriteJSON(w, http.StatusCreated, franchiseFromProto(resp.GetFranchise()))
+}
+
+func (h CatalogHandler) handleSuggestFranchises(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+
+	resp, err := h.Catalog.SuggestFranchises(r.Context(), &catalogv1.SuggestFranchisesRequest{
+		Limit: int32(parseIntDefault(r.URL.Query().Get("limit"), 0)),
+	})
+	if err != nil {
+		writeCatalogError(w, rid, err)
+		return
+	}
+	out := franchiseSuggestionsResponse{Suggestions: make([]franchiseSuggestion, 0, len(resp.GetSuggestions()))}
+	for _, s := range resp.GetSuggestions() {
+		out.Suggestions = append(out.Suggestions, franchiseSuggestion{
+			Title:        s.GetTitle(),
+			AnimeIDs:     s.GetAnimeIds(),
+			FranchiseIDs: s.GetFranchiseIds(),
+		})
+	}
+	api.WriteJSON(w, http.StatusOK, out)
+}
+
+// handleGetFranchise returns every entry, including ones hidden by availability rules.
+func (h CatalogHandler) handleGetFranchise(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+	franchiseID := strings.TrimSpace(chi.URLParam(r, "franchise_id"))
+	if franchiseID == "" {
+		api.BadRequest(w, "VALIDATION_FRANCHISE_ID", "franchise_id is required", rid, nil)
+		return
+	}
+
+	resp, err := h.Catalog.GetFranchise(r.Context(), &catalogv1.GetFranchiseRequest{FranchiseId: franchiseID, IncludeUnavailable: true})
+	if err != nil {
+		writeCatalogError(w, rid, err)
+		return
+	}
+	api.WriteJSON(w, http.StatusOK, franchiseFromProto(resp.GetFranchise()))
+}
+
+func (h CatalogHandler) handleUpdateFranchise(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+	franchiseID := strings.TrimSpace(chi.URLParam(r, "franchise_id"))
+
+	var body franchiseRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20)).Decode(&body); err != nil {
+		api.BadRequest(w, "INVALID_JSON", "Invalid JSON", rid, nil)
+		return
+	}
+	if franchiseID == "" || strings.TrimSpace(body.Title) == "" {
+		api.BadRequest(w, "VALIDATION_TITLE", "franchise_id and title are required", rid, nil)
+		return
+	}
+
+	resp, err := h.Catalog.UpdateFranchise(r.Context(), &catalogv1.UpdateFranchiseRequest{
+		FranchiseId: franchiseID,
+		Title:       body.Title,
+		Image:       body.Image,
+		Description: body.Description,
+	})
+	if err != nil {
+		writeCatalogError(w, rid, err)
+		return
+	}
+	api.WriteJSON(w, http.StatusOK, franchiseFromProto(resp.GetFranchise()))
+}
+
+func (h CatalogHandler) handleSetFranchiseEntries(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+	franchiseID := strings.TrimSpace(chi.URLParam(r, "franchise_id"))
+
+	var body franchiseEntriesRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20)).Decode(&body); err != nil {
+		api.BadRequest(w, "INVALID_JSON", "Invalid JSON", rid, nil)
+		return
+	}
+	if franchiseID == "" {
+		api.BadRequest(w, "VALIDATION_FRANCHISE_ID", "franchise_id is required", rid, nil)
+		return
+	}
+
+	resp, err := h.Catalog.SetFranchiseEntries(r.Context(), &catalogv1.SetFranchiseEntriesRequest{FranchiseId: franchiseID, AnimeIds: body.AnimeIDs})
+	if err != nil {
+		writeCatalogError(w, rid, err)
+		return
+	}
+	api.WriteJSON(w, http.StatusOK, franchiseFromProto(resp.GetFranchise()))
+}
+
+func (h CatalogHandler) handleDeleteFranchise(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+	franchiseID := strings.TrimSpace(chi.URLParam(r, "franchise_id"))
+	if franchiseID == "" {
+		api.BadRequest(w, "VALIDATION_FRANCHISE_ID", "franchise_id is required", rid, nil)
+		return
+	}
+
+	if _, err := h.Catalog.DeleteFranchise(r.Context(), &catalogv1.DeleteFranchiseRequest{FranchiseId: franchiseID}); err != nil {
+		writeCatalogError(w, rid, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func franchiseFromProto(f *catalogv1.Franchise) franchise {
+	out := franchise{
+		ID:          f.GetId(),
+		Title:       f.GetTitle(),
+		Image:       f.GetImage(),
+		Description: f.GetDescription(),
+		Entries:     make([]franchiseEntry, 0, len(f.GetEntries())),
+		UpdatedAt:   f.GetUpdatedAtRfc3339(),
+	}
+	for _, e := range f.GetEntries() {
+		out.Entries = append(out.Entries, franchiseEntry{AnimeID: e.GetAnimeId(), Position: e.GetPosition()})
+	}
+	return out
+}
@@ -0,0 +1,648 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ingestionv1 "github.com/example/anime-platform/gen/ingestion/v1"
+	"github.com/example/anime-platform/internal/platform/api"
+	"github.com/example/anime-platform/internal/platform/httpserver"
+)
+
+// IngestionHandler exposes ingestion schedules and jobs to admins.
+type IngestionHandler struct {
+	Ingestion ingestionv1.IngestionAdminServiceClient
+}
+
+type schedule struct {
+	Name           string          `json:"name"`
+	Cron           string          `json:"cron"`
+	Job            string          `json:"job"`
+	Args           json.RawMessage `json:"args,omitempty"`
+	Paused         bool            `json:"paused"`
+	NextRunAt      string          `json:"next_run_at,omitempty"`
+	LastRunAt      string          `json:"last_run_at,omitempty"`
+	LastDurationMs int64           `json:"last_duration_ms,omitempty"`
+	LastStatus     string          `json:"last_status,omitempty"`
+	LastError      string          `json:"last_error,omitempty"`
+}
+
+type schedulesResponse struct {
+	Schedules []schedule `json:"schedules"`
+}
+
+type updateScheduleRequest struct {
+	Cron string `json:"cron"`
+}
+
+type job struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	Payload    json.RawMessage `json:"payload,omitempty"`
+	Status     string          `json:"status"`
+	Attempts   int32           `json:"attempts"`
+	LastError  string          `json:"last_error,omitempty"`
+	EnqueuedAt string          `json:"enqueued_at"`
+	StartedAt  string          `json:"started_at,omitempty"`
+	FinishedAt string          `json:"finished_at,omitempty"`
+	UpdatedAt  string          `json:"updated_at"`
+	ReplayOf   string          `json:"replay_of_dlq_id,omitempty"`
+	Report     json.RawMessage `json:"report,omitempty"`
+}
+
+type jobAttempt struct {
+	Attempt    int32  `json:"attempt"`
+	StartedAt  string `json:"started_at"`
+	FinishedAt string `json:"finished_at,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+type jobsResponse struct {
+	Jobs []job `json:"jobs"`
+}
+
+type dlqEntry struct {
+	ID            string          `json:"id"`
+	Subject       string          `json:"subject"`
+	Type          string          `json:"type"`
+	Reason        string          `json:"reason"`
+	Payload       json.RawMessage `json:"payload,omitempty"`
+	JobID         string          `json:"job_id,omitempty"`
+	Status        string          `json:"status"`
+	ReplayedJobID string          `json:"replayed_job_id,omitempty"`
+	FailedAt      string          `json:"failed_at"`
+	ResolvedAt    string          `json:"resolved_at,omitempty"`
+}
+
+type dlqEntriesResponse struct {
+	Entries []dlqEntry `json:"entries"`
+}
+
+// dlqBulkRequest selects pending DLQ entries by job type and failure time.
+type dlqBulkRequest struct {
+	Type  string `json:"type"`
+	Since string `json:"since"`
+	Until string `json:"until"`
+	Limit int32  `json:"limit"`
+}
+
+func (b dlqBulkRequest) filter() *ingestionv1.DLQFilter {
+	return &ingestionv1.DLQFilter{
+		Type:         strings.TrimSpace(b.Type),
+		SinceRfc3339: strings.TrimSpace(b.Since),
+		UntilRfc3339: strings.TrimSpace(b.Until),
+	}
+}
+
+type dlqReplayResponse struct {
+	Replayed []dlqEntry `json:"replayed"`
+	Failed   int32      `json:"failed"`
+}
+
+type dlqDiscardResponse struct {
+	Discarded int32 `json:"discarded"`
+}
+
+type jobDetailResponse struct {
+	job
+	AttemptLog []jobAttempt `json:"attempt_log"`
+}
+
+type episodeSyncResult struct {
+	Provider            string `json:"provider"`
+	MALID               int32  `json:"mal_id"`
+	AnimeID             string `json:"anime_id,omitempty"`
+	ProviderAnimeID     string `json:"provider_anime_id,omitempty"`
+	Airing              bool   `json:"airing"`
+	Episodes            int32  `json:"episodes"`
+	Added               int32  `json:"added"`
+	Renamed             int32  `json:"renamed"`
+	Renumbered          int32  `json:"renumbered"`
+	FillerChanged       int32  `json:"filler_changed"`
+	AvailabilityChanged int32  `json:"availability_changed"`
+	Removed             int32  `json:"removed"`
+	Error               string `json:"error,omitempty"`
+	SyncedAt            string `json:"synced_at"`
+	ChangedAt           string `json:"changed_at,omitempty"`
+}
+
+type episodeSyncResultsResponse struct {
+	Results []episodeSyncResult `json:"results"`
+}
+
+type dryRunChange struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+type dryRunTitle struct {
+	MALID   int32          `json:"mal_id"`
+	AnimeID string         `json:"anime_id,omitempty"`
+	Title   string         `json:"title"`
+	Changes []dryRunChange `json:"changes,omitempty"`
+}
+
+type dryRunSlug struct {
+	MALID           int32  `json:"mal_id"`
+	AnimeID         string `json:"anime_id,omitempty"`
+	Slug            string `json:"slug,omitempty"`
+	Source          string `json:"source,omitempty"`
+	Error           string `json:"error,omitempty"`
+	Episodes        int32  `json:"episodes"`
+	EpisodesAdded   int32  `json:"episodes_added"`
+	EpisodesChanged int32  `json:"episodes_changed"`
+	EpisodesRemoved int32  `json:"episodes_removed"`
+}
+
+type dryRunReport struct {
+	ID                string        `json:"id"`
+	Jobs              int32         `json:"jobs"`
+	Pending           int32         `json:"pending"`
+	Failed            int32         `json:"failed"`
+	NewTitles         []dryRunTitle `json:"new_titles"`
+	ChangedTitles     []dryRunTitle `json:"changed_titles"`
+	UnchangedTitles   int32         `json:"unchanged_titles"`
+	UnavailableMALIDs []int32       `json:"unavailable_mal_ids"`
+	UnmatchedSlugs    []dryRunSlug  `json:"unmatched_slugs"`
+	EpisodeChanges    []dryRunSlug  `json:"episode_changes"`
+}
+
+type hianimeMapping struct {
+	MALID     int32  `json:"mal_id"`
+	Slug      string `json:"slug"`
+	Note      string `json:"note,omitempty"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+type hianimeMappingsResponse struct {
+	Mappings []hianimeMapping `json:"mappings"`
+}
+
+// setHianimeMappingRequest pins a slug; with sync set the MAL ID is re-synced
+// straight away.
+type setHianimeMappingRequest struct {
+	Slug string `json:"slug"`
+	Note string `json:"note"`
+	Sync bool   `json:"sync"`
+}
+
+type setHianimeMappingResponse struct {
+	hianimeMapping
+	HiAnimeName  string `json:"hianime_name,omitempty"`
+	HiAnimeMALID int32  `json:"hianime_mal_id,omitempty"`
+	JobID        string `json:"job_id,omitempty"`
+}
+
+func (h IngestionHandler) Register(r chi.Router) {
+	r.Get("/ingestion/schedules", h.handleListSchedules)
+	r.Put("/ingestion/schedules/{name}", h.handleUpdateSchedule)
+	r.Post("/ingestion/schedules/{name}/pause", h.handlePauseSchedule)
+	r.Post("/ingestion/schedules/{name}/resume", h.handleResumeSchedule)
+	r.Post("/ingestion/schedules/{name}/trigger", h.handleTriggerSchedule)
+	r.Get("/ingestion/jobs", h.handleListJobs)
+	r.Get("/ingestion/jobs/{job_id}", h.handleGetJob)
+	r.Get("/ingestion/dlq", h.handleListDLQ)
+	r.Post("/ingestion/dlq/replay", h.handleReplayDLQBulk)
+	r.Post("/ingestion/dlq/discard", h.handleDiscardDLQBulk)
+	r.Post("/ingestion/dlq/{entry_id}/replay", h.handleReplayDLQEntry)
+	r.Post("/ingestion/dlq/{entry_id}/discard", h.handleDiscardDLQEntry)
+	r.Get("/ingestion/sync-results", h.handleListSyncResults)
+	r.Get("/ingestion/dry-runs/{dry_run_id}", h.handleGetDryRun)
+	r.Get("/ingestion/hianime/mappings", h.handleListHiAnimeMappings)
+	r.Put("/ingestion/hianime/mappings/{mal_id}", h.handleSetHiAnimeMapping)
+	r.Delete("/ingestion/hianime/mappings/{mal_id}", h.handleDeleteHiAnimeMapping)
+}
+
+func (h IngestionHandler) handleListSchedules(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+	resp, err := h.Ingestion.ListSchedules(r.Context(), &ingestionv1.ListSchedulesRequest{})
+	if err != nil {
+		writeIngestionError(w, rid, err)
+		return
+	}
+	out := schedulesResponse{Schedules: make([]schedule, 0, len(resp.GetSchedules()))}
+	for _, s := range resp.GetSchedules() {
+		out.Schedules = append(out.Schedules, scheduleFromProto(s))
+	}
+	api.WriteJSON(w, http.StatusOK, out)
+}
+
+func (h IngestionHandler) handleUpdateSchedule(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+	name := strings.TrimSpace(chi.URLParam(r, "name"))
+
+	var body updateScheduleRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20)).Decode(&body); err != nil {
+		api.BadRequest(w, "INVALID_JSON", "Invalid JSON", rid, nil)
+		return
+	}
+	cron := strings.TrimSpace(body.Cron)
+	if name == "" || cron == "" {
+		api.BadRequest(w, "VALIDATION_CRON", "name and cron are required", rid, nil)
+		return
+	}
+
+	resp, err := h.Ingestion.UpdateSchedule(r.Context(), &ingestionv1.UpdateScheduleRequest{Name: name, Cron: cron})
+	if err != nil {
+		writeIngestionError(w, rid, err)
+		return
+	}
+	api.WriteJSON(w, http.StatusOK, scheduleFromProto(resp.GetSchedule()))
+}
+
+func (h IngestionHandler) handlePauseSchedule(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+	resp, err := h.Ingestion.PauseSchedule(r.Context(), &ingestionv1.PauseScheduleRequest{Name: strings.TrimSpace(chi.URLParam(r, "name"))})
+	if err != nil {
+		writeIngestionError(w, rid, err)
+		return
+	}
+	api.WriteJSON(w, http.StatusOK, scheduleFromProto(resp.GetSchedule()))
+}
+
+func (h IngestionHandler) handleResumeSchedule(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+	resp, err := h.Ingestion.ResumeSchedule(r.Context(), &ingestionv1.ResumeScheduleRequest{Name: strings.TrimSpace(chi.URLParam(r, "name"))})
+	if err != nil {
+		writeIngestionError(w, rid, err)
+		return
+	}
+	api.WriteJSON(w, http.StatusOK, scheduleFromProto(resp.GetSchedule()))
+}
+
+func (h IngestionHandler) handleTriggerSchedule(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+	resp, err := h.Ingestion.TriggerSchedule(r.Context(), &ingestionv1.TriggerScheduleRequest{Name: strings.TrimSpace(chi.URLParam(r, "name"))})
+	if err != nil {
+		writeIngestionError(w, rid, err)
+		return
+	}
+	api.WriteJSON(w, http.StatusAccepted, scheduleFromProto(resp.GetSchedule()))
+}
+
+// handleListJobs lists ingestion jobs, newest first. Query parameters: type
+// (e.g. jikan.sync), status, mal_id, since and until (RFC 3339), limit, offset.
+func (h IngestionHandler) handleListJobs(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+	q := r.URL.Query()
+
+	resp, err := h.Ingestion.ListJobs(r.Context(), &ingestionv1.ListJobsRequest{
+		Type:         strings.TrimSpace(q.Get("type")),
+		Status:       strings.TrimSpace(q.Get("status")),
+		MalId:        int32(parseIntDefault(q.Get("mal_id"), 0)),
+		SinceRfc3339: strings.TrimSpace(q.Get("since")),
+		UntilRfc3339: strings.TrimSpace(q.Get("until")),
+		Limit:        int32(parseIntDefault(q.Get("limit"), 0)),
+		Offset:       int32(parseIntDefault(q.Get("offset"), 0)),
+	})
+	if err != nil {
+		writeIngestionError(w, rid, err)
+		return
+	}
+	out := jobsResponse{Jobs: make([]job, 0, len(resp.GetJobs()))}
+	for _, j := range resp.GetJobs() {
+		out.Jobs = append(out.Jobs, jobFromProto(j))
+	}
+	api.WriteJSON(w, http.StatusOK, out)
+}
+
+func (h IngestionHandler) handleGetJob(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+	resp, err := h.Ingestion.GetJob(r.Context(), &ingestionv1.GetJobRequest{Id: strings.TrimSpace(chi.URLParam(r, "job_id"))})
+	if err != nil {
+		writeIngestionError(w, rid, err)
+		return
+	}
+	out := jobDetailResponse{job: jobFromProto(resp.GetJob()), AttemptLog: make([]jobAttempt, 0, len(resp.GetAttempts()))}
+	for _, a := range resp.GetAttempts() {
+		out.AttemptLog = append(out.AttemptLog, jobAttempt{
+			Attempt:    a.GetAttempt(),
+			StartedAt:  a.GetStartedAtRfc3339(),
+			FinishedAt: a.GetFinishedAtRfc3339(),
+			Error:      a.GetError(),
+		})
+	}
+	api.WriteJSON(w, http.StatusOK, out)
+}
+
+func jobFromProto(j *ingestionv1.Job) job {
+	return job{
+		ID:         j.GetId(),
+		Type:       j.GetType(),
+		Payload:    rawJSON(j.GetPayloadJson()),
+		Status:     j.GetStatus(),
+		Attempts:   j.GetAttempts(),
+		LastError:  j.GetLastError(),
+		EnqueuedAt: j.GetEnqueuedAtRfc3339(),
+		StartedAt:  j.GetStartedAtRfc3339(),
+		FinishedAt: j.GetFinishedAtRfc3339(),
+		UpdatedAt:  j.GetUpdatedAtRfc3339(),
+		ReplayOf:   j.GetReplayOfDlqId(),
+		Report:     rawJSON(j.GetReportJson()),
+	}
+}
+
+// handleListDLQ lists dead-lettered jobs, newest first. Query parameters: type,
+// status (pending, replayed, discarded), since and until (RFC 3339), limit, offset.
+func (h IngestionHandler) handleListDLQ(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+	q := r.URL.Query()
+
+	resp, err := h.Ingestion.ListDLQEntries(r.Context(), &ingestionv1.ListDLQEntriesRequest{
+		Type:         strings.TrimSpace(q.Get("type")),
+		Status:       strings.TrimSpace(q.Get("status")),
+		SinceRfc3339: strings.TrimSpace(q.Get("since")),
+		UntilRfc3339: strings.TrimSpace(q.Get("until")),
+		Limit:        int32(parseIntDefault(q.Get("limit"), 0)),
+		Offset:       int32(parseIntDefault(q.Get("offset"), 0)),
+	})
+	if err != nil {
+		writeIngestionError(w, rid, err)
+		return
+	}
+	out := dlqEntriesResponse{Entries: make([]dlqEntry, 0, len(resp.GetEntries()))}
+	for _, e := range resp.GetEntries() {
+		out.Entries = append(out.Entries, dlqEntryFromProto(e))
+	}
+	api.WriteJSON(w, http.StatusOK, out)
+}
+
+func (h IngestionHandler) handleReplayDLQEntry(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+	resp, err := h.Ingestion.ReplayDLQEntry(r.Context(), &ingestionv1.ReplayDLQEntryRequest{Id: strings.TrimSpace(chi.URLParam(r, "entry_id"))})
+	if err != nil {
+		writeIngestionError(w, rid, err)
+		return
+	}
+	api.WriteJSON(w, http.StatusAccepted, dlqEntryFromProto(resp.GetEntry()))
+}
+
+func (h IngestionHandler) handleDiscardDLQEntry(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+	if _, err := h.Ingestion.DiscardDLQEntry(r.Context(), &ingestionv1.DiscardDLQEntryRequest{Id: strings.TrimSpace(chi.URLParam(r, "entry_id"))}); err != nil {
+		writeIngestionError(w, rid, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h IngestionHandler) handleReplayDLQBulk(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+	var body dlqBulkRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20)).Decode(&body); err != nil {
+		api.BadRequest(w, "INVALID_JSON", "Invalid JSON", rid, nil)
+		return
+	}
+
+	resp, err := h.Ingestion.ReplayDLQEntries(r.Context(), &ingestionv1.ReplayDLQEntriesRequest{Filter: body.filter(), Limit: body.Limit})
+	if err != nil {
+		writeIngestionError(w, rid, err)
+		return
+	}
+	out := dlqReplayResponse{Replayed: make([]dlqEntry, 0, len(resp.GetReplayed())), Failed: resp.GetFailed()}
+	for _, e := range resp.GetReplayed() {
+		out.Replayed = append(out.Replayed, dlqEntryFromProto(e))
+	}
+	api.WriteJSON(w, http.StatusAccepted, out)
+}
+
+func (h IngestionHandler) handleDiscardDLQBulk(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+	var body dlqBulkRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20)).Decode(&body); err != nil {
+		api.BadRequest(w, "INVALID_JSON", "Invalid JSON", rid, nil)
+		return
+	}
+
+	resp, err := h.Ingestion.DiscardDLQEntries(r.Context(), &ingestionv1.DiscardDLQEntriesRequest{Filter: body.filter(), Limit: body.Limit})
+	if err != nil {
+		writeIngestionError(w, rid, err)
+		return
+	}
+	api.WriteJSON(w, http.StatusOK, dlqDiscardResponse{Discarded: resp.GetDiscarded()})
+}
+
+// handleGetDryRun returns the report of a backfill dry run. It fills in as
+// the dry run's jobs finish; pending counts the ones that have not.
+func (h IngestionHandler) handleGetDryRun(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+	resp, err := h.Ingestion.GetDryRunReport(r.Context(), &ingestionv1.GetDryRunReportRequest{Id: strings.TrimSpace(chi.URLParam(r, "dry_run_id"))})
+	if err != nil {
+		writeIngestionError(w, rid, err)
+		return
+	}
+	rep := resp.GetReport()
+	out := dryRunReport{
+		ID:                rep.GetId(),
+		Jobs:              rep.GetJobs(),
+		Pending:           rep.GetPending(),
+		Failed:            rep.GetFailed(),
+		NewTitles:         dryRunTitlesFromProto(rep.GetNewTitles()),
+		ChangedTitles:     dryRunTitlesFromProto(rep.GetChangedTitles()),
+		UnchangedTitles:   rep.GetUnchangedTitles(),
+		UnavailableMALIDs: append([]int32{}, rep.GetUnavailableMalIds()...),
+		UnmatchedSlugs:    dryRunSlugsFromProto(rep.GetUnmatchedSlugs()),
+		EpisodeChanges:    dryRunSlugsFromProto(rep.GetEpisodeChanges()),
+	}
+	api.WriteJSON(w, http.StatusOK, out)
+}
+
+// handleListSyncResults lists the latest episode sync per anime, most recent
+// first. Query parameters: provider, mal_id, airing=true, failed=true, limit, offset.
+func (h IngestionHandler) handleListSyncResults(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+	q := r.URL.Query()
+
+	resp, err := h.Ingestion.ListEpisodeSyncResults(r.Context(), &ingestionv1.ListEpisodeSyncResultsRequest{
+		Provider:   strings.TrimSpace(q.Get("provider")),
+		MalId:      int32(parseIntDefault(q.Get("mal_id"), 0)),
+		AiringOnly: q.Get("airing") == "true",
+		FailedOnly: q.Get("failed") == "true",
+		Limit:      int32(parseIntDefault(q.Get("limit"), 0)),
+		Offset:     int32(parseIntDefault(q.Get("offset"), 0)),
+	})
+	if err != nil {
+		writeIngestionError(w, rid, err)
+		return
+	}
+	out := episodeSyncResultsResponse{Results: make([]episodeSyncResult, 0, len(resp.GetResults()))}
+	for _, res := range resp.GetResults() {
+		out.Results = append(out.Results, episodeSyncResult{
+			Provider:            res.GetProvider(),
+			MALID:               res.GetMalId(),
+			AnimeID:             res.GetAnimeId(),
+			ProviderAnimeID:     res.GetProviderAnimeId(),
+			Airing:              res.GetAiring(),
+			Episodes:            res.GetEpisodes(),
+			Added:               res.GetAdded(),
+			Renamed:             res.GetRenamed(),
+			Renumbered:          res.GetRenumbered(),
+			FillerChanged:       res.GetFillerChanged(),
+			AvailabilityChanged: res.GetAvailabilityChanged(),
+			Removed:             res.GetRemoved(),
+			Error:               res.GetError(),
+			SyncedAt:            res.GetSyncedAtRfc3339(),
+			ChangedAt:           res.GetChangedAtRfc3339(),
+		})
+	}
+	api.WriteJSON(w, http.StatusOK, out)
+}
+
+func (h IngestionHandler) handleListHiAnimeMappings(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+	q := r.URL.Query()
+	resp, err := h.Ingestion.ListHiAnimeMappings(r.Context(), &ingestionv1.ListHiAnimeMappingsRequest{
+		Limit:  int32(parseIntDefault(q.Get("limit"), 0)),
+		Offset: int32(parseIntDefault(q.Get("offset"), 0)),
+	})
+	if err != nil {
+		writeIngestionError(w, rid, err)
+		return
+	}
+	out := hianimeMappingsResponse{Mappings: make([]hianimeMapping, 0, len(resp.GetMappings()))}
+	for _, m := range resp.GetMappings() {
+		out.Mappings = append(out.Mappings, hianimeMappingFromProto(m))
+	}
+	api.WriteJSON(w, http.StatusOK, out)
+}
+
+func (h IngestionHandler) handleSetHiAnimeMapping(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+	malID := parseIntDefault(chi.URLParam(r, "mal_id"), 0)
+	if malID <= 0 {
+		api.BadRequest(w, "VALIDATION_MAL_ID", "Invalid mal_id", rid, nil)
+		return
+	}
+	var body setHianimeMappingRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20)).Decode(&body); err != nil {
+		api.BadRequest(w, "INVALID_JSON", "Invalid JSON", rid, nil)
+		return
+	}
+	slug := strings.TrimSpace(body.Slug)
+	if slug == "" {
+		api.BadRequest(w, "VALIDATION_SLUG", "slug is required", rid, nil)
+		return
+	}
+
+	resp, err := h.Ingestion.SetHiAnimeMapping(r.Context(), &ingestionv1.SetHiAnimeMappingRequest{
+		MalId: int32(malID),
+		Slug:  slug,
+		Note:  strings.TrimSpace(body.Note),
+		Sync:  body.Sync,
+	})
+	if err != nil {
+		writeIngestionError(w, rid, err)
+		return
+	}
+	api.WriteJSON(w, http.StatusOK, setHianimeMappingResponse{
+		hianimeMapping: hianimeMappingFromProto(resp.GetMapping()),
+		HiAnimeName:    resp.GetHianimeName(),
+		HiAnimeMALID:   resp.GetHianimeMalId(),
+		JobID:          resp.GetJobId(),
+	})
+}
+
+func (h IngestionHandler) handleDeleteHiAnimeMapping(w http.ResponseWriter, r *http.Request) {
+	rid := httpserver.RequestIDFromContext(r.Context())
+	malID := parseIntDefault(chi.URLParam(r, "mal_id"), 0)
+	if malID <= 0 {
+		api.BadRequest(w, "VALIDATION_MAL_ID", "Invalid mal_id", rid, nil)
+		return
+	}
+	if _, err := h.Ingestion.DeleteHiAnimeMapping(r.Context(), &ingestionv1.DeleteHiAnimeMappingRequest{MalId: int32(malID)}); err != nil {
+		writeIngestionError(w, rid, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func dryRunTitlesFromProto(in []*ingestionv1.DryRunTitle) []dryRunTitle {
+	out := make([]dryRunTitle, 0, len(in))
+	for _, t := range in {
+		dt := dryRunTitle{MALID: t.GetMalId(), AnimeID: t.GetAnimeId(), Title: t.GetTitle()}
+		for _, c := range t.GetChanges() {
+			dt.Changes = append(dt.Changes, dryRunChange{Field: c.GetField(), From: c.GetFrom(), To: c.GetTo()})
+		}
+		out = append(out, dt)
+	}
+	return out
+}
+
+func dryRunSlugsFromProto(in []*ingestionv1.DryRunSlug) []dryRunSlug {
+	out := make([]dryRunSlug, 0, len(in))
+	for _, s := range in {
+		out = append(out, dryRunSlug{
+			MALID:           s.GetMalId(),
+			AnimeID:         s.GetAnimeId(),
+			Slug:            s.GetSlug(),
+			Source:          s.GetSource(),
+			Error:           s.GetError(),
+			Episodes:        s.GetEpisodes(),
+			EpisodesAdded:   s.GetEpisodesAdded(),
+			EpisodesChanged: s.GetEpisodesChanged(),
+			EpisodesRemoved: s.GetEpisodesRemoved(),
+		})
+	}
+	return out
+}
+
+func hianimeMappingFromProto(m *ingestionv1.HiAnimeMapping) hianimeMapping {
+	return hianimeMapping{
+		MALID:     m.GetMalId(),
+		Slug:      m.GetSlug(),
+		Note:      m.GetNote(),
+		CreatedAt: m.GetCreatedAtRfc3339(),
+		UpdatedAt: m.GetUpdatedAtRfc3339(),
+	}
+}
+
+func dlqEntryFromProto(e *ingestionv1.DLQEntry) dlqEntry {
+	return dlqEntry{
+		ID:            e.GetId(),
+		Subject:       e.GetSubject(),
+		Type:          e.GetType(),
+		Reason:        e.GetReason(),
+		Payload:       rawJSON(e.GetPayloadJson()),
+		JobID:         e.GetJobId(),
+		Status:        e.GetStatus(),
+		ReplayedJobID: e.GetReplayedJobId(),
+		FailedAt:      e.GetFailedAtRfc3339(),
+		ResolvedAt:    e.GetResolvedAtRfc3339(),
+	}
+}
+
+func scheduleFromProto(s *ingestionv1.Schedule) schedule {
+	return schedule{
+		Name:           s.GetName(),
+		Cron:           s.GetCron(),
+		Job:            s.GetJob(),
+		Args:           rawJSON(s.GetArgsJson()),
+		Paused:         s.GetPaused(),
+		NextRunAt:      s.GetNextRunAtRfc3339(),
+		LastRunAt:      s.GetLastRunAtRfc3339(),
+		LastDurationMs: s.GetLastDurationMs(),
+		LastStatus:     s.GetLastStatus(),
+		LastError:      s.GetLastError(),
+	}
+}
+
+func writeIngestionError(w http.ResponseWriter, rid string, err error) {
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		api.BadRequest(w, "VALIDATION_FAILED", st.Message(), rid, nil)
+	case codes.NotFound:
+		api.NotFound(w, "NOT_FOUND", st.Message(), rid)
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		api.Conflict(w, "CONFLICT", st.Message(), rid, nil)
+	default:
+		api.WriteError(w, http.StatusBadGateway, "INGESTION_FAILED", st.Message(), rid, nil)
+	}
+}
@@ -12,10 +12,13 @@ import (
 	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	"github.com/nats-io/nats.go"
 
 	"github.com/example/anime-platform/internal/platform/api"
 	"github.com/example/anime-platform/internal/platform/httpserver"
+	"github.com/example/anime-platform/internal/platform/ingestjob"
+	bffhandlers "github.com/example/anime-platform/services/bff/internal/handlers"
 )
 
 type BackfillHandler struct {
@@ -27,6 +30,8 @@ type BackfillHandler struct {
 type publishResult struct {
 	Requested int `json:"requested"`
 	Published int `json:"published"`
+	// DryRunID is set for dry runs; the report is at /ingestion/dry-runs/{id}.
+	DryRunID string `json:"dry_run_id,omitempty"`
 }
 
 func (h BackfillHandler) Register(r chi.Router) {
@@ -44,7 +49,7 @@ func (h BackfillHandler) handleTop(w http.ResponseWriter, r *http.Request) {
 	}
 	res, err := h.enqueueJikanList(r.Context(), func(page int) string {
 		return fmt.Sprintf("%s/top/anime?page=%d", strings.TrimRight(h.JikanBaseURL, "/"), page)
-	}, pages)
+	}, pages, isDryRun(r))
 	if err != nil {
 		api.WriteError(w, http.StatusBadGateway, "BACKFILL_FAILED", err.Error(), httpserver.RequestIDFromContext(r.Context()), nil)
 		return
@@ -62,7 +67,7 @@ func (h BackfillHandler) handleSeasonNow(w http.ResponseWriter, r *http.Request)
 	}
 	res, err := h.enqueueJikanList(r.Context(), func(page int) string {
 		return fmt.Sprintf("%s/seasons/now?page=%d", strings.TrimRight(h.JikanBaseURL, "/"), page)
-	}, pages)
+	}, pages, isDryRun(r))
 	if err != nil {
 		api.WriteError(w, http.StatusBadGateway, "BACKFILL_FAILED", err.Error(), httpserver.RequestIDFromContext(r.Context()), nil)
 		return
@@ -76,7 +81,14 @@ type jikanListResponse struct {
 	} `json:"data"`
 }
 
-func (h BackfillHandler) enqueueJikanList(ctx context.Context, urlForPage func(page int) string, pages int) (publishResult, error) {
+// isDryRun reports whether the request asks for a dry run (?dry_run=true):
+// the jobs compare upstream data with the catalog and write nothing.
+func isDryRun(r *http.Request) bool {
+	v, _ := strconv.ParseBool(r.URL.Query().Get("dry_run"))
+	return v
+}
+
+func (h BackfillHandler) enqueueJikanList(ctx context.Context, urlForPage func(page int) string, pages int, dryRun bool) (publishResult, error) {
 	if h.HTTPClient == nil {
 		h.HTTPClient = &http.Client{Timeout: 15 * time.Second}
 	}
@@ -95,15 +107,22 @@ func (h BackfillHandler) enqueueJikanList(ctx context.Context, urlForPage func(p
 		}
 	}
 
-	published := 0
+	subject := ingestjob.LaneSubject("ingestion.jikan.sync", ingestjob.LaneBackfill)
+	res := publishResult{Requested: requested}
+	if dryRun {
+		res.DryRunID = uuid.NewString()
+	}
 	for id := range dedup {
-		b, _ := json.Marshal(map[string]any{"mal_id": id})
-		if _, err := h.JS.Publish("ingestion.jikan.sync", b); err != nil {
+		job := map[string]any{"mal_id": id}
+		if dryRun {
+			job["dry_run_id"] = res.DryRunID
+		}
+		if _, err := bffhandlers.PublishIngestionJob(h.JS, subject, job); err != nil {
 			return publishResult{}, err
 		}
-		published++
+		res.Published++
 	}
-	return publishResult{Requested: requested, Published: published}, nil
+	return res, nil
 }
 
 func fetchMALIDs(ctx context.Context, hc *http.Client, rawURL string) ([]int, error) {
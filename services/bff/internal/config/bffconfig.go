@@ -15,12 +15,26 @@ type BFFConfig struct {
 	SearchGRPCAddr        string
 	StreamingGRPCAddr     string
 	SocialGRPCAddr        string
+	IngestionGRPCAddr     string
 	HLSProxyBaseURL       string
 	HLSProxySigningSecret string
-	NATSURL               string
-	JikanBaseURL          string
+	// ImageBaseURL is the public URL of the catalog's /images endpoint; mirrored
+	// cover URLs are only emitted when it and ImageSigningSecret are set.
+	ImageBaseURL       string
+	ImageSigningSecret string
+	NATSURL            string
+	JikanBaseURL       string
+	// RedisURL backs the Jikan rate limit shared with ingestion; empty limits
+	// this process on its own.
+	RedisURL              string
+	JikanRPS              int
 	CacheTTLSeconds       int
 	CacheInvalidationSubj string
+	// GeoTrustedHeader is the header the edge proxy sets to the client's
+	// country; GeoDevHeaders also trusts client-set X-Client-Country for
+	// local development.
+	GeoTrustedHeader string
+	GeoDevHeaders    bool
 }
 
 func LoadBFF() (BFFConfig, error) {
@@ -52,6 +66,10 @@ func LoadBFF() (BFFConfig, error) {
 	if socialAddr == "" {
 		return BFFConfig{}, errors.New("SOCIAL_GRPC_ADDR is required")
 	}
+	ingestionAddr := strings.TrimSpace(os.Getenv("INGESTION_GRPC_ADDR"))
+	if ingestionAddr == "" {
+		return BFFConfig{}, errors.New("INGESTION_GRPC_ADDR is required")
+	}
 	hlsBase := strings.TrimSpace(os.Getenv("HLS_PROXY_BASE_URL"))
 	if hlsBase == "" {
 		return BFFConfig{}, errors.New("HLS_PROXY_BASE_URL is required")
@@ -70,6 +88,14 @@ func LoadBFF() (BFFConfig, error) {
 		jikanURL = "https://api.jikan.moe/v4"
 	}
 
+	redisURL := strings.TrimSpace(os.Getenv("REDIS_URL"))
+	jikanRPS := 1
+	if v := strings.TrimSpace(os.Getenv("JIKAN_RPS")); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			jikanRPS = n
+		}
+	}
+
 	// optional cache TTL for BFF list/search responses (seconds)
 	ttl := 60
 	if v := strings.TrimSpace(os.Getenv("BFF_CACHE_TTL_SEC")); v != "" {
@@ -82,6 +108,15 @@ func LoadBFF() (BFFConfig, error) {
 		subj = "bff.cache.invalidate"
 	}
 
+	geoHeader := strings.TrimSpace(os.Getenv("GEO_TRUSTED_HEADER"))
+	if geoHeader == "" {
+		geoHeader = "CF-IPCountry"
+	}
+	geoDev, _ := strconv.ParseBool(strings.TrimSpace(os.Getenv("GEO_DEV_HEADERS")))
+
+	imageBase := strings.TrimSpace(os.Getenv("IMAGE_BASE_URL"))
+	imageSecret := strings.TrimSpace(os.Getenv("IMAGE_SIGNING_SECRET"))
+
 	return BFFConfig{
 		JWTSecret:             []byte(secret),
 		AuthGRPCAddr:          authAddr,
@@ -90,11 +125,18 @@ func LoadBFF() (BFFConfig, error) {
 		SearchGRPCAddr:        searchAddr,
 		StreamingGRPCAddr:     streamingAddr,
 		SocialGRPCAddr:        socialAddr,
+		IngestionGRPCAddr:     ingestionAddr,
 		HLSProxyBaseURL:       hlsBase,
 		HLSProxySigningSecret: hlsSecret,
+		ImageBaseURL:          imageBase,
+		ImageSigningSecret:    imageSecret,
 		NATSURL:               natsURL,
 		JikanBaseURL:          jikanURL,
+		RedisURL:              redisURL,
+		JikanRPS:              jikanRPS,
 		CacheTTLSeconds:       ttl,
 		CacheInvalidationSubj: subj,
+		GeoTrustedHeader:      geoHeader,
+		GeoDevHeaders:         geoDev,
 	}, nil
 }
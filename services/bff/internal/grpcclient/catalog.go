@@ -5,6 +5,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 
 	catalogv1 "github.com/example/anime-platform/gen/catalog/v1"
+	"github.com/example/anime-platform/internal/platform/geo"
 )
 
 type CatalogClient struct {
@@ -13,7 +14,11 @@ type CatalogClient struct {
 }
 
 func NewCatalogClient(addr string) (*CatalogClient, error) {
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.NewClient(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		// Forward the client country so the service can enforce regional availability.
+		grpc.WithUnaryInterceptor(geo.UnaryClientInterceptor()),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,21 @@
+package grpcclient
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	ingestionv1 "github.com/example/anime-platform/gen/ingestion/v1"
+)
+
+type IngestionClient struct {
+	Conn   *grpc.ClientConn
+	Client ingestionv1.IngestionAdminServiceClient
+}
+
+func NewIngestionClient(addr string) (*IngestionClient, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return &IngestionClient{Conn: conn, Client: ingestionv1.NewIngestionAdminServiceClient(conn)}, nil
+}
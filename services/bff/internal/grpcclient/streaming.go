@@ -5,6 +5,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 
 	streamingv1 "github.com/example/anime-platform/gen/streaming/v1"
+	"github.com/example/anime-platform/internal/platform/geo"
 )
 
 type StreamingResolverClient struct {
@@ -13,7 +14,11 @@ type StreamingResolverClient struct {
 }
 
 func NewStreamingResolverClient(addr string) (*StreamingResolverClient, error) {
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.NewClient(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		// Forward the client country so the service can enforce regional availability.
+		grpc.WithUnaryInterceptor(geo.UnaryClientInterceptor()),
+	)
 	if err != nil {
 		return nil, err
 	}
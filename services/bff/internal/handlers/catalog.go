@@ -12,6 +12,7 @@ import (
 	"github.com/example/anime-platform/internal/platform/analytics"
 	"github.com/example/anime-platform/internal/platform/api"
 	"github.com/example/anime-platform/internal/platform/auth"
+	"github.com/example/anime-platform/internal/platform/geo"
 	"github.com/example/anime-platform/internal/platform/httpserver"
 )
 
@@ -27,18 +28,54 @@ type animeResponse struct {
 	Status        string   `json:"status,omitempty"`
 	Type          string   `json:"type,omitempty"`
 	TotalEpisodes int32    `json:"total_episodes"`
+	Locale        string   `json:"locale,omitempty"`
+	Themes        []string `json:"themes,omitempty"`
+	Demographics  []string `json:"demographics,omitempty"`
+	// Images maps variant name (thumb, medium, large) to a signed mirrored URL.
+	Images map[string]string `json:"images,omitempty"`
+	// BannerImage, ProviderScores (provider -> 0-10 score) and Tags come from
+	// enrichment providers such as AniList.
+	BannerImage    string             `json:"banner_image,omitempty"`
+	ProviderScores map[string]float32 `json:"provider_scores,omitempty"`
+	Tags           []tagResponse      `json:"tags,omitempty"`
+}
+
+type tagResponse struct {
+	Name    string `json:"name"`
+	Rank    int32  `json:"rank"`
+	Spoiler bool   `json:"spoiler,omitempty"`
 }
 
 type episodeResponse struct {
-	ID      string `json:"id"`
-	AnimeID string `json:"anime_id"`
-	Number  int32  `json:"number"`
-	Title   string `json:"title"`
-	AiredAt string `json:"aired_at,omitempty"`
+	ID              string `json:"id"`
+	AnimeID         string `json:"anime_id"`
+	Number          int32  `json:"number"`
+	Title           string `json:"title"`
+	AiredAt         string `json:"aired_at,omitempty"`
+	IsFiller        bool   `json:"is_filler"`
+	IsRecap         bool   `json:"is_recap"`
+	DurationSeconds int32  `json:"duration_seconds,omitempty"`
+	Synopsis        string `json:"synopsis,omitempty"`
+	Thumbnail       string `json:"thumbnail,omitempty"`
+}
+
+type genreResponse struct {
+	Slug       string `json:"slug"`
+	Name       string `json:"name"`
+	Kind       string `json:"kind"`
+	AnimeCount int32  `json:"anime_count"`
+}
+
+type episodeProviderResponse struct {
+	Provider string `json:"provider"`
+	HasSub   bool   `json:"has_sub"`
+	HasDub   bool   `json:"has_dub"`
 }
 
-func toAnimeResponse(a *catalogv1.Anime) animeResponse {
-	return animeResponse{
+// toAnimeResponse replaces the upstream image with the mirrored "large" variant
+// when one is available, so clients do not hotlink the upstream CDN.
+func toAnimeResponse(a *catalogv1.Anime, imgs *ImageURLs) animeResponse {
+	resp := animeResponse{
 		ID:            a.GetId(),
 		Title:         a.GetTitle(),
 		TitleEnglish:  a.GetTitleEnglish(),
@@ -50,20 +87,54 @@ func toAnimeResponse(a *catalogv1.Anime) animeResponse {
 		Status:        a.GetStatus(),
 		Type:          a.GetType(),
 		TotalEpisodes: a.GetTotalEpisodes(),
+		Locale:        a.GetLocale(),
+		Themes:        genreNames(a.GetThemes()),
+		Demographics:  genreNames(a.GetDemographics()),
+		Images:        imgs.Sign(a.GetImageVariants()),
+		BannerImage:   a.GetBannerImage(),
 	}
+	if large, ok := resp.Images["large"]; ok {
+		resp.Image = large
+	}
+	for _, ps := range a.GetProviderScores() {
+		if resp.ProviderScores == nil {
+			resp.ProviderScores = map[string]float32{}
+		}
+		resp.ProviderScores[ps.GetProvider()] = ps.GetScore()
+	}
+	for _, t := range a.GetTags() {
+		resp.Tags = append(resp.Tags, tagResponse{Name: t.GetName(), Rank: t.GetRank(), Spoiler: t.GetSpoiler()})
+	}
+	return resp
+}
+
+func genreNames(genres []*catalogv1.Genre) []string {
+	if len(genres) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(genres))
+	for _, g := range genres {
+		out = append(out, g.GetName())
+	}
+	return out
 }
 
 func toEpisodeResponse(e *catalogv1.Episode) episodeResponse {
 	return episodeResponse{
-		ID:      e.GetId(),
-		AnimeID: e.GetAnimeId(),
-		Number:  e.GetNumber(),
-		Title:   e.GetTitle(),
-		AiredAt: e.GetAiredAtRfc3339(),
+		ID:              e.GetId(),
+		AnimeID:         e.GetAnimeId(),
+		Number:          e.GetNumber(),
+		Title:           e.GetTitle(),
+		AiredAt:         e.GetAiredAtRfc3339(),
+		IsFiller:        e.GetIsFiller(),
+		IsRecap:         e.GetIsRecap(),
+		DurationSeconds: e.GetDurationSeconds(),
+		Synopsis:        e.GetSynopsis(),
+		Thumbnail:       e.GetThumbnail(),
 	}
 }
 
-func GetAnime(catalog catalogv1.CatalogServiceClient, ap *analytics.Publisher) http.HandlerFunc {
+func GetAnime(catalog catalogv1.CatalogServiceClient, ap *analytics.Publisher, imgs *ImageURLs) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		rid := httpserver.RequestIDFromContext(r.Context())
 
@@ -74,7 +145,7 @@ func GetAnime(catalog catalogv1.CatalogServiceClient, ap *analytics.Publisher) h
 		}
 
 		ctx := metadata.NewOutgoingContext(r.Context(), metadata.New(nil))
-		resp, err := catalog.GetAnimeByIDs(ctx, &catalogv1.GetAnimeByIDsRequest{AnimeIds: []string{animeID}})
+		resp, err := catalog.GetAnimeByIDs(ctx, &catalogv1.GetAnimeByIDsRequest{AnimeIds: []string{animeID}, Locale: requestLocale(w, r)})
 		if err != nil {
 			writeGRPCError(w, rid, err)
 			return
@@ -92,7 +163,7 @@ func GetAnime(catalog catalogv1.CatalogServiceClient, ap *analytics.Publisher) h
 			"title":    a.GetTitle(),
 		})
 
-		api.WriteJSON(w, http.StatusOK, toAnimeResponse(a))
+		api.WriteJSON(w, http.StatusOK, toAnimeResponse(a, imgs))
 	}
 }
 
@@ -148,7 +219,7 @@ func GetEpisode(catalog catalogv1.CatalogServiceClient) http.HandlerFunc {
 	}
 }
 
-func ListAnime(catalog catalogv1.CatalogServiceClient, cache Cache) http.HandlerFunc {
+func ListAnime(catalog catalogv1.CatalogServiceClient, cache Cache, imgs *ImageURLs) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		rid := httpserver.RequestIDFromContext(r.Context())
 
@@ -156,7 +227,9 @@ func ListAnime(catalog catalogv1.CatalogServiceClient, cache Cache) http.Handler
 		offset := parseInt32(r.URL.Query().Get("offset"), 0, 0, 10000)
 
 		// Try cache first
-		key := fmt.Sprintf("ListAnime:%d:%d", limit, offset)
+		// Regional rules and localization make the page country- and locale-dependent.
+		loc := requestLocale(w, r)
+		key := fmt.Sprintf("ListAnime:%s:%s:%d:%d", geo.FromContext(r.Context()), loc, limit, offset)
 		if cached, ok := cache.Get(key); ok {
 			api.WriteJSON(w, http.StatusOK, cached)
 			return
@@ -185,7 +258,7 @@ func ListAnime(catalog catalogv1.CatalogServiceClient, cache Cache) http.Handler
 		}
 		pageIDs := allIDs[offset:end]
 
-		animeResp, err := catalog.GetAnimeByIDs(ctx, &catalogv1.GetAnimeByIDsRequest{AnimeIds: pageIDs})
+		animeResp, err := catalog.GetAnimeByIDs(ctx, &catalogv1.GetAnimeByIDsRequest{AnimeIds: pageIDs, Locale: loc})
 		if err != nil {
 			writeGRPCError(w, rid, err)
 			return
@@ -193,7 +266,7 @@ func ListAnime(catalog catalogv1.CatalogServiceClient, cache Cache) http.Handler
 
 		items := make([]animeResponse, 0, len(animeResp.GetAnime()))
 		for _, a := range animeResp.GetAnime() {
-			items = append(items, toAnimeResponse(a))
+			items = append(items, toAnimeResponse(a, imgs))
 		}
 
 		resp := map[string]any{"anime": items, "total": total, "limit": limit, "offset": offset}
@@ -201,3 +274,131 @@ func ListAnime(catalog catalogv1.CatalogServiceClient, cache Cache) http.Handler
 		api.WriteJSON(w, http.StatusOK, resp)
 	}
 }
+
+// GetEpisodeProviders lists which streaming providers carry an episode and whether
+// each one has subbed and dubbed tracks. Provider episode IDs stay internal.
+func GetEpisodeProviders(catalog catalogv1.CatalogServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rid := httpserver.RequestIDFromContext(r.Context())
+
+		episodeID := strings.TrimSpace(chi.URLParam(r, "episode_id"))
+		if episodeID == "" {
+			api.BadRequest(w, "MISSING_ID", "episode_id is required", rid, nil)
+			return
+		}
+
+		resp, err := catalog.ListEpisodeProviders(r.Context(), &catalogv1.ListEpisodeProvidersRequest{EpisodeId: episodeID})
+		if err != nil {
+			writeGRPCError(w, rid, err)
+			return
+		}
+
+		providers := make([]episodeProviderResponse, 0, len(resp.GetProviders()))
+		for _, p := range resp.GetProviders() {
+			providers = append(providers, episodeProviderResponse{Provider: p.GetProvider(), HasSub: p.GetHasSub(), HasDub: p.GetHasDub()})
+		}
+		api.WriteJSON(w, http.StatusOK, map[string]any{"episode_id": episodeID, "providers": providers})
+	}
+}
+
+// ListGenres returns the genre, theme and demographic taxonomy with visible anime counts.
+// An optional ?kind= narrows the list to one taxonomy.
+type trendingItem struct {
+	animeResponse
+	TrendingScore float64 `json:"trending_score"`
+}
+
+// GetTrending returns the most active anime for window (24h, 7d or 30d),
+// hydrated with catalog details.
+func GetTrending(catalog catalogv1.CatalogServiceClient, cache Cache, imgs *ImageURLs) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rid := httpserver.RequestIDFromContext(r.Context())
+
+		window := strings.ToLower(strings.TrimSpace(r.URL.Query().Get("window")))
+		if window == "" {
+			window = "7d"
+		}
+		limit := parseInt32(r.URL.Query().Get("limit"), 25, 1, 100)
+
+		loc := requestLocale(w, r)
+		key := fmt.Sprintf("GetTrending:%s:%s:%s:%d", geo.FromContext(r.Context()), loc, window, limit)
+		if cached, ok := cache.Get(key); ok {
+			api.WriteJSON(w, http.StatusOK, cached)
+			return
+		}
+
+		ctx := metadata.NewOutgoingContext(r.Context(), metadata.New(nil))
+		trending, err := catalog.GetTrending(ctx, &catalogv1.GetTrendingRequest{Window: window, Limit: limit})
+		if err != nil {
+			writeGRPCError(w, rid, err)
+			return
+		}
+
+		ids := make([]string, 0, len(trending.GetAnime()))
+		for _, t := range trending.GetAnime() {
+			ids = append(ids, t.GetAnimeId())
+		}
+		byID := map[string]*catalogv1.Anime{}
+		if len(ids) > 0 {
+			animeResp, err := catalog.GetAnimeByIDs(ctx, &catalogv1.GetAnimeByIDsRequest{AnimeIds: ids, Locale: loc})
+			if err != nil {
+				writeGRPCError(w, rid, err)
+				return
+			}
+			for _, a := range animeResp.GetAnime() {
+				byID[a.GetId()] = a
+			}
+		}
+
+		items := make([]trendingItem, 0, len(ids))
+		for _, t := range trending.GetAnime() {
+			a, ok := byID[t.GetAnimeId()]
+			if !ok {
+				continue
+			}
+			items = append(items, trendingItem{animeResponse: toAnimeResponse(a, imgs), TrendingScore: windowScore(t.GetPopularity(), trending.GetWindow())})
+		}
+
+		resp := map[string]any{"window": trending.GetWindow(), "anime": items}
+		cache.Set(key, resp)
+		api.WriteJSON(w, http.StatusOK, resp)
+	}
+}
+
+func windowScore(p *catalogv1.Popularity, window string) float64 {
+	switch window {
+	case "24h":
+		return p.GetScore_24H()
+	case "30d":
+		return p.GetScore_30D()
+	default:
+		return p.GetScore_7D()
+	}
+}
+
+func ListGenres(catalog catalogv1.CatalogServiceClient, cache Cache) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rid := httpserver.RequestIDFromContext(r.Context())
+
+		kind := strings.ToLower(strings.TrimSpace(r.URL.Query().Get("kind")))
+		key := "ListGenres:" + kind
+		if cached, ok := cache.Get(key); ok {
+			api.WriteJSON(w, http.StatusOK, cached)
+			return
+		}
+
+		resp, err := catalog.ListGenres(r.Context(), &catalogv1.ListGenresRequest{Kind: kind})
+		if err != nil {
+			writeGRPCError(w, rid, err)
+			return
+		}
+
+		genres := make([]genreResponse, 0, len(resp.GetGenres()))
+		for _, g := range resp.GetGenres() {
+			genres = append(genres, genreResponse{Slug: g.GetSlug(), Name: g.GetName(), Kind: g.GetKind(), AnimeCount: g.GetAnimeCount()})
+		}
+		out := map[string]any{"genres": genres}
+		cache.Set(key, out)
+		api.WriteJSON(w, http.StatusOK, out)
+	}
+}
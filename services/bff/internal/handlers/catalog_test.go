@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/go-chi/chi/v5"
@@ -18,17 +19,31 @@ import (
 type stubCatalogClient struct {
 	catalogv1.CatalogServiceClient
 
-	getAnimeByIDsResp        *catalogv1.GetAnimeByIDsResponse
-	getAnimeByIDsErr         error
-	getEpisodesByIDsResp     *catalogv1.GetEpisodesByIDsResponse
-	getEpisodesByIDsErr      error
-	getEpisodesByAnimeIDResp *catalogv1.GetEpisodesByAnimeIDResponse
-	getEpisodesByAnimeIDErr  error
-	getAnimeIDsResp          *catalogv1.GetAnimeIDsResponse
-	getAnimeIDsErr           error
+	getAnimeByIDsResp         *catalogv1.GetAnimeByIDsResponse
+	getAnimeByIDsErr          error
+	getEpisodesByIDsResp      *catalogv1.GetEpisodesByIDsResponse
+	getEpisodesByIDsErr       error
+	getEpisodesByAnimeIDResp  *catalogv1.GetEpisodesByAnimeIDResponse
+	getEpisodesByAnimeIDErr   error
+	getEpisodesByAnimeIDsResp *catalogv1.GetEpisodesByAnimeIDsResponse
+	getEpisodesByAnimeIDsErr  error
+	getAnimeIDsResp           *catalogv1.GetAnimeIDsResponse
+	getAnimeIDsErr            error
+	listEpisodeProvidersResp  *catalogv1.ListEpisodeProvidersResponse
+	listEpisodeProvidersErr   error
+	listGenresResp            *catalogv1.ListGenresResponse
+	listGenresErr             error
+	getTrendingResp           *catalogv1.GetTrendingResponse
+	getTrendingErr            error
+	getFranchiseResp          *catalogv1.GetFranchiseResponse
+	getFranchiseErr           error
+
+	lastGetAnimeByIDsReq       *catalogv1.GetAnimeByIDsRequest
+	getEpisodesByAnimeIDsCalls int
 }
 
-func (s *stubCatalogClient) GetAnimeByIDs(_ context.Context, _ *catalogv1.GetAnimeByIDsRequest, _ ...grpc.CallOption) (*catalogv1.GetAnimeByIDsResponse, error) {
+func (s *stubCatalogClient) GetAnimeByIDs(_ context.Context, req *catalogv1.GetAnimeByIDsRequest, _ ...grpc.CallOption) (*catalogv1.GetAnimeByIDsResponse, error) {
+	s.lastGetAnimeByIDsReq = req
 	return s.getAnimeByIDsResp, s.getAnimeByIDsErr
 }
 
@@ -40,10 +55,31 @@ func (s *stubCatalogClient) GetEpisodesByAnimeID(_ context.Context, _ *catalogv1
 	return s.getEpisodesByAnimeIDResp, s.getEpisodesByAnimeIDErr
 }
 
+func (s *stubCatalogClient) GetEpisodesByAnimeIDs(_ context.Context, _ *catalogv1.GetEpisodesByAnimeIDsRequest, _ ...grpc.CallOption) (*catalogv1.GetEpisodesByAnimeIDsResponse, error) {
+	s.getEpisodesByAnimeIDsCalls++
+	return s.getEpisodesByAnimeIDsResp, s.getEpisodesByAnimeIDsErr
+}
+
 func (s *stubCatalogClient) GetAnimeIDs(_ context.Context, _ *catalogv1.GetAnimeIDsRequest, _ ...grpc.CallOption) (*catalogv1.GetAnimeIDsResponse, error) {
 	return s.getAnimeIDsResp, s.getAnimeIDsErr
 }
 
+func (s *stubCatalogClient) ListEpisodeProviders(_ context.Context, _ *catalogv1.ListEpisodeProvidersRequest, _ ...grpc.CallOption) (*catalogv1.ListEpisodeProvidersResponse, error) {
+	return s.listEpisodeProvidersResp, s.listEpisodeProvidersErr
+}
+
+func (s *stubCatalogClient) ListGenres(_ context.Context, _ *catalogv1.ListGenresRequest, _ ...grpc.CallOption) (*catalogv1.ListGenresResponse, error) {
+	return s.listGenresResp, s.listGenresErr
+}
+
+func (s *stubCatalogClient) GetTrending(_ context.Context, _ *catalogv1.GetTrendingRequest, _ ...grpc.CallOption) (*catalogv1.GetTrendingResponse, error) {
+	return s.getTrendingResp, s.getTrendingErr
+}
+
+func (s *stubCatalogClient) GetFranchise(_ context.Context, _ *catalogv1.GetFranchiseRequest, _ ...grpc.CallOption) (*catalogv1.GetFranchiseResponse, error) {
+	return s.getFranchiseResp, s.getFranchiseErr
+}
+
 func chiReq(url string, params map[string]string) *http.Request {
 	req := httptest.NewRequest(http.MethodGet, url, nil)
 	rctx := chi.NewRouteContext()
@@ -59,7 +95,7 @@ func TestGetAnime_OK(t *testing.T) {
 			Anime: []*catalogv1.Anime{{Id: "a1", Title: "Steins;Gate", Score: 9.1}},
 		},
 	}
-	handler := GetAnime(stub, nil)
+	handler := GetAnime(stub, nil, nil)
 	rr := httptest.NewRecorder()
 	handler.ServeHTTP(rr, chiReq("/v1/anime/a1", map[string]string{"anime_id": "a1"}))
 
@@ -75,11 +111,90 @@ func TestGetAnime_OK(t *testing.T) {
 	}
 }
 
+func TestGetAnime_MirroredImages(t *testing.T) {
+	stub := &stubCatalogClient{
+		getAnimeByIDsResp: &catalogv1.GetAnimeByIDsResponse{
+			Anime: []*catalogv1.Anime{{
+				Id:    "a1",
+				Image: "https://cdn.myanimelist.net/images/anime/1.jpg",
+				ImageVariants: []*catalogv1.ImageVariant{
+					{Name: "thumb", Key: "anime/a1/h/thumb.jpg"},
+					{Name: "large", Key: "anime/a1/h/large.jpg"},
+				},
+			}},
+		},
+	}
+	handler := GetAnime(stub, nil, NewImageURLs("https://img.example.com/images", "secret"))
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, chiReq("/v1/anime/a1", map[string]string{"anime_id": "a1"}))
+
+	var resp animeResponse
+	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(resp.Image, "https://img.example.com/images/anime/a1/h/large.jpg?") {
+		t.Fatalf("image not mirrored: %q", resp.Image)
+	}
+	if len(resp.Images) != 2 || !strings.Contains(resp.Images["thumb"], "sig=") {
+		t.Fatalf("unexpected images: %+v", resp.Images)
+	}
+}
+
+func TestGetAnime_NoImageSigner(t *testing.T) {
+	stub := &stubCatalogClient{
+		getAnimeByIDsResp: &catalogv1.GetAnimeByIDsResponse{
+			Anime: []*catalogv1.Anime{{
+				Id:            "a1",
+				Image:         "https://cdn.myanimelist.net/images/anime/1.jpg",
+				ImageVariants: []*catalogv1.ImageVariant{{Name: "large", Key: "anime/a1/h/large.jpg"}},
+			}},
+		},
+	}
+	handler := GetAnime(stub, nil, nil)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, chiReq("/v1/anime/a1", map[string]string{"anime_id": "a1"}))
+
+	var resp animeResponse
+	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Image != "https://cdn.myanimelist.net/images/anime/1.jpg" || resp.Images != nil {
+		t.Fatalf("expected upstream image untouched: %+v", resp)
+	}
+}
+
+func TestGetAnime_AcceptLanguage(t *testing.T) {
+	stub := &stubCatalogClient{
+		getAnimeByIDsResp: &catalogv1.GetAnimeByIDsResponse{
+			Anime: []*catalogv1.Anime{{Id: "a1", Title: "Portões de Steins", Locale: "pt"}},
+		},
+	}
+	handler := GetAnime(stub, nil, nil)
+	rr := httptest.NewRecorder()
+	req := chiReq("/v1/anime/a1", map[string]string{"anime_id": "a1"})
+	req.Header.Set("Accept-Language", "pt-BR,pt;q=0.9,en;q=0.8")
+	handler.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+	if got := stub.lastGetAnimeByIDsReq.GetLocale(); got != "pt-BR" {
+		t.Fatalf("expected locale pt-BR to be forwarded, got %q", got)
+	}
+	var resp animeResponse
+	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Locale != "pt" {
+		t.Fatalf("expected resolved locale pt, got %+v", resp)
+	}
+}
+
 func TestGetAnime_NotFound(t *testing.T) {
 	stub := &stubCatalogClient{
 		getAnimeByIDsResp: &catalogv1.GetAnimeByIDsResponse{Anime: nil},
 	}
-	handler := GetAnime(stub, nil)
+	handler := GetAnime(stub, nil, nil)
 	rr := httptest.NewRecorder()
 	handler.ServeHTTP(rr, chiReq("/v1/anime/missing", map[string]string{"anime_id": "missing"}))
 
@@ -90,7 +205,7 @@ func TestGetAnime_NotFound(t *testing.T) {
 
 func TestGetAnime_MissingID(t *testing.T) {
 	stub := &stubCatalogClient{}
-	handler := GetAnime(stub, nil)
+	handler := GetAnime(stub, nil, nil)
 	rr := httptest.NewRecorder()
 	handler.ServeHTTP(rr, chiReq("/v1/anime/", map[string]string{"anime_id": ""}))
 
@@ -103,7 +218,7 @@ func TestGetAnime_GRPCError(t *testing.T) {
 	stub := &stubCatalogClient{
 		getAnimeByIDsErr: status.Error(codes.Internal, "db error"),
 	}
-	handler := GetAnime(stub, nil)
+	handler := GetAnime(stub, nil, nil)
 	rr := httptest.NewRecorder()
 	handler.ServeHTTP(rr, chiReq("/v1/anime/a1", map[string]string{"anime_id": "a1"}))
 
@@ -115,7 +230,7 @@ func TestGetAnime_GRPCError(t *testing.T) {
 func TestGetEpisode_OK(t *testing.T) {
 	stub := &stubCatalogClient{
 		getEpisodesByIDsResp: &catalogv1.GetEpisodesByIDsResponse{
-			Episodes: []*catalogv1.Episode{{Id: "e1", AnimeId: "a1", Number: 1, Title: "Prologue"}},
+			Episodes: []*catalogv1.Episode{{Id: "e1", AnimeId: "a1", Number: 1, Title: "Prologue", IsFiller: true, DurationSeconds: 1440, Thumbnail: "https://img/e1.jpg"}},
 		},
 	}
 	handler := GetEpisode(stub)
@@ -132,6 +247,9 @@ func TestGetEpisode_OK(t *testing.T) {
 	if resp.ID != "e1" || resp.Title != "Prologue" {
 		t.Fatalf("unexpected response: %+v", resp)
 	}
+	if !resp.IsFiller || resp.DurationSeconds != 1440 || resp.Thumbnail != "https://img/e1.jpg" {
+		t.Fatalf("episode metadata not passed through: %+v", resp)
+	}
 }
 
 func TestGetEpisode_NotFound(t *testing.T) {
@@ -196,7 +314,7 @@ func TestListAnime_OK(t *testing.T) {
 			},
 		},
 	}
-	handler := ListAnime(stub, NewTTLCache(0, nil, ""))
+	handler := ListAnime(stub, NewTTLCache(0, nil, ""), nil)
 	rr := httptest.NewRecorder()
 	handler.ServeHTTP(rr, chiReq("/v1/anime?limit=2&offset=0", nil))
 
@@ -225,7 +343,7 @@ func TestListAnime_OffsetBeyondTotal(t *testing.T) {
 	stub := &stubCatalogClient{
 		getAnimeIDsResp: &catalogv1.GetAnimeIDsResponse{AnimeIds: []string{"a1"}},
 	}
-	handler := ListAnime(stub, NewTTLCache(0, nil, ""))
+	handler := ListAnime(stub, NewTTLCache(0, nil, ""), nil)
 	rr := httptest.NewRecorder()
 	handler.ServeHTTP(rr, chiReq("/v1/anime?offset=100", nil))
 
@@ -244,3 +362,112 @@ func TestListAnime_OffsetBeyondTotal(t *testing.T) {
 		t.Fatalf("expected empty anime list, got %d", len(resp.Anime))
 	}
 }
+
+func TestGetEpisodeProviders_OK(t *testing.T) {
+	stub := &stubCatalogClient{
+		listEpisodeProvidersResp: &catalogv1.ListEpisodeProvidersResponse{
+			Providers: []*catalogv1.EpisodeProvider{{Provider: "hianime", ProviderEpisodeId: "x?ep=1", HasSub: true, HasDub: true}},
+		},
+	}
+	handler := GetEpisodeProviders(stub)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, chiReq("/v1/episodes/e1/providers", map[string]string{"episode_id": "e1"}))
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+	var resp struct {
+		Providers []episodeProviderResponse `json:"providers"`
+	}
+	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Providers) != 1 || resp.Providers[0].Provider != "hianime" || !resp.Providers[0].HasDub {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+}
+
+func TestListGenres_OK(t *testing.T) {
+	stub := &stubCatalogClient{
+		listGenresResp: &catalogv1.ListGenresResponse{
+			Genres: []*catalogv1.GenreCount{
+				{Slug: "action", Name: "Action", Kind: "genre", AnimeCount: 12},
+				{Slug: "time-travel", Name: "Time Travel", Kind: "theme", AnimeCount: 2},
+			},
+		},
+	}
+	handler := ListGenres(stub, NewTTLCache(0, nil, ""))
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, chiReq("/v1/genres", nil))
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+	var resp struct {
+		Genres []genreResponse `json:"genres"`
+	}
+	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Genres) != 2 || resp.Genres[1].Slug != "time-travel" || resp.Genres[0].AnimeCount != 12 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+}
+
+func TestListGenres_InvalidKind(t *testing.T) {
+	stub := &stubCatalogClient{listGenresErr: status.Error(codes.InvalidArgument, "bad kind")}
+	handler := ListGenres(stub, NewTTLCache(0, nil, ""))
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, chiReq("/v1/genres?kind=studio", nil))
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d: %s", rr.Code, rr.Body.String())
+	}
+}
+
+func TestGetTrending_OK(t *testing.T) {
+	stub := &stubCatalogClient{
+		getTrendingResp: &catalogv1.GetTrendingResponse{
+			Window: "24h",
+			Anime: []*catalogv1.TrendingAnime{
+				{AnimeId: "a2", Popularity: &catalogv1.Popularity{Score_24H: 7}},
+				{AnimeId: "gone", Popularity: &catalogv1.Popularity{Score_24H: 5}},
+				{AnimeId: "a1", Popularity: &catalogv1.Popularity{Score_24H: 3}},
+			},
+		},
+		getAnimeByIDsResp: &catalogv1.GetAnimeByIDsResponse{
+			Anime: []*catalogv1.Anime{{Id: "a1", Title: "One"}, {Id: "a2", Title: "Two"}},
+		},
+	}
+	handler := GetTrending(stub, NewTTLCache(0, nil, ""), nil)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, chiReq("/v1/trending?window=24h", nil))
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+	var resp struct {
+		Window string         `json:"window"`
+		Anime  []trendingItem `json:"anime"`
+	}
+	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Window != "24h" || len(resp.Anime) != 2 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if resp.Anime[0].ID != "a2" || resp.Anime[0].TrendingScore != 7 || resp.Anime[1].Title != "One" {
+		t.Fatalf("ranking not preserved: %+v", resp.Anime)
+	}
+}
+
+func TestGetTrending_InvalidWindow(t *testing.T) {
+	stub := &stubCatalogClient{getTrendingErr: status.Error(codes.InvalidArgument, "bad window")}
+	handler := GetTrending(stub, NewTTLCache(0, nil, ""), nil)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, chiReq("/v1/trending?window=1y", nil))
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d: %s", rr.Code, rr.Body.String())
+	}
+}
@@ -0,0 +1,196 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc/metadata"
+
+	activityv1 "github.com/example/anime-platform/gen/activity/v1"
+	catalogv1 "github.com/example/anime-platform/gen/catalog/v1"
+	"github.com/example/anime-platform/internal/platform/api"
+	"github.com/example/anime-platform/internal/platform/auth"
+	"github.com/example/anime-platform/internal/platform/httpserver"
+)
+
+// Per-entry watch status.
+const (
+	progressNotStarted = "not_started"
+	progressWatching   = "watching"
+	progressCompleted  = "completed"
+)
+
+// progressLookupChunk matches the activity service's GetEpisodesProgress cap.
+const progressLookupChunk = 1000
+
+type franchiseProgress struct {
+	Status            string `json:"status"`
+	EpisodesTotal     int    `json:"episodes_total"`
+	EpisodesCompleted int    `json:"episodes_completed"`
+	// Last* describe the most recently watched episode.
+	LastEpisodeID     string `json:"last_episode_id,omitempty"`
+	LastEpisodeNumber int32  `json:"last_episode_number,omitempty"`
+	LastWatchedAtMs   int64  `json:"last_watched_at_ms,omitempty"`
+}
+
+type franchiseEntryResponse struct {
+	Position int32              `json:"position"`
+	Anime    animeResponse      `json:"anime"`
+	Progress *franchiseProgress `json:"progress,omitempty"`
+}
+
+type franchiseResponse struct {
+	ID          string                   `json:"id"`
+	Title       string                   `json:"title"`
+	Image       string                   `json:"image,omitempty"`
+	Description string                   `json:"description,omitempty"`
+	Entries     []franchiseEntryResponse `json:"entries"`
+}
+
+// GetFranchise returns a franchise's entries in order. Signed-in callers also
+// get their progress on each entry; anonymous callers get the entries alone.
+// Entries unavailable in the caller's region are omitted.
+func GetFranchise(catalog catalogv1.CatalogServiceClient, activity activityv1.ActivityServiceClient, imgs *ImageURLs) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rid := httpserver.RequestIDFromContext(r.Context())
+		uid, _ := auth.UserIDFromContext(r.Context())
+		uid = strings.TrimSpace(uid)
+		franchiseID := strings.TrimSpace(chi.URLParam(r, "franchise_id"))
+		if franchiseID == "" {
+			api.BadRequest(w, "MISSING_ID", "franchise_id is required", rid, nil)
+			return
+		}
+
+		ctx := metadata.NewOutgoingContext(r.Context(), metadata.New(nil))
+		fr, err := catalog.GetFranchise(ctx, &catalogv1.GetFranchiseRequest{FranchiseId: franchiseID})
+		if err != nil {
+			writeGRPCError(w, rid, err)
+			return
+		}
+		f := fr.GetFranchise()
+		out := franchiseResponse{
+			ID:          f.GetId(),
+			Title:       f.GetTitle(),
+			Image:       f.GetImage(),
+			Description: f.GetDescription(),
+			Entries:     []franchiseEntryResponse{},
+		}
+		if len(f.GetEntries()) == 0 {
+			api.WriteJSON(w, http.StatusOK, out)
+			return
+		}
+
+		animeIDs := make([]string, 0, len(f.GetEntries()))
+		for _, e := range f.GetEntries() {
+			animeIDs = append(animeIDs, e.GetAnimeId())
+		}
+		ar, err := catalog.GetAnimeByIDs(ctx, &catalogv1.GetAnimeByIDsRequest{AnimeIds: animeIDs, Locale: requestLocale(w, r)})
+		if err != nil {
+			writeGRPCError(w, rid, err)
+			return
+		}
+		anime := make(map[string]*catalogv1.Anime, len(ar.GetAnime()))
+		for _, a := range ar.GetAnime() {
+			anime[a.GetId()] = a
+		}
+
+		var (
+			episodes map[string][]*catalogv1.Episode
+			progress map[string]*activityv1.EpisodeProgress
+		)
+		if uid != "" {
+			episodes, err = fetchAnimeEpisodes(ctx, catalog, animeIDs)
+			if err != nil {
+				writeGRPCError(w, rid, err)
+				return
+			}
+			var episodeIDs []string
+			for _, eps := range episodes {
+				for _, e := range eps {
+					episodeIDs = append(episodeIDs, e.GetId())
+				}
+			}
+			progress, err = fetchEpisodesProgress(ctx, activity, uid, episodeIDs)
+			if err != nil {
+				writeGRPCError(w, rid, err)
+				return
+			}
+		}
+
+		for _, e := range f.GetEntries() {
+			a, ok := anime[e.GetAnimeId()]
+			if !ok {
+				continue
+			}
+			entry := franchiseEntryResponse{
+				Position: e.GetPosition(),
+				Anime:    toAnimeResponse(a, imgs),
+			}
+			if uid != "" {
+				p := entryProgress(episodes[e.GetAnimeId()], progress)
+				entry.Progress = &p
+			}
+			out.Entries = append(out.Entries, entry)
+		}
+		api.WriteJSON(w, http.StatusOK, out)
+	}
+}
+
+// fetchAnimeEpisodes loads every entry's episodes in one catalog call, grouped
+// by anime ID.
+func fetchAnimeEpisodes(ctx context.Context, catalog catalogv1.CatalogServiceClient, animeIDs []string) (map[string][]*catalogv1.Episode, error) {
+	resp, err := catalog.GetEpisodesByAnimeIDs(ctx, &catalogv1.GetEpisodesByAnimeIDsRequest{AnimeIds: animeIDs})
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string][]*catalogv1.Episode, len(animeIDs))
+	for _, e := range resp.GetEpisodes() {
+		out[e.GetAnimeId()] = append(out[e.GetAnimeId()], e)
+	}
+	return out, nil
+}
+
+func fetchEpisodesProgress(ctx context.Context, activity activityv1.ActivityServiceClient, uid string, episodeIDs []string) (map[string]*activityv1.EpisodeProgress, error) {
+	out := make(map[string]*activityv1.EpisodeProgress, len(episodeIDs))
+	for i := 0; i < len(episodeIDs); i += progressLookupChunk {
+		end := min(i+progressLookupChunk, len(episodeIDs))
+		resp, err := activity.GetEpisodesProgress(ctx, &activityv1.GetEpisodesProgressRequest{UserId: uid, EpisodeIds: episodeIDs[i:end]})
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range resp.GetProgress() {
+			out[p.GetEpisodeId()] = p
+		}
+	}
+	return out, nil
+}
+
+// entryProgress summarizes the user's progress across one anime's episodes.
+func entryProgress(episodes []*catalogv1.Episode, progress map[string]*activityv1.EpisodeProgress) franchiseProgress {
+	p := franchiseProgress{Status: progressNotStarted, EpisodesTotal: len(episodes)}
+	started := false
+	for _, e := range episodes {
+		ep, ok := progress[e.GetId()]
+		if !ok {
+			continue
+		}
+		started = true
+		if ep.GetCompleted() {
+			p.EpisodesCompleted++
+		}
+		if ep.GetUpdatedAtMs() > p.LastWatchedAtMs {
+			p.LastEpisodeID = e.GetId()
+			p.LastEpisodeNumber = e.GetNumber()
+			p.LastWatchedAtMs = ep.GetUpdatedAtMs()
+		}
+	}
+	switch {
+	case p.EpisodesTotal > 0 && p.EpisodesCompleted == p.EpisodesTotal:
+		p.Status = progressCompleted
+	case started:
+		p.Status = progressWatching
+	}
+	return p
+}
@@ -0,0 +1,149 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	activityv1 "github.com/example/anime-platform/gen/activity/v1"
+	catalogv1 "github.com/example/anime-platform/gen/catalog/v1"
+)
+
+type stubActivityClient struct {
+	activityv1.ActivityServiceClient
+
+	getEpisodesProgressResp *activityv1.GetEpisodesProgressResponse
+	getEpisodesProgressErr  error
+
+	lastGetEpisodesProgressReq *activityv1.GetEpisodesProgressRequest
+}
+
+func (s *stubActivityClient) GetEpisodesProgress(_ context.Context, req *activityv1.GetEpisodesProgressRequest, _ ...grpc.CallOption) (*activityv1.GetEpisodesProgressResponse, error) {
+	s.lastGetEpisodesProgressReq = req
+	return s.getEpisodesProgressResp, s.getEpisodesProgressErr
+}
+
+func TestGetFranchise_OK(t *testing.T) {
+	catalog := &stubCatalogClient{
+		getFranchiseResp: &catalogv1.GetFranchiseResponse{Franchise: &catalogv1.Franchise{
+			Id:    "f1",
+			Title: "Steins;Gate",
+			Entries: []*catalogv1.FranchiseEntry{
+				{AnimeId: "a1", Position: 1},
+				{AnimeId: "a2", Position: 2}, // unavailable, not returned by GetAnimeByIDs
+			},
+		}},
+		getAnimeByIDsResp: &catalogv1.GetAnimeByIDsResponse{
+			Anime: []*catalogv1.Anime{{Id: "a1", Title: "Steins;Gate"}},
+		},
+		getEpisodesByAnimeIDsResp: &catalogv1.GetEpisodesByAnimeIDsResponse{
+			Episodes: []*catalogv1.Episode{{Id: "e1", AnimeId: "a1", Number: 1}, {Id: "e2", AnimeId: "a1", Number: 2}},
+		},
+	}
+	activity := &stubActivityClient{
+		getEpisodesProgressResp: &activityv1.GetEpisodesProgressResponse{
+			Progress: []*activityv1.EpisodeProgress{{EpisodeId: "e1", Completed: true, UpdatedAtMs: 100}},
+		},
+	}
+
+	rr := httptest.NewRecorder()
+	req := asAuthUser(chiReq("/v1/franchises/f1", map[string]string{"franchise_id": "f1"}))
+	GetFranchise(catalog, activity, nil).ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+	var resp franchiseResponse
+	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.ID != "f1" || len(resp.Entries) != 1 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	p := resp.Entries[0].Progress
+	if p == nil {
+		t.Fatal("expected progress for a signed-in caller")
+	}
+	if p.Status != progressWatching || p.EpisodesTotal != 2 || p.EpisodesCompleted != 1 || p.LastEpisodeID != "e1" {
+		t.Fatalf("unexpected progress: %+v", p)
+	}
+	if catalog.getEpisodesByAnimeIDsCalls != 1 {
+		t.Fatalf("expected one batched episodes call, got %d", catalog.getEpisodesByAnimeIDsCalls)
+	}
+	if got := activity.lastGetEpisodesProgressReq.GetUserId(); got != "user-1" {
+		t.Fatalf("expected progress lookup for user-1, got %q", got)
+	}
+}
+
+func TestGetFranchise_Anonymous(t *testing.T) {
+	catalog := &stubCatalogClient{
+		getFranchiseResp: &catalogv1.GetFranchiseResponse{Franchise: &catalogv1.Franchise{
+			Id:      "f1",
+			Entries: []*catalogv1.FranchiseEntry{{AnimeId: "a1", Position: 1}},
+		}},
+		getAnimeByIDsResp: &catalogv1.GetAnimeByIDsResponse{
+			Anime: []*catalogv1.Anime{{Id: "a1", Title: "Steins;Gate"}},
+		},
+	}
+	activity := &stubActivityClient{}
+
+	rr := httptest.NewRecorder()
+	GetFranchise(catalog, activity, nil).ServeHTTP(rr, chiReq("/v1/franchises/f1", map[string]string{"franchise_id": "f1"}))
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+	var resp franchiseResponse
+	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Entries) != 1 || resp.Entries[0].Progress != nil {
+		t.Fatalf("expected one entry without progress, got %+v", resp.Entries)
+	}
+	if catalog.getEpisodesByAnimeIDsCalls != 0 || activity.lastGetEpisodesProgressReq != nil {
+		t.Fatal("anonymous request should not load episodes or progress")
+	}
+}
+
+func TestGetFranchise_NotFound(t *testing.T) {
+	catalog := &stubCatalogClient{getFranchiseErr: status.Error(codes.NotFound, "franchise not found")}
+	rr := httptest.NewRecorder()
+	req := asAuthUser(chiReq("/v1/franchises/f1", map[string]string{"franchise_id": "f1"}))
+	GetFranchise(catalog, &stubActivityClient{}, nil).ServeHTTP(rr, req)
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", rr.Code)
+	}
+}
+
+func TestEntryProgress(t *testing.T) {
+	eps := []*catalogv1.Episode{{Id: "e1", Number: 1}, {Id: "e2", Number: 2}}
+	cases := []struct {
+		name     string
+		progress map[string]*activityv1.EpisodeProgress
+		status   string
+		last     int32
+	}{
+		{"none", nil, progressNotStarted, 0},
+		{"partial", map[string]*activityv1.EpisodeProgress{
+			"e2": {EpisodeId: "e2", UpdatedAtMs: 50},
+		}, progressWatching, 2},
+		{"all", map[string]*activityv1.EpisodeProgress{
+			"e1": {EpisodeId: "e1", Completed: true, UpdatedAtMs: 10},
+			"e2": {EpisodeId: "e2", Completed: true, UpdatedAtMs: 20},
+		}, progressCompleted, 2},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := entryProgress(eps, tc.progress)
+			if p.Status != tc.status || p.LastEpisodeNumber != tc.last {
+				t.Fatalf("got %+v", p)
+			}
+		})
+	}
+}
@@ -46,7 +46,7 @@ func writeGRPCError(w http.ResponseWriter, requestID string, err error) {
 		api.Forbidden(w, code, st.Message(), requestID)
 	case codes.NotFound:
 		api.NotFound(w, code, st.Message(), requestID)
-	case codes.AlreadyExists:
+	case codes.AlreadyExists, codes.FailedPrecondition:
 		api.Conflict(w, code, st.Message(), requestID, details)
 	case codes.ResourceExhausted:
 		api.RateLimited(w, code, st.Message(), requestID, details)
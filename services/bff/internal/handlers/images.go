@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"time"
+
+	catalogv1 "github.com/example/anime-platform/gen/catalog/v1"
+	"github.com/example/anime-platform/internal/platform/signing"
+)
+
+// imageURLTTL is how long a signed image URL stays valid. Expiry is rounded to
+// imageURLBucket so the same URL is handed out for a day and stays cacheable.
+const (
+	imageURLTTL    = 7 * 24 * time.Hour
+	imageURLBucket = 24 * time.Hour
+)
+
+// ImageURLs turns mirrored image keys into signed catalog /images URLs. A nil
+// *ImageURLs leaves responses pointing at the upstream image.
+type ImageURLs struct {
+	base   string
+	signer *signing.Signer
+	now    func() time.Time
+}
+
+// NewImageURLs returns nil when mirroring is not configured.
+func NewImageURLs(base, secret string) *ImageURLs {
+	if base == "" || secret == "" {
+		return nil
+	}
+	return &ImageURLs{base: base, signer: signing.New(secret), now: time.Now}
+}
+
+// Sign returns variant name -> signed URL.
+func (u *ImageURLs) Sign(variants []*catalogv1.ImageVariant) map[string]string {
+	if u == nil || len(variants) == 0 {
+		return nil
+	}
+	exp := u.now().Add(imageURLTTL).Truncate(imageURLBucket)
+	out := make(map[string]string, len(variants))
+	for _, v := range variants {
+		signed := u.signer.SignPath(v.GetKey(), exp)
+		url, err := signing.BuildSignedPathURL(u.base, signed)
+		if err != nil {
+			continue
+		}
+		out[v.GetName()] = url
+	}
+	return out
+}
@@ -13,6 +13,9 @@ import (
 	"github.com/nats-io/nats.go"
 
 	searchv1 "github.com/example/anime-platform/gen/search/v1"
+	"github.com/example/anime-platform/internal/platform/cassette"
+	"github.com/example/anime-platform/internal/platform/ingestjob"
+	"github.com/example/anime-platform/internal/platform/ratelimit"
 )
 
 // JikanFallback searches Jikan when local search has no results,
@@ -28,13 +31,15 @@ type jikanFallbackClient struct {
 }
 
 // NewJikanFallback creates a JikanFallback that queries Jikan and triggers ingestion via NATS.
-func NewJikanFallback(baseURL string, js nats.JetStreamContext) JikanFallback {
+// Requests count against limiter's shared Jikan budget; cas can record or
+// replay them.
+func NewJikanFallback(baseURL string, js nats.JetStreamContext, limiter ratelimit.Limiter, cas cassette.Config) JikanFallback {
 	if baseURL == "" {
 		baseURL = "https://api.jikan.moe/v4"
 	}
 	return &jikanFallbackClient{
 		baseURL:    strings.TrimRight(baseURL, "/"),
-		httpClient: &http.Client{Timeout: 5 * time.Second},
+		httpClient: cas.Client(ratelimit.NewHTTPClient(limiter, ratelimit.UpstreamJikan, 5*time.Second), "jikan"),
 		js:         js,
 	}
 }
@@ -100,12 +105,13 @@ func (c *jikanFallbackClient) Search(ctx context.Context, q string, limit int32)
 		malIDs = append(malIDs, a.MalID)
 	}
 
-	// Enqueue ingestion asynchronously so results appear in local search next time.
+	// Enqueue ingestion asynchronously so results appear in local search next
+	// time. A user is waiting on these, so they skip ahead of bulk imports.
 	if c.js != nil && len(malIDs) > 0 {
+		subject := ingestjob.LaneSubject("ingestion.jikan.sync", ingestjob.LaneInteractive)
 		go func() {
 			for _, id := range malIDs {
-				msg, _ := json.Marshal(map[string]any{"mal_id": id})
-				_, _ = c.js.Publish("ingestion.jikan.sync", msg)
+				_, _ = PublishIngestionJob(c.js, subject, map[string]any{"mal_id": id})
 			}
 		}()
 	}
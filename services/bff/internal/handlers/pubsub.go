@@ -9,6 +9,8 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/nats-io/nats.go"
+
+	"github.com/example/anime-platform/internal/platform/ingestjob"
 )
 
 var ErrAsyncPublishDisabled = errors.New("async publish is disabled")
@@ -58,3 +60,19 @@ func (p *EventPublisher) PublishJSON(subject string, payload map[string]any) (st
 	}
 	return eventID, nil
 }
+
+// PublishIngestionJob publishes an ingestion job with a new job id and returns the id.
+func PublishIngestionJob(js nats.JetStreamContext, subject string, job any) (string, error) {
+	body, err := json.Marshal(job)
+	if err != nil {
+		return "", err
+	}
+	id := uuid.NewString()
+	msg := nats.NewMsg(subject)
+	msg.Header.Set(ingestjob.IDHeader, id)
+	msg.Data = body
+	if _, err := js.PublishMsg(msg); err != nil {
+		return "", err
+	}
+	return id, nil
+}
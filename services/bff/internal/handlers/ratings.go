@@ -6,7 +6,9 @@ import (
 	"github.com/go-chi/chi/v5"
 
 	socialv1 "github.com/example/anime-platform/gen/social/v1"
+	"github.com/example/anime-platform/internal/platform/analytics"
 	"github.com/example/anime-platform/internal/platform/api"
+	"github.com/example/anime-platform/internal/platform/auth"
 	"github.com/example/anime-platform/internal/platform/httpserver"
 )
 
@@ -51,7 +53,7 @@ func GetRating(client socialv1.SocialServiceClient) http.HandlerFunc {
 }
 
 // RateAnime submits or updates the authenticated user's rating for an anime.
-func RateAnime(client socialv1.SocialServiceClient) http.HandlerFunc {
+func RateAnime(client socialv1.SocialServiceClient, ap *analytics.Publisher) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		rid := httpserver.RequestIDFromContext(r.Context())
 
@@ -81,6 +83,12 @@ func RateAnime(client socialv1.SocialServiceClient) http.HandlerFunc {
 			return
 		}
 
+		uid, _ := auth.UserIDFromContext(r.Context())
+		ap.Publish(analytics.SubjectSocialAnimeRated, "anime_rated", uid, map[string]any{
+			"anime_id": animeID,
+			"score":    req.Score,
+		})
+
 		api.WriteJSON(w, http.StatusOK, map[string]any{
 			"anime_id": animeID,
 			"average":  resp.GetAverage(),
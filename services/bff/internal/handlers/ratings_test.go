@@ -132,7 +132,7 @@ func TestRateAnime_OK(t *testing.T) {
 	req := asAuthUser(ratingReq(http.MethodPost, "/v1/anime/anime-1/rating", "anime-1", body))
 
 	rr := httptest.NewRecorder()
-	RateAnime(stub).ServeHTTP(rr, req)
+	RateAnime(stub, nil).ServeHTTP(rr, req)
 
 	if rr.Code != http.StatusOK {
 		t.Fatalf("expected 200, got %d: %s", rr.Code, rr.Body.String())
@@ -149,7 +149,7 @@ func TestRateAnime_MissingAnimeID(t *testing.T) {
 	req := asAuthUser(ratingReq(http.MethodPost, "/v1/anime//rating", "", body))
 
 	rr := httptest.NewRecorder()
-	RateAnime(&stubSocialClient{}).ServeHTTP(rr, req)
+	RateAnime(&stubSocialClient{}, nil).ServeHTTP(rr, req)
 
 	if rr.Code != http.StatusBadRequest {
 		t.Fatalf("expected 400, got %d", rr.Code)
@@ -162,7 +162,7 @@ func TestRateAnime_Unauthenticated(t *testing.T) {
 	// No auth injected
 
 	rr := httptest.NewRecorder()
-	RateAnime(&stubSocialClient{}).ServeHTTP(rr, req)
+	RateAnime(&stubSocialClient{}, nil).ServeHTTP(rr, req)
 
 	if rr.Code != http.StatusUnauthorized {
 		t.Fatalf("expected 401, got %d", rr.Code)
@@ -173,7 +173,7 @@ func TestRateAnime_InvalidBody(t *testing.T) {
 	req := asAuthUser(ratingReq(http.MethodPost, "/v1/anime/anime-1/rating", "anime-1", []byte("not json")))
 
 	rr := httptest.NewRecorder()
-	RateAnime(&stubSocialClient{}).ServeHTTP(rr, req)
+	RateAnime(&stubSocialClient{}, nil).ServeHTTP(rr, req)
 
 	if rr.Code != http.StatusBadRequest {
 		t.Fatalf("expected 400 for invalid JSON, got %d", rr.Code)
@@ -188,7 +188,7 @@ func TestRateAnime_GRPCValidationError(t *testing.T) {
 	req := asAuthUser(ratingReq(http.MethodPost, "/v1/anime/anime-1/rating", "anime-1", body))
 
 	rr := httptest.NewRecorder()
-	RateAnime(stub).ServeHTTP(rr, req)
+	RateAnime(stub, nil).ServeHTTP(rr, req)
 
 	if rr.Code != http.StatusBadRequest {
 		t.Fatalf("expected 400, got %d", rr.Code)
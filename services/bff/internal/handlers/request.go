@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/example/anime-platform/internal/platform/api"
+	"github.com/example/anime-platform/internal/platform/locale"
 )
 
 const maxRequestBodyBytes = 1 << 20 // 1 MiB
@@ -18,3 +19,10 @@ func decodeJSON[T any](w http.ResponseWriter, r *http.Request, rid string, dst *
 	}
 	return true
 }
+
+// requestLocale returns the preferred locale from Accept-Language and marks the
+// response as varying on it for shared caches.
+func requestLocale(w http.ResponseWriter, r *http.Request) string {
+	w.Header().Add("Vary", "Accept-Language")
+	return locale.FromAcceptLanguage(r.Header.Get("Accept-Language"))
+}
@@ -24,10 +24,13 @@ func Search(search searchv1.SearchServiceClient, cache Cache, fallback JikanFall
 		limit := parseInt32(r.URL.Query().Get("limit"), 25, 1, 100)
 		offset := parseInt32(r.URL.Query().Get("offset"), 0, 0, 10000)
 		genres := splitList(r.URL.Query().Get("genres"))
+		themes := splitList(r.URL.Query().Get("themes"))
+		demographics := splitList(r.URL.Query().Get("demographics"))
 		status := strings.TrimSpace(r.URL.Query().Get("status"))
 		animeType := strings.TrimSpace(r.URL.Query().Get("type"))
 		minScore := parseFloat32(r.URL.Query().Get("min_score"), 0)
 		maxScore := parseFloat32(r.URL.Query().Get("max_score"), 0)
+		sort := strings.TrimSpace(r.URL.Query().Get("sort"))
 
 		// Cache key based on raw query
 		key := "Search:" + r.URL.RawQuery
@@ -37,7 +40,7 @@ func Search(search searchv1.SearchServiceClient, cache Cache, fallback JikanFall
 		}
 
 		ctx := metadata.NewOutgoingContext(r.Context(), metadata.New(nil))
-		resp, err := search.SearchAnime(ctx, &searchv1.SearchAnimeRequest{Query: q, Limit: limit, Offset: offset, Genres: genres, Status: status, Type: animeType, MinScore: minScore, MaxScore: maxScore})
+		resp, err := search.SearchAnime(ctx, &searchv1.SearchAnimeRequest{Query: q, Limit: limit, Offset: offset, Genres: genres, Themes: themes, Demographics: demographics, Status: status, Type: animeType, MinScore: minScore, MaxScore: maxScore, Sort: sort})
 		if err != nil {
 			writeGRPCError(w, rid, err)
 			return
@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	catalogv1 "github.com/example/anime-platform/gen/catalog/v1"
+	"github.com/example/anime-platform/internal/platform/api"
+	"github.com/example/anime-platform/internal/platform/auth"
+	"github.com/example/anime-platform/internal/platform/httpserver"
+)
+
+type skipSegmentResponse struct {
+	ID        string  `json:"id"`
+	Kind      string  `json:"kind"`
+	Start     float32 `json:"start"`
+	End       float32 `json:"end"`
+	Source    string  `json:"source"`
+	Score     int32   `json:"score"`
+	CreatedAt string  `json:"created_at"`
+}
+
+type submitSkipSegmentReq struct {
+	Kind  string  `json:"kind"`
+	Start float32 `json:"start"`
+	End   float32 `json:"end"`
+}
+
+func skipSegmentFromProto(g *catalogv1.SkipSegment) skipSegmentResponse {
+	return skipSegmentResponse{
+		ID:        g.GetId(),
+		Kind:      g.GetKind(),
+		Start:     g.GetStart(),
+		End:       g.GetEnd(),
+		Source:    g.GetSource(),
+		Score:     g.GetScore(),
+		CreatedAt: g.GetCreatedAtRfc3339(),
+	}
+}
+
+// ListSkipSegments returns an episode's stored intro/outro/recap/preview
+// segments: provider segments first, then community submissions by score.
+func ListSkipSegments(catalog catalogv1.CatalogServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rid := httpserver.RequestIDFromContext(r.Context())
+
+		episodeID := strings.TrimSpace(chi.URLParam(r, "episode_id"))
+		if episodeID == "" {
+			api.BadRequest(w, "MISSING_ID", "episode_id is required", rid, nil)
+			return
+		}
+
+		resp, err := catalog.ListSkipSegments(r.Context(), &catalogv1.ListSkipSegmentsRequest{EpisodeId: episodeID})
+		if err != nil {
+			writeGRPCError(w, rid, err)
+			return
+		}
+		segments := make([]skipSegmentResponse, 0, len(resp.GetSegments()))
+		for _, g := range resp.GetSegments() {
+			segments = append(segments, skipSegmentFromProto(g))
+		}
+		api.WriteJSON(w, http.StatusOK, map[string]any{"episode_id": episodeID, "segments": segments})
+	}
+}
+
+// SubmitSkipSegment stores the caller's timestamps for one segment kind,
+// replacing their earlier submission of that kind.
+func SubmitSkipSegment(catalog catalogv1.CatalogServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rid := httpserver.RequestIDFromContext(r.Context())
+		uid, ok := auth.UserIDFromContext(r.Context())
+		if !ok || strings.TrimSpace(uid) == "" {
+			api.Unauthorized(w, "AUTH_MISSING", "Missing auth", rid)
+			return
+		}
+
+		episodeID := strings.TrimSpace(chi.URLParam(r, "episode_id"))
+		if episodeID == "" {
+			api.BadRequest(w, "MISSING_ID", "episode_id is required", rid, nil)
+			return
+		}
+
+		var req submitSkipSegmentReq
+		if !decodeJSON(w, r, rid, &req) {
+			return
+		}
+
+		resp, err := catalog.SubmitSkipSegment(r.Context(), &catalogv1.SubmitSkipSegmentRequest{
+			EpisodeId: episodeID,
+			UserId:    uid,
+			Segment:   &catalogv1.SkipSegmentInput{Kind: req.Kind, Start: req.Start, End: req.End},
+		})
+		if err != nil {
+			writeGRPCError(w, rid, err)
+			return
+		}
+		api.WriteJSON(w, http.StatusCreated, skipSegmentFromProto(resp.GetSegment()))
+	}
+}
+
+// VoteSkipSegment up- or downvotes a community segment ({"vote": 1 | -1}).
+func VoteSkipSegment(catalog catalogv1.CatalogServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rid := httpserver.RequestIDFromContext(r.Context())
+		uid, ok := auth.UserIDFromContext(r.Context())
+		if !ok || strings.TrimSpace(uid) == "" {
+			api.Unauthorized(w, "AUTH_MISSING", "Missing auth", rid)
+			return
+		}
+
+		segmentID := strings.TrimSpace(chi.URLParam(r, "segment_id"))
+		if segmentID == "" {
+			api.BadRequest(w, "MISSING_ID", "segment_id is required", rid, nil)
+			return
+		}
+
+		var req voteReq
+		if !decodeJSON(w, r, rid, &req) {
+			return
+		}
+
+		resp, err := catalog.VoteSkipSegment(r.Context(), &catalogv1.VoteSkipSegmentRequest{
+			SegmentId: segmentID,
+			UserId:    uid,
+			Vote:      req.Vote,
+		})
+		if err != nil {
+			writeGRPCError(w, rid, err)
+			return
+		}
+		api.WriteJSON(w, http.StatusOK, skipSegmentFromProto(resp.GetSegment()))
+	}
+}
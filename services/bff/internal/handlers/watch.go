@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
@@ -21,6 +22,9 @@ type watchResponse struct {
 	Tracks            []*streamingv1.PlaybackTrack    `json:"tracks"`
 	Intro             *streamingv1.PlaybackIntroOutro `json:"intro,omitempty"`
 	Outro             *streamingv1.PlaybackIntroOutro `json:"outro,omitempty"`
+	Recap             *streamingv1.PlaybackIntroOutro `json:"recap,omitempty"`
+	Preview           *streamingv1.PlaybackIntroOutro `json:"preview,omitempty"`
+	Provider          string                          `json:"provider,omitempty"`
 	SignedPlaybackURL string                          `json:"signed_playback_url"`
 }
 
@@ -41,6 +45,14 @@ func Watch(client streamingv1.StreamingResolverServiceClient, hlsBase, hlsSecret
 		resp, err := client.GetPlayback(ctx, &streamingv1.GetPlaybackRequest{EpisodeId: episodeID, Category: category, Server: server})
 		if err != nil {
 			st, ok := status.FromError(err)
+			switch {
+			case ok && st.Code() == codes.PermissionDenied:
+				api.WriteError(w, http.StatusUnavailableForLegalReasons, "REGION_BLOCKED", st.Message(), rid, nil)
+				return
+			case ok && st.Code() == codes.NotFound:
+				api.NotFound(w, "NOT_FOUND", st.Message(), rid)
+				return
+			}
 			if ok && st.Message() != "" {
 				api.WriteError(w, http.StatusBadGateway, "STREAMING_UNAVAILABLE", st.Message(), rid, nil)
 				return
@@ -74,6 +86,6 @@ func Watch(client streamingv1.StreamingResolverServiceClient, hlsBase, hlsSecret
 			"episode_id": episodeID,
 			"category":   category,
 		})
-		api.WriteJSON(w, http.StatusOK, watchResponse{Sources: resp.GetSources(), Tracks: resp.GetTracks(), Intro: resp.GetIntro(), Outro: resp.GetOutro(), SignedPlaybackURL: url})
+		api.WriteJSON(w, http.StatusOK, watchResponse{Sources: resp.GetSources(), Tracks: resp.GetTracks(), Intro: resp.GetIntro(), Outro: resp.GetOutro(), Recap: resp.GetRecap(), Preview: resp.GetPreview(), Provider: resp.GetProvider(), SignedPlaybackURL: url})
 	}
 }
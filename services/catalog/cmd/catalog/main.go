@@ -2,24 +2,33 @@ package main
 
 import (
 	"context"
+	"errors"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	"github.com/go-chi/chi/v5"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
 	catalogv1 "github.com/example/anime-platform/gen/catalog/v1"
+	"github.com/example/anime-platform/internal/platform/blob"
 	"github.com/example/anime-platform/internal/platform/db"
+	"github.com/example/anime-platform/internal/platform/httpserver"
 	"github.com/example/anime-platform/internal/platform/logging"
+	"github.com/example/anime-platform/internal/platform/metrics"
 	"github.com/example/anime-platform/internal/platform/natsconn"
 	"github.com/example/anime-platform/internal/platform/run"
+	"github.com/example/anime-platform/internal/platform/signing"
 	catalogconfig "github.com/example/anime-platform/services/catalog/internal/config"
 	grpcapi "github.com/example/anime-platform/services/catalog/internal/grpc"
+	"github.com/example/anime-platform/services/catalog/internal/images"
 	"github.com/example/anime-platform/services/catalog/internal/outbox"
+	"github.com/example/anime-platform/services/catalog/internal/popularity"
 	catalogstore "github.com/example/anime-platform/services/catalog/internal/store"
 )
 
@@ -46,7 +55,19 @@ func main() {
 	defer pool.Close()
 
 	grpcCfg := catalogconfig.LoadGRPC()
-	outboxCfg := catalogconfig.LoadOutbox()
+	outboxCfg, err := catalogconfig.LoadOutbox()
+	if err != nil {
+		log.Error("outbox config", zap.Error(err))
+		run.Exit(1)
+	}
+	httpCfg := catalogconfig.LoadHTTP()
+	imageCfg := catalogconfig.LoadImage()
+
+	blobs, err := blob.Open(imageCfg.Blob)
+	if err != nil {
+		log.Error("blob store", zap.Error(err))
+		run.Exit(1)
+	}
 
 	lis, err := net.Listen("tcp", grpcCfg.Addr)
 	if err != nil {
@@ -54,9 +75,10 @@ func main() {
 		run.Exit(1)
 	}
 
+	store := catalogstore.NewPostgresCatalogStore(pool)
 	grpcSrv := grpc.NewServer()
 	catalogv1.RegisterCatalogServiceServer(grpcSrv, &grpcapi.CatalogService{
-		Store: catalogstore.NewPostgresCatalogStore(pool),
+		Store: store,
 	})
 	reflection.Register(grpcSrv)
 
@@ -67,7 +89,7 @@ func main() {
 	}
 	defer nc.Close()
 
-	publisher, err := outbox.NewPublisher(log, pool, nc)
+	publisher, err := outbox.NewPublisher(log, pool, nc, outboxCfg.Retention)
 	if err != nil {
 		log.Error("outbox publisher", zap.Error(err))
 		run.Exit(1)
@@ -89,6 +111,25 @@ func main() {
 		}
 	}()
 
+	router := chi.NewRouter()
+	httpserver.SetupRouter(router, httpserver.RouterConfig{Logger: log, ReadyFunc: func() error { return pool.Ping(context.Background()) }})
+	router.Handle("/metrics", metrics.Handler())
+	if imageCfg.SigningSecret != "" {
+		router.Handle("/images/*", images.Handler(log, "/images", signing.New(imageCfg.SigningSecret), blobs))
+	} else {
+		log.Warn("IMAGE_SIGNING_SECRET not set; /images endpoint disabled")
+	}
+	httpSrv := httpserver.New(httpserver.Options{Addr: httpCfg.Addr, ServiceName: cfgService, Logger: log, Router: router})
+	go func() {
+		if err := httpSrv.Start(log); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("http serve", zap.Error(err))
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		_ = httpSrv.Shutdown(context.Background())
+	}()
+
 	go func() {
 		if err := publisher.Run(ctx); err != nil {
 			log.Error("outbox publisher stopped", zap.Error(err))
@@ -96,6 +137,20 @@ func main() {
 		}
 	}()
 
+	go func() {
+		if err := popularity.NewConsumer(log, store, nc).Run(ctx); err != nil {
+			log.Error("popularity consumer stopped", zap.Error(err))
+		}
+	}()
+
+	if imageCfg.MirrorEnabled {
+		go func() {
+			if err := images.NewWorker(log, store, blobs).Run(ctx); err != nil {
+				log.Error("image mirror stopped", zap.Error(err))
+			}
+		}()
+	}
+
 	log.Info("grpc server starting", zap.String("addr", grpcCfg.Addr))
 
 	if err := grpcSrv.Serve(lis); err != nil {
@@ -0,0 +1,19 @@
+package config
+
+import (
+	"os"
+	"strings"
+)
+
+// HTTPConfig is the catalog's side HTTP listener for health checks and /metrics.
+type HTTPConfig struct {
+	Addr string
+}
+
+func LoadHTTP() HTTPConfig {
+	addr := strings.TrimSpace(os.Getenv("HTTP_ADDR"))
+	if addr == "" {
+		addr = ":8082"
+	}
+	return HTTPConfig{Addr: addr}
+}
@@ -0,0 +1,30 @@
+package config
+
+import (
+	"os"
+	"strings"
+
+	"github.com/example/anime-platform/internal/platform/blob"
+)
+
+// ImageConfig controls cover image mirroring and the signed /images endpoint.
+type ImageConfig struct {
+	// MirrorEnabled runs the worker that downloads covers into blob storage.
+	MirrorEnabled bool
+	// SigningSecret verifies /images URLs signed by the BFF; the endpoint is
+	// not mounted when it is empty.
+	SigningSecret string
+	Blob          blob.Config
+}
+
+func LoadImage() ImageConfig {
+	enabled := true
+	if v := strings.TrimSpace(os.Getenv("IMAGE_MIRROR_ENABLED")); v == "false" || v == "0" {
+		enabled = false
+	}
+	return ImageConfig{
+		MirrorEnabled: enabled,
+		SigningSecret: strings.TrimSpace(os.Getenv("IMAGE_SIGNING_SECRET")),
+		Blob:          blob.LoadConfig(),
+	}
+}
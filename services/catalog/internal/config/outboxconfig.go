@@ -1,18 +1,31 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"strings"
+	"time"
 )
 
 type OutboxConfig struct {
 	NATSURL string
+	// Retention is how long published outbox rows stay replayable through
+	// WatchCatalogChanges. Zero keeps them forever.
+	Retention time.Duration
 }
 
-func LoadOutbox() OutboxConfig {
+func LoadOutbox() (OutboxConfig, error) {
 	url := strings.TrimSpace(os.Getenv("NATS_URL"))
 	if url == "" {
 		url = "nats://nats:4222"
 	}
-	return OutboxConfig{NATSURL: url}
+	retention := 7 * 24 * time.Hour
+	if v := strings.TrimSpace(os.Getenv("OUTBOX_RETENTION")); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			return OutboxConfig{}, fmt.Errorf("OUTBOX_RETENTION: %q is not a non-negative duration", v)
+		}
+		retention = d
+	}
+	return OutboxConfig{NATSURL: url, Retention: retention}, nil
 }
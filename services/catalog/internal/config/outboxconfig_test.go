@@ -0,0 +1,20 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLoadOutbox_Retention(t *testing.T) {
+	t.Setenv("OUTBOX_RETENTION", "48h")
+	cfg, err := LoadOutbox()
+	if err != nil || cfg.Retention != 48*time.Hour {
+		t.Fatalf("cfg = %+v, err = %v", cfg, err)
+	}
+	for _, bad := range []string{"7d", "-1h"} {
+		t.Setenv("OUTBOX_RETENTION", bad)
+		if _, err := LoadOutbox(); err == nil {
+			t.Fatalf("OUTBOX_RETENTION=%q was accepted", bad)
+		}
+	}
+}
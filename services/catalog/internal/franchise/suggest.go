@@ -0,0 +1,197 @@
+// Package franchise derives franchise suggestions from the anime relations graph.
+package franchise
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/example/anime-platform/services/catalog/internal/store"
+)
+
+// groupingRelations are the relation kinds that put two anime in the same
+// franchise. "character" and "other" link unrelated works too loosely.
+var groupingRelations = map[string]bool{
+	"sequel":              true,
+	"prequel":             true,
+	"parent_story":        true,
+	"side_story":          true,
+	"spin-off":            true,
+	"summary":             true,
+	"full_story":          true,
+	"alternative_version": true,
+	"alternative_setting": true,
+}
+
+// Suggestion is a connected group of related anime in watch order.
+// FranchiseIDs lists existing franchises that already hold some of them.
+type Suggestion struct {
+	Title        string
+	AnimeIDs     []string
+	FranchiseIDs []string
+}
+
+// Suggest groups the graph into connected components of at least two anime and
+// orders each one. Components already contained in full by a single franchise
+// are skipped. Larger groups come first; at most limit are returned (all when
+// limit <= 0).
+func Suggest(g store.RelationGraph, limit int) []Suggestion {
+	parent := map[string]string{}
+	var find func(string) string
+	find = func(x string) string {
+		p, ok := parent[x]
+		if !ok || p == x {
+			parent[x] = x
+			return x
+		}
+		root := find(p)
+		parent[x] = root
+		return root
+	}
+
+	var edges []store.RelationEdge
+	for _, e := range g.Edges {
+		if !groupingRelations[e.Relation] {
+			continue
+		}
+		edges = append(edges, e)
+		if a, b := find(e.AnimeID), find(e.RelatedAnimeID); a != b {
+			parent[a] = b
+		}
+	}
+
+	components := map[string][]string{}
+	for id := range parent {
+		root := find(id)
+		components[root] = append(components[root], id)
+	}
+
+	var out []Suggestion
+	for root, members := range components {
+		if len(members) < 2 {
+			continue
+		}
+		franchises := memberFranchises(g.Memberships, members)
+		if coveredByOne(g.Memberships, members, franchises) {
+			continue
+		}
+		var own []store.RelationEdge
+		for _, e := range edges {
+			if find(e.AnimeID) == root {
+				own = append(own, e)
+			}
+		}
+		ordered := Order(members, own, g.Titles)
+		out = append(out, Suggestion{Title: g.Titles[ordered[0]], AnimeIDs: ordered, FranchiseIDs: franchises})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if len(out[i].AnimeIDs) != len(out[j].AnimeIDs) {
+			return len(out[i].AnimeIDs) > len(out[j].AnimeIDs)
+		}
+		return out[i].Title < out[j].Title
+	})
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
+
+func memberFranchises(memberships map[string][]string, members []string) []string {
+	seen := map[string]bool{}
+	var out []string
+	for _, id := range members {
+		for _, f := range memberships[id] {
+			if !seen[f] {
+				seen[f] = true
+				out = append(out, f)
+			}
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+func coveredByOne(memberships map[string][]string, members, franchises []string) bool {
+	for _, f := range franchises {
+		all := true
+		for _, id := range members {
+			if !contains(memberships[id], f) {
+				all = false
+				break
+			}
+		}
+		if all {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(list []string, v string) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Order sorts ids so that prequels and parent stories come before the entries
+// that follow them. Unconstrained entries and cycles fall back to title order.
+func Order(ids []string, edges []store.RelationEdge, titles map[string]string) []string {
+	in := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		in[id] = true
+	}
+	after := map[string]map[string]bool{} // before -> set of entries that follow it
+	indegree := map[string]int{}
+	addOrder := func(before, next string) {
+		if before == next || !in[before] || !in[next] {
+			return
+		}
+		if after[before] == nil {
+			after[before] = map[string]bool{}
+		}
+		if after[before][next] {
+			return
+		}
+		after[before][next] = true
+		indegree[next]++
+	}
+	for _, e := range edges {
+		switch e.Relation {
+		case "sequel", "side_story", "spin-off", "summary":
+			addOrder(e.AnimeID, e.RelatedAnimeID)
+		case "prequel", "parent_story", "full_story":
+			addOrder(e.RelatedAnimeID, e.AnimeID)
+		}
+	}
+
+	less := func(a, b string) bool {
+		ta, tb := strings.ToLower(titles[a]), strings.ToLower(titles[b])
+		if ta != tb {
+			return ta < tb
+		}
+		return a < b
+	}
+	remaining := append([]string(nil), ids...)
+	out := make([]string, 0, len(ids))
+	for len(remaining) > 0 {
+		// Pick the first ready entry by title; on a cycle, the one with the
+		// fewest unmet predecessors.
+		best := -1
+		for i, id := range remaining {
+			if best < 0 || indegree[id] < indegree[remaining[best]] ||
+				(indegree[id] == indegree[remaining[best]] && less(id, remaining[best])) {
+				best = i
+			}
+		}
+		id := remaining[best]
+		remaining = append(remaining[:best], remaining[best+1:]...)
+		out = append(out, id)
+		for next := range after[id] {
+			indegree[next]--
+		}
+		delete(after, id)
+	}
+	return out
+}
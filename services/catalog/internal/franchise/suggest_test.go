@@ -0,0 +1,72 @@
+package franchise
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/example/anime-platform/services/catalog/internal/store"
+)
+
+var titles = map[string]string{
+	"bake":  "Bakemonogatari",
+	"nise":  "Nisemonogatari",
+	"neko":  "Nekomonogatari: Kuro",
+	"kizu":  "Kizumonogatari",
+	"other": "Steins;Gate",
+	"og":    "Steins;Gate 0",
+	"char":  "Unrelated",
+}
+
+func TestSuggest_GroupsAndOrders(t *testing.T) {
+	g := store.RelationGraph{
+		Titles: titles,
+		Edges: []store.RelationEdge{
+			{AnimeID: "bake", RelatedAnimeID: "nise", Relation: "sequel"},
+			{AnimeID: "nise", RelatedAnimeID: "bake", Relation: "prequel"},
+			{AnimeID: "nise", RelatedAnimeID: "neko", Relation: "sequel"},
+			{AnimeID: "bake", RelatedAnimeID: "kizu", Relation: "prequel"},
+			{AnimeID: "other", RelatedAnimeID: "og", Relation: "alternative_setting"},
+			{AnimeID: "bake", RelatedAnimeID: "char", Relation: "character"},
+		},
+		Memberships: map[string][]string{},
+	}
+	got := Suggest(g, 0)
+	if len(got) != 2 {
+		t.Fatalf("expected 2 suggestions, got %+v", got)
+	}
+	if want := []string{"kizu", "bake", "nise", "neko"}; !reflect.DeepEqual(got[0].AnimeIDs, want) {
+		t.Fatalf("order = %v, want %v", got[0].AnimeIDs, want)
+	}
+	if got[0].Title != "Kizumonogatari" {
+		t.Fatalf("title = %q", got[0].Title)
+	}
+	if want := []string{"other", "og"}; !reflect.DeepEqual(got[1].AnimeIDs, want) {
+		t.Fatalf("unconstrained order = %v, want title order %v", got[1].AnimeIDs, want)
+	}
+}
+
+func TestSuggest_SkipsCoveredComponents(t *testing.T) {
+	g := store.RelationGraph{
+		Titles: titles,
+		Edges: []store.RelationEdge{
+			{AnimeID: "bake", RelatedAnimeID: "nise", Relation: "sequel"},
+			{AnimeID: "other", RelatedAnimeID: "og", Relation: "sequel"},
+		},
+		Memberships: map[string][]string{"bake": {"f1"}, "nise": {"f1"}, "other": {"f2"}},
+	}
+	got := Suggest(g, 10)
+	if len(got) != 1 || !reflect.DeepEqual(got[0].FranchiseIDs, []string{"f2"}) {
+		t.Fatalf("expected only the partially covered group, got %+v", got)
+	}
+}
+
+func TestOrder_BreaksCycles(t *testing.T) {
+	edges := []store.RelationEdge{
+		{AnimeID: "bake", RelatedAnimeID: "nise", Relation: "sequel"},
+		{AnimeID: "nise", RelatedAnimeID: "bake", Relation: "sequel"},
+	}
+	got := Order([]string{"nise", "bake"}, edges, titles)
+	if want := []string{"bake", "nise"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("order = %v, want %v", got, want)
+	}
+}
@@ -9,28 +9,72 @@ import (
 	"google.golang.org/grpc/status"
 
 	catalogv1 "github.com/example/anime-platform/gen/catalog/v1"
+	"github.com/example/anime-platform/internal/platform/geo"
+	"github.com/example/anime-platform/internal/platform/locale"
+	"github.com/example/anime-platform/internal/platform/taxonomy"
 	"github.com/example/anime-platform/services/catalog/internal/store"
 )
 
 type CatalogService struct {
 	catalogv1.UnimplementedCatalogServiceServer
 	Store store.CatalogStore
+	// ChangePollInterval is how often WatchCatalogChanges polls for new changes
+	// once it has caught up. Zero means one second.
+	ChangePollInterval time.Duration
 }
 
+const (
+	changeBatchSize         = 500
+	changeSnapshotCompleted = "catalog.snapshot.completed"
+)
+
 func (s *CatalogService) GetEpisodesByAnimeID(ctx context.Context, req *catalogv1.GetEpisodesByAnimeIDRequest) (*catalogv1.GetEpisodesByAnimeIDResponse, error) {
 	animeID := strings.TrimSpace(req.GetAnimeId())
 	if animeID == "" {
 		return nil, status.Error(codes.InvalidArgument, "anime_id is required")
 	}
-	eps, err := s.Store.GetEpisodesByAnimeID(ctx, animeID)
+	eps, err := s.Store.GetEpisodesByAnimeID(ctx, animeID, geo.FromIncoming(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp := &catalogv1.GetEpisodesByAnimeIDResponse{Episodes: episodesToProto(eps)}
+	if provider := strings.TrimSpace(req.GetProvider()); provider != "" {
+		states, err := s.Store.ListProviderEpisodes(ctx, provider, animeID)
+		if err != nil {
+			return nil, err
+		}
+		resp.ProviderEpisodes = make([]*catalogv1.ProviderEpisode, 0, len(states))
+		for _, p := range states {
+			resp.ProviderEpisodes = append(resp.ProviderEpisodes, &catalogv1.ProviderEpisode{
+				ProviderEpisodeId: p.ProviderEpisodeID,
+				Number:            p.Number,
+				Title:             p.Title,
+				IsFiller:          p.IsFiller,
+				HasSub:            p.HasSub,
+				HasDub:            p.HasDub,
+				EpisodeId:         p.EpisodeID,
+			})
+		}
+	}
+	return resp, nil
+}
+
+// maxEpisodesAnimeIDs bounds GetEpisodesByAnimeIDs to one franchise's worth of anime.
+const maxEpisodesAnimeIDs = maxFranchiseEntries
+
+func (s *CatalogService) GetEpisodesByAnimeIDs(ctx context.Context, req *catalogv1.GetEpisodesByAnimeIDsRequest) (*catalogv1.GetEpisodesByAnimeIDsResponse, error) {
+	if len(req.GetAnimeIds()) > maxEpisodesAnimeIDs {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d anime_ids", maxEpisodesAnimeIDs)
+	}
+	eps, err := s.Store.GetEpisodesByAnimeIDs(ctx, req.GetAnimeIds(), geo.FromIncoming(ctx))
 	if err != nil {
 		return nil, err
 	}
-	return &catalogv1.GetEpisodesByAnimeIDResponse{Episodes: episodesToProto(eps)}, nil
+	return &catalogv1.GetEpisodesByAnimeIDsResponse{Episodes: episodesToProto(eps)}, nil
 }
 
 func (s *CatalogService) GetEpisodesByIDs(ctx context.Context, req *catalogv1.GetEpisodesByIDsRequest) (*catalogv1.GetEpisodesByIDsResponse, error) {
-	eps, err := s.Store.GetEpisodesByIDs(ctx, req.GetEpisodeIds())
+	eps, err := s.Store.GetEpisodesByIDs(ctx, req.GetEpisodeIds(), geo.FromIncoming(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -50,6 +94,27 @@ func (s *CatalogService) GetProviderEpisodeID(ctx context.Context, req *catalogv
 	return &catalogv1.GetProviderEpisodeIDResponse{ProviderEpisodeId: id}, nil
 }
 
+func (s *CatalogService) ListEpisodeProviders(ctx context.Context, req *catalogv1.ListEpisodeProvidersRequest) (*catalogv1.ListEpisodeProvidersResponse, error) {
+	epID := strings.TrimSpace(req.GetEpisodeId())
+	if epID == "" {
+		return nil, status.Error(codes.InvalidArgument, "episode_id is required")
+	}
+	providers, err := s.Store.ListEpisodeProviders(ctx, epID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &catalogv1.ListEpisodeProvidersResponse{}
+	for _, p := range providers {
+		resp.Providers = append(resp.Providers, &catalogv1.EpisodeProvider{
+			Provider:          p.Provider,
+			ProviderEpisodeId: p.ProviderEpisodeID,
+			HasSub:            p.HasSub,
+			HasDub:            p.HasDub,
+		})
+	}
+	return resp, nil
+}
+
 func (s *CatalogService) GetAnimeIDs(ctx context.Context, _ *catalogv1.GetAnimeIDsRequest) (*catalogv1.GetAnimeIDsResponse, error) {
 	ids, err := s.Store.GetAllAnimeIDs(ctx)
 	if err != nil {
@@ -59,24 +124,37 @@ func (s *CatalogService) GetAnimeIDs(ctx context.Context, _ *catalogv1.GetAnimeI
 }
 
 func (s *CatalogService) GetAnimeByIDs(ctx context.Context, req *catalogv1.GetAnimeByIDsRequest) (*catalogv1.GetAnimeByIDsResponse, error) {
-	animes, err := s.Store.GetAnimeByIDs(ctx, req.GetAnimeIds())
+	var locales []string
+	if strings.TrimSpace(req.GetLocale()) != "" {
+		locales = locale.FallbackChain(req.GetLocale())
+	}
+	animes, err := s.Store.GetAnimeByIDs(ctx, req.GetAnimeIds(), geo.FromIncoming(ctx), locales)
 	if err != nil {
 		return nil, err
 	}
 	resp := &catalogv1.GetAnimeByIDsResponse{}
 	for _, a := range animes {
 		resp.Anime = append(resp.Anime, &catalogv1.Anime{
-			Id:            a.ID,
-			Title:         a.Title,
-			TitleEnglish:  a.TitleEnglish,
-			TitleJapanese: a.TitleJapanese,
-			Image:         a.Image,
-			Description:   a.Description,
-			Genres:        a.Genres,
-			Score:         a.Score,
-			Status:        a.Status,
-			Type:          a.Type,
-			TotalEpisodes: a.TotalEpisodes,
+			Id:             a.ID,
+			Title:          a.Title,
+			TitleEnglish:   a.TitleEnglish,
+			TitleJapanese:  a.TitleJapanese,
+			Image:          a.Image,
+			Description:    a.Description,
+			Genres:         a.Genres,
+			Score:          a.Score,
+			Status:         a.Status,
+			Type:           a.Type,
+			TotalEpisodes:  a.TotalEpisodes,
+			Locale:         a.Locale,
+			GenreTags:      genresToProto(a.GenreTags),
+			Themes:         genresToProto(a.Themes),
+			Demographics:   genresToProto(a.Demographics),
+			ImageVariants:  imageVariantsToProto(a.ImageVariants),
+			Popularity:     popularityToProto(a.Popularity),
+			BannerImage:    a.BannerImage,
+			ProviderScores: providerScoresToProto(a.ProviderScores),
+			Tags:           tagsToProto(a.Tags),
 		})
 	}
 	return resp, nil
@@ -108,6 +186,42 @@ func (s *CatalogService) ResolveAnimeIDByExternalID(ctx context.Context, req *ca
 	return &catalogv1.ResolveAnimeIDByExternalIDResponse{AnimeId: id}, nil
 }
 
+// maxProviderTags bounds the tags stored per anime and provider.
+const maxProviderTags = 200
+
+func (s *CatalogService) UpsertProviderMetadata(ctx context.Context, req *catalogv1.UpsertProviderMetadataRequest) (*catalogv1.UpsertProviderMetadataResponse, error) {
+	provider := strings.TrimSpace(req.GetProvider())
+	externalID := strings.TrimSpace(req.GetExternalId())
+	if provider == "" || externalID == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider and external_id are required")
+	}
+	if req.GetScore() < 0 || req.GetScore() > 10 {
+		return nil, status.Error(codes.InvalidArgument, "score must be between 0 and 10")
+	}
+	if len(req.GetTags()) > maxProviderTags {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d tags", maxProviderTags)
+	}
+	m := store.ProviderMetadata{
+		Provider:    provider,
+		BannerImage: strings.TrimSpace(req.GetBannerImage()),
+		Score:       req.GetScore(),
+		ScoreCount:  max(req.GetScoreCount(), 0),
+		Tags:        make([]store.Tag, 0, len(req.GetTags())),
+	}
+	for _, t := range req.GetTags() {
+		name := strings.TrimSpace(t.GetName())
+		if name == "" {
+			continue
+		}
+		m.Tags = append(m.Tags, store.Tag{Name: name, Category: strings.TrimSpace(t.GetCategory()), Rank: t.GetRank(), Spoiler: t.GetSpoiler()})
+	}
+	animeID, err := s.Store.UpsertProviderMetadata(ctx, externalID, m)
+	if err != nil {
+		return nil, err
+	}
+	return &catalogv1.UpsertProviderMetadataResponse{AnimeId: animeID}, nil
+}
+
 func (s *CatalogService) UpsertHiAnimeEpisodes(ctx context.Context, req *catalogv1.UpsertHiAnimeEpisodesRequest) (*catalogv1.UpsertHiAnimeEpisodesResponse, error) {
 	animeID := strings.TrimSpace(req.GetAnimeId())
 	slug := strings.TrimSpace(req.GetHianimeSlug())
@@ -129,16 +243,105 @@ func (s *CatalogService) UpsertHiAnimeEpisodes(ctx context.Context, req *catalog
 			Title:             ep.GetTitle(),
 			IsFiller:          ep.GetIsFiller(),
 			HasIsFiller:       true,
+			// The legacy HiAnime payload only ever carried the subbed episode list.
+			HasSub: true,
 		})
 	}
 
-	ids, err := s.Store.UpsertHiAnimeEpisodes(ctx, animeID, slug, episodes)
+	ids, err := s.Store.UpsertProviderEpisodes(ctx, "hianime", animeID, slug, episodes)
 	if err != nil {
 		return nil, err
 	}
 	return &catalogv1.UpsertHiAnimeEpisodesResponse{EpisodeIds: ids}, nil
 }
 
+func (s *CatalogService) UpsertProviderEpisodes(ctx context.Context, req *catalogv1.UpsertProviderEpisodesRequest) (*catalogv1.UpsertProviderEpisodesResponse, error) {
+	provider := strings.TrimSpace(req.GetProvider())
+	animeID := strings.TrimSpace(req.GetAnimeId())
+	if provider == "" || animeID == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider and anime_id are required")
+	}
+
+	episodes := make([]store.EpisodeInput, 0, len(req.GetEpisodes()))
+	for _, ep := range req.GetEpisodes() {
+		if ep == nil {
+			continue
+		}
+		episodes = append(episodes, store.EpisodeInput{
+			ProviderEpisodeID: strings.TrimSpace(ep.GetProviderEpisodeId()),
+			Number:            ep.GetNumber(),
+			Title:             ep.GetTitle(),
+			IsFiller:          ep.GetIsFiller(),
+			HasIsFiller:       true,
+			HasSub:            ep.GetHasSub(),
+			HasDub:            ep.GetHasDub(),
+		})
+	}
+
+	ids, err := s.Store.UpsertProviderEpisodes(ctx, provider, animeID, req.GetProviderAnimeId(), episodes)
+	if err != nil {
+		return nil, err
+	}
+	return &catalogv1.UpsertProviderEpisodesResponse{EpisodeIds: ids}, nil
+}
+
+func (s *CatalogService) RemoveProviderEpisodes(ctx context.Context, req *catalogv1.RemoveProviderEpisodesRequest) (*catalogv1.RemoveProviderEpisodesResponse, error) {
+	provider := strings.TrimSpace(req.GetProvider())
+	animeID := strings.TrimSpace(req.GetAnimeId())
+	if provider == "" || animeID == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider and anime_id are required")
+	}
+	ids := make([]string, 0, len(req.GetProviderEpisodeIds()))
+	for _, id := range req.GetProviderEpisodeIds() {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+
+	removed, err := s.Store.RemoveProviderEpisodes(ctx, provider, animeID, ids)
+	if err != nil {
+		return nil, err
+	}
+	return &catalogv1.RemoveProviderEpisodesResponse{EpisodeIds: removed}, nil
+}
+
+func (s *CatalogService) UpsertJikanEpisodes(ctx context.Context, req *catalogv1.UpsertJikanEpisodesRequest) (*catalogv1.UpsertJikanEpisodesResponse, error) {
+	animeID := strings.TrimSpace(req.GetAnimeId())
+	if animeID == "" {
+		return nil, status.Error(codes.InvalidArgument, "anime_id is required")
+	}
+
+	episodes := make([]store.JikanEpisodeInput, 0, len(req.GetEpisodes()))
+	for _, ep := range req.GetEpisodes() {
+		if ep == nil || ep.GetNumber() <= 0 {
+			continue
+		}
+		in := store.JikanEpisodeInput{
+			Number:          ep.GetNumber(),
+			Title:           strings.TrimSpace(ep.GetTitle()),
+			IsFiller:        ep.GetIsFiller(),
+			IsRecap:         ep.GetIsRecap(),
+			DurationSeconds: ep.GetDurationSeconds(),
+			Synopsis:        strings.TrimSpace(ep.GetSynopsis()),
+			Thumbnail:       strings.TrimSpace(ep.GetThumbnail()),
+		}
+		if v := strings.TrimSpace(ep.GetAiredAtRfc3339()); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid aired_at_rfc3339 for episode %d", ep.GetNumber())
+			}
+			in.AiredAt = &t
+		}
+		episodes = append(episodes, in)
+	}
+
+	ids, err := s.Store.UpsertJikanEpisodes(ctx, animeID, episodes)
+	if err != nil {
+		return nil, err
+	}
+	return &catalogv1.UpsertJikanEpisodesResponse{EpisodeIds: ids}, nil
+}
+
 func (s *CatalogService) UpsertJikanAnime(ctx context.Context, req *catalogv1.UpsertJikanAnimeRequest) (*catalogv1.UpsertJikanAnimeResponse, error) {
 	anime := req.GetAnime()
 	if anime == nil {
@@ -160,6 +363,10 @@ func (s *CatalogService) UpsertJikanAnime(ctx context.Context, req *catalogv1.Up
 		Status:        anime.GetStatus(),
 		TotalEpisodes: anime.GetEpisodes(),
 		Score:         anime.GetScore(),
+		TypedGenres:   taxaFromProto(anime.GetTypedGenres()),
+		Themes:        taxaFromProto(anime.GetThemes()),
+		Demographics:  taxaFromProto(anime.GetDemographics()),
+		Relations:     relationsFromProto(anime.GetRelations()),
 	})
 	if err != nil {
 		return nil, err
@@ -167,12 +374,300 @@ func (s *CatalogService) UpsertJikanAnime(ctx context.Context, req *catalogv1.Up
 	return &catalogv1.UpsertJikanAnimeResponse{AnimeId: animeID}, nil
 }
 
+func (s *CatalogService) MergeAnime(ctx context.Context, req *catalogv1.MergeAnimeRequest) (*catalogv1.MergeAnimeResponse, error) {
+	sourceID := strings.TrimSpace(req.GetSourceAnimeId())
+	targetID := strings.TrimSpace(req.GetTargetAnimeId())
+	if sourceID == "" || targetID == "" {
+		return nil, status.Error(codes.InvalidArgument, "source_anime_id and target_anime_id are required")
+	}
+	if sourceID == targetID {
+		return nil, status.Error(codes.InvalidArgument, "source_anime_id and target_anime_id must differ")
+	}
+	res, err := s.Store.MergeAnime(ctx, sourceID, targetID)
+	if err != nil {
+		return nil, err
+	}
+	return &catalogv1.MergeAnimeResponse{
+		TargetAnimeId:    res.TargetAnimeID,
+		MovedEpisodes:    res.MovedEpisodes,
+		MergedEpisodes:   res.MergedEpisodes,
+		MovedExternalIds: res.MovedExternalIDs,
+	}, nil
+}
+
+func (s *CatalogService) SetAnimeAvailability(ctx context.Context, req *catalogv1.SetAnimeAvailabilityRequest) (*catalogv1.SetAnimeAvailabilityResponse, error) {
+	animeID := strings.TrimSpace(req.GetAnimeId())
+	if animeID == "" {
+		return nil, status.Error(codes.InvalidArgument, "anime_id is required")
+	}
+	a, err := availabilityFromProto(req.GetAvailability())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.SetAnimeAvailability(ctx, animeID, a); err != nil {
+		return nil, err
+	}
+	return &catalogv1.SetAnimeAvailabilityResponse{}, nil
+}
+
+func (s *CatalogService) SetEpisodeAvailability(ctx context.Context, req *catalogv1.SetEpisodeAvailabilityRequest) (*catalogv1.SetEpisodeAvailabilityResponse, error) {
+	epID := strings.TrimSpace(req.GetEpisodeId())
+	if epID == "" {
+		return nil, status.Error(codes.InvalidArgument, "episode_id is required")
+	}
+	a, err := availabilityFromProto(req.GetAvailability())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.SetEpisodeAvailability(ctx, epID, a); err != nil {
+		return nil, err
+	}
+	return &catalogv1.SetEpisodeAvailabilityResponse{}, nil
+}
+
+func (s *CatalogService) GetEpisodeAvailability(ctx context.Context, req *catalogv1.GetEpisodeAvailabilityRequest) (*catalogv1.GetEpisodeAvailabilityResponse, error) {
+	epID := strings.TrimSpace(req.GetEpisodeId())
+	if epID == "" {
+		return nil, status.Error(codes.InvalidArgument, "episode_id is required")
+	}
+	ok, reason, err := s.Store.GetEpisodeAvailability(ctx, epID, geo.FromIncoming(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &catalogv1.GetEpisodeAvailabilityResponse{Available: ok, Reason: reason}, nil
+}
+
+func (s *CatalogService) UpsertAnimeTranslation(ctx context.Context, req *catalogv1.UpsertAnimeTranslationRequest) (*catalogv1.UpsertAnimeTranslationResponse, error) {
+	animeID := strings.TrimSpace(req.GetAnimeId())
+	loc := locale.Normalize(req.GetLocale())
+	if animeID == "" || loc == "" {
+		return nil, status.Error(codes.InvalidArgument, "anime_id and a valid locale are required")
+	}
+	title := strings.TrimSpace(req.GetTitle())
+	synopsis := strings.TrimSpace(req.GetSynopsis())
+	if title == "" && synopsis == "" {
+		return nil, status.Error(codes.InvalidArgument, "title or synopsis is required")
+	}
+	if err := s.Store.UpsertAnimeTranslation(ctx, animeID, loc, title, synopsis); err != nil {
+		return nil, err
+	}
+	return &catalogv1.UpsertAnimeTranslationResponse{}, nil
+}
+
+func (s *CatalogService) ListGenres(ctx context.Context, req *catalogv1.ListGenresRequest) (*catalogv1.ListGenresResponse, error) {
+	kind := strings.ToLower(strings.TrimSpace(req.GetKind()))
+	if kind != "" && !taxonomy.ValidKind(kind) {
+		return nil, status.Error(codes.InvalidArgument, "kind must be genre, theme or demographic")
+	}
+	genres, err := s.Store.ListGenres(ctx, kind)
+	if err != nil {
+		return nil, err
+	}
+	resp := &catalogv1.ListGenresResponse{Genres: make([]*catalogv1.GenreCount, 0, len(genres))}
+	for _, g := range genres {
+		resp.Genres = append(resp.Genres, &catalogv1.GenreCount{
+			Slug:       g.Slug,
+			Name:       g.Name,
+			Kind:       g.Kind,
+			AnimeCount: g.AnimeCount,
+		})
+	}
+	return resp, nil
+}
+
+const (
+	defaultTrendingLimit = 25
+	maxTrendingLimit     = 1000
+)
+
+func (s *CatalogService) GetTrending(ctx context.Context, req *catalogv1.GetTrendingRequest) (*catalogv1.GetTrendingResponse, error) {
+	window := strings.ToLower(strings.TrimSpace(req.GetWindow()))
+	if window == "" {
+		window = store.TrendingWindow7d
+	}
+	if !store.ValidTrendingWindow(window) {
+		return nil, status.Error(codes.InvalidArgument, "window must be 24h, 7d or 30d")
+	}
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultTrendingLimit
+	}
+	if limit > maxTrendingLimit {
+		limit = maxTrendingLimit
+	}
+	items, err := s.Store.GetTrending(ctx, window, limit, geo.FromIncoming(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp := &catalogv1.GetTrendingResponse{Window: window, Anime: make([]*catalogv1.TrendingAnime, 0, len(items))}
+	for _, t := range items {
+		resp.Anime = append(resp.Anime, &catalogv1.TrendingAnime{AnimeId: t.AnimeID, Popularity: popularityToProto(t.Popularity)})
+	}
+	return resp, nil
+}
+
+const (
+	defaultHistoryLimit = 20
+	maxHistoryLimit     = 200
+)
+
+func (s *CatalogService) GetAnimeHistory(ctx context.Context, req *catalogv1.GetAnimeHistoryRequest) (*catalogv1.GetAnimeHistoryResponse, error) {
+	animeID := strings.TrimSpace(req.GetAnimeId())
+	if animeID == "" {
+		return nil, status.Error(codes.InvalidArgument, "anime_id is required")
+	}
+	q := store.HistoryQuery{Limit: int(req.GetLimit()), BeforeVersion: req.GetBeforeVersion()}
+	if q.Limit <= 0 {
+		q.Limit = defaultHistoryLimit
+	}
+	if q.Limit > maxHistoryLimit {
+		q.Limit = maxHistoryLimit
+	}
+	if q.BeforeVersion < 0 {
+		return nil, status.Error(codes.InvalidArgument, "before_version must not be negative")
+	}
+	if v := strings.TrimSpace(req.GetAsOfRfc3339()); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "as_of_rfc3339 must be RFC 3339")
+		}
+		q.AsOf = t
+	}
+
+	versions, err := s.Store.ListAnimeVersions(ctx, animeID, q)
+	if err != nil {
+		return nil, err
+	}
+	resp := &catalogv1.GetAnimeHistoryResponse{Versions: make([]*catalogv1.AnimeVersion, 0, len(versions))}
+	for _, v := range versions {
+		changes, err := store.DiffSnapshots(v.Previous, v.Data)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "decode history")
+		}
+		pv := &catalogv1.AnimeVersion{
+			Version:           v.Version,
+			RecordedAtRfc3339: v.RecordedAt.UTC().Format(time.RFC3339),
+			Reason:            v.Reason,
+			Changes:           fieldChangesToProto(changes),
+		}
+		if req.GetIncludeSnapshots() {
+			pv.SnapshotJson = string(v.Data)
+		}
+		resp.Versions = append(resp.Versions, pv)
+	}
+
+	if req.GetIncludeEpisodes() {
+		eps, err := s.Store.ListEpisodeVersions(ctx, animeID, store.HistoryQuery{Limit: q.Limit, AsOf: q.AsOf})
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range eps {
+			changes, err := store.DiffSnapshots(v.Previous, v.Data)
+			if err != nil {
+				return nil, status.Error(codes.Internal, "decode history")
+			}
+			pv := &catalogv1.EpisodeVersion{
+				EpisodeId:         v.EpisodeID,
+				Version:           v.Version,
+				RecordedAtRfc3339: v.RecordedAt.UTC().Format(time.RFC3339),
+				Reason:            v.Reason,
+				Changes:           fieldChangesToProto(changes),
+			}
+			if req.GetIncludeSnapshots() {
+				pv.SnapshotJson = string(v.Data)
+			}
+			resp.Episodes = append(resp.Episodes, pv)
+		}
+	}
+	return resp, nil
+}
+
+func fieldChangesToProto(changes []store.FieldChange) []*catalogv1.FieldChange {
+	out := make([]*catalogv1.FieldChange, 0, len(changes))
+	for _, c := range changes {
+		out = append(out, &catalogv1.FieldChange{Field: c.Field, OldJson: string(c.Old), NewJson: string(c.New)})
+	}
+	return out
+}
+
+func (s *CatalogService) RevertAnime(ctx context.Context, req *catalogv1.RevertAnimeRequest) (*catalogv1.RevertAnimeResponse, error) {
+	animeID := strings.TrimSpace(req.GetAnimeId())
+	if animeID == "" || req.GetVersion() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "anime_id and a positive version are required")
+	}
+	v, err := s.Store.RevertAnime(ctx, animeID, req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+	return &catalogv1.RevertAnimeResponse{Version: v}, nil
+}
+
+func (s *CatalogService) WatchCatalogChanges(req *catalogv1.WatchCatalogChangesRequest, stream catalogv1.CatalogService_WatchCatalogChangesServer) error {
+	ctx := stream.Context()
+	cursor, err := store.ParseChangeCursor(req.GetSinceCursor())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid since_cursor")
+	}
+
+	if req.GetSnapshot() {
+		changes, from, err := s.Store.SnapshotAnimeChanges(ctx)
+		if err != nil {
+			return err
+		}
+		for _, c := range changes {
+			if err := stream.Send(changeToProto(c)); err != nil {
+				return err
+			}
+		}
+		cursor = from
+		done := store.CatalogChange{Cursor: from, EventType: changeSnapshotCompleted, Payload: []byte("{}"), CreatedAt: time.Now()}
+		if err := stream.Send(changeToProto(done)); err != nil {
+			return err
+		}
+	}
+
+	interval := s.ChangePollInterval
+	if interval <= 0 {
+		interval = time.Second
+	}
+	for {
+		changes, err := s.Store.ListCatalogChanges(ctx, cursor, changeBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, c := range changes {
+			if err := stream.Send(changeToProto(c)); err != nil {
+				return err
+			}
+			cursor = c.Cursor
+		}
+		if len(changes) == changeBatchSize {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
 // ── helpers ────────────────────────────────────────────────────────────────
 
 func episodesToProto(eps []store.Episode) []*catalogv1.Episode {
 	out := make([]*catalogv1.Episode, 0, len(eps))
 	for _, ep := range eps {
-		pb := &catalogv1.Episode{Id: ep.ID, AnimeId: ep.AnimeID, Number: ep.Number, Title: ep.Title}
+		pb := &catalogv1.Episode{
+			Id:              ep.ID,
+			AnimeId:         ep.AnimeID,
+			Number:          ep.Number,
+			Title:           ep.Title,
+			IsFiller:        ep.IsFiller,
+			IsRecap:         ep.IsRecap,
+			DurationSeconds: ep.DurationSeconds,
+			Synopsis:        ep.Synopsis,
+			Thumbnail:       ep.Thumbnail,
+		}
 		if ep.AiredAt != nil {
 			pb.AiredAtRfc3339 = ep.AiredAt.UTC().Format(time.RFC3339)
 		}
@@ -180,3 +675,89 @@ func episodesToProto(eps []store.Episode) []*catalogv1.Episode {
 	}
 	return out
 }
+
+func changeToProto(c store.CatalogChange) *catalogv1.WatchCatalogChangesResponse {
+	return &catalogv1.WatchCatalogChangesResponse{Change: &catalogv1.CatalogChange{
+		Cursor:           c.Cursor.String(),
+		EventType:        c.EventType,
+		Schema:           c.Schema,
+		Payload:          c.Payload,
+		CreatedAtRfc3339: c.CreatedAt.UTC().Format(time.RFC3339),
+	}}
+}
+
+func genresToProto(genres []store.Genre) []*catalogv1.Genre {
+	out := make([]*catalogv1.Genre, 0, len(genres))
+	for _, g := range genres {
+		out = append(out, &catalogv1.Genre{Slug: g.Slug, Name: g.Name})
+	}
+	return out
+}
+
+func imageVariantsToProto(variants []store.ImageVariant) []*catalogv1.ImageVariant {
+	out := make([]*catalogv1.ImageVariant, 0, len(variants))
+	for _, v := range variants {
+		out = append(out, &catalogv1.ImageVariant{Name: v.Name, Width: v.Width, Height: v.Height, Format: v.Format, Key: v.Key})
+	}
+	return out
+}
+
+func popularityToProto(p store.Popularity) *catalogv1.Popularity {
+	return &catalogv1.Popularity{Score_24H: p.Score24h, Score_7D: p.Score7d, Score_30D: p.Score30d}
+}
+
+func providerScoresToProto(scores []store.ProviderScore) []*catalogv1.ProviderScore {
+	out := make([]*catalogv1.ProviderScore, 0, len(scores))
+	for _, sc := range scores {
+		out = append(out, &catalogv1.ProviderScore{Provider: sc.Provider, Score: sc.Score, ScoreCount: sc.ScoreCount})
+	}
+	return out
+}
+
+func tagsToProto(tags []store.Tag) []*catalogv1.Tag {
+	out := make([]*catalogv1.Tag, 0, len(tags))
+	for _, t := range tags {
+		out = append(out, &catalogv1.Tag{Name: t.Name, Category: t.Category, Rank: t.Rank, Spoiler: t.Spoiler})
+	}
+	return out
+}
+
+func taxaFromProto(pb []*catalogv1.JikanGenre) []store.Taxon {
+	out := make([]store.Taxon, 0, len(pb))
+	for _, g := range pb {
+		out = append(out, store.Taxon{MalID: g.GetMalId(), Name: g.GetName()})
+	}
+	return out
+}
+
+func relationsFromProto(pb []*catalogv1.JikanRelation) []store.AnimeRelation {
+	out := make([]store.AnimeRelation, 0, len(pb))
+	for _, r := range pb {
+		out = append(out, store.AnimeRelation{Relation: r.GetRelation(), MalID: r.GetMalId()})
+	}
+	return out
+}
+
+func availabilityFromProto(pb *catalogv1.Availability) (store.Availability, error) {
+	if pb == nil {
+		return store.Availability{}, status.Error(codes.InvalidArgument, "availability is required")
+	}
+	a := store.Availability{
+		Visibility:       strings.ToLower(strings.TrimSpace(pb.GetVisibility())),
+		Reason:           strings.TrimSpace(pb.GetReason()),
+		AllowedCountries: geo.NormalizeList(pb.GetAllowedCountries()),
+		BlockedCountries: geo.NormalizeList(pb.GetBlockedCountries()),
+	}
+	switch a.Visibility {
+	case "":
+		a.Visibility = store.VisibilityVisible
+	case store.VisibilityVisible, store.VisibilityHidden:
+	case store.VisibilityTakedown:
+		if a.Reason == "" {
+			return store.Availability{}, status.Error(codes.InvalidArgument, "reason is required for takedown")
+		}
+	default:
+		return store.Availability{}, status.Error(codes.InvalidArgument, "visibility must be visible, hidden or takedown")
+	}
+	return a, nil
+}
@@ -2,7 +2,13 @@ package grpcapi
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	catalogv1 "github.com/example/anime-platform/gen/catalog/v1"
 	"github.com/example/anime-platform/services/catalog/internal/store"
@@ -11,7 +17,7 @@ import (
 // stubStore is a no-op CatalogStore for unit tests that don't hit the DB.
 type stubStore struct{ store.CatalogStore }
 
-func (stubStore) GetAnimeByIDs(_ context.Context, ids []string) ([]store.Anime, error) {
+func (stubStore) GetAnimeByIDs(_ context.Context, ids []string, _ string, _ []string) ([]store.Anime, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -32,3 +38,383 @@ func TestGetAnimeByIDs_EmptyRequest(t *testing.T) {
 		t.Fatalf("expected empty anime list, got %d items", len(resp.GetAnime()))
 	}
 }
+
+func TestMergeAnime_Validation(t *testing.T) {
+	svc := &CatalogService{Store: stubStore{}}
+
+	cases := []*catalogv1.MergeAnimeRequest{
+		{},
+		{SourceAnimeId: "a1"},
+		{TargetAnimeId: "a1"},
+		{SourceAnimeId: "a1", TargetAnimeId: "a1"},
+	}
+	for _, req := range cases {
+		_, err := svc.MergeAnime(context.Background(), req)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("req %+v: expected InvalidArgument, got %v", req, err)
+		}
+	}
+}
+
+func TestUpsertProviderEpisodes_Validation(t *testing.T) {
+	svc := &CatalogService{Store: stubStore{}}
+
+	cases := []*catalogv1.UpsertProviderEpisodesRequest{
+		{},
+		{Provider: "hianime"},
+		{AnimeId: "a1"},
+	}
+	for _, req := range cases {
+		_, err := svc.UpsertProviderEpisodes(context.Background(), req)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("req %+v: expected InvalidArgument, got %v", req, err)
+		}
+	}
+}
+
+func TestSetAnimeAvailability_Validation(t *testing.T) {
+	svc := &CatalogService{Store: stubStore{}}
+
+	cases := []*catalogv1.SetAnimeAvailabilityRequest{
+		{AnimeId: "a1"},
+		{Availability: &catalogv1.Availability{Visibility: "hidden"}},
+		{AnimeId: "a1", Availability: &catalogv1.Availability{Visibility: "archived"}},
+		{AnimeId: "a1", Availability: &catalogv1.Availability{Visibility: "takedown"}},
+	}
+	for _, req := range cases {
+		_, err := svc.SetAnimeAvailability(context.Background(), req)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("req %+v: expected InvalidArgument, got %v", req, err)
+		}
+	}
+}
+
+func TestListGenres_InvalidKind(t *testing.T) {
+	svc := &CatalogService{Store: stubStore{}}
+
+	_, err := svc.ListGenres(context.Background(), &catalogv1.ListGenresRequest{Kind: "studio"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+}
+
+// trendingStore records the arguments GetTrending was called with.
+type trendingStore struct {
+	stubStore
+	window string
+	limit  int
+}
+
+func (s *trendingStore) GetTrending(_ context.Context, window string, limit int, _ string) ([]store.TrendingAnime, error) {
+	s.window, s.limit = window, limit
+	return []store.TrendingAnime{{AnimeID: "a1", Popularity: store.Popularity{Score24h: 3, Score7d: 5, Score30d: 8}}}, nil
+}
+
+func TestGetTrending_Defaults(t *testing.T) {
+	st := &trendingStore{}
+	svc := &CatalogService{Store: st}
+
+	resp, err := svc.GetTrending(context.Background(), &catalogv1.GetTrendingRequest{Limit: 5000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.window != store.TrendingWindow7d || st.limit != maxTrendingLimit {
+		t.Fatalf("store called with window=%q limit=%d", st.window, st.limit)
+	}
+	if resp.GetWindow() != "7d" || len(resp.GetAnime()) != 1 || resp.GetAnime()[0].GetPopularity().GetScore_7D() != 5 {
+		t.Fatalf("unexpected response: %v", resp)
+	}
+}
+
+func TestGetTrending_InvalidWindow(t *testing.T) {
+	svc := &CatalogService{Store: stubStore{}}
+
+	_, err := svc.GetTrending(context.Background(), &catalogv1.GetTrendingRequest{Window: "1y"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+}
+
+// changeStore serves a fixed change feed.
+type changeStore struct {
+	stubStore
+	snapshot []store.CatalogChange
+	from     store.ChangeCursor
+	changes  []store.CatalogChange
+}
+
+func (s changeStore) SnapshotAnimeChanges(context.Context) ([]store.CatalogChange, store.ChangeCursor, error) {
+	return s.snapshot, s.from, nil
+}
+
+func (s changeStore) ListCatalogChanges(_ context.Context, after store.ChangeCursor, limit int) ([]store.CatalogChange, error) {
+	var out []store.CatalogChange
+	for _, c := range s.changes {
+		if c.Cursor.TxID > after.TxID || (c.Cursor.TxID == after.TxID && c.Cursor.Seq > after.Seq) {
+			out = append(out, c)
+		}
+	}
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+// collectStream records sent changes and cancels its context after want of them.
+type collectStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	cancel context.CancelFunc
+	want   int
+	got    []*catalogv1.CatalogChange
+}
+
+func (s *collectStream) Context() context.Context { return s.ctx }
+
+func (s *collectStream) Send(resp *catalogv1.WatchCatalogChangesResponse) error {
+	s.got = append(s.got, resp.GetChange())
+	if len(s.got) >= s.want {
+		s.cancel()
+	}
+	return nil
+}
+
+func newCollectStream(want int) *collectStream {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	return &collectStream{ctx: ctx, cancel: cancel, want: want}
+}
+
+func TestWatchCatalogChanges_InvalidCursor(t *testing.T) {
+	svc := &CatalogService{Store: stubStore{}}
+
+	err := svc.WatchCatalogChanges(&catalogv1.WatchCatalogChangesRequest{SinceCursor: "nope"}, newCollectStream(1))
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+}
+
+func TestWatchCatalogChanges_ResumesAfterCursor(t *testing.T) {
+	st := changeStore{changes: []store.CatalogChange{
+		{Cursor: store.ChangeCursor{TxID: 10, Seq: 1}, EventType: "catalog.anime.upserted"},
+		{Cursor: store.ChangeCursor{TxID: 10, Seq: 2}, EventType: "catalog.anime.merged"},
+		{Cursor: store.ChangeCursor{TxID: 12, Seq: 3}, EventType: "catalog.anime.upserted"},
+	}}
+	svc := &CatalogService{Store: st, ChangePollInterval: time.Millisecond}
+
+	stream := newCollectStream(2)
+	if err := svc.WatchCatalogChanges(&catalogv1.WatchCatalogChangesRequest{SinceCursor: "10.1"}, stream); err != nil {
+		t.Fatal(err)
+	}
+	if len(stream.got) != 2 || stream.got[0].GetCursor() != "10.2" || stream.got[1].GetCursor() != "12.3" {
+		t.Fatalf("unexpected changes: %v", stream.got)
+	}
+}
+
+func TestWatchCatalogChanges_Snapshot(t *testing.T) {
+	st := changeStore{
+		snapshot: []store.CatalogChange{{EventType: "catalog.anime.upserted", Payload: []byte(`{"anime_id":"a1"}`)}},
+		from:     store.ChangeCursor{TxID: 20},
+		changes: []store.CatalogChange{
+			{Cursor: store.ChangeCursor{TxID: 19, Seq: 1}, EventType: "catalog.anime.upserted"},
+			{Cursor: store.ChangeCursor{TxID: 20, Seq: 2}, EventType: "catalog.anime.upserted"},
+		},
+	}
+	svc := &CatalogService{Store: st, ChangePollInterval: time.Millisecond}
+
+	stream := newCollectStream(3)
+	if err := svc.WatchCatalogChanges(&catalogv1.WatchCatalogChangesRequest{Snapshot: true}, stream); err != nil {
+		t.Fatal(err)
+	}
+	if len(stream.got) != 3 {
+		t.Fatalf("expected 3 changes, got %v", stream.got)
+	}
+	if stream.got[0].GetCursor() != "" || stream.got[1].GetEventType() != changeSnapshotCompleted || stream.got[1].GetCursor() != "20.0" {
+		t.Fatalf("unexpected snapshot prefix: %v", stream.got)
+	}
+	if stream.got[2].GetCursor() != "20.2" {
+		t.Fatalf("expected live change after snapshot cursor, got %v", stream.got[2])
+	}
+}
+
+// historyStore serves fixed anime versions and records the query.
+type historyStore struct {
+	stubStore
+	q        store.HistoryQuery
+	versions []store.AnimeVersion
+}
+
+func (s *historyStore) ListAnimeVersions(_ context.Context, _ string, q store.HistoryQuery) ([]store.AnimeVersion, error) {
+	s.q = q
+	return s.versions, nil
+}
+
+func TestGetAnimeHistory_Diff(t *testing.T) {
+	st := &historyStore{versions: []store.AnimeVersion{
+		{Version: 2, Reason: "jikan_sync", Data: []byte(`{"title":"B","score":8}`), Previous: []byte(`{"title":"A", "score":8}`)},
+		{Version: 1, Reason: "jikan_sync", Data: []byte(`{"title":"A","score":8}`)},
+	}}
+	svc := &CatalogService{Store: st}
+
+	resp, err := svc.GetAnimeHistory(context.Background(), &catalogv1.GetAnimeHistoryRequest{
+		AnimeId: "a1", Limit: 1000, AsOfRfc3339: "2026-01-02T03:04:05Z",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.q.Limit != maxHistoryLimit || st.q.AsOf.IsZero() {
+		t.Fatalf("store called with %+v", st.q)
+	}
+	v := resp.GetVersions()
+	if len(v) != 2 || len(v[0].GetChanges()) != 1 || len(v[1].GetChanges()) != 2 {
+		t.Fatalf("unexpected versions: %v", v)
+	}
+	if c := v[0].GetChanges()[0]; c.GetField() != "title" || c.GetOldJson() != `"A"` || c.GetNewJson() != `"B"` {
+		t.Fatalf("unexpected change: %v", c)
+	}
+	if v[0].GetSnapshotJson() != "" {
+		t.Fatal("snapshot returned without include_snapshots")
+	}
+}
+
+func TestGetAnimeHistory_Validation(t *testing.T) {
+	svc := &CatalogService{Store: stubStore{}}
+
+	cases := []*catalogv1.GetAnimeHistoryRequest{
+		{},
+		{AnimeId: "a1", BeforeVersion: -1},
+		{AnimeId: "a1", AsOfRfc3339: "yesterday"},
+	}
+	for _, req := range cases {
+		_, err := svc.GetAnimeHistory(context.Background(), req)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("req %+v: expected InvalidArgument, got %v", req, err)
+		}
+	}
+}
+
+func TestRevertAnime_Validation(t *testing.T) {
+	svc := &CatalogService{Store: stubStore{}}
+
+	cases := []*catalogv1.RevertAnimeRequest{
+		{},
+		{AnimeId: "a1"},
+		{Version: 2},
+	}
+	for _, req := range cases {
+		_, err := svc.RevertAnime(context.Background(), req)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("req %+v: expected InvalidArgument, got %v", req, err)
+		}
+	}
+}
+
+func TestUpsertProviderMetadata_Validation(t *testing.T) {
+	svc := &CatalogService{Store: stubStore{}}
+
+	cases := []*catalogv1.UpsertProviderMetadataRequest{
+		{},
+		{Provider: "anilist"},
+		{ExternalId: "9253"},
+		{Provider: "anilist", ExternalId: "9253", Score: 11},
+		{Provider: "anilist", ExternalId: "9253", Score: -1},
+		{Provider: "anilist", ExternalId: "9253", Tags: make([]*catalogv1.Tag, maxProviderTags+1)},
+	}
+	for _, req := range cases {
+		_, err := svc.UpsertProviderMetadata(context.Background(), req)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("req %+v: expected InvalidArgument, got %v", req, err)
+		}
+	}
+}
+
+func TestFranchise_Validation(t *testing.T) {
+	svc := &CatalogService{Store: stubStore{}}
+	ctx := context.Background()
+
+	if _, err := svc.CreateFranchise(ctx, &catalogv1.CreateFranchiseRequest{Title: "  "}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("create without title: expected InvalidArgument, got %v", err)
+	}
+	if _, err := svc.UpdateFranchise(ctx, &catalogv1.UpdateFranchiseRequest{Title: "Monogatari"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("update without id: expected InvalidArgument, got %v", err)
+	}
+	if _, err := svc.SetFranchiseEntries(ctx, &catalogv1.SetFranchiseEntriesRequest{FranchiseId: "f1", AnimeIds: make([]string, maxFranchiseEntries+1)}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("too many entries: expected InvalidArgument, got %v", err)
+	}
+	if _, err := svc.GetFranchise(ctx, &catalogv1.GetFranchiseRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("get without id: expected InvalidArgument, got %v", err)
+	}
+	if _, err := svc.ListFranchises(ctx, &catalogv1.ListFranchisesRequest{Offset: -1}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("negative offset: expected InvalidArgument, got %v", err)
+	}
+}
+
+// graphStore serves a fixed relations graph.
+type graphStore struct {
+	stubStore
+	graph store.RelationGraph
+}
+
+func (s graphStore) LoadRelationGraph(context.Context) (store.RelationGraph, error) {
+	return s.graph, nil
+}
+
+func TestSuggestFranchises(t *testing.T) {
+	svc := &CatalogService{Store: graphStore{graph: store.RelationGraph{
+		Titles: map[string]string{"a1": "Bakemonogatari", "a2": "Nisemonogatari"},
+		Edges:  []store.RelationEdge{{AnimeID: "a2", RelatedAnimeID: "a1", Relation: "prequel"}},
+	}}}
+
+	resp, err := svc.SuggestFranchises(context.Background(), &catalogv1.SuggestFranchisesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.GetSuggestions()) != 1 {
+		t.Fatalf("expected one suggestion, got %v", resp)
+	}
+	s := resp.GetSuggestions()[0]
+	if s.GetTitle() != "Bakemonogatari" || len(s.GetAnimeIds()) != 2 || s.GetAnimeIds()[0] != "a1" {
+		t.Fatalf("unexpected suggestion: %v", s)
+	}
+}
+
+type voteStore struct {
+	stubStore
+	gotVote int16
+}
+
+func (s *voteStore) VoteSkipSegment(_ context.Context, segID, _ string, vote int16) (store.SkipSegment, error) {
+	s.gotVote = vote
+	return store.SkipSegment{ID: segID}, nil
+}
+
+func TestVoteSkipSegment_Validation(t *testing.T) {
+	st := &voteStore{}
+	svc := &CatalogService{Store: st}
+
+	// 65537 and -65537 narrow to 1 and -1 as int16.
+	for _, vote := range []int32{0, 2, -2, 65537, -65537} {
+		_, err := svc.VoteSkipSegment(context.Background(), &catalogv1.VoteSkipSegmentRequest{SegmentId: "s1", UserId: "u1", Vote: vote})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("vote %d: expected InvalidArgument, got %v", vote, err)
+		}
+	}
+	if st.gotVote != 0 {
+		t.Fatalf("invalid vote reached the store as %d", st.gotVote)
+	}
+	if _, err := svc.VoteSkipSegment(context.Background(), &catalogv1.VoteSkipSegmentRequest{SegmentId: "s1", UserId: "u1", Vote: -1}); err != nil {
+		t.Fatal(err)
+	}
+	if st.gotVote != -1 {
+		t.Fatalf("vote = %d, want -1", st.gotVote)
+	}
+}
+
+func TestSkipSegmentInput_Bounds(t *testing.T) {
+	_, err := skipSegmentInput(&catalogv1.SkipSegmentInput{Kind: "intro", Start: 0, End: maxSkipSeconds + 1})
+	if status.Code(err) != codes.InvalidArgument || !strings.Contains(err.Error(), "<= 14400") {
+		t.Fatalf("expected InvalidArgument naming the upper bound, got %v", err)
+	}
+	if _, err := skipSegmentInput(&catalogv1.SkipSegmentInput{Kind: "intro", Start: 0, End: maxSkipSeconds}); err != nil {
+		t.Fatalf("end at the bound: %v", err)
+	}
+}
@@ -0,0 +1,159 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	catalogv1 "github.com/example/anime-platform/gen/catalog/v1"
+	"github.com/example/anime-platform/internal/platform/geo"
+	"github.com/example/anime-platform/services/catalog/internal/franchise"
+	"github.com/example/anime-platform/services/catalog/internal/store"
+)
+
+const (
+	defaultFranchiseLimit = 50
+	maxFranchiseLimit     = 200
+	maxFranchiseEntries   = 500
+)
+
+func franchiseInput(title, image, description string) (store.FranchiseInput, error) {
+	in := store.FranchiseInput{
+		Title:       strings.TrimSpace(title),
+		Image:       strings.TrimSpace(image),
+		Description: strings.TrimSpace(description),
+	}
+	if in.Title == "" {
+		return store.FranchiseInput{}, status.Error(codes.InvalidArgument, "title is required")
+	}
+	return in, nil
+}
+
+func franchiseLimit(v int32) int {
+	switch {
+	case v <= 0:
+		return defaultFranchiseLimit
+	case v > maxFranchiseLimit:
+		return maxFranchiseLimit
+	}
+	return int(v)
+}
+
+func (s *CatalogService) CreateFranchise(ctx context.Context, req *catalogv1.CreateFranchiseRequest) (*catalogv1.CreateFranchiseResponse, error) {
+	in, err := franchiseInput(req.GetTitle(), req.GetImage(), req.GetDescription())
+	if err != nil {
+		return nil, err
+	}
+	if len(req.GetAnimeIds()) > maxFranchiseEntries {
+		return nil, status.Error(codes.InvalidArgument, "too many anime_ids")
+	}
+	f, err := s.Store.CreateFranchise(ctx, in, req.GetAnimeIds())
+	if err != nil {
+		return nil, err
+	}
+	return &catalogv1.CreateFranchiseResponse{Franchise: franchiseToProto(f)}, nil
+}
+
+func (s *CatalogService) UpdateFranchise(ctx context.Context, req *catalogv1.UpdateFranchiseRequest) (*catalogv1.UpdateFranchiseResponse, error) {
+	id := strings.TrimSpace(req.GetFranchiseId())
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "franchise_id is required")
+	}
+	in, err := franchiseInput(req.GetTitle(), req.GetImage(), req.GetDescription())
+	if err != nil {
+		return nil, err
+	}
+	f, err := s.Store.UpdateFranchise(ctx, id, in)
+	if err != nil {
+		return nil, err
+	}
+	return &catalogv1.UpdateFranchiseResponse{Franchise: franchiseToProto(f)}, nil
+}
+
+func (s *CatalogService) SetFranchiseEntries(ctx context.Context, req *catalogv1.SetFranchiseEntriesRequest) (*catalogv1.SetFranchiseEntriesResponse, error) {
+	id := strings.TrimSpace(req.GetFranchiseId())
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "franchise_id is required")
+	}
+	if len(req.GetAnimeIds()) > maxFranchiseEntries {
+		return nil, status.Error(codes.InvalidArgument, "too many anime_ids")
+	}
+	f, err := s.Store.SetFranchiseEntries(ctx, id, req.GetAnimeIds())
+	if err != nil {
+		return nil, err
+	}
+	return &catalogv1.SetFranchiseEntriesResponse{Franchise: franchiseToProto(f)}, nil
+}
+
+func (s *CatalogService) DeleteFranchise(ctx context.Context, req *catalogv1.DeleteFranchiseRequest) (*catalogv1.DeleteFranchiseResponse, error) {
+	id := strings.TrimSpace(req.GetFranchiseId())
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "franchise_id is required")
+	}
+	if err := s.Store.DeleteFranchise(ctx, id); err != nil {
+		return nil, err
+	}
+	return &catalogv1.DeleteFranchiseResponse{}, nil
+}
+
+func (s *CatalogService) GetFranchise(ctx context.Context, req *catalogv1.GetFranchiseRequest) (*catalogv1.GetFranchiseResponse, error) {
+	id := strings.TrimSpace(req.GetFranchiseId())
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "franchise_id is required")
+	}
+	f, err := s.Store.GetFranchise(ctx, id, geo.FromIncoming(ctx), req.GetIncludeUnavailable())
+	if err != nil {
+		return nil, err
+	}
+	return &catalogv1.GetFranchiseResponse{Franchise: franchiseToProto(f)}, nil
+}
+
+func (s *CatalogService) ListFranchises(ctx context.Context, req *catalogv1.ListFranchisesRequest) (*catalogv1.ListFranchisesResponse, error) {
+	if req.GetOffset() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset must not be negative")
+	}
+	list, err := s.Store.ListFranchises(ctx, req.GetAnimeId(), franchiseLimit(req.GetLimit()), int(req.GetOffset()))
+	if err != nil {
+		return nil, err
+	}
+	resp := &catalogv1.ListFranchisesResponse{Franchises: make([]*catalogv1.Franchise, 0, len(list))}
+	for _, f := range list {
+		resp.Franchises = append(resp.Franchises, franchiseToProto(f))
+	}
+	return resp, nil
+}
+
+func (s *CatalogService) SuggestFranchises(ctx context.Context, req *catalogv1.SuggestFranchisesRequest) (*catalogv1.SuggestFranchisesResponse, error) {
+	g, err := s.Store.LoadRelationGraph(ctx)
+	if err != nil {
+		return nil, err
+	}
+	suggestions := franchise.Suggest(g, franchiseLimit(req.GetLimit()))
+	resp := &catalogv1.SuggestFranchisesResponse{Suggestions: make([]*catalogv1.FranchiseSuggestion, 0, len(suggestions))}
+	for _, sg := range suggestions {
+		resp.Suggestions = append(resp.Suggestions, &catalogv1.FranchiseSuggestion{
+			Title:        sg.Title,
+			AnimeIds:     sg.AnimeIDs,
+			FranchiseIds: sg.FranchiseIDs,
+		})
+	}
+	return resp, nil
+}
+
+func franchiseToProto(f store.Franchise) *catalogv1.Franchise {
+	pb := &catalogv1.Franchise{
+		Id:               f.ID,
+		Title:            f.Title,
+		Image:            f.Image,
+		Description:      f.Description,
+		Entries:          make([]*catalogv1.FranchiseEntry, 0, len(f.Entries)),
+		UpdatedAtRfc3339: f.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+	for _, e := range f.Entries {
+		pb.Entries = append(pb.Entries, &catalogv1.FranchiseEntry{AnimeId: e.AnimeID, Position: e.Position})
+	}
+	return pb
+}
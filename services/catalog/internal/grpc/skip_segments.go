@@ -0,0 +1,129 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	catalogv1 "github.com/example/anime-platform/gen/catalog/v1"
+	"github.com/example/anime-platform/services/catalog/internal/store"
+)
+
+// maxSkipSeconds bounds segment times; no episode runs this long.
+const maxSkipSeconds = 4 * 60 * 60
+
+func skipSegmentInput(in *catalogv1.SkipSegmentInput) (store.SkipSegmentInput, error) {
+	seg := store.SkipSegmentInput{
+		Kind:  strings.ToLower(strings.TrimSpace(in.GetKind())),
+		Start: in.GetStart(),
+		End:   in.GetEnd(),
+	}
+	switch seg.Kind {
+	case store.SkipIntro, store.SkipOutro, store.SkipRecap, store.SkipPreview:
+	default:
+		return store.SkipSegmentInput{}, status.Error(codes.InvalidArgument, "kind must be intro, outro, recap or preview")
+	}
+	if seg.Start < 0 || seg.End <= seg.Start || seg.End > maxSkipSeconds {
+		return store.SkipSegmentInput{}, status.Errorf(codes.InvalidArgument, "segment needs 0 <= start < end <= %d", maxSkipSeconds)
+	}
+	return seg, nil
+}
+
+func (s *CatalogService) UpsertProviderSkipSegments(ctx context.Context, req *catalogv1.UpsertProviderSkipSegmentsRequest) (*catalogv1.UpsertProviderSkipSegmentsResponse, error) {
+	epID := strings.TrimSpace(req.GetEpisodeId())
+	provider := strings.ToLower(strings.TrimSpace(req.GetProvider()))
+	if epID == "" || provider == "" {
+		return nil, status.Error(codes.InvalidArgument, "episode_id and provider required")
+	}
+	if provider == store.SkipSourceUser {
+		return nil, status.Error(codes.InvalidArgument, "provider must not be \"user\"")
+	}
+	segs := make([]store.SkipSegmentInput, 0, len(req.GetSegments()))
+	seen := map[string]bool{}
+	for _, in := range req.GetSegments() {
+		seg, err := skipSegmentInput(in)
+		if err != nil {
+			return nil, err
+		}
+		if seen[seg.Kind] {
+			return nil, status.Error(codes.InvalidArgument, "one segment per kind")
+		}
+		seen[seg.Kind] = true
+		segs = append(segs, seg)
+	}
+	if len(segs) == 0 {
+		return &catalogv1.UpsertProviderSkipSegmentsResponse{}, nil
+	}
+	if err := s.Store.UpsertProviderSkipSegments(ctx, epID, provider, segs); err != nil {
+		return nil, err
+	}
+	return &catalogv1.UpsertProviderSkipSegmentsResponse{}, nil
+}
+
+func (s *CatalogService) ListSkipSegments(ctx context.Context, req *catalogv1.ListSkipSegmentsRequest) (*catalogv1.ListSkipSegmentsResponse, error) {
+	epID := strings.TrimSpace(req.GetEpisodeId())
+	if epID == "" {
+		return nil, status.Error(codes.InvalidArgument, "episode_id is required")
+	}
+	segs, err := s.Store.ListSkipSegments(ctx, epID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &catalogv1.ListSkipSegmentsResponse{Segments: make([]*catalogv1.SkipSegment, 0, len(segs))}
+	for _, g := range segs {
+		resp.Segments = append(resp.Segments, skipSegmentToProto(g))
+	}
+	return resp, nil
+}
+
+func (s *CatalogService) SubmitSkipSegment(ctx context.Context, req *catalogv1.SubmitSkipSegmentRequest) (*catalogv1.SubmitSkipSegmentResponse, error) {
+	epID := strings.TrimSpace(req.GetEpisodeId())
+	userID := strings.TrimSpace(req.GetUserId())
+	if epID == "" || userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "episode_id and user_id required")
+	}
+	seg, err := skipSegmentInput(req.GetSegment())
+	if err != nil {
+		return nil, err
+	}
+	g, err := s.Store.SubmitSkipSegment(ctx, epID, userID, seg)
+	if err != nil {
+		return nil, err
+	}
+	return &catalogv1.SubmitSkipSegmentResponse{Segment: skipSegmentToProto(g)}, nil
+}
+
+func (s *CatalogService) VoteSkipSegment(ctx context.Context, req *catalogv1.VoteSkipSegmentRequest) (*catalogv1.VoteSkipSegmentResponse, error) {
+	segID := strings.TrimSpace(req.GetSegmentId())
+	userID := strings.TrimSpace(req.GetUserId())
+	if segID == "" || userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "segment_id and user_id required")
+	}
+	// Check before narrowing: int16(65537) would be 1.
+	vote := req.GetVote()
+	if vote != 1 && vote != -1 {
+		return nil, status.Error(codes.InvalidArgument, "vote must be 1 or -1")
+	}
+	g, err := s.Store.VoteSkipSegment(ctx, segID, userID, int16(vote))
+	if err != nil {
+		return nil, err
+	}
+	return &catalogv1.VoteSkipSegmentResponse{Segment: skipSegmentToProto(g)}, nil
+}
+
+func skipSegmentToProto(g store.SkipSegment) *catalogv1.SkipSegment {
+	return &catalogv1.SkipSegment{
+		Id:               g.ID,
+		EpisodeId:        g.EpisodeID,
+		Kind:             g.Kind,
+		Start:            g.Start,
+		End:              g.End,
+		Source:           g.Source,
+		SubmittedBy:      g.SubmittedBy,
+		Score:            g.Score,
+		CreatedAtRfc3339: g.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,62 @@
+package images
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"sync"
+
+	"github.com/HugoSmits86/nativewebp"
+)
+
+// Encoder writes an image in one output format.
+type Encoder struct {
+	Ext         string
+	ContentType string
+	Encode      func(img image.Image) ([]byte, error)
+}
+
+var (
+	encodersMu sync.RWMutex
+	encoders   = map[string]Encoder{
+		"jpeg": {Ext: "jpg", ContentType: "image/jpeg", Encode: encodeJPEG},
+		"webp": {Ext: "webp", ContentType: "image/webp", Encode: encodeWebP},
+	}
+)
+
+// RegisterEncoder makes format available to variants, replacing any encoder
+// already registered for it.
+func RegisterEncoder(format string, e Encoder) {
+	encodersMu.Lock()
+	defer encodersMu.Unlock()
+	encoders[format] = e
+}
+
+func encoderFor(format string) (Encoder, error) {
+	encodersMu.RLock()
+	defer encodersMu.RUnlock()
+	e, ok := encoders[format]
+	if !ok {
+		return Encoder{}, fmt.Errorf("no encoder for format %q", format)
+	}
+	return e, nil
+}
+
+func encodeJPEG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 82}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// encodeWebP writes lossless WebP (VP8L), the only kind nativewebp encodes.
+// Covers are small after resizing, so it still undercuts JPEG on flat artwork.
+func encodeWebP(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := nativewebp.Encode(&buf, img, nil); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
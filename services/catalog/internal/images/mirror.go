@@ -0,0 +1,253 @@
+// Package images mirrors anime cover images into blob storage and generates
+// resized variants, so clients never hotlink upstream CDNs.
+package images
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif" // register decoders for image.Decode
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	_ "golang.org/x/image/webp"
+	"golang.org/x/sync/semaphore"
+
+	"github.com/example/anime-platform/internal/platform/blob"
+	"github.com/example/anime-platform/internal/platform/metrics"
+	"github.com/example/anime-platform/services/catalog/internal/store"
+)
+
+var (
+	mirrored       = metrics.NewCounter("catalog_images_mirrored_total", "Cover images mirrored into blob storage.")
+	mirrorFailures = metrics.NewCounter("catalog_image_mirror_failures_total", "Cover image mirror attempts that failed.")
+)
+
+// Variant is one output size. Width is a maximum; smaller sources are not upscaled.
+type Variant struct {
+	Name   string
+	Width  int
+	Format string
+}
+
+// DefaultVariants covers list thumbnails, cards and the detail page, each as
+// JPEG for every client and WebP for those that accept it.
+var DefaultVariants = []Variant{
+	{Name: "thumb", Width: 160, Format: "jpeg"},
+	{Name: "medium", Width: 360, Format: "jpeg"},
+	{Name: "large", Width: 720, Format: "jpeg"},
+	{Name: "thumb_webp", Width: 160, Format: "webp"},
+	{Name: "medium_webp", Width: 360, Format: "webp"},
+	{Name: "large_webp", Width: 720, Format: "webp"},
+}
+
+// maxSourceBytes guards against oversized or malicious upstream responses.
+const maxSourceBytes = 10 << 20
+
+// maxSourcePixels bounds the decoded size. A compressed image of a few KB
+// can declare dimensions that would need gigabytes once decoded.
+const maxSourcePixels = 16_000_000
+
+// decodeBytesPerPixel over-estimates a decoded image's memory per pixel:
+// 16-bit PNGs decode to 8 bytes, and progressive JPEGs hold coefficients
+// while decoding.
+const decodeBytesPerPixel = 8
+
+// defaultDecodeBudget bounds decoded source images held at once across a
+// worker's jobs, room for two images at maxSourcePixels.
+const defaultDecodeBudget = 2 * maxSourcePixels * decodeBytesPerPixel
+
+// Store is the subset of the catalog store the worker needs.
+type Store interface {
+	ClaimImageJobs(ctx context.Context, limit int) ([]store.ImageJob, error)
+	CompleteImageJob(ctx context.Context, job store.ImageJob, variants []store.ImageVariant) error
+	FailImageJob(ctx context.Context, job store.ImageJob, reason string) error
+}
+
+type Worker struct {
+	Log   *zap.Logger
+	Store Store
+	Blob  blob.Store
+	// HTTP fetches source images. NewWorker's client refuses non-public
+	// addresses.
+	HTTP *http.Client
+	// DecodeBudget bounds the estimated bytes of decoded source images held
+	// at once; nil means unbounded.
+	DecodeBudget *semaphore.Weighted
+	Variants     []Variant
+	BatchSize    int
+	Concurrency  int
+	PollInterval time.Duration
+}
+
+func NewWorker(log *zap.Logger, st Store, b blob.Store) *Worker {
+	return &Worker{
+		Log:          log,
+		Store:        st,
+		Blob:         b,
+		HTTP:         newPublicHTTPClient(20 * time.Second),
+		DecodeBudget: semaphore.NewWeighted(defaultDecodeBudget),
+		Variants:     DefaultVariants,
+		BatchSize:    20,
+		Concurrency:  4,
+		PollInterval: 5 * time.Second,
+	}
+}
+
+func (w *Worker) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := w.runOnce(ctx); err != nil {
+				w.Log.Warn("image mirror batch failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (w *Worker) runOnce(ctx context.Context) error {
+	jobs, err := w.Store.ClaimImageJobs(ctx, w.BatchSize)
+	if err != nil {
+		return err
+	}
+	sem := make(chan struct{}, max(w.Concurrency, 1))
+	var wg sync.WaitGroup
+	for _, job := range jobs {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(job store.ImageJob) {
+			defer func() { <-sem; wg.Done() }()
+			w.handle(ctx, job)
+		}(job)
+	}
+	wg.Wait()
+	return nil
+}
+
+func (w *Worker) handle(ctx context.Context, job store.ImageJob) {
+	variants, err := w.Mirror(ctx, job.AnimeID, job.SourceURL)
+	if err != nil {
+		mirrorFailures.Inc()
+		w.Log.Warn("image mirror failed", zap.String("anime_id", job.AnimeID), zap.String("url", job.SourceURL),
+			zap.Int32("attempt", job.Attempts), zap.Error(err))
+		if err := w.Store.FailImageJob(ctx, job, err.Error()); err != nil {
+			w.Log.Warn("image mirror: record failure", zap.Error(err))
+		}
+		return
+	}
+	if err := w.Store.CompleteImageJob(ctx, job, variants); err != nil {
+		w.Log.Warn("image mirror: record variants", zap.String("anime_id", job.AnimeID), zap.Error(err))
+		return
+	}
+	mirrored.Inc()
+}
+
+// Mirror downloads sourceURL and writes every configured variant to blob
+// storage. Keys include a hash of the source URL, so a changed cover never
+// overwrites objects that cached URLs still point at.
+func (w *Worker) Mirror(ctx context.Context, animeID, sourceURL string) ([]store.ImageVariant, error) {
+	src, release, err := w.fetch(ctx, sourceURL)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	sum := sha256.Sum256([]byte(sourceURL))
+	prefix := fmt.Sprintf("anime/%s/%s", animeID, hex.EncodeToString(sum[:6]))
+
+	out := make([]store.ImageVariant, 0, len(w.Variants))
+	resized := map[int]*image.RGBA{}
+	for _, v := range w.Variants {
+		enc, err := encoderFor(v.Format)
+		if err != nil {
+			return nil, err
+		}
+		img, ok := resized[v.Width]
+		if !ok {
+			img = Resize(src, v.Width)
+			resized[v.Width] = img
+		}
+		data, err := enc.Encode(img)
+		if err != nil {
+			return nil, fmt.Errorf("encode %s: %w", v.Name, err)
+		}
+		key := prefix + "/" + v.Name + "." + enc.Ext
+		if err := w.Blob.Put(ctx, key, data, enc.ContentType); err != nil {
+			return nil, fmt.Errorf("store %s: %w", v.Name, err)
+		}
+		b := img.Bounds()
+		out = append(out, store.ImageVariant{
+			Name:   v.Name,
+			Width:  int32(b.Dx()),
+			Height: int32(b.Dy()),
+			Format: v.Format,
+			Key:    key,
+		})
+	}
+	return out, nil
+}
+
+// fetch downloads and decodes sourceURL. The decoded image counts against
+// DecodeBudget until release is called.
+func (w *Worker) fetch(ctx context.Context, sourceURL string) (img image.Image, release func(), err error) {
+	u, err := url.Parse(sourceURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, nil, fmt.Errorf("fetch: unsupported scheme %q", u.Scheme)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, sourceURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := w.HTTP.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("fetch: upstream status %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxSourceBytes+1))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(body) > maxSourceBytes {
+		return nil, nil, errors.New("fetch: image too large")
+	}
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, fmt.Errorf("decode: %w", err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || int64(cfg.Width)*int64(cfg.Height) > maxSourcePixels {
+		return nil, nil, fmt.Errorf("decode: %dx%d image exceeds %d pixels", cfg.Width, cfg.Height, maxSourcePixels)
+	}
+	release = func() {}
+	if w.DecodeBudget != nil {
+		cost := int64(cfg.Width) * int64(cfg.Height) * decodeBytesPerPixel
+		if err := w.DecodeBudget.Acquire(ctx, cost); err != nil {
+			return nil, nil, err
+		}
+		release = func() { w.DecodeBudget.Release(cost) }
+	}
+	img, _, err = image.Decode(bytes.NewReader(body))
+	if err != nil {
+		release()
+		return nil, nil, fmt.Errorf("decode: %w", err)
+	}
+	return img, release, nil
+}
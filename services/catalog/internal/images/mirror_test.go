@@ -0,0 +1,263 @@
+package images
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/image/webp"
+	"golang.org/x/sync/semaphore"
+
+	"github.com/example/anime-platform/internal/platform/blob"
+	"github.com/example/anime-platform/internal/platform/signing"
+)
+
+func testPNG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.NRGBA{R: 200, G: 10, B: 10, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestResize_KeepsAspectAndColour(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 400, 600))
+	for i := 0; i < len(src.Pix); i += 4 {
+		copy(src.Pix[i:], []byte{0x80, 0x80, 0x80, 0xff})
+	}
+	dst := Resize(src, 100)
+	if b := dst.Bounds(); b.Dx() != 100 || b.Dy() != 150 {
+		t.Fatalf("size = %v", b)
+	}
+	if got := dst.RGBAAt(50, 75); got.R != 0x80 || got.A != 0xff {
+		t.Fatalf("pixel = %v", got)
+	}
+	if b := Resize(src, 1000).Bounds(); b.Dx() != 400 {
+		t.Fatalf("upscaled to %v", b)
+	}
+}
+
+func TestResize_FlattensOntoWhite(t *testing.T) {
+	// 7 rows into 2 leaves strips of 3 and 4 rows.
+	src := image.NewNRGBA(image.Rect(0, 0, 9, 7))
+	dst := Resize(src, 3)
+	if b := dst.Bounds(); b.Dx() != 3 || b.Dy() != 2 {
+		t.Fatalf("size = %v", b)
+	}
+	for _, p := range [][2]int{{0, 0}, {2, 1}} {
+		if got := dst.RGBAAt(p[0], p[1]); got != (color.RGBA{0xff, 0xff, 0xff, 0xff}) {
+			t.Fatalf("pixel %v = %v, want white", p, got)
+		}
+	}
+}
+
+func TestMirror_WritesVariants(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(testPNG(t, 400, 600))
+	}))
+	defer upstream.Close()
+
+	local, err := blob.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := NewWorker(zap.NewNop(), nil, local)
+	w.HTTP = upstream.Client() // the test server is on loopback
+	variants, err := w.Mirror(context.Background(), "a1", upstream.URL+"/cover.png")
+	if err != nil {
+		t.Fatalf("Mirror: %v", err)
+	}
+	if len(variants) != len(DefaultVariants) {
+		t.Fatalf("variants = %d", len(variants))
+	}
+	want := map[string][2]int32{"thumb": {160, 240}, "medium": {360, 540}, "large": {400, 600}}
+	webps := 0
+	for _, v := range variants {
+		size := strings.TrimSuffix(v.Name, "_webp")
+		if got := [2]int32{v.Width, v.Height}; got != want[size] {
+			t.Errorf("%s: size %v, want %v", v.Name, got, want[size])
+		}
+		obj, err := local.Get(context.Background(), v.Key)
+		if err != nil {
+			t.Fatalf("%s: %v", v.Key, err)
+		}
+		switch v.Format {
+		case "jpeg":
+			if _, err := jpeg.Decode(obj.Body); err != nil {
+				t.Errorf("%s: not a JPEG: %v", v.Key, err)
+			}
+		case "webp":
+			webps++
+			if obj.ContentType != "image/webp" || !strings.HasSuffix(v.Key, ".webp") {
+				t.Errorf("%s: stored as %q", v.Key, obj.ContentType)
+			}
+			cfg, err := webp.DecodeConfig(obj.Body)
+			if err != nil {
+				t.Errorf("%s: not a WebP: %v", v.Key, err)
+			} else if cfg.Width != int(v.Width) {
+				t.Errorf("%s: WebP width %d, want %d", v.Key, cfg.Width, v.Width)
+			}
+		}
+		_ = obj.Body.Close()
+	}
+	if webps != 3 {
+		t.Fatalf("webp variants = %d, want 3", webps)
+	}
+}
+
+// pngHeader is a PNG signature and IHDR declaring w×h, enough for
+// image.DecodeConfig but with no pixel data behind it.
+func pngHeader(w, h uint32) []byte {
+	ihdr := make([]byte, 17)
+	copy(ihdr, "IHDR")
+	binary.BigEndian.PutUint32(ihdr[4:], w)
+	binary.BigEndian.PutUint32(ihdr[8:], h)
+	ihdr[12], ihdr[13] = 8, 6 // 8-bit RGBA
+	out := []byte("\x89PNG\r\n\x1a\n")
+	out = binary.BigEndian.AppendUint32(out, 13)
+	out = append(out, ihdr...)
+	return binary.BigEndian.AppendUint32(out, crc32.ChecksumIEEE(ihdr))
+}
+
+func TestMirror_RejectsHugeDimensions(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(pngHeader(50000, 50000))
+	}))
+	defer upstream.Close()
+
+	local, _ := blob.NewLocal(t.TempDir())
+	w := NewWorker(zap.NewNop(), nil, local)
+	w.HTTP = upstream.Client()
+	_, err := w.Mirror(context.Background(), "a1", upstream.URL)
+	if err == nil || !strings.Contains(err.Error(), "exceeds") {
+		t.Fatalf("err = %v, want pixel limit error", err)
+	}
+}
+
+func TestMirror_UpstreamError(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer upstream.Close()
+
+	local, _ := blob.NewLocal(t.TempDir())
+	w := NewWorker(zap.NewNop(), nil, local)
+	w.HTTP = upstream.Client()
+	if _, err := w.Mirror(context.Background(), "a1", upstream.URL); err == nil {
+		t.Fatal("expected error for 404 upstream")
+	}
+}
+
+func TestMirror_RefusesNonPublicAddresses(t *testing.T) {
+	hit := false
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hit = true
+		_, _ = w.Write(testPNG(t, 10, 10))
+	}))
+	defer upstream.Close()
+
+	local, _ := blob.NewLocal(t.TempDir())
+	w := NewWorker(zap.NewNop(), nil, local)
+	_, err := w.Mirror(context.Background(), "a1", upstream.URL)
+	if !errors.Is(err, errNonPublicAddr) || hit {
+		t.Fatalf("err = %v, hit = %v; want the loopback upstream refused", err, hit)
+	}
+	if _, err := w.Mirror(context.Background(), "a1", "file:///etc/passwd"); err == nil {
+		t.Fatal("expected non-HTTP scheme to be refused")
+	}
+}
+
+func TestPublicAddr(t *testing.T) {
+	for addr, want := range map[string]bool{
+		"203.0.113.7":     true,
+		"2606:4700::1111": true,
+		"127.0.0.1":       false,
+		"10.1.2.3":        false,
+		"172.16.0.1":      false,
+		"192.168.1.1":     false,
+		"169.254.169.254": false,
+		"100.64.0.1":      false,
+		"0.0.0.0":         false,
+		"::1":             false,
+		"fd00::1":         false,
+		"fe80::1":         false,
+		"::ffff:10.0.0.1": false,
+	} {
+		if got := publicAddr(netip.MustParseAddr(addr)); got != want {
+			t.Errorf("publicAddr(%s) = %v, want %v", addr, got, want)
+		}
+	}
+}
+
+func TestMirror_DecodeBudgetBlocks(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(testPNG(t, 40, 40))
+	}))
+	defer upstream.Close()
+
+	local, _ := blob.NewLocal(t.TempDir())
+	w := NewWorker(zap.NewNop(), nil, local)
+	w.HTTP = upstream.Client()
+	w.DecodeBudget = semaphore.NewWeighted(40 * 40 * decodeBytesPerPixel)
+	// Another job holds the whole budget, so this one waits until cancelled.
+	if err := w.DecodeBudget.Acquire(context.Background(), 1); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := w.Mirror(ctx, "a1", upstream.URL); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want to wait for decode budget", err)
+	}
+	w.DecodeBudget.Release(1)
+	if _, err := w.Mirror(context.Background(), "a1", upstream.URL); err != nil {
+		t.Fatalf("Mirror after release: %v", err)
+	}
+}
+
+func TestHandler_Signed(t *testing.T) {
+	local, _ := blob.NewLocal(t.TempDir())
+	_ = local.Put(context.Background(), "anime/a1/x/thumb.jpg", []byte("jpeg"), "image/jpeg")
+	signer := signing.New("image-secret")
+	h := Handler(zap.NewNop(), "/images", signer, local)
+
+	signed := signer.SignPath("anime/a1/x/thumb.jpg", time.Now().Add(time.Hour))
+	good, _ := signing.BuildSignedPathURL("/images", signed)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, good, nil))
+	if rec.Code != http.StatusOK || rec.Body.String() != "jpeg" || rec.Header().Get("Content-Type") != "image/jpeg" {
+		t.Fatalf("signed: %d %q %q", rec.Code, rec.Body.String(), rec.Header().Get("Content-Type"))
+	}
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/images/anime/a1/x/large.jpg?"+mustQuery(t, good), nil))
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("other key with same signature: %d", rec.Code)
+	}
+}
+
+func mustQuery(t *testing.T, raw string) string {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, raw, nil)
+	return req.URL.RawQuery
+}
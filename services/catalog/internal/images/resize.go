@@ -0,0 +1,60 @@
+package images
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+// Resize scales src to width pixels wide, keeping the aspect ratio, by averaging
+// every source pixel that falls under each destination pixel. It never upscales
+// and flattens transparency onto white so the result can be encoded as JPEG.
+// Source rows are flattened one destination row at a time, so the only extra
+// memory beyond the result is a strip of those rows.
+func Resize(src image.Image, width int) *image.RGBA {
+	b := src.Bounds()
+	sw, sh := b.Dx(), b.Dy()
+	if width <= 0 || width > sw {
+		width = sw
+	}
+	height := sh * width / sw
+	if height < 1 {
+		height = 1
+	}
+
+	white := image.NewUniform(color.White)
+	strip := image.NewRGBA(image.Rect(0, 0, sw, (sh+height-1)/height))
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for dy := 0; dy < height; dy++ {
+		y0, y1 := dy*sh/height, (dy+1)*sh/height
+		if y1 == y0 {
+			y1 = y0 + 1
+		}
+		rows := image.Rect(0, 0, sw, y1-y0)
+		draw.Draw(strip, rows, white, image.Point{}, draw.Src)
+		draw.Draw(strip, rows, src, image.Pt(b.Min.X, b.Min.Y+y0), draw.Over)
+		for dx := 0; dx < width; dx++ {
+			x0, x1 := dx*sw/width, (dx+1)*sw/width
+			if x1 == x0 {
+				x1 = x0 + 1
+			}
+			var r, g, bl, n uint32
+			for y := 0; y < y1-y0; y++ {
+				row := strip.Pix[y*strip.Stride:]
+				for x := x0; x < x1; x++ {
+					p := row[x*4 : x*4+3]
+					r += uint32(p[0])
+					g += uint32(p[1])
+					bl += uint32(p[2])
+					n++
+				}
+			}
+			o := dst.PixOffset(dx, dy)
+			dst.Pix[o] = uint8(r / n)
+			dst.Pix[o+1] = uint8(g / n)
+			dst.Pix[o+2] = uint8(bl / n)
+			dst.Pix[o+3] = 0xff
+		}
+	}
+	return dst
+}
@@ -0,0 +1,54 @@
+package images
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/example/anime-platform/internal/platform/blob"
+	"github.com/example/anime-platform/internal/platform/signing"
+)
+
+// Handler serves blob objects whose key (the request path below prefix) was
+// signed with signing.SignPath. Keys are content-addressed, so responses are
+// cacheable for as long as the signature is valid.
+func Handler(log *zap.Logger, prefix string, signer *signing.Signer, b blob.Store) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		key := strings.TrimPrefix(r.URL.Path, strings.TrimRight(prefix, "/")+"/")
+		exp, sig, err := signing.ExtractSignedPath(r.URL.Query())
+		if err != nil || !blob.ValidKey(key) || !signer.VerifyPath(key, exp, sig) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		obj, err := b.Get(r.Context(), key)
+		if err != nil {
+			if errors.Is(err, blob.ErrNotFound) {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			log.Warn("image get", zap.String("key", key), zap.Error(err))
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		defer obj.Body.Close()
+
+		w.Header().Set("Content-Type", obj.ContentType)
+		if obj.Size > 0 {
+			w.Header().Set("Content-Length", strconv.FormatInt(obj.Size, 10))
+		}
+		w.Header().Set("Cache-Control", "public, max-age=86400, immutable")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		if r.Method == http.MethodHead {
+			return
+		}
+		_, _ = io.Copy(w, obj.Body)
+	})
+}
@@ -0,0 +1,54 @@
+package images
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// errNonPublicAddr is returned when a source URL resolves to an address the
+// mirror must not reach, such as loopback, private or link-local ranges.
+var errNonPublicAddr = errors.New("non-public address")
+
+// cgnat is the shared address space (RFC 6598), not covered by IsPrivate.
+var cgnat = netip.MustParsePrefix("100.64.0.0/10")
+
+// publicAddr reports whether ip is a public unicast address.
+func publicAddr(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	return ip.IsValid() && ip.IsGlobalUnicast() && !ip.IsPrivate() && !cgnat.Contains(ip)
+}
+
+// dialPublicOnly rejects connections to non-public addresses. It runs after
+// DNS resolution, so a hostname that resolves to an internal address, or is
+// re-bound to one, is refused too.
+func dialPublicOnly(_, address string, _ syscall.RawConn) error {
+	ap, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+	if !publicAddr(ap.Addr()) {
+		return fmt.Errorf("%w: %s", errNonPublicAddr, ap.Addr())
+	}
+	return nil
+}
+
+// newPublicHTTPClient returns a client that only connects to public
+// addresses, including on redirects. It ignores proxy settings, which would
+// otherwise hide the destination from the check.
+func newPublicHTTPClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{Timeout: 10 * time.Second, Control: dialPublicOnly}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: 10 * time.Second,
+			MaxIdleConns:        10,
+			IdleConnTimeout:     90 * time.Second,
+		},
+	}
+}
@@ -10,6 +10,17 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/nats-io/nats.go"
 	"go.uber.org/zap"
+
+	"github.com/example/anime-platform/internal/platform/metrics"
+)
+
+// SchemaHeader carries the fully qualified protobuf message name of the payload.
+const SchemaHeader = "Catalog-Event-Schema"
+
+var (
+	unpublishedRows   = metrics.NewGauge("catalog_outbox_unpublished_rows", "Outbox rows not yet published to NATS.")
+	oldestUnpublished = metrics.NewGauge("catalog_outbox_oldest_unpublished_seconds", "Age of the oldest unpublished outbox row.")
+	deletedRows       = metrics.NewCounter("catalog_outbox_deleted_rows_total", "Published outbox rows removed by retention cleanup.")
 )
 
 type Publisher struct {
@@ -18,25 +29,32 @@ type Publisher struct {
 	JS           nats.JetStreamContext
 	BatchSize    int
 	PollInterval time.Duration
+	// Retention is how long published rows are kept for WatchCatalogChanges
+	// replay; zero disables cleanup.
+	Retention       time.Duration
+	CleanupInterval time.Duration
 }
 
 type outboxRow struct {
 	ID        string
 	EventType string
+	Schema    string
 	Payload   json.RawMessage
 }
 
-func NewPublisher(log *zap.Logger, db *pgxpool.Pool, nc *nats.Conn) (*Publisher, error) {
+func NewPublisher(log *zap.Logger, db *pgxpool.Pool, nc *nats.Conn, retention time.Duration) (*Publisher, error) {
 	js, err := nc.JetStream()
 	if err != nil {
 		return nil, err
 	}
 	return &Publisher{
-		Log:          log,
-		DB:           db,
-		JS:           js,
-		BatchSize:    100,
-		PollInterval: 2 * time.Second,
+		Log:             log,
+		DB:              db,
+		JS:              js,
+		BatchSize:       100,
+		PollInterval:    2 * time.Second,
+		Retention:       retention,
+		CleanupInterval: 10 * time.Minute,
 	}, nil
 }
 
@@ -78,6 +96,13 @@ func (p *Publisher) Run(ctx context.Context) error {
 	ticker := time.NewTicker(p.PollInterval)
 	defer ticker.Stop()
 
+	var cleanup <-chan time.Time
+	if p.Retention > 0 {
+		t := time.NewTicker(p.CleanupInterval)
+		defer t.Stop()
+		cleanup = t.C
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -86,10 +111,82 @@ func (p *Publisher) Run(ctx context.Context) error {
 			if err := p.flushOnce(ctx); err != nil {
 				p.Log.Warn("outbox flush failed", zap.Error(err))
 			}
+			if err := p.observeBacklog(ctx); err != nil {
+				p.Log.Warn("outbox backlog query failed", zap.Error(err))
+			}
+		case <-cleanup:
+			n, err := p.cleanupOnce(ctx)
+			if err != nil {
+				p.Log.Warn("outbox cleanup failed", zap.Error(err))
+			} else if n > 0 {
+				p.Log.Info("outbox cleanup", zap.Int64("deleted", n))
+			}
 		}
 	}
 }
 
+// observeBacklog refreshes the unpublished-row gauges.
+func (p *Publisher) observeBacklog(ctx context.Context) error {
+	var n int64
+	var age float64
+	if err := p.DB.QueryRow(ctx, `
+SELECT count(*), COALESCE(EXTRACT(EPOCH FROM now() - min(created_at)), 0)::float8
+FROM catalog_outbox
+WHERE published_at IS NULL`).Scan(&n, &age); err != nil {
+		return err
+	}
+	unpublishedRows.Set(float64(n))
+	oldestUnpublished.Set(age)
+	return nil
+}
+
+// cleanupOnce deletes published rows older than Retention in batches and advances
+// the retention watermark that WatchCatalogChanges uses to reject stale cursors.
+func (p *Publisher) cleanupOnce(ctx context.Context) (int64, error) {
+	var total int64
+	for {
+		n, err := p.cleanupBatch(ctx)
+		total += n
+		if err != nil || n < int64(p.BatchSize)*10 {
+			deletedRows.Add(uint64(total))
+			return total, err
+		}
+	}
+}
+
+func (p *Publisher) cleanupBatch(ctx context.Context) (int64, error) {
+	tx, err := p.DB.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var n int64
+	err = tx.QueryRow(ctx, `
+WITH deleted AS (
+  DELETE FROM catalog_outbox
+  WHERE id IN (
+    SELECT id FROM catalog_outbox
+    WHERE published_at < now() - make_interval(secs => $1)
+    ORDER BY tx_id, seq
+    LIMIT $2
+  )
+  RETURNING tx_id, seq
+), newest AS (
+  SELECT tx_id, seq FROM deleted ORDER BY tx_id DESC, seq DESC LIMIT 1
+), watermark AS (
+  INSERT INTO catalog_outbox_retention (id, tx_id, seq, updated_at)
+  SELECT TRUE, tx_id, seq, now() FROM newest
+  ON CONFLICT (id) DO UPDATE SET tx_id = EXCLUDED.tx_id, seq = EXCLUDED.seq, updated_at = EXCLUDED.updated_at
+  WHERE (catalog_outbox_retention.tx_id, catalog_outbox_retention.seq) < (EXCLUDED.tx_id, EXCLUDED.seq)
+)
+SELECT count(*) FROM deleted`, p.Retention.Seconds(), p.BatchSize*10).Scan(&n)
+	if err != nil {
+		return 0, err
+	}
+	return n, tx.Commit(ctx)
+}
+
 func (p *Publisher) flushOnce(ctx context.Context) error {
 	tx, err := p.DB.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
@@ -98,7 +195,7 @@ func (p *Publisher) flushOnce(ctx context.Context) error {
 	defer func() { _ = tx.Rollback(ctx) }()
 
 	rows, err := tx.Query(ctx, `
-SELECT id, event_type, payload
+SELECT id, event_type, schema, payload
 FROM catalog_outbox
 WHERE published_at IS NULL
 ORDER BY created_at
@@ -113,7 +210,7 @@ FOR UPDATE SKIP LOCKED
 	items := make([]outboxRow, 0, p.BatchSize)
 	for rows.Next() {
 		var item outboxRow
-		if err := rows.Scan(&item.ID, &item.EventType, &item.Payload); err != nil {
+		if err := rows.Scan(&item.ID, &item.EventType, &item.Schema, &item.Payload); err != nil {
 			return err
 		}
 		items = append(items, item)
@@ -125,7 +222,12 @@ FOR UPDATE SKIP LOCKED
 	// Publish all items asynchronously for better throughput, then wait for acks.
 	futures := make([]nats.PubAckFuture, 0, len(items))
 	for _, item := range items {
-		f, err := p.JS.PublishAsync(item.EventType, item.Payload)
+		msg := nats.NewMsg(item.EventType)
+		msg.Data = item.Payload
+		if item.Schema != "" {
+			msg.Header.Set(SchemaHeader, item.Schema)
+		}
+		f, err := p.JS.PublishMsgAsync(msg)
 		if err != nil {
 			return err
 		}
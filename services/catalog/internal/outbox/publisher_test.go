@@ -0,0 +1,60 @@
+package outbox
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/example/anime-platform/services/catalog/internal/dbtest"
+)
+
+func TestCleanupBatch_AdvancesWatermark(t *testing.T) {
+	pool := dbtest.Pool(t)
+	ctx := context.Background()
+	// Two rows published before the retention window and one inside it; each
+	// insert is its own transaction, so tx_id increases row by row.
+	for _, age := range []time.Duration{3 * time.Hour, 2 * time.Hour, time.Minute} {
+		if _, err := pool.Exec(ctx,
+			`INSERT INTO catalog_outbox (id, event_type, payload, published_at) VALUES ($1, 'catalog.anime.upserted', '{}', $2)`,
+			uuid.New(), time.Now().Add(-age),
+		); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var newestOld string
+	if err := pool.QueryRow(ctx, `
+SELECT tx_id::text || '.' || seq FROM catalog_outbox
+WHERE published_at < now() - interval '1 hour' ORDER BY tx_id DESC, seq DESC LIMIT 1`).Scan(&newestOld); err != nil {
+		t.Fatal(err)
+	}
+
+	p := &Publisher{DB: pool, BatchSize: 1, Retention: time.Hour}
+	n, err := p.cleanupBatch(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Fatalf("deleted %d rows, want 2", n)
+	}
+	watermark := func() string {
+		t.Helper()
+		var wm string
+		if err := pool.QueryRow(ctx, `SELECT tx_id::text || '.' || seq FROM catalog_outbox_retention`).Scan(&wm); err != nil {
+			t.Fatal(err)
+		}
+		return wm
+	}
+	if got := watermark(); got != newestOld {
+		t.Fatalf("watermark = %s, want the newest deleted row %s", got, newestOld)
+	}
+
+	// Nothing else is old enough: the watermark stays put.
+	if n, err := p.cleanupBatch(ctx); err != nil || n != 0 {
+		t.Fatalf("second cleanup deleted %d rows, err %v", n, err)
+	}
+	if got := watermark(); got != newestOld {
+		t.Fatalf("watermark moved to %s", got)
+	}
+}
@@ -0,0 +1,205 @@
+// Package popularity projects platform activity (watch progress, playback starts
+// and ratings) into the catalog's decayed trending scores.
+package popularity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+
+	"github.com/example/anime-platform/internal/platform/analytics"
+	"github.com/example/anime-platform/services/catalog/internal/store"
+)
+
+const (
+	// analyticsStream is owned by the analytics service and already sources
+	// activity.progress alongside the analytics.> subjects.
+	analyticsStream = "ANALYTICS"
+	durableName     = "catalog_popularity"
+
+	subjectProgress = "activity.progress"
+)
+
+// Store is the subset of the catalog store the consumer needs.
+type Store interface {
+	RecordPopularitySignal(ctx context.Context, sig store.PopularitySignal) (bool, error)
+	PrunePopularity(ctx context.Context, keepDays int) (int64, error)
+}
+
+type Consumer struct {
+	Log       *zap.Logger
+	Store     Store
+	NATS      *nats.Conn
+	BatchSize int
+	// PruneEvery controls how often stale dedupe rows and decayed scores are removed.
+	PruneEvery time.Duration
+}
+
+func NewConsumer(log *zap.Logger, st Store, nc *nats.Conn) *Consumer {
+	return &Consumer{Log: log, Store: st, NATS: nc, BatchSize: 100, PruneEvery: time.Hour}
+}
+
+// Run consumes until ctx is cancelled. The ANALYTICS stream may not exist until
+// the analytics service has started, so subscribing is retried.
+func (c *Consumer) Run(ctx context.Context) error {
+	js, err := c.NATS.JetStream()
+	if err != nil {
+		return err
+	}
+	var sub *nats.Subscription
+	for {
+		sub, err = js.PullSubscribe(">", durableName, nats.BindStream(analyticsStream))
+		if err == nil {
+			break
+		}
+		c.Log.Warn("popularity: subscribe failed, retrying", zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(30 * time.Second):
+		}
+	}
+
+	go c.pruneLoop(ctx, js)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+		msgs, err := sub.Fetch(c.BatchSize, nats.MaxWait(2*time.Second))
+		if err != nil {
+			if errors.Is(err, nats.ErrTimeout) {
+				continue
+			}
+			c.Log.Warn("popularity: fetch", zap.Error(err))
+			time.Sleep(time.Second)
+			continue
+		}
+		for _, m := range msgs {
+			if err := c.handle(ctx, m); err != nil {
+				c.Log.Warn("popularity: record signal", zap.String("subject", m.Subject), zap.Error(err))
+				_ = m.Nak()
+				continue
+			}
+			_ = m.Ack()
+		}
+	}
+}
+
+func (c *Consumer) handle(ctx context.Context, m *nats.Msg) error {
+	sig, ok := SignalFromMessage(m.Subject, m.Data)
+	if !ok {
+		return nil
+	}
+	_, err := c.Store.RecordPopularitySignal(ctx, sig)
+	return err
+}
+
+// pruneLoop removes dedupe rows once the ANALYTICS stream can no longer
+// redeliver or replay their events. The stream's MaxAge is read on every run
+// because the analytics service owns it and may change it.
+func (c *Consumer) pruneLoop(ctx context.Context, js nats.JetStreamContext) {
+	t := time.NewTicker(c.PruneEvery)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			info, err := js.StreamInfo(analyticsStream, nats.Context(ctx))
+			if err != nil {
+				c.Log.Warn("popularity: stream info", zap.Error(err))
+				continue
+			}
+			if _, err := c.Store.PrunePopularity(ctx, DedupeDays(info.Config.MaxAge)); err != nil {
+				c.Log.Warn("popularity: prune", zap.Error(err))
+			}
+		}
+	}
+}
+
+// DedupeDays is how many days of dedupe rows to keep for a stream that retains
+// messages for maxAge: every day a retained event can fall on, plus one for
+// events stamped just before their publish time. An unbounded stream (zero
+// maxAge) keeps them all.
+func DedupeDays(maxAge time.Duration) int {
+	if maxAge <= 0 {
+		return 0
+	}
+	day := 24 * time.Hour
+	return int((maxAge+day-1)/day) + 1
+}
+
+// progressEvent is the activity.progress payload published by the BFF.
+type progressEvent struct {
+	EventID   string `json:"event_id"`
+	UserID    string `json:"user_id"`
+	AnimeID   string `json:"anime_id"`
+	EpisodeID string `json:"episode_id"`
+	CreatedAt string `json:"created_at"`
+}
+
+// SignalFromMessage maps a stream message to a popularity signal. It returns
+// false for subjects that do not feed popularity and for malformed payloads,
+// which are acknowledged and dropped.
+func SignalFromMessage(subject string, data []byte) (store.PopularitySignal, bool) {
+	switch subject {
+	case subjectProgress:
+		var ev progressEvent
+		if json.Unmarshal(data, &ev) != nil {
+			return store.PopularitySignal{}, false
+		}
+		at, _ := time.Parse(time.RFC3339, ev.CreatedAt)
+		return newSignal(store.SignalWatchProgress, 1, ev.UserID, ev.EventID, ev.AnimeID, ev.EpisodeID, at)
+
+	case analytics.SubjectStreamingStarted, analytics.SubjectSocialAnimeRated:
+		var ev analytics.Event
+		if json.Unmarshal(data, &ev) != nil {
+			return store.PopularitySignal{}, false
+		}
+		animeID, _ := ev.Properties["anime_id"].(string)
+		episodeID, _ := ev.Properties["episode_id"].(string)
+		if subject == analytics.SubjectStreamingStarted {
+			return newSignal(store.SignalPlayback, 1, ev.UserID, ev.EventID, animeID, episodeID, ev.OccurredAt)
+		}
+		// Ratings of 1..10 weigh 0.2..2: a strong recommendation counts for
+		// more than a view, a poor score for less.
+		score, _ := ev.Properties["score"].(float64)
+		if score < 1 || score > 10 {
+			return store.PopularitySignal{}, false
+		}
+		return newSignal(store.SignalRating, score/5, ev.UserID, ev.EventID, animeID, "", ev.OccurredAt)
+	}
+	return store.PopularitySignal{}, false
+}
+
+func newSignal(kind string, weight float64, userID, eventID, animeID, episodeID string, at time.Time) (store.PopularitySignal, bool) {
+	actor := strings.TrimSpace(userID)
+	if actor == "" {
+		// Anonymous events cannot be folded per user; the event ID still makes
+		// redeliveries idempotent.
+		actor = "event:" + strings.TrimSpace(eventID)
+		if actor == "event:" {
+			return store.PopularitySignal{}, false
+		}
+	}
+	animeID, episodeID = strings.TrimSpace(animeID), strings.TrimSpace(episodeID)
+	if animeID == "" && episodeID == "" {
+		return store.PopularitySignal{}, false
+	}
+	return store.PopularitySignal{
+		AnimeID:   animeID,
+		EpisodeID: episodeID,
+		Actor:     actor,
+		Kind:      kind,
+		Weight:    weight,
+		At:        at,
+	}, true
+}
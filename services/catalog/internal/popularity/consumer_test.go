@@ -0,0 +1,69 @@
+package popularity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/example/anime-platform/internal/platform/analytics"
+	"github.com/example/anime-platform/services/catalog/internal/store"
+)
+
+func TestSignalFromMessage_Progress(t *testing.T) {
+	sig, ok := SignalFromMessage("activity.progress", []byte(`{"event_id":"e1","user_id":"u1","anime_id":"","episode_id":"ep1","created_at":"2024-05-01T10:00:00Z"}`))
+	if !ok {
+		t.Fatal("expected signal")
+	}
+	want := store.PopularitySignal{EpisodeID: "ep1", Actor: "u1", Kind: store.SignalWatchProgress, Weight: 1, At: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)}
+	if sig != want {
+		t.Fatalf("got %+v, want %+v", sig, want)
+	}
+}
+
+func TestSignalFromMessage_PlaybackUsesProperties(t *testing.T) {
+	sig, ok := SignalFromMessage(analytics.SubjectStreamingStarted, []byte(`{"event_id":"e2","user_id":"u1","occurred_at":"2024-05-01T10:00:00Z","properties":{"episode_id":"ep9","category":"sub"}}`))
+	if !ok || sig.EpisodeID != "ep9" || sig.Kind != store.SignalPlayback || sig.Actor != "u1" {
+		t.Fatalf("unexpected signal %+v ok=%v", sig, ok)
+	}
+}
+
+func TestSignalFromMessage_RatingWeight(t *testing.T) {
+	sig, ok := SignalFromMessage(analytics.SubjectSocialAnimeRated, []byte(`{"event_id":"e3","user_id":"u1","properties":{"anime_id":"a1","score":9}}`))
+	if !ok || sig.AnimeID != "a1" || sig.Weight != 1.8 {
+		t.Fatalf("unexpected signal %+v ok=%v", sig, ok)
+	}
+	if _, ok := SignalFromMessage(analytics.SubjectSocialAnimeRated, []byte(`{"user_id":"u1","properties":{"anime_id":"a1","score":11}}`)); ok {
+		t.Fatal("expected out-of-range score to be dropped")
+	}
+}
+
+func TestSignalFromMessage_Ignored(t *testing.T) {
+	for subject, body := range map[string]string{
+		analytics.SubjectSearchPerformed:  `{"event_id":"e4"}`,
+		"activity.progress":               `not json`,
+		analytics.SubjectStreamingStarted: `{"properties":{"episode_id":"ep1"}}`, // no user or event ID
+	} {
+		if _, ok := SignalFromMessage(subject, []byte(body)); ok {
+			t.Errorf("%s %s: expected no signal", subject, body)
+		}
+	}
+}
+
+func TestSignalFromMessage_AnonymousUsesEventID(t *testing.T) {
+	sig, ok := SignalFromMessage(analytics.SubjectStreamingStarted, []byte(`{"event_id":"e5","properties":{"episode_id":"ep1"}}`))
+	if !ok || sig.Actor != "event:e5" {
+		t.Fatalf("unexpected signal %+v ok=%v", sig, ok)
+	}
+}
+
+func TestDedupeDays(t *testing.T) {
+	cases := map[time.Duration]int{
+		0:                   0,
+		30 * 24 * time.Hour: 31,
+		36 * time.Hour:      3,
+	}
+	for maxAge, want := range cases {
+		if got := DedupeDays(maxAge); got != want {
+			t.Errorf("DedupeDays(%v) = %d, want %d", maxAge, got, want)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+// Package snapshot defines the JSONL format used by catalogctl to export and
+// import the catalog. Every line is a Record whose Data matches its Type; the
+// first line is always a Header. Records are written parents-first (taxonomy,
+// anime, then rows that reference anime, then episodes and their mappings), so
+// an importer can apply them in file order. Franchises follow the anime rows
+// and precede their entries.
+package snapshot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Version is the snapshot format version written by this build. Version 2
+// added relations and franchises; version 1 files still import.
+const Version = 2
+
+const (
+	TypeHeader            = "header"
+	TypeTaxon             = "taxon"
+	TypeAnime             = "anime"
+	TypeTranslation       = "anime_translation"
+	TypeExternalAnimeID   = "external_anime_id"
+	TypeRedirect          = "anime_redirect"
+	TypeRelation          = "anime_relation"
+	TypeFranchise         = "franchise"
+	TypeFranchiseEntry    = "franchise_entry"
+	TypeEpisode           = "episode"
+	TypeExternalEpisodeID = "external_episode_id"
+)
+
+type Record struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+type Header struct {
+	Version    int       `json:"version"`
+	ExportedAt time.Time `json:"exported_at"`
+}
+
+// Taxon is a genre, theme or demographic (see internal/platform/taxonomy).
+type Taxon struct {
+	Kind  string `json:"kind"`
+	Slug  string `json:"slug"`
+	Name  string `json:"name"`
+	MalID *int32 `json:"mal_id,omitempty"`
+}
+
+type Anime struct {
+	ID               string          `json:"id"`
+	Title            string          `json:"title"`
+	TitleEnglish     string          `json:"title_english"`
+	TitleJapanese    string          `json:"title_japanese"`
+	OtherName        string          `json:"other_name"`
+	URL              string          `json:"url"`
+	Image            string          `json:"image"`
+	Description      string          `json:"description"`
+	Genres           json.RawMessage `json:"genres"`
+	SubOrDub         string          `json:"sub_or_dub"`
+	Type             string          `json:"type"`
+	Status           string          `json:"status"`
+	TotalEpisodes    int32           `json:"total_episodes"`
+	Score            float32         `json:"score"`
+	Visibility       string          `json:"visibility"`
+	VisibilityReason string          `json:"visibility_reason"`
+	AllowedCountries []string        `json:"allowed_countries"`
+	BlockedCountries []string        `json:"blocked_countries"`
+	GenreSlugs       []string        `json:"genre_slugs"`
+	ThemeSlugs       []string        `json:"theme_slugs"`
+	DemographicSlugs []string        `json:"demographic_slugs"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+}
+
+type Translation struct {
+	AnimeID   string    `json:"anime_id"`
+	Locale    string    `json:"locale"`
+	Title     string    `json:"title"`
+	Synopsis  string    `json:"synopsis"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ExternalAnimeID struct {
+	Provider        string    `json:"provider"`
+	ProviderAnimeID string    `json:"provider_anime_id"`
+	AnimeID         string    `json:"anime_id"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+type Redirect struct {
+	SourceAnimeID string    `json:"source_anime_id"`
+	TargetAnimeID string    `json:"target_anime_id"`
+	MergedAt      time.Time `json:"merged_at"`
+}
+
+// Relation points at a provider entry, which need not be in the catalog.
+type Relation struct {
+	AnimeID                string    `json:"anime_id"`
+	Relation               string    `json:"relation"`
+	Provider               string    `json:"provider"`
+	RelatedProviderAnimeID string    `json:"related_provider_anime_id"`
+	UpdatedAt              time.Time `json:"updated_at"`
+}
+
+type Franchise struct {
+	ID          string    `json:"id"`
+	Title       string    `json:"title"`
+	Image       string    `json:"image"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type FranchiseEntry struct {
+	FranchiseID string `json:"franchise_id"`
+	AnimeID     string `json:"anime_id"`
+	Position    int32  `json:"position"`
+}
+
+type Episode struct {
+	ID               string     `json:"id"`
+	AnimeID          string     `json:"anime_id"`
+	Number           int32      `json:"number"`
+	Title            string     `json:"title"`
+	URL              string     `json:"url"`
+	AiredAt          *time.Time `json:"aired_at,omitempty"`
+	IsFiller         bool       `json:"is_filler"`
+	IsRecap          bool       `json:"is_recap"`
+	DurationSeconds  int32      `json:"duration_seconds"`
+	Synopsis         string     `json:"synopsis"`
+	Thumbnail        string     `json:"thumbnail"`
+	Visibility       string     `json:"visibility"`
+	VisibilityReason string     `json:"visibility_reason"`
+	AllowedCountries []string   `json:"allowed_countries"`
+	BlockedCountries []string   `json:"blocked_countries"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+type ExternalEpisodeID struct {
+	Provider          string    `json:"provider"`
+	ProviderEpisodeID string    `json:"provider_episode_id"`
+	EpisodeID         string    `json:"episode_id"`
+	HasSub            bool      `json:"has_sub"`
+	HasDub            bool      `json:"has_dub"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// Writer encodes records as JSONL, starting with a Header.
+type Writer struct {
+	enc    *json.Encoder
+	counts map[string]int
+}
+
+func NewWriter(w io.Writer, exportedAt time.Time) (*Writer, error) {
+	sw := &Writer{enc: json.NewEncoder(w), counts: map[string]int{}}
+	if err := sw.write(TypeHeader, Header{Version: Version, ExportedAt: exportedAt.UTC()}); err != nil {
+		return nil, err
+	}
+	return sw, nil
+}
+
+func (w *Writer) Write(typ string, v any) error {
+	if err := w.write(typ, v); err != nil {
+		return err
+	}
+	w.counts[typ]++
+	return nil
+}
+
+func (w *Writer) write(typ string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return w.enc.Encode(Record{Type: typ, Data: data})
+}
+
+// Counts reports how many records of each type have been written.
+func (w *Writer) Counts() map[string]int { return w.counts }
+
+// Reader decodes records written by Writer. The header is consumed and
+// validated by NewReader.
+type Reader struct {
+	dec    *json.Decoder
+	Header Header
+	line   int
+}
+
+func NewReader(r io.Reader) (*Reader, error) {
+	sr := &Reader{dec: json.NewDecoder(r)}
+	rec, err := sr.Next()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("snapshot: empty input")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if rec.Type != TypeHeader {
+		return nil, fmt.Errorf("snapshot: first record is %q, want %q", rec.Type, TypeHeader)
+	}
+	if err := json.Unmarshal(rec.Data, &sr.Header); err != nil {
+		return nil, fmt.Errorf("snapshot: header: %w", err)
+	}
+	if sr.Header.Version < 1 || sr.Header.Version > Version {
+		return nil, fmt.Errorf("snapshot: unsupported version %d", sr.Header.Version)
+	}
+	return sr, nil
+}
+
+// Next returns the next record, or io.EOF at the end of input.
+func (r *Reader) Next() (Record, error) {
+	var rec Record
+	if err := r.dec.Decode(&rec); err != nil {
+		if errors.Is(err, io.EOF) {
+			return Record{}, io.EOF
+		}
+		return Record{}, fmt.Errorf("snapshot: record %d: %w", r.line+1, err)
+	}
+	r.line++
+	return rec, nil
+}
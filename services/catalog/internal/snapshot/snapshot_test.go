@@ -0,0 +1,75 @@
+package snapshot
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write(TypeAnime, Anime{ID: "a1", Title: "Steins;Gate", Genres: json.RawMessage(`["Sci-Fi"]`)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write(TypeEpisode, Episode{ID: "e1", AnimeID: "a1", Number: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if got := w.Counts()[TypeAnime]; got != 1 {
+		t.Fatalf("anime count = %d", got)
+	}
+	if lines := strings.Count(buf.String(), "\n"); lines != 3 {
+		t.Fatalf("expected 3 lines, got %d", lines)
+	}
+
+	r, err := NewReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Header.Version != Version {
+		t.Fatalf("header version = %d", r.Header.Version)
+	}
+	rec, err := r.Next()
+	if err != nil || rec.Type != TypeAnime {
+		t.Fatalf("unexpected record %+v, %v", rec, err)
+	}
+	var a Anime
+	if err := json.Unmarshal(rec.Data, &a); err != nil || a.Title != "Steins;Gate" {
+		t.Fatalf("unexpected anime %+v, %v", a, err)
+	}
+	if rec, err = r.Next(); err != nil || rec.Type != TypeEpisode {
+		t.Fatalf("unexpected record %+v, %v", rec, err)
+	}
+	if _, err := r.Next(); !errors.Is(err, io.EOF) {
+		t.Fatalf("expected EOF, got %v", err)
+	}
+}
+
+func TestNewReader_RejectsMissingHeader(t *testing.T) {
+	if _, err := NewReader(strings.NewReader(`{"type":"anime","data":{}}` + "\n")); err == nil {
+		t.Fatal("expected error for missing header")
+	}
+	if _, err := NewReader(strings.NewReader(`{"type":"header","data":{"version":99}}` + "\n")); err == nil {
+		t.Fatal("expected error for future version")
+	}
+	if _, err := NewReader(strings.NewReader("")); err == nil {
+		t.Fatal("expected error for empty input")
+	}
+}
+
+func TestNewReader_AcceptsVersion1(t *testing.T) {
+	r, err := NewReader(strings.NewReader(`{"type":"header","data":{"version":1}}` + "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Header.Version != 1 {
+		t.Fatalf("header version = %d", r.Header.Version)
+	}
+}
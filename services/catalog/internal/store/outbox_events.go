@@ -0,0 +1,132 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	catalogeventsv1 "github.com/example/anime-platform/gen/catalog/events/v1"
+)
+
+// eventJSON keeps proto field names (anime_id, not animeId) so JSON consumers that
+// predate the typed payloads still parse them.
+var eventJSON = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+
+func insertOutboxEvent(ctx context.Context, tx pgx.Tx, eventType string, payload proto.Message) error {
+	b, err := eventJSON.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx,
+		`INSERT INTO catalog_outbox (id, event_type, payload, schema) VALUES ($1,$2,$3,$4)`,
+		uuid.New(), eventType, b, string(proto.MessageName(payload)),
+	)
+	return err
+}
+
+const animeEventColumns = `id, title, title_english, title_japanese, image, type, status, total_episodes, score, genres, visibility, updated_at`
+
+func scanAnimeEvent(row pgx.Row) (*catalogeventsv1.AnimeUpserted, error) {
+	ev := &catalogeventsv1.AnimeUpserted{}
+	var genres []byte
+	var updatedAt time.Time
+	if err := row.Scan(&ev.AnimeId, &ev.Title, &ev.TitleEnglish, &ev.TitleJapanese, &ev.Image, &ev.Type, &ev.Status,
+		&ev.TotalEpisodes, &ev.Score, &genres, &ev.Visibility, &updatedAt); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(genres, &ev.Genres); err != nil {
+		return nil, fmt.Errorf("anime %s genres: %w", ev.AnimeId, err)
+	}
+	ev.UpdatedAtRfc3339 = updatedAt.UTC().Format(time.RFC3339)
+	return ev, nil
+}
+
+// emitAnimeUpserted records catalog.anime.upserted carrying the anime's current
+// fields, and a history version when the anime changed since the last one.
+func emitAnimeUpserted(ctx context.Context, tx pgx.Tx, animeID, reason string) error {
+	if err := recordAnimeVersion(ctx, tx, animeID, reason); err != nil {
+		return err
+	}
+	ev, err := scanAnimeEvent(tx.QueryRow(ctx, `SELECT `+animeEventColumns+` FROM anime WHERE id = $1::uuid`, animeID))
+	if err != nil {
+		return err
+	}
+	return insertOutboxEvent(ctx, tx, catalogEventAnimeUpserted, ev)
+}
+
+// emitEpisodesUpserted records one catalog.episode.upserted per episode, plus
+// history versions for the episodes that changed.
+func emitEpisodesUpserted(ctx context.Context, tx pgx.Tx, episodeIDs []string, reason string) error {
+	if len(episodeIDs) == 0 {
+		return nil
+	}
+	if err := recordEpisodeVersions(ctx, tx, episodeIDs, reason); err != nil {
+		return err
+	}
+	rows, err := tx.Query(ctx, `
+SELECT id, anime_id, number, title, aired_at, is_filler, is_recap, duration_seconds, visibility, updated_at
+FROM episodes WHERE id = ANY($1::uuid[])
+ORDER BY number`, episodeIDs)
+	if err != nil {
+		return err
+	}
+	var events []*catalogeventsv1.EpisodeUpserted
+	for rows.Next() {
+		ev := &catalogeventsv1.EpisodeUpserted{}
+		var airedAt *time.Time
+		var updatedAt time.Time
+		if err := rows.Scan(&ev.EpisodeId, &ev.AnimeId, &ev.Number, &ev.Title, &airedAt, &ev.IsFiller, &ev.IsRecap,
+			&ev.DurationSeconds, &ev.Visibility, &updatedAt); err != nil {
+			rows.Close()
+			return err
+		}
+		if airedAt != nil {
+			ev.AiredAtRfc3339 = airedAt.UTC().Format(time.RFC3339)
+		}
+		ev.UpdatedAtRfc3339 = updatedAt.UTC().Format(time.RFC3339)
+		events = append(events, ev)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	// Rows must be fully read before the connection can run the inserts.
+	for _, ev := range events {
+		if err := insertOutboxEvent(ctx, tx, catalogEventEpisodeUpserted, ev); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func emitExternalIDAttached(ctx context.Context, tx pgx.Tx, provider, externalID, animeID, episodeID string) error {
+	return insertOutboxEvent(ctx, tx, catalogEventExternalIDAttached, &catalogeventsv1.ExternalIDAttached{
+		Provider:   provider,
+		ExternalId: externalID,
+		AnimeId:    animeID,
+		EpisodeId:  episodeID,
+	})
+}
+
+// attachExternalAnimeID maps provider/externalID to animeID and reports whether
+// the mapping is new or now points at a different anime.
+func attachExternalAnimeID(ctx context.Context, tx pgx.Tx, provider, externalID string, animeID any) (bool, error) {
+	var changed bool
+	err := tx.QueryRow(ctx, `
+INSERT INTO external_anime_ids (provider, provider_anime_id, anime_id)
+VALUES ($1,$2,$3::uuid)
+ON CONFLICT (provider, provider_anime_id) DO UPDATE SET anime_id = EXCLUDED.anime_id
+  WHERE external_anime_ids.anime_id IS DISTINCT FROM EXCLUDED.anime_id
+RETURNING TRUE`, provider, externalID, animeID).Scan(&changed)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	return changed, err
+}
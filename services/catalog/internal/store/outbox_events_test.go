@@ -0,0 +1,66 @@
+package store
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	catalogeventsv1 "github.com/example/anime-platform/gen/catalog/events/v1"
+)
+
+// fakeRow scans fixed values into the destinations, in order.
+type fakeRow []any
+
+func (r fakeRow) Scan(dest ...any) error {
+	for i, d := range dest {
+		reflect.ValueOf(d).Elem().Set(reflect.ValueOf(r[i]))
+	}
+	return nil
+}
+
+func animeRow(genres string) fakeRow {
+	updated := time.Date(2024, 4, 1, 12, 0, 0, 0, time.UTC)
+	return fakeRow{"anime-1", "Title", "English", "日本語", "cover.jpg", "TV", "Airing",
+		int32(12), float32(8.5), []byte(genres), VisibilityVisible, updated}
+}
+
+func TestScanAnimeEvent_TypedPayload(t *testing.T) {
+	ev, err := scanAnimeEvent(animeRow(`["Action","Drama"]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := eventJSON.Marshal(ev)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Consumers that predate the typed payloads read proto field names.
+	var raw map[string]any
+	if err := json.Unmarshal(b, &raw); err != nil {
+		t.Fatal(err)
+	}
+	if raw["anime_id"] != "anime-1" || raw["updated_at_rfc3339"] != "2024-04-01T12:00:00Z" {
+		t.Fatalf("payload = %s", b)
+	}
+
+	var back catalogeventsv1.AnimeUpserted
+	if err := protojson.Unmarshal(b, &back); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(&back, ev) || len(back.GetGenres()) != 2 {
+		t.Fatalf("round trip = %v, want %v", &back, ev)
+	}
+	if got := string(proto.MessageName(ev)); got != "catalog.events.v1.AnimeUpserted" {
+		t.Fatalf("schema = %q", got)
+	}
+}
+
+func TestScanAnimeEvent_CorruptGenres(t *testing.T) {
+	if _, err := scanAnimeEvent(animeRow(`{"not":"a list"`)); err == nil {
+		t.Fatal("corrupt genres were dropped silently")
+	}
+}
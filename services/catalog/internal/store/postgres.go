@@ -13,9 +13,31 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+
+	catalogeventsv1 "github.com/example/anime-platform/gen/catalog/events/v1"
+	"github.com/example/anime-platform/internal/platform/geo"
+	"github.com/example/anime-platform/internal/platform/locale"
 )
 
-const catalogEventAnimeUpserted = "catalog.anime.upserted"
+const episodeColumns = `e.id, e.anime_id, e.number, e.title, e.aired_at, e.is_filler, e.is_recap, e.duration_seconds, e.synopsis, e.thumbnail`
+
+// availableSQL is a predicate over a row alias (anime or episodes) that is true when
+// the row is visible and allowed in the country placeholder. It follows geo.Allowed:
+// an unknown (empty) country only sees rows without an allow-list, and
+// geo.Anywhere skips regional rules.
+func availableSQL(alias, country string) string {
+	return fmt.Sprintf(`%[1]s.visibility = 'visible' AND (%[2]s = '%[3]s' OR (
+  (cardinality(%[1]s.allowed_countries) = 0 OR (%[2]s <> '' AND %[2]s = ANY(%[1]s.allowed_countries)))
+  AND NOT (%[2]s = ANY(%[1]s.blocked_countries))))`, alias, country, geo.Anywhere)
+}
+
+const (
+	catalogEventAnimeUpserted      = "catalog.anime.upserted"
+	catalogEventAnimeMerged        = "catalog.anime.merged"
+	catalogEventAnimeDeleted       = "catalog.anime.deleted"
+	catalogEventEpisodeUpserted    = "catalog.episode.upserted"
+	catalogEventExternalIDAttached = "catalog.external_id.attached"
+)
 
 // PostgresCatalogStore is the production Postgres-backed implementation.
 type PostgresCatalogStore struct {
@@ -28,13 +50,40 @@ func NewPostgresCatalogStore(db *pgxpool.Pool) *PostgresCatalogStore {
 
 // ── Anime reads ────────────────────────────────────────────────────────────
 
-func (s *PostgresCatalogStore) GetAnimeByIDs(ctx context.Context, ids []string) ([]Anime, error) {
+func (s *PostgresCatalogStore) GetAnimeByIDs(ctx context.Context, ids []string, country string, locales []string) ([]Anime, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
+	if locales == nil {
+		locales = []string{}
+	}
+	// Title and synopsis are resolved independently so a translation that only
+	// provides a title still falls back to the next locale's synopsis.
 	rows, err := s.db.Query(ctx, `
-SELECT id, title, title_english, title_japanese, image, description, genres, score, status, type, total_episodes
-FROM anime WHERE id = ANY($1::uuid[])`, ids)
+SELECT a.id, COALESCE(tt.title, a.title), a.title_english, a.title_japanese, a.image, COALESCE(ts.synopsis, a.description),
+       a.genres, a.score, a.status, a.type, a.total_episodes, COALESCE(tt.locale, ''),
+       `+genreTable.jsonSQL("a")+`,
+       `+themeTable.jsonSQL("a")+`,
+       `+demographicTable.jsonSQL("a")+`,
+       (SELECT i.variants FROM anime_images i WHERE i.anime_id = a.id AND i.status = 'ready'),
+       `+popularitySQL("p")+`,
+       `+providerMetadataSQL("a")+`
+FROM anime a
+LEFT JOIN anime_popularity p ON p.anime_id = a.id
+LEFT JOIN LATERAL (
+  SELECT t.title, t.locale FROM anime_translations t
+  WHERE t.anime_id = a.id AND t.title <> '' AND t.locale = ANY($3::text[])
+  ORDER BY array_position($3::text[], t.locale) LIMIT 1
+) tt ON TRUE
+LEFT JOIN LATERAL (
+  SELECT t.synopsis FROM anime_translations t
+  WHERE t.anime_id = a.id AND t.synopsis <> '' AND t.locale = ANY($3::text[])
+  ORDER BY array_position($3::text[], t.locale) LIMIT 1
+) ts ON TRUE
+WHERE a.id = ANY(
+  SELECT COALESCE(r.target_anime_id, i) FROM unnest($1::uuid[]) AS i
+  LEFT JOIN anime_redirects r ON r.source_anime_id = i
+) AND `+availableSQL("a", "$2::text"), ids, country, locales)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "db query")
 	}
@@ -43,18 +92,35 @@ FROM anime WHERE id = ANY($1::uuid[])`, ids)
 	var out []Anime
 	for rows.Next() {
 		var a Anime
-		var genresJSON []byte
-		if err := rows.Scan(&a.ID, &a.Title, &a.TitleEnglish, &a.TitleJapanese, &a.Image, &a.Description, &genresJSON, &a.Score, &a.Status, &a.Type, &a.TotalEpisodes); err != nil {
+		var genresJSON, genreTagsJSON, themesJSON, demographicsJSON, variantsJSON, metadataJSON []byte
+		if err := rows.Scan(&a.ID, &a.Title, &a.TitleEnglish, &a.TitleJapanese, &a.Image, &a.Description, &genresJSON, &a.Score, &a.Status, &a.Type, &a.TotalEpisodes, &a.Locale,
+			&genreTagsJSON, &themesJSON, &demographicsJSON, &variantsJSON,
+			&a.Popularity.Score24h, &a.Popularity.Score7d, &a.Popularity.Score30d, &metadataJSON); err != nil {
 			return nil, status.Error(codes.Internal, "db scan")
 		}
-		_ = json.Unmarshal(genresJSON, &a.Genres)
+		if a.Locale == "" {
+			a.Locale = locale.Default
+		}
+		a.GenreTags = decodeGenres(genreTagsJSON)
+		a.Themes = decodeGenres(themesJSON)
+		a.Demographics = decodeGenres(demographicsJSON)
+		a.ImageVariants = decodeImageVariants(variantsJSON)
+		applyProviderMetadata(&a, metadataJSON)
+		if len(a.GenreTags) > 0 {
+			a.Genres = make([]string, 0, len(a.GenreTags))
+			for _, g := range a.GenreTags {
+				a.Genres = append(a.Genres, g.Name)
+			}
+		} else {
+			_ = json.Unmarshal(genresJSON, &a.Genres)
+		}
 		out = append(out, a)
 	}
 	return out, nil
 }
 
 func (s *PostgresCatalogStore) GetAllAnimeIDs(ctx context.Context) ([]string, error) {
-	rows, err := s.db.Query(ctx, `SELECT id FROM anime ORDER BY updated_at DESC`)
+	rows, err := s.db.Query(ctx, `SELECT id FROM anime WHERE visibility = 'visible' ORDER BY updated_at DESC`)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "db query")
 	}
@@ -89,14 +155,24 @@ func (s *PostgresCatalogStore) ResolveAnimeIDByExternalID(ctx context.Context, p
 // ── Anime writes ───────────────────────────────────────────────────────────
 
 func (s *PostgresCatalogStore) AttachExternalAnimeID(ctx context.Context, provider, externalID, animeID string) error {
-	_, err := s.db.Exec(ctx, `
-INSERT INTO external_anime_ids (provider, provider_anime_id, anime_id)
-VALUES ($1,$2,$3::uuid)
-ON CONFLICT (provider, provider_anime_id) DO UPDATE SET anime_id = EXCLUDED.anime_id`,
-		provider, externalID, animeID)
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return status.Error(codes.Internal, "db begin")
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	changed, err := attachExternalAnimeID(ctx, tx, provider, externalID, animeID)
 	if err != nil {
 		return status.Error(codes.Internal, "db")
 	}
+	if changed {
+		if err := emitExternalIDAttached(ctx, tx, provider, externalID, animeID, ""); err != nil {
+			return status.Error(codes.Internal, "db outbox")
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return status.Error(codes.Internal, "db commit")
+	}
 	return nil
 }
 
@@ -134,6 +210,9 @@ VALUES ($1,$2,$3,$4,$5,$6,$7,$8,'unknown',$9,$10,'',$11,$12,$13,$14)`,
 		); err != nil {
 			return "", status.Error(codes.Internal, "db")
 		}
+		if err := emitExternalIDAttached(ctx, tx, "mal", externalID, animeID.String(), ""); err != nil {
+			return "", status.Error(codes.Internal, "db outbox")
+		}
 	} else {
 		if _, err := tx.Exec(ctx, `
 UPDATE anime
@@ -146,7 +225,19 @@ WHERE id=$1`,
 		}
 	}
 
-	if err := insertOutboxEvent(ctx, tx, map[string]any{"anime_id": animeID.String()}); err != nil {
+	if err := upsertJikanTaxonomy(ctx, tx, animeID, a); err != nil {
+		return "", status.Error(codes.Internal, "db taxonomy")
+	}
+	if len(a.Relations) > 0 {
+		if err := replaceAnimeRelations(ctx, tx, animeID, "mal", a.Relations); err != nil {
+			return "", status.Error(codes.Internal, "db relations")
+		}
+	}
+	if err := enqueueAnimeImage(ctx, tx, animeID, a.Image); err != nil {
+		return "", status.Error(codes.Internal, "db image")
+	}
+
+	if err := emitAnimeUpserted(ctx, tx, animeID.String(), HistoryReasonJikanSync); err != nil {
 		return "", status.Error(codes.Internal, "db outbox")
 	}
 	if err := tx.Commit(ctx); err != nil {
@@ -155,12 +246,299 @@ WHERE id=$1`,
 	return animeID.String(), nil
 }
 
+// MergeAnime folds source into target: external IDs, episodes, translations and
+// taxonomy move to the target (episodes sharing a number with a target episode
+// are collapsed into it), the source row is deleted and a redirect is recorded
+// in its place.
+func (s *PostgresCatalogStore) MergeAnime(ctx context.Context, sourceID, targetID string) (MergeResult, error) {
+	src, err := uuid.Parse(strings.TrimSpace(sourceID))
+	if err != nil {
+		return MergeResult{}, status.Error(codes.InvalidArgument, "invalid source_anime_id")
+	}
+	dst, err := uuid.Parse(strings.TrimSpace(targetID))
+	if err != nil {
+		return MergeResult{}, status.Error(codes.InvalidArgument, "invalid target_anime_id")
+	}
+	if src == dst {
+		return MergeResult{}, status.Error(codes.InvalidArgument, "source and target must differ")
+	}
+
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return MergeResult{}, status.Error(codes.Internal, "db begin")
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	res, err := mergeAnimeTx(ctx, tx, src, dst)
+	if err != nil {
+		return MergeResult{}, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return MergeResult{}, status.Error(codes.Internal, "db commit")
+	}
+	return res, nil
+}
+
+// mergeAnimeTx is MergeAnime within tx, for callers that merge as part of a
+// larger change.
+func mergeAnimeTx(ctx context.Context, tx pgx.Tx, src, dst uuid.UUID) (MergeResult, error) {
+	now := time.Now().UTC()
+
+	var locked int
+	if err := tx.QueryRow(ctx,
+		`SELECT count(*) FROM (SELECT id FROM anime WHERE id = ANY($1::uuid[]) FOR UPDATE) l`,
+		[]uuid.UUID{src, dst},
+	).Scan(&locked); err != nil {
+		return MergeResult{}, status.Error(codes.Internal, "db")
+	}
+	if locked != 2 {
+		return MergeResult{}, status.Error(codes.NotFound, "anime not found")
+	}
+
+	res := MergeResult{TargetAnimeID: dst.String(), EpisodeIDMap: map[string]string{}}
+
+	targetByNumber := map[int32]uuid.UUID{}
+	rows, err := tx.Query(ctx, `SELECT id, number FROM episodes WHERE anime_id=$1`, dst)
+	if err != nil {
+		return MergeResult{}, status.Error(codes.Internal, "db query")
+	}
+	for rows.Next() {
+		var id uuid.UUID
+		var n int32
+		if err := rows.Scan(&id, &n); err != nil {
+			rows.Close()
+			return MergeResult{}, status.Error(codes.Internal, "db scan")
+		}
+		targetByNumber[n] = id
+	}
+	rows.Close()
+
+	type srcEpisode struct {
+		id     uuid.UUID
+		number int32
+	}
+	var srcEpisodes []srcEpisode
+	rows, err = tx.Query(ctx, `SELECT id, number FROM episodes WHERE anime_id=$1`, src)
+	if err != nil {
+		return MergeResult{}, status.Error(codes.Internal, "db query")
+	}
+	for rows.Next() {
+		var ep srcEpisode
+		if err := rows.Scan(&ep.id, &ep.number); err != nil {
+			rows.Close()
+			return MergeResult{}, status.Error(codes.Internal, "db scan")
+		}
+		srcEpisodes = append(srcEpisodes, ep)
+	}
+	rows.Close()
+
+	var movedIDs []string
+	for _, ep := range srcEpisodes {
+		if existing, ok := targetByNumber[ep.number]; ok {
+			// An episode keeps one mapping per provider: where the target
+			// episode already has one, the source's mappings for that provider
+			// are dropped rather than left to compete with it.
+			if _, err := tx.Exec(ctx, `
+DELETE FROM external_episode_ids s
+WHERE s.episode_id=$1 AND EXISTS (
+  SELECT 1 FROM external_episode_ids t WHERE t.episode_id=$2 AND t.provider=s.provider)`,
+				ep.id, existing,
+			); err != nil {
+				return MergeResult{}, status.Error(codes.Internal, "db")
+			}
+			if _, err := tx.Exec(ctx,
+				`UPDATE external_episode_ids SET episode_id=$2, updated_at=$3 WHERE episode_id=$1`, ep.id, existing, now,
+			); err != nil {
+				return MergeResult{}, status.Error(codes.Internal, "db")
+			}
+			// Skip segments the target episode already has for the same source
+			// win; the rest move and keep their votes.
+			if _, err := tx.Exec(ctx, `
+UPDATE episode_skip_segments s SET episode_id=$2, updated_at=$3
+WHERE s.episode_id=$1 AND NOT EXISTS (
+  SELECT 1 FROM episode_skip_segments t
+  WHERE t.episode_id=$2 AND t.kind=s.kind AND t.source=s.source AND t.submitted_by=s.submitted_by)`,
+				ep.id, existing, now,
+			); err != nil {
+				return MergeResult{}, status.Error(codes.Internal, "db")
+			}
+			if _, err := tx.Exec(ctx, `DELETE FROM episodes WHERE id=$1`, ep.id); err != nil {
+				return MergeResult{}, status.Error(codes.Internal, "db")
+			}
+			res.EpisodeIDMap[ep.id.String()] = existing.String()
+			res.MergedEpisodes++
+			continue
+		}
+		if _, err := tx.Exec(ctx,
+			`UPDATE episodes SET anime_id=$2, updated_at=$3 WHERE id=$1`, ep.id, dst, now,
+		); err != nil {
+			return MergeResult{}, status.Error(codes.Internal, "db")
+		}
+		movedIDs = append(movedIDs, ep.id.String())
+		res.MovedEpisodes++
+	}
+
+	tag, err := tx.Exec(ctx, `UPDATE external_anime_ids SET anime_id=$2 WHERE anime_id=$1`, src, dst)
+	if err != nil {
+		return MergeResult{}, status.Error(codes.Internal, "db")
+	}
+	res.MovedExternalIDs = int32(tag.RowsAffected())
+
+	// Keep redirect chains one hop long: anything that pointed at the source now points at the target.
+	if _, err := tx.Exec(ctx, `UPDATE anime_redirects SET target_anime_id=$2 WHERE target_anime_id=$1`, src, dst); err != nil {
+		return MergeResult{}, status.Error(codes.Internal, "db")
+	}
+	if err := mergePopularity(ctx, tx, src, dst); err != nil {
+		return MergeResult{}, status.Error(codes.Internal, "db")
+	}
+	if err := mergeFranchiseData(ctx, tx, src, dst); err != nil {
+		return MergeResult{}, status.Error(codes.Internal, "db")
+	}
+	if err := mergeProviderMetadata(ctx, tx, src, dst); err != nil {
+		return MergeResult{}, status.Error(codes.Internal, "db")
+	}
+	if err := mergeTranslations(ctx, tx, src, dst); err != nil {
+		return MergeResult{}, status.Error(codes.Internal, "db")
+	}
+	if err := mergeAnimeTaxonomy(ctx, tx, src, dst); err != nil {
+		return MergeResult{}, status.Error(codes.Internal, "db")
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM anime WHERE id=$1`, src); err != nil {
+		return MergeResult{}, status.Error(codes.Internal, "db")
+	}
+	if _, err := tx.Exec(ctx, `
+INSERT INTO anime_redirects (source_anime_id, target_anime_id, merged_at) VALUES ($1,$2,$3)
+ON CONFLICT (source_anime_id) DO UPDATE SET target_anime_id = EXCLUDED.target_anime_id, merged_at = EXCLUDED.merged_at`,
+		src, dst, now,
+	); err != nil {
+		return MergeResult{}, status.Error(codes.Internal, "db")
+	}
+	if _, err := tx.Exec(ctx, `UPDATE anime SET updated_at=$2 WHERE id=$1`, dst, now); err != nil {
+		return MergeResult{}, status.Error(codes.Internal, "db")
+	}
+
+	if err := insertOutboxEvent(ctx, tx, catalogEventAnimeMerged, &catalogeventsv1.AnimeMerged{
+		SourceAnimeId: src.String(),
+		TargetAnimeId: dst.String(),
+		EpisodeIdMap:  res.EpisodeIDMap,
+	}); err != nil {
+		return MergeResult{}, status.Error(codes.Internal, "db outbox")
+	}
+	if err := insertOutboxEvent(ctx, tx, catalogEventAnimeDeleted, &catalogeventsv1.AnimeDeleted{
+		AnimeId:           src.String(),
+		Reason:            "merged",
+		MergedIntoAnimeId: dst.String(),
+	}); err != nil {
+		return MergeResult{}, status.Error(codes.Internal, "db outbox")
+	}
+	if err := emitEpisodesUpserted(ctx, tx, movedIDs, HistoryReasonMerge); err != nil {
+		return MergeResult{}, status.Error(codes.Internal, "db outbox")
+	}
+	if err := emitAnimeUpserted(ctx, tx, dst.String(), HistoryReasonMerge); err != nil {
+		return MergeResult{}, status.Error(codes.Internal, "db outbox")
+	}
+	return res, nil
+}
+
+// SetAnimeAvailability changes visibility and regional rules. The upserted event lets
+// the search indexer drop or restore the document.
+func (s *PostgresCatalogStore) SetAnimeAvailability(ctx context.Context, animeID string, a Availability) error {
+	id, err := uuid.Parse(strings.TrimSpace(animeID))
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid anime_id")
+	}
+
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return status.Error(codes.Internal, "db begin")
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	tag, err := tx.Exec(ctx, `
+UPDATE anime SET visibility=$2, visibility_reason=$3, allowed_countries=$4, blocked_countries=$5, updated_at=now()
+WHERE id=$1`,
+		id, a.Visibility, a.Reason, a.AllowedCountries, a.BlockedCountries,
+	)
+	if err != nil {
+		return status.Error(codes.Internal, "db")
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Error(codes.NotFound, "anime not found")
+	}
+
+	if err := emitAnimeUpserted(ctx, tx, id.String(), HistoryReasonAvailability); err != nil {
+		return status.Error(codes.Internal, "db outbox")
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return status.Error(codes.Internal, "db commit")
+	}
+	return nil
+}
+
+func (s *PostgresCatalogStore) UpsertAnimeTranslation(ctx context.Context, animeID, loc, title, synopsis string) error {
+	id, err := uuid.Parse(strings.TrimSpace(animeID))
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid anime_id")
+	}
+
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return status.Error(codes.Internal, "db begin")
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var exists bool
+	if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM anime WHERE id=$1)`, id).Scan(&exists); err != nil {
+		return status.Error(codes.Internal, "db")
+	}
+	if !exists {
+		return status.Error(codes.NotFound, "anime not found")
+	}
+
+	if _, err := tx.Exec(ctx, `
+INSERT INTO anime_translations (anime_id, locale, title, synopsis, updated_at)
+VALUES ($1,$2,$3,$4,now())
+ON CONFLICT (anime_id, locale) DO UPDATE SET
+  title = EXCLUDED.title,
+  synopsis = EXCLUDED.synopsis,
+  updated_at = EXCLUDED.updated_at`,
+		id, loc, title, synopsis,
+	); err != nil {
+		return status.Error(codes.Internal, "db")
+	}
+
+	if err := emitAnimeUpserted(ctx, tx, id.String(), HistoryReasonTranslation); err != nil {
+		return status.Error(codes.Internal, "db outbox")
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return status.Error(codes.Internal, "db commit")
+	}
+	return nil
+}
+
+// mergeTranslations copies the source's translations to the target. Where both
+// have a locale, the target's text wins and only its empty fields are filled.
+func mergeTranslations(ctx context.Context, tx pgx.Tx, src, dst uuid.UUID) error {
+	_, err := tx.Exec(ctx, `
+INSERT INTO anime_translations (anime_id, locale, title, synopsis, updated_at)
+SELECT $2, locale, title, synopsis, updated_at FROM anime_translations WHERE anime_id=$1
+ON CONFLICT (anime_id, locale) DO UPDATE SET
+  title = CASE WHEN anime_translations.title = '' THEN EXCLUDED.title ELSE anime_translations.title END,
+  synopsis = CASE WHEN anime_translations.synopsis = '' THEN EXCLUDED.synopsis ELSE anime_translations.synopsis END,
+  updated_at = GREATEST(anime_translations.updated_at, EXCLUDED.updated_at)`, src, dst)
+	return err
+}
+
 // ── Episode reads ──────────────────────────────────────────────────────────
 
-func (s *PostgresCatalogStore) GetEpisodesByAnimeID(ctx context.Context, animeID string) ([]Episode, error) {
+func (s *PostgresCatalogStore) GetEpisodesByAnimeID(ctx context.Context, animeID, country string) ([]Episode, error) {
 	rows, err := s.db.Query(ctx, `
-SELECT id, anime_id, number, title, aired_at
-FROM episodes WHERE anime_id=$1::uuid ORDER BY number ASC`, animeID)
+SELECT `+episodeColumns+`
+FROM episodes e JOIN anime a ON a.id = e.anime_id
+WHERE e.anime_id = COALESCE((SELECT target_anime_id FROM anime_redirects WHERE source_anime_id=$1::uuid), $1::uuid)
+  AND `+availableSQL("a", "$2::text")+`
+  AND `+availableSQL("e", "$2::text")+`
+ORDER BY e.number ASC`, animeID, country)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "db query")
 	}
@@ -168,13 +546,36 @@ FROM episodes WHERE anime_id=$1::uuid ORDER BY number ASC`, animeID)
 	return scanEpisodes(rows)
 }
 
-func (s *PostgresCatalogStore) GetEpisodesByIDs(ctx context.Context, ids []string) ([]Episode, error) {
+func (s *PostgresCatalogStore) GetEpisodesByAnimeIDs(ctx context.Context, animeIDs []string, country string) ([]Episode, error) {
+	if len(animeIDs) == 0 {
+		return nil, nil
+	}
+	rows, err := s.db.Query(ctx, `
+SELECT `+episodeColumns+`
+FROM episodes e JOIN anime a ON a.id = e.anime_id
+WHERE e.anime_id IN (
+  SELECT COALESCE(r.target_anime_id, x.id) FROM unnest($1::uuid[]) AS x(id)
+  LEFT JOIN anime_redirects r ON r.source_anime_id = x.id)
+  AND `+availableSQL("a", "$2::text")+`
+  AND `+availableSQL("e", "$2::text")+`
+ORDER BY e.anime_id, e.number ASC`, animeIDs, country)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db query")
+	}
+	defer rows.Close()
+	return scanEpisodes(rows)
+}
+
+func (s *PostgresCatalogStore) GetEpisodesByIDs(ctx context.Context, ids []string, country string) ([]Episode, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
 	rows, err := s.db.Query(ctx, `
-SELECT id, anime_id, number, title, aired_at
-FROM episodes WHERE id = ANY($1::uuid[])`, ids)
+SELECT `+episodeColumns+`
+FROM episodes e JOIN anime a ON a.id = e.anime_id
+WHERE e.id = ANY($1::uuid[])
+  AND `+availableSQL("a", "$2::text")+`
+  AND `+availableSQL("e", "$2::text"), ids, country)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "db query")
 	}
@@ -182,6 +583,36 @@ FROM episodes WHERE id = ANY($1::uuid[])`, ids)
 	return scanEpisodes(rows)
 }
 
+func (s *PostgresCatalogStore) GetEpisodeAvailability(ctx context.Context, episodeID, country string) (bool, string, error) {
+	id, err := uuid.Parse(strings.TrimSpace(episodeID))
+	if err != nil {
+		return false, "", status.Error(codes.InvalidArgument, "invalid episode_id")
+	}
+	var anime, ep Availability
+	err = s.db.QueryRow(ctx, `
+SELECT a.visibility, a.allowed_countries, a.blocked_countries, e.visibility, e.allowed_countries, e.blocked_countries
+FROM episodes e JOIN anime a ON a.id = e.anime_id
+WHERE e.id = $1`, id,
+	).Scan(&anime.Visibility, &anime.AllowedCountries, &anime.BlockedCountries, &ep.Visibility, &ep.AllowedCountries, &ep.BlockedCountries)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, "", status.Error(codes.NotFound, "episode not found")
+		}
+		return false, "", status.Error(codes.Internal, "db query")
+	}
+	for _, a := range []Availability{anime, ep} {
+		if a.Visibility != VisibilityVisible {
+			return false, a.Visibility, nil
+		}
+	}
+	for _, a := range []Availability{anime, ep} {
+		if !geo.Allowed(country, a.AllowedCountries, a.BlockedCountries) {
+			return false, "region", nil
+		}
+	}
+	return true, "", nil
+}
+
 func (s *PostgresCatalogStore) GetProviderEpisodeID(ctx context.Context, episodeID, provider string) (string, error) {
 	var providerEpisodeID string
 	err := s.db.QueryRow(ctx,
@@ -197,9 +628,107 @@ func (s *PostgresCatalogStore) GetProviderEpisodeID(ctx context.Context, episode
 	return providerEpisodeID, nil
 }
 
+func (s *PostgresCatalogStore) ListEpisodeProviders(ctx context.Context, episodeID string) ([]EpisodeProvider, error) {
+	id, err := uuid.Parse(strings.TrimSpace(episodeID))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid episode_id")
+	}
+	rows, err := s.db.Query(ctx, `
+SELECT provider, provider_episode_id, has_sub, has_dub
+FROM external_episode_ids WHERE episode_id=$1
+ORDER BY provider ASC, provider_episode_id ASC`, id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db query")
+	}
+	defer rows.Close()
+
+	var out []EpisodeProvider
+	for rows.Next() {
+		var p EpisodeProvider
+		if err := rows.Scan(&p.Provider, &p.ProviderEpisodeID, &p.HasSub, &p.HasDub); err != nil {
+			return nil, status.Error(codes.Internal, "db scan")
+		}
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+func (s *PostgresCatalogStore) ListProviderEpisodes(ctx context.Context, provider, animeID string) ([]ProviderEpisodeState, error) {
+	id, err := uuid.Parse(strings.TrimSpace(animeID))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid anime_id")
+	}
+	rows, err := s.db.Query(ctx, `
+SELECT e.id::text, x.provider_episode_id, e.number, e.title, e.is_filler, x.has_sub, x.has_dub
+FROM external_episode_ids x JOIN episodes e ON e.id = x.episode_id
+WHERE x.provider=$1
+  AND e.anime_id = COALESCE((SELECT target_anime_id FROM anime_redirects WHERE source_anime_id=$2), $2)
+ORDER BY e.number ASC, x.provider_episode_id ASC`, provider, id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db query")
+	}
+	defer rows.Close()
+
+	var out []ProviderEpisodeState
+	for rows.Next() {
+		var p ProviderEpisodeState
+		if err := rows.Scan(&p.EpisodeID, &p.ProviderEpisodeID, &p.Number, &p.Title, &p.IsFiller, &p.HasSub, &p.HasDub); err != nil {
+			return nil, status.Error(codes.Internal, "db scan")
+		}
+		out = append(out, p)
+	}
+	if rows.Err() != nil {
+		return nil, status.Error(codes.Internal, "db query")
+	}
+	return out, nil
+}
+
 // ── Episode writes ─────────────────────────────────────────────────────────
 
-func (s *PostgresCatalogStore) UpsertHiAnimeEpisodes(ctx context.Context, animeID, slug string, episodes []EpisodeInput) ([]string, error) {
+func (s *PostgresCatalogStore) SetEpisodeAvailability(ctx context.Context, episodeID string, a Availability) error {
+	id, err := uuid.Parse(strings.TrimSpace(episodeID))
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid episode_id")
+	}
+
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return status.Error(codes.Internal, "db begin")
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var animeID uuid.UUID
+	err = tx.QueryRow(ctx, `
+UPDATE episodes SET visibility=$2, visibility_reason=$3, allowed_countries=$4, blocked_countries=$5, updated_at=now()
+WHERE id=$1 RETURNING anime_id`,
+		id, a.Visibility, a.Reason, a.AllowedCountries, a.BlockedCountries,
+	).Scan(&animeID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return status.Error(codes.NotFound, "episode not found")
+		}
+		return status.Error(codes.Internal, "db")
+	}
+
+	if err := emitEpisodesUpserted(ctx, tx, []string{id.String()}, HistoryReasonAvailability); err != nil {
+		return status.Error(codes.Internal, "db outbox")
+	}
+	if err := emitAnimeUpserted(ctx, tx, animeID.String(), HistoryReasonAvailability); err != nil {
+		return status.Error(codes.Internal, "db outbox")
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return status.Error(codes.Internal, "db commit")
+	}
+	return nil
+}
+
+// UpsertProviderEpisodes records a provider's episode list for an anime, creating
+// catalog episodes for numbers nobody has seen yet and refreshing sub/dub availability.
+func (s *PostgresCatalogStore) UpsertProviderEpisodes(ctx context.Context, provider, animeID, providerAnimeID string, episodes []EpisodeInput) ([]string, error) {
+	provider = strings.TrimSpace(provider)
+	if provider == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider is required")
+	}
 	id, err := uuid.Parse(strings.TrimSpace(animeID))
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid anime_id")
@@ -212,21 +741,84 @@ func (s *PostgresCatalogStore) UpsertHiAnimeEpisodes(ctx context.Context, animeI
 	}
 	defer func() { _ = tx.Rollback(ctx) }()
 
-	if _, err := tx.Exec(ctx, `
-INSERT INTO external_anime_ids (provider, provider_anime_id, anime_id)
-VALUES ('hianime',$1,$2)
-ON CONFLICT (provider, provider_anime_id) DO UPDATE SET anime_id = EXCLUDED.anime_id`,
-		slug, id,
-	); err != nil {
-		return nil, status.Error(codes.Internal, "db")
+	if providerAnimeID = strings.TrimSpace(providerAnimeID); providerAnimeID != "" {
+		changed, err := attachExternalAnimeID(ctx, tx, provider, providerAnimeID, id)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "db")
+		}
+		if changed {
+			if err := emitExternalIDAttached(ctx, tx, provider, providerAnimeID, id.String(), ""); err != nil {
+				return nil, status.Error(codes.Internal, "db outbox")
+			}
+		}
 	}
 
-	episodeIDs, err := upsertEpisodes(ctx, tx, "hianime", id, episodes, now)
+	episodeIDs, err := upsertEpisodes(ctx, tx, provider, id, episodes, now)
 	if err != nil {
 		return nil, err
 	}
+	if err := emitEpisodesUpserted(ctx, tx, episodeIDs, HistoryReasonEpisodeSync); err != nil {
+		return nil, status.Error(codes.Internal, "db outbox")
+	}
+
+	if err := emitAnimeUpserted(ctx, tx, animeID, HistoryReasonEpisodeSync); err != nil {
+		return nil, status.Error(codes.Internal, "db outbox")
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, status.Error(codes.Internal, "db commit")
+	}
+	return episodeIDs, nil
+}
+
+func (s *PostgresCatalogStore) RemoveProviderEpisodes(ctx context.Context, provider, animeID string, providerEpisodeIDs []string) ([]string, error) {
+	provider = strings.TrimSpace(provider)
+	if provider == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider is required")
+	}
+	id, err := uuid.Parse(strings.TrimSpace(animeID))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid anime_id")
+	}
+	if len(providerEpisodeIDs) == 0 {
+		return nil, nil
+	}
+
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db begin")
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	rows, err := tx.Query(ctx, `
+DELETE FROM external_episode_ids x USING episodes e
+WHERE e.id = x.episode_id AND x.provider=$1
+  AND e.anime_id = COALESCE((SELECT target_anime_id FROM anime_redirects WHERE source_anime_id=$2), $2)
+  AND x.provider_episode_id = ANY($3::text[])
+RETURNING x.episode_id::text`, provider, id, providerEpisodeIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db")
+	}
+	var episodeIDs []string
+	for rows.Next() {
+		var epID string
+		if err := rows.Scan(&epID); err != nil {
+			rows.Close()
+			return nil, status.Error(codes.Internal, "db scan")
+		}
+		episodeIDs = append(episodeIDs, epID)
+	}
+	rows.Close()
+	if rows.Err() != nil {
+		return nil, status.Error(codes.Internal, "db")
+	}
+	if len(episodeIDs) == 0 {
+		return nil, nil
+	}
 
-	if err := insertOutboxEvent(ctx, tx, map[string]any{"anime_id": animeID}); err != nil {
+	if err := emitEpisodesUpserted(ctx, tx, episodeIDs, HistoryReasonEpisodeSync); err != nil {
+		return nil, status.Error(codes.Internal, "db outbox")
+	}
+	if err := emitAnimeUpserted(ctx, tx, id.String(), HistoryReasonEpisodeSync); err != nil {
 		return nil, status.Error(codes.Internal, "db outbox")
 	}
 	if err := tx.Commit(ctx); err != nil {
@@ -235,13 +827,77 @@ ON CONFLICT (provider, provider_anime_id) DO UPDATE SET anime_id = EXCLUDED.anim
 	return episodeIDs, nil
 }
 
+// UpsertJikanEpisodes merges Jikan metadata into the anime's episodes by number.
+// Episodes that no provider has created yet are inserted so their metadata is
+// available; provider syncs later attach to them by number. Provider titles win
+// over Jikan titles, Jikan wins for air date and filler/recap flags.
+func (s *PostgresCatalogStore) UpsertJikanEpisodes(ctx context.Context, animeID string, episodes []JikanEpisodeInput) ([]string, error) {
+	id, err := uuid.Parse(strings.TrimSpace(animeID))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid anime_id")
+	}
+	now := time.Now().UTC()
+
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db begin")
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var exists bool
+	if err := tx.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM anime WHERE id=$1)`, id).Scan(&exists); err != nil {
+		return nil, status.Error(codes.Internal, "db")
+	}
+	if !exists {
+		return nil, status.Error(codes.NotFound, "anime not found")
+	}
+
+	ids := make([]string, 0, len(episodes))
+	for _, ep := range episodes {
+		if ep.Number <= 0 {
+			continue
+		}
+		var epID uuid.UUID
+		if err := tx.QueryRow(ctx, `
+INSERT INTO episodes (id, anime_id, number, title, url, aired_at, is_filler, is_recap, duration_seconds, synopsis, thumbnail, updated_at)
+VALUES ($1,$2,$3,$4,'',$5,$6,$7,$8,$9,$10,$11)
+ON CONFLICT (anime_id, number) DO UPDATE SET
+  title = CASE WHEN episodes.title = '' THEN EXCLUDED.title ELSE episodes.title END,
+  aired_at = COALESCE(EXCLUDED.aired_at, episodes.aired_at),
+  is_filler = EXCLUDED.is_filler,
+  is_recap = EXCLUDED.is_recap,
+  duration_seconds = CASE WHEN EXCLUDED.duration_seconds > 0 THEN EXCLUDED.duration_seconds ELSE episodes.duration_seconds END,
+  synopsis = CASE WHEN EXCLUDED.synopsis <> '' THEN EXCLUDED.synopsis ELSE episodes.synopsis END,
+  thumbnail = CASE WHEN EXCLUDED.thumbnail <> '' THEN EXCLUDED.thumbnail ELSE episodes.thumbnail END,
+  updated_at = EXCLUDED.updated_at
+RETURNING id`,
+			uuid.New(), id, ep.Number, ep.Title, ep.AiredAt, ep.IsFiller, ep.IsRecap,
+			ep.DurationSeconds, ep.Synopsis, ep.Thumbnail, now,
+		).Scan(&epID); err != nil {
+			return nil, status.Error(codes.Internal, "db")
+		}
+		ids = append(ids, epID.String())
+	}
+
+	if err := emitEpisodesUpserted(ctx, tx, ids, HistoryReasonEpisodeSync); err != nil {
+		return nil, status.Error(codes.Internal, "db outbox")
+	}
+	if err := emitAnimeUpserted(ctx, tx, id.String(), HistoryReasonEpisodeSync); err != nil {
+		return nil, status.Error(codes.Internal, "db outbox")
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, status.Error(codes.Internal, "db commit")
+	}
+	return ids, nil
+}
+
 // ── helpers ────────────────────────────────────────────────────────────────
 
 func scanEpisodes(rows pgx.Rows) ([]Episode, error) {
 	var out []Episode
 	for rows.Next() {
 		var ep Episode
-		if err := rows.Scan(&ep.ID, &ep.AnimeID, &ep.Number, &ep.Title, &ep.AiredAt); err != nil {
+		if err := rows.Scan(&ep.ID, &ep.AnimeID, &ep.Number, &ep.Title, &ep.AiredAt, &ep.IsFiller, &ep.IsRecap, &ep.DurationSeconds, &ep.Synopsis, &ep.Thumbnail); err != nil {
 			return nil, status.Error(codes.Internal, "db scan")
 		}
 		out = append(out, ep)
@@ -249,18 +905,6 @@ func scanEpisodes(rows pgx.Rows) ([]Episode, error) {
 	return out, nil
 }
 
-func insertOutboxEvent(ctx context.Context, tx pgx.Tx, payload any) error {
-	b, err := json.Marshal(payload)
-	if err != nil {
-		return err
-	}
-	_, err = tx.Exec(ctx,
-		`INSERT INTO catalog_outbox (id, event_type, payload) VALUES ($1,$2,$3)`,
-		uuid.New(), catalogEventAnimeUpserted, b,
-	)
-	return err
-}
-
 func upsertEpisodes(ctx context.Context, tx pgx.Tx, provider string, animeID uuid.UUID, episodes []EpisodeInput, now time.Time) ([]string, error) {
 	ids := make([]string, 0, len(episodes))
 	for _, ep := range episodes {
@@ -278,20 +922,40 @@ func upsertEpisodes(ctx context.Context, tx pgx.Tx, provider string, animeID uui
 			if !errors.Is(err, pgx.ErrNoRows) {
 				return nil, status.Error(codes.Internal, "db")
 			}
-			epID = uuid.New()
-			if err := writeEpisode(ctx, tx, epID, animeID, ep, now, true); err != nil {
+			// No mapping yet: reuse an episode another source already created for this number.
+			insert := false
+			err = tx.QueryRow(ctx,
+				`SELECT id FROM episodes WHERE anime_id=$1 AND number=$2`, animeID, ep.Number,
+			).Scan(&epID)
+			if err != nil {
+				if !errors.Is(err, pgx.ErrNoRows) {
+					return nil, status.Error(codes.Internal, "db")
+				}
+				epID = uuid.New()
+				insert = true
+			}
+			if err := writeEpisode(ctx, tx, epID, animeID, ep, now, insert); err != nil {
 				return nil, err
 			}
 			if _, err := tx.Exec(ctx,
-				`INSERT INTO external_episode_ids (provider, provider_episode_id, episode_id) VALUES ($1,$2,$3)`,
-				provider, ep.ProviderEpisodeID, epID,
+				`INSERT INTO external_episode_ids (provider, provider_episode_id, episode_id, has_sub, has_dub, updated_at) VALUES ($1,$2,$3,$4,$5,$6)`,
+				provider, ep.ProviderEpisodeID, epID, ep.HasSub, ep.HasDub, now,
 			); err != nil {
 				return nil, status.Error(codes.Internal, "db")
 			}
+			if err := emitExternalIDAttached(ctx, tx, provider, ep.ProviderEpisodeID, animeID.String(), epID.String()); err != nil {
+				return nil, status.Error(codes.Internal, "db outbox")
+			}
 		} else {
 			if err := writeEpisode(ctx, tx, epID, animeID, ep, now, false); err != nil {
 				return nil, err
 			}
+			if _, err := tx.Exec(ctx,
+				`UPDATE external_episode_ids SET has_sub=$3, has_dub=$4, updated_at=$5 WHERE provider=$1 AND provider_episode_id=$2`,
+				provider, ep.ProviderEpisodeID, ep.HasSub, ep.HasDub, now,
+			); err != nil {
+				return nil, status.Error(codes.Internal, "db")
+			}
 		}
 		ids = append(ids, epID.String())
 	}
@@ -0,0 +1,106 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Only rows written by transactions older than every in-flight transaction are
+// returned, so the (tx_id, seq) order never changes underneath a reader.
+func (s *PostgresCatalogStore) ListCatalogChanges(ctx context.Context, after ChangeCursor, limit int) ([]CatalogChange, error) {
+	// Retention may have removed rows the caller has not seen yet.
+	var wmTx string
+	var wmSeq int64
+	err := s.db.QueryRow(ctx, `SELECT tx_id::text, seq FROM catalog_outbox_retention`).Scan(&wmTx, &wmSeq)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.Internal, "db query")
+	}
+	if err == nil {
+		wm := ChangeCursor{Seq: wmSeq}
+		if wm.TxID, err = strconv.ParseUint(wmTx, 10, 64); err != nil {
+			return nil, status.Error(codes.Internal, "db scan")
+		}
+		if after.Before(wm) {
+			return nil, status.Error(codes.FailedPrecondition, "cursor expired; resync with snapshot")
+		}
+	}
+
+	rows, err := s.db.Query(ctx, `
+SELECT tx_id::text, seq, event_type, schema, payload, created_at
+FROM catalog_outbox
+WHERE (tx_id, seq) > ($1::text::xid8, $2)
+  AND tx_id < pg_snapshot_xmin(pg_current_snapshot())
+ORDER BY tx_id, seq
+LIMIT $3`, strconv.FormatUint(after.TxID, 10), after.Seq, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db query")
+	}
+	defer rows.Close()
+
+	var out []CatalogChange
+	for rows.Next() {
+		var c CatalogChange
+		var txID string
+		if err := rows.Scan(&txID, &c.Cursor.Seq, &c.EventType, &c.Schema, &c.Payload, &c.CreatedAt); err != nil {
+			return nil, status.Error(codes.Internal, "db scan")
+		}
+		if c.Cursor.TxID, err = strconv.ParseUint(txID, 10, 64); err != nil {
+			return nil, status.Error(codes.Internal, "db scan")
+		}
+		out = append(out, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Error(codes.Internal, "db query")
+	}
+	return out, nil
+}
+
+func (s *PostgresCatalogStore) SnapshotAnimeChanges(ctx context.Context) ([]CatalogChange, ChangeCursor, error) {
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, ChangeCursor{}, status.Error(codes.Internal, "db begin")
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	// Every outbox row from a transaction below xmin is already reflected in this
+	// snapshot; rows at or above it may not be, so the feed resumes from (xmin, 0).
+	var xmin string
+	if err := tx.QueryRow(ctx, `SELECT pg_snapshot_xmin(pg_current_snapshot())::text`).Scan(&xmin); err != nil {
+		return nil, ChangeCursor{}, status.Error(codes.Internal, "db query")
+	}
+	txID, err := strconv.ParseUint(xmin, 10, 64)
+	if err != nil {
+		return nil, ChangeCursor{}, status.Error(codes.Internal, "db scan")
+	}
+
+	rows, err := tx.Query(ctx, `SELECT `+animeEventColumns+` FROM anime ORDER BY id`)
+	if err != nil {
+		return nil, ChangeCursor{}, status.Error(codes.Internal, "db query")
+	}
+	defer rows.Close()
+
+	now := time.Now().UTC()
+	var out []CatalogChange
+	for rows.Next() {
+		ev, err := scanAnimeEvent(rows)
+		if err != nil {
+			return nil, ChangeCursor{}, status.Error(codes.Internal, "db scan")
+		}
+		payload, err := eventJSON.Marshal(ev)
+		if err != nil {
+			return nil, ChangeCursor{}, status.Error(codes.Internal, "encode event")
+		}
+		out = append(out, CatalogChange{EventType: catalogEventAnimeUpserted, Schema: string(proto.MessageName(ev)), Payload: payload, CreatedAt: now})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, ChangeCursor{}, status.Error(codes.Internal, "db query")
+	}
+	return out, ChangeCursor{TxID: txID}, nil
+}
@@ -0,0 +1,46 @@
+package store
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListCatalogChanges_StaleCursor(t *testing.T) {
+	st, pool := testStore(t)
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		if _, err := pool.Exec(ctx, `INSERT INTO catalog_outbox (id, event_type, payload) VALUES ($1, 'catalog.anime.upserted', '{}')`, uuid.New()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Retention removed everything up to the second row.
+	var wmTx string
+	var wmSeq int64
+	if err := pool.QueryRow(ctx, `
+INSERT INTO catalog_outbox_retention (tx_id, seq)
+SELECT tx_id, seq FROM catalog_outbox ORDER BY tx_id, seq OFFSET 1 LIMIT 1
+RETURNING tx_id::text, seq`).Scan(&wmTx, &wmSeq); err != nil {
+		t.Fatal(err)
+	}
+	txID, err := strconv.ParseUint(wmTx, 10, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wm := ChangeCursor{TxID: txID, Seq: wmSeq}
+
+	if _, err := st.ListCatalogChanges(ctx, ChangeCursor{}, 10); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("cursor before the watermark: err = %v, want FailedPrecondition", err)
+	}
+	changes, err := st.ListCatalogChanges(ctx, wm, 10)
+	if err != nil {
+		t.Fatalf("cursor at the watermark: %v", err)
+	}
+	if len(changes) != 1 {
+		t.Fatalf("got %d changes after the watermark, want 1", len(changes))
+	}
+}
@@ -0,0 +1,363 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AnimeRelation links an anime to another provider entry, e.g. its sequel.
+type AnimeRelation struct {
+	Relation string
+	MalID    int32
+}
+
+// RelationKind normalizes provider wording ("Side Story") to the stored form
+// ("side_story").
+func RelationKind(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(s)), "_")
+}
+
+// Franchise groups anime entries under its own title and image. Entries are
+// ordered by Position.
+type Franchise struct {
+	ID          string
+	Title       string
+	Image       string
+	Description string
+	Entries     []FranchiseEntry
+	UpdatedAt   time.Time
+}
+
+type FranchiseEntry struct {
+	AnimeID  string
+	Position int32
+}
+
+// FranchiseInput holds the editable franchise fields.
+type FranchiseInput struct {
+	Title       string
+	Image       string
+	Description string
+}
+
+// RelationEdge is a relation between two anime that are both in the catalog.
+type RelationEdge struct {
+	AnimeID        string
+	RelatedAnimeID string
+	Relation       string
+}
+
+// RelationGraph is the resolved relations graph used to suggest franchises.
+// Titles covers every anime appearing in Edges; Memberships maps anime IDs to
+// the franchises that already contain them.
+type RelationGraph struct {
+	Edges       []RelationEdge
+	Titles      map[string]string
+	Memberships map[string][]string
+}
+
+// replaceAnimeRelations makes rels the complete set of provider relations for
+// the anime.
+func replaceAnimeRelations(ctx context.Context, tx pgx.Tx, animeID uuid.UUID, provider string, rels []AnimeRelation) error {
+	if _, err := tx.Exec(ctx, `DELETE FROM anime_relations WHERE anime_id=$1 AND provider=$2`, animeID, provider); err != nil {
+		return err
+	}
+	for _, r := range rels {
+		kind := RelationKind(r.Relation)
+		if kind == "" || r.MalID <= 0 {
+			continue
+		}
+		if _, err := tx.Exec(ctx, `
+INSERT INTO anime_relations (anime_id, relation, provider, related_provider_anime_id) VALUES ($1,$2,$3,$4)
+ON CONFLICT DO NOTHING`, animeID, kind, provider, strconv.Itoa(int(r.MalID))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeFranchiseData moves the source's relations and franchise memberships
+// onto the target. Memberships the target already has keep their position.
+func mergeFranchiseData(ctx context.Context, tx pgx.Tx, src, dst uuid.UUID) error {
+	if _, err := tx.Exec(ctx, `
+INSERT INTO anime_relations (anime_id, relation, provider, related_provider_anime_id, updated_at)
+SELECT $2, relation, provider, related_provider_anime_id, updated_at FROM anime_relations WHERE anime_id=$1
+ON CONFLICT DO NOTHING`, src, dst); err != nil {
+		return err
+	}
+	_, err := tx.Exec(ctx, `
+INSERT INTO franchise_entries (franchise_id, anime_id, position)
+SELECT franchise_id, $2, position FROM franchise_entries WHERE anime_id=$1
+ON CONFLICT DO NOTHING`, src, dst)
+	return err
+}
+
+func parseFranchiseAnimeIDs(ids []string) ([]uuid.UUID, error) {
+	out := make([]uuid.UUID, 0, len(ids))
+	seen := make(map[uuid.UUID]bool, len(ids))
+	for _, raw := range ids {
+		id, err := uuid.Parse(strings.TrimSpace(raw))
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid anime_id")
+		}
+		if seen[id] {
+			return nil, status.Error(codes.InvalidArgument, "duplicate anime_id")
+		}
+		seen[id] = true
+		out = append(out, id)
+	}
+	return out, nil
+}
+
+// setFranchiseEntries replaces the franchise's entries with ids in order.
+func setFranchiseEntries(ctx context.Context, tx pgx.Tx, franchiseID uuid.UUID, ids []uuid.UUID) error {
+	if len(ids) > 0 {
+		var found int
+		if err := tx.QueryRow(ctx, `SELECT count(*) FROM anime WHERE id = ANY($1::uuid[])`, ids).Scan(&found); err != nil {
+			return status.Error(codes.Internal, "db")
+		}
+		if found != len(ids) {
+			return status.Error(codes.NotFound, "anime not found")
+		}
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM franchise_entries WHERE franchise_id=$1`, franchiseID); err != nil {
+		return status.Error(codes.Internal, "db")
+	}
+	for i, id := range ids {
+		if _, err := tx.Exec(ctx,
+			`INSERT INTO franchise_entries (franchise_id, anime_id, position) VALUES ($1,$2,$3)`, franchiseID, id, i+1,
+		); err != nil {
+			return status.Error(codes.Internal, "db")
+		}
+	}
+	return nil
+}
+
+func (s *PostgresCatalogStore) CreateFranchise(ctx context.Context, in FranchiseInput, animeIDs []string) (Franchise, error) {
+	ids, err := parseFranchiseAnimeIDs(animeIDs)
+	if err != nil {
+		return Franchise{}, err
+	}
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return Franchise{}, status.Error(codes.Internal, "db begin")
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	id := uuid.New()
+	if _, err := tx.Exec(ctx, `INSERT INTO franchises (id, title, image, description) VALUES ($1,$2,$3,$4)`,
+		id, in.Title, in.Image, in.Description); err != nil {
+		return Franchise{}, status.Error(codes.Internal, "db")
+	}
+	if err := setFranchiseEntries(ctx, tx, id, ids); err != nil {
+		return Franchise{}, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return Franchise{}, status.Error(codes.Internal, "db commit")
+	}
+	return s.GetFranchise(ctx, id.String(), "", true)
+}
+
+func (s *PostgresCatalogStore) UpdateFranchise(ctx context.Context, franchiseID string, in FranchiseInput) (Franchise, error) {
+	id, err := uuid.Parse(strings.TrimSpace(franchiseID))
+	if err != nil {
+		return Franchise{}, status.Error(codes.InvalidArgument, "invalid franchise_id")
+	}
+	tag, err := s.db.Exec(ctx, `UPDATE franchises SET title=$2, image=$3, description=$4, updated_at=now() WHERE id=$1`,
+		id, in.Title, in.Image, in.Description)
+	if err != nil {
+		return Franchise{}, status.Error(codes.Internal, "db")
+	}
+	if tag.RowsAffected() == 0 {
+		return Franchise{}, status.Error(codes.NotFound, "franchise not found")
+	}
+	return s.GetFranchise(ctx, id.String(), "", true)
+}
+
+// SetFranchiseEntries replaces the franchise's entries; animeIDs is the new order.
+func (s *PostgresCatalogStore) SetFranchiseEntries(ctx context.Context, franchiseID string, animeIDs []string) (Franchise, error) {
+	id, err := uuid.Parse(strings.TrimSpace(franchiseID))
+	if err != nil {
+		return Franchise{}, status.Error(codes.InvalidArgument, "invalid franchise_id")
+	}
+	ids, err := parseFranchiseAnimeIDs(animeIDs)
+	if err != nil {
+		return Franchise{}, err
+	}
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return Franchise{}, status.Error(codes.Internal, "db begin")
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	tag, err := tx.Exec(ctx, `UPDATE franchises SET updated_at=now() WHERE id=$1`, id)
+	if err != nil {
+		return Franchise{}, status.Error(codes.Internal, "db")
+	}
+	if tag.RowsAffected() == 0 {
+		return Franchise{}, status.Error(codes.NotFound, "franchise not found")
+	}
+	if err := setFranchiseEntries(ctx, tx, id, ids); err != nil {
+		return Franchise{}, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return Franchise{}, status.Error(codes.Internal, "db commit")
+	}
+	return s.GetFranchise(ctx, id.String(), "", true)
+}
+
+func (s *PostgresCatalogStore) DeleteFranchise(ctx context.Context, franchiseID string) error {
+	id, err := uuid.Parse(strings.TrimSpace(franchiseID))
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid franchise_id")
+	}
+	tag, err := s.db.Exec(ctx, `DELETE FROM franchises WHERE id=$1`, id)
+	if err != nil {
+		return status.Error(codes.Internal, "db")
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Error(codes.NotFound, "franchise not found")
+	}
+	return nil
+}
+
+// GetFranchise loads a franchise with its entries. Unless includeUnavailable is
+// set, entries not available in country are left out.
+func (s *PostgresCatalogStore) GetFranchise(ctx context.Context, franchiseID, country string, includeUnavailable bool) (Franchise, error) {
+	id, err := uuid.Parse(strings.TrimSpace(franchiseID))
+	if err != nil {
+		return Franchise{}, status.Error(codes.InvalidArgument, "invalid franchise_id")
+	}
+	f := Franchise{ID: id.String()}
+	err = s.db.QueryRow(ctx, `SELECT title, image, description, updated_at FROM franchises WHERE id=$1`, id).
+		Scan(&f.Title, &f.Image, &f.Description, &f.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Franchise{}, status.Error(codes.NotFound, "franchise not found")
+	}
+	if err != nil {
+		return Franchise{}, status.Error(codes.Internal, "db")
+	}
+
+	rows, err := s.db.Query(ctx, `
+SELECT fe.anime_id::text, fe.position
+FROM franchise_entries fe
+JOIN anime a ON a.id = fe.anime_id
+WHERE fe.franchise_id = $1 AND ($2::bool OR `+availableSQL("a", "$3::text")+`)
+ORDER BY fe.position, fe.anime_id`, id, includeUnavailable, country)
+	if err != nil {
+		return Franchise{}, status.Error(codes.Internal, "db query")
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var e FranchiseEntry
+		if err := rows.Scan(&e.AnimeID, &e.Position); err != nil {
+			return Franchise{}, status.Error(codes.Internal, "db scan")
+		}
+		f.Entries = append(f.Entries, e)
+	}
+	if err := rows.Err(); err != nil {
+		return Franchise{}, status.Error(codes.Internal, "db query")
+	}
+	return f, nil
+}
+
+// ListFranchises returns franchises by title, with all of their entries. A
+// non-empty animeID restricts the list to franchises containing that anime.
+func (s *PostgresCatalogStore) ListFranchises(ctx context.Context, animeID string, limit, offset int) ([]Franchise, error) {
+	var filter *uuid.UUID
+	if animeID = strings.TrimSpace(animeID); animeID != "" {
+		id, err := uuid.Parse(animeID)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid anime_id")
+		}
+		filter = &id
+	}
+	rows, err := s.db.Query(ctx, `
+SELECT f.id::text, f.title, f.image, f.description, f.updated_at,
+       COALESCE((SELECT jsonb_agg(jsonb_build_object('anime_id', fe.anime_id, 'position', fe.position) ORDER BY fe.position, fe.anime_id)
+                 FROM franchise_entries fe WHERE fe.franchise_id = f.id), '[]'::jsonb)
+FROM franchises f
+WHERE $1::uuid IS NULL OR EXISTS (SELECT 1 FROM franchise_entries fe WHERE fe.franchise_id = f.id AND fe.anime_id = $1)
+ORDER BY f.title, f.id
+LIMIT $2 OFFSET $3`, filter, limit, offset)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db query")
+	}
+	defer rows.Close()
+
+	var out []Franchise
+	for rows.Next() {
+		var f Franchise
+		var entries []struct {
+			AnimeID  string `json:"anime_id"`
+			Position int32  `json:"position"`
+		}
+		if err := rows.Scan(&f.ID, &f.Title, &f.Image, &f.Description, &f.UpdatedAt, &entries); err != nil {
+			return nil, status.Error(codes.Internal, "db scan")
+		}
+		for _, e := range entries {
+			f.Entries = append(f.Entries, FranchiseEntry{AnimeID: e.AnimeID, Position: e.Position})
+		}
+		out = append(out, f)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Error(codes.Internal, "db query")
+	}
+	return out, nil
+}
+
+// LoadRelationGraph resolves stored relations to catalog anime. Relations to
+// entries not yet ingested are skipped.
+func (s *PostgresCatalogStore) LoadRelationGraph(ctx context.Context) (RelationGraph, error) {
+	g := RelationGraph{Titles: map[string]string{}, Memberships: map[string][]string{}}
+
+	rows, err := s.db.Query(ctx, `
+SELECT r.anime_id::text, a.title, x.anime_id::text, b.title, r.relation
+FROM anime_relations r
+JOIN external_anime_ids x ON x.provider = r.provider AND x.provider_anime_id = r.related_provider_anime_id
+JOIN anime a ON a.id = r.anime_id
+JOIN anime b ON b.id = x.anime_id
+WHERE r.anime_id <> x.anime_id
+ORDER BY 1, 3, 5`)
+	if err != nil {
+		return RelationGraph{}, status.Error(codes.Internal, "db query")
+	}
+	err = forEachRow(rows, func() error {
+		var e RelationEdge
+		var title, relatedTitle string
+		if err := rows.Scan(&e.AnimeID, &title, &e.RelatedAnimeID, &relatedTitle, &e.Relation); err != nil {
+			return err
+		}
+		g.Titles[e.AnimeID], g.Titles[e.RelatedAnimeID] = title, relatedTitle
+		g.Edges = append(g.Edges, e)
+		return nil
+	})
+	if err != nil {
+		return RelationGraph{}, status.Error(codes.Internal, "db scan")
+	}
+
+	rows, err = s.db.Query(ctx, `SELECT anime_id::text, franchise_id::text FROM franchise_entries ORDER BY 1, 2`)
+	if err != nil {
+		return RelationGraph{}, status.Error(codes.Internal, "db query")
+	}
+	err = forEachRow(rows, func() error {
+		var animeID, franchiseID string
+		if err := rows.Scan(&animeID, &franchiseID); err != nil {
+			return err
+		}
+		g.Memberships[animeID] = append(g.Memberships[animeID], franchiseID)
+		return nil
+	})
+	if err != nil {
+		return RelationGraph{}, status.Error(codes.Internal, "db scan")
+	}
+	return g, nil
+}
@@ -0,0 +1,327 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// History reasons recorded with each version.
+const (
+	HistoryReasonJikanSync        = "jikan_sync"
+	HistoryReasonEpisodeSync      = "episode_sync"
+	HistoryReasonMerge            = "merge"
+	HistoryReasonAvailability     = "availability"
+	HistoryReasonTranslation      = "translation"
+	HistoryReasonImage            = "image"
+	HistoryReasonImport           = "import"
+	HistoryReasonRevert           = "revert"
+	HistoryReasonProviderMetadata = "provider_metadata"
+)
+
+// AnimeVersion is one recorded snapshot of an anime row. Data holds the row's
+// columns, one slug array per taxonomy join table and the translations keyed
+// by locale; Previous is the version before it, or nil for the first.
+type AnimeVersion struct {
+	AnimeID    string
+	Version    int32
+	Reason     string
+	RecordedAt time.Time
+	Data       json.RawMessage
+	Previous   json.RawMessage
+}
+
+// EpisodeVersion is one recorded snapshot of an episode row.
+type EpisodeVersion struct {
+	EpisodeID  string
+	AnimeID    string
+	Version    int32
+	Reason     string
+	RecordedAt time.Time
+	Data       json.RawMessage
+	Previous   json.RawMessage
+}
+
+// HistoryQuery pages through history newest first. BeforeVersion (anime only)
+// and AsOf are exclusive and inclusive upper bounds; zero values disable them.
+type HistoryQuery struct {
+	Limit         int
+	BeforeVersion int32
+	AsOf          time.Time
+}
+
+// FieldChange is one top-level field that differs between two snapshots. Old
+// or New is nil when the field was absent on that side.
+type FieldChange struct {
+	Field string
+	Old   json.RawMessage
+	New   json.RawMessage
+}
+
+// DiffSnapshots compares two JSON objects key by key, returning changed fields
+// sorted by name. A nil prev reports every field of next as added.
+func DiffSnapshots(prev, next json.RawMessage) ([]FieldChange, error) {
+	var a, b map[string]json.RawMessage
+	if len(prev) > 0 {
+		if err := json.Unmarshal(prev, &a); err != nil {
+			return nil, err
+		}
+	}
+	if err := json.Unmarshal(next, &b); err != nil {
+		return nil, err
+	}
+	fields := make(map[string]struct{}, len(a)+len(b))
+	for k := range a {
+		fields[k] = struct{}{}
+	}
+	for k := range b {
+		fields[k] = struct{}{}
+	}
+	var out []FieldChange
+	for k := range fields {
+		o, n := a[k], b[k]
+		if jsonEqual(o, n) {
+			continue
+		}
+		out = append(out, FieldChange{Field: k, Old: o, New: n})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Field < out[j].Field })
+	return out, nil
+}
+
+// jsonEqual compares two JSON values ignoring insignificant whitespace.
+func jsonEqual(a, b json.RawMessage) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	var ca, cb bytes.Buffer
+	if json.Compact(&ca, a) != nil || json.Compact(&cb, b) != nil {
+		return bytes.Equal(a, b)
+	}
+	return bytes.Equal(ca.Bytes(), cb.Bytes())
+}
+
+// animeSnapshotSQL builds the history document for anime alias a: the row minus
+// its timestamps plus the slugs linked through each taxonomy join table and its
+// translations as {locale: {title, synopsis}}.
+func animeSnapshotSQL(alias string) string {
+	parts := []string{fmt.Sprintf(`(to_jsonb(%s) - 'created_at' - 'updated_at')`, alias)}
+	for _, t := range taxonomyTables {
+		parts = append(parts, fmt.Sprintf(`jsonb_build_object('%[1]s',
+  (SELECT COALESCE(jsonb_agg(j.%[2]s ORDER BY j.%[2]s), '[]'::jsonb) FROM %[1]s j WHERE j.anime_id = %[3]s.id))`,
+			t.join, t.column, alias))
+	}
+	parts = append(parts, fmt.Sprintf(`jsonb_build_object('anime_translations',
+  (SELECT COALESCE(jsonb_object_agg(t.locale, jsonb_build_object('title', t.title, 'synopsis', t.synopsis)), '{}'::jsonb)
+   FROM anime_translations t WHERE t.anime_id = %s.id))`, alias))
+	return strings.Join(parts, " || ")
+}
+
+// recordAnimeVersion appends the anime's current state to anime_history unless
+// it matches the latest recorded version. The anime row is locked first so
+// concurrent writers number their versions in commit order.
+func recordAnimeVersion(ctx context.Context, tx pgx.Tx, animeID, reason string) error {
+	var locked bool
+	err := tx.QueryRow(ctx, `SELECT TRUE FROM anime WHERE id = $1::uuid FOR UPDATE`, animeID).Scan(&locked)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, `
+INSERT INTO anime_history (anime_id, version, data, reason)
+SELECT a.id, COALESCE((SELECT max(h.version) FROM anime_history h WHERE h.anime_id = a.id), 0) + 1, s.data, $2
+FROM anime a, LATERAL (SELECT `+animeSnapshotSQL("a")+` AS data) s
+WHERE a.id = $1::uuid
+  AND s.data IS DISTINCT FROM (SELECT h.data FROM anime_history h WHERE h.anime_id = a.id ORDER BY h.version DESC LIMIT 1)`,
+		animeID, reason)
+	return err
+}
+
+// recordEpisodeVersions appends a version for each listed episode whose state
+// differs from its latest recorded version.
+func recordEpisodeVersions(ctx context.Context, tx pgx.Tx, episodeIDs []string, reason string) error {
+	if len(episodeIDs) == 0 {
+		return nil
+	}
+	_, err := tx.Exec(ctx, `
+INSERT INTO episode_history (episode_id, version, anime_id, data, reason)
+SELECT e.id, COALESCE((SELECT max(h.version) FROM episode_history h WHERE h.episode_id = e.id), 0) + 1, e.anime_id, s.data, $2
+FROM episodes e, LATERAL (SELECT to_jsonb(e) - 'created_at' - 'updated_at' AS data) s
+WHERE e.id = ANY($1::uuid[])
+  AND s.data IS DISTINCT FROM (SELECT h.data FROM episode_history h WHERE h.episode_id = e.id ORDER BY h.version DESC LIMIT 1)`,
+		episodeIDs, reason)
+	return err
+}
+
+func historyAsOf(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// ListAnimeVersions returns recorded versions of an anime newest first, each
+// with the version before it so callers can diff them.
+func (s *PostgresCatalogStore) ListAnimeVersions(ctx context.Context, animeID string, q HistoryQuery) ([]AnimeVersion, error) {
+	id, err := uuid.Parse(strings.TrimSpace(animeID))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid anime_id")
+	}
+	rows, err := s.db.Query(ctx, `
+SELECT version, reason, recorded_at, data, prev FROM (
+  SELECT version, reason, recorded_at, data, lag(data) OVER (ORDER BY version) AS prev
+  FROM anime_history WHERE anime_id = $1
+) h
+WHERE ($2::int = 0 OR version < $2) AND ($3::timestamptz IS NULL OR recorded_at <= $3)
+ORDER BY version DESC
+LIMIT $4`, id, q.BeforeVersion, historyAsOf(q.AsOf), q.Limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db query")
+	}
+	defer rows.Close()
+
+	var out []AnimeVersion
+	for rows.Next() {
+		v := AnimeVersion{AnimeID: id.String()}
+		var data, prev []byte
+		if err := rows.Scan(&v.Version, &v.Reason, &v.RecordedAt, &data, &prev); err != nil {
+			return nil, status.Error(codes.Internal, "db scan")
+		}
+		v.Data, v.Previous = data, prev
+		out = append(out, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Error(codes.Internal, "db query")
+	}
+	return out, nil
+}
+
+// ListEpisodeVersions returns recorded versions of an anime's episodes newest
+// first. BeforeVersion is ignored since versions are numbered per episode.
+func (s *PostgresCatalogStore) ListEpisodeVersions(ctx context.Context, animeID string, q HistoryQuery) ([]EpisodeVersion, error) {
+	id, err := uuid.Parse(strings.TrimSpace(animeID))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid anime_id")
+	}
+	rows, err := s.db.Query(ctx, `
+SELECT episode_id::text, version, reason, recorded_at, data, prev FROM (
+  SELECT episode_id, version, reason, recorded_at, data,
+         lag(data) OVER (PARTITION BY episode_id ORDER BY version) AS prev
+  FROM episode_history WHERE anime_id = $1
+) h
+WHERE ($2::timestamptz IS NULL OR recorded_at <= $2)
+ORDER BY recorded_at DESC, episode_id, version DESC
+LIMIT $3`, id, historyAsOf(q.AsOf), q.Limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db query")
+	}
+	defer rows.Close()
+
+	var out []EpisodeVersion
+	for rows.Next() {
+		v := EpisodeVersion{AnimeID: id.String()}
+		var data, prev []byte
+		if err := rows.Scan(&v.EpisodeID, &v.Version, &v.Reason, &v.RecordedAt, &data, &prev); err != nil {
+			return nil, status.Error(codes.Internal, "db scan")
+		}
+		v.Data, v.Previous = data, prev
+		out = append(out, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Error(codes.Internal, "db query")
+	}
+	return out, nil
+}
+
+// revertAnimeColumns are restored by RevertAnime. Availability is deliberately
+// left alone: a takedown or region block must not be undone by rolling back a
+// bad sync.
+const revertAnimeColumns = `title, title_english, title_japanese, url, image, description, genres, sub_or_dub, type, status, other_name, total_episodes, score`
+
+// RevertAnime restores the anime's content, taxonomy and translations to a
+// recorded version and returns the version number of the resulting state.
+func (s *PostgresCatalogStore) RevertAnime(ctx context.Context, animeID string, version int32) (int32, error) {
+	id, err := uuid.Parse(strings.TrimSpace(animeID))
+	if err != nil {
+		return 0, status.Error(codes.InvalidArgument, "invalid anime_id")
+	}
+
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return 0, status.Error(codes.Internal, "db begin")
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var data []byte
+	err = tx.QueryRow(ctx, `SELECT data FROM anime_history WHERE anime_id=$1 AND version=$2`, id, version).Scan(&data)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, status.Error(codes.NotFound, "version not found")
+	}
+	if err != nil {
+		return 0, status.Error(codes.Internal, "db")
+	}
+
+	var image string
+	err = tx.QueryRow(ctx, `
+UPDATE anime SET (`+revertAnimeColumns+`) = (SELECT `+revertAnimeColumns+` FROM jsonb_populate_record(NULL::anime, $2::jsonb)),
+  updated_at = now()
+WHERE id = $1
+RETURNING image`, id, data).Scan(&image)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, status.Error(codes.NotFound, "anime not found")
+	}
+	if err != nil {
+		return 0, status.Error(codes.Internal, "db")
+	}
+
+	// Versions recorded before a taxonomy existed lack its key; leave those links.
+	for _, t := range taxonomyTables {
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`DELETE FROM %[1]s WHERE anime_id = $1 AND $2::jsonb ? '%[1]s'`, t.join), id, data); err != nil {
+			return 0, status.Error(codes.Internal, "db taxonomy")
+		}
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`
+INSERT INTO %[1]s (anime_id, %[2]s)
+SELECT $1, x.slug FROM jsonb_array_elements_text($2::jsonb -> '%[1]s') AS x(slug)
+WHERE EXISTS (SELECT 1 FROM %[3]s WHERE slug = x.slug)
+ON CONFLICT DO NOTHING`, t.join, t.column, t.table), id, data); err != nil {
+			return 0, status.Error(codes.Internal, "db taxonomy")
+		}
+	}
+	// Likewise for versions recorded before translations were.
+	if _, err := tx.Exec(ctx, `DELETE FROM anime_translations WHERE anime_id = $1 AND $2::jsonb ? 'anime_translations'`, id, data); err != nil {
+		return 0, status.Error(codes.Internal, "db translations")
+	}
+	if _, err := tx.Exec(ctx, `
+INSERT INTO anime_translations (anime_id, locale, title, synopsis, updated_at)
+SELECT $1, x.key, COALESCE(x.value ->> 'title', ''), COALESCE(x.value ->> 'synopsis', ''), now()
+FROM jsonb_each($2::jsonb -> 'anime_translations') AS x`, id, data); err != nil {
+		return 0, status.Error(codes.Internal, "db translations")
+	}
+	if err := enqueueAnimeImage(ctx, tx, id, image); err != nil {
+		return 0, status.Error(codes.Internal, "db image")
+	}
+	if err := emitAnimeUpserted(ctx, tx, id.String(), fmt.Sprintf("%s:v%d", HistoryReasonRevert, version)); err != nil {
+		return 0, status.Error(codes.Internal, "db outbox")
+	}
+
+	var current int32
+	if err := tx.QueryRow(ctx, `SELECT COALESCE(max(version), 0) FROM anime_history WHERE anime_id=$1`, id).Scan(&current); err != nil {
+		return 0, status.Error(codes.Internal, "db")
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, status.Error(codes.Internal, "db commit")
+	}
+	return current, nil
+}
@@ -0,0 +1,62 @@
+package store
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRevertAnime_RestoresTranslations(t *testing.T) {
+	st, pool := testStore(t)
+	ctx := context.Background()
+	importRecords(t, st, anime(localAnime, "Original"))
+
+	if err := st.UpsertAnimeTranslation(ctx, localAnime, "de", "Erster Titel", ""); err != nil {
+		t.Fatal(err)
+	}
+	versions, err := st.ListAnimeVersions(ctx, localAnime, HistoryQuery{Limit: 1})
+	if err != nil || len(versions) != 1 {
+		t.Fatalf("versions: %v %v", versions, err)
+	}
+	before := versions[0]
+	if before.Reason != HistoryReasonTranslation {
+		t.Fatalf("latest version reason = %q", before.Reason)
+	}
+
+	if err := st.UpsertAnimeTranslation(ctx, localAnime, "de", "Zweiter Titel", "Neu."); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.UpsertAnimeTranslation(ctx, localAnime, "fr", "Titre", ""); err != nil {
+		t.Fatal(err)
+	}
+	versions, err = st.ListAnimeVersions(ctx, localAnime, HistoryQuery{Limit: 1})
+	if err != nil || len(versions) != 1 {
+		t.Fatalf("versions: %v %v", versions, err)
+	}
+	changes, err := DiffSnapshots(versions[0].Previous, versions[0].Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0].Field != "anime_translations" {
+		t.Fatalf("translation version changed %+v, want only anime_translations", changes)
+	}
+
+	if _, err := st.RevertAnime(ctx, localAnime, before.Version); err != nil {
+		t.Fatalf("revert: %v", err)
+	}
+	got := map[string]string{}
+	rows, err := pool.Query(ctx, `SELECT locale, title || '|' || synopsis FROM anime_translations WHERE anime_id=$1`, localAnime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for rows.Next() {
+		var loc, v string
+		if err := rows.Scan(&loc, &v); err != nil {
+			t.Fatal(err)
+		}
+		got[loc] = v
+	}
+	rows.Close()
+	if len(got) != 1 || got["de"] != "Erster Titel|" {
+		t.Fatalf("translations after revert = %v", got)
+	}
+}
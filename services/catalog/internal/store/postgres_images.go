@@ -0,0 +1,130 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ImageVariant is one resized copy of an anime cover in blob storage.
+type ImageVariant struct {
+	Name   string `json:"name"`
+	Width  int32  `json:"width"`
+	Height int32  `json:"height"`
+	Format string `json:"format"`
+	Key    string `json:"key"`
+}
+
+// ImageJob is a cover image waiting to be mirrored.
+type ImageJob struct {
+	AnimeID   string
+	SourceURL string
+	Attempts  int32
+}
+
+// imageMaxAttempts bounds retries of a failing source URL; a new URL resets it.
+const imageMaxAttempts = 5
+
+// enqueueAnimeImage schedules a mirror of image for animeID. Variants already
+// generated for the same source URL are kept.
+func enqueueAnimeImage(ctx context.Context, tx pgx.Tx, animeID any, image string) error {
+	if image == "" {
+		_, err := tx.Exec(ctx, `DELETE FROM anime_images WHERE anime_id = $1`, animeID)
+		return err
+	}
+	_, err := tx.Exec(ctx, `
+INSERT INTO anime_images (anime_id, source_url, status, updated_at) VALUES ($1, $2, 'pending', now())
+ON CONFLICT (anime_id) DO UPDATE
+SET source_url = EXCLUDED.source_url, status = 'pending', variants = '[]'::jsonb, attempts = 0, error = '', updated_at = now()
+WHERE anime_images.source_url <> EXCLUDED.source_url`, animeID, image)
+	return err
+}
+
+// ClaimImageJobs marks up to limit images as processing and returns them. Failed
+// images are retried with a linear backoff, and jobs stuck in processing (a
+// worker died mid-flight) are reclaimed after ten minutes.
+func (s *PostgresCatalogStore) ClaimImageJobs(ctx context.Context, limit int) ([]ImageJob, error) {
+	rows, err := s.db.Query(ctx, `
+UPDATE anime_images SET status = 'processing', attempts = attempts + 1, updated_at = now()
+WHERE anime_id IN (
+  SELECT anime_id FROM anime_images
+  WHERE status = 'pending'
+     OR (status = 'processing' AND updated_at < now() - interval '10 minutes')
+     OR (status = 'failed' AND attempts < $2 AND updated_at < now() - attempts * interval '10 minutes')
+  ORDER BY updated_at
+  LIMIT $1
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING anime_id::text, source_url, attempts`, limit, imageMaxAttempts)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db query")
+	}
+	defer rows.Close()
+
+	var out []ImageJob
+	for rows.Next() {
+		var j ImageJob
+		if err := rows.Scan(&j.AnimeID, &j.SourceURL, &j.Attempts); err != nil {
+			return nil, status.Error(codes.Internal, "db scan")
+		}
+		out = append(out, j)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Error(codes.Internal, "db query")
+	}
+	return out, nil
+}
+
+// CompleteImageJob stores the generated variants and emits an anime upserted
+// event so caches pick up the mirrored URLs. It is a no-op if the anime's image
+// changed while the job ran; the new URL has already been queued.
+func (s *PostgresCatalogStore) CompleteImageJob(ctx context.Context, job ImageJob, variants []ImageVariant) error {
+	b, err := json.Marshal(variants)
+	if err != nil {
+		return status.Error(codes.Internal, "encode variants")
+	}
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return status.Error(codes.Internal, "db begin")
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	tag, err := tx.Exec(ctx, `
+UPDATE anime_images SET status = 'ready', variants = $3, error = '', updated_at = now()
+WHERE anime_id = $1::uuid AND source_url = $2`, job.AnimeID, job.SourceURL, b)
+	if err != nil {
+		return status.Error(codes.Internal, "db")
+	}
+	if tag.RowsAffected() == 0 {
+		return nil
+	}
+	if err := emitAnimeUpserted(ctx, tx, job.AnimeID, HistoryReasonImage); err != nil {
+		return status.Error(codes.Internal, "db outbox")
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return status.Error(codes.Internal, "db commit")
+	}
+	return nil
+}
+
+// FailImageJob records why a mirror attempt failed; ClaimImageJobs retries it later.
+func (s *PostgresCatalogStore) FailImageJob(ctx context.Context, job ImageJob, reason string) error {
+	if _, err := s.db.Exec(ctx, `
+UPDATE anime_images SET status = 'failed', error = $3, updated_at = now()
+WHERE anime_id = $1::uuid AND source_url = $2`, job.AnimeID, job.SourceURL, reason); err != nil {
+		return status.Error(codes.Internal, "db")
+	}
+	return nil
+}
+
+func decodeImageVariants(b []byte) []ImageVariant {
+	var out []ImageVariant
+	if len(b) == 0 {
+		return nil
+	}
+	_ = json.Unmarshal(b, &out)
+	return out
+}
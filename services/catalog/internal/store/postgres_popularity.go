@@ -0,0 +1,223 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Trending windows. Each score decays exponentially with the window length as
+// its time constant, so an event contributes 1/e of its weight once it is one
+// window old.
+const (
+	TrendingWindow24h = "24h"
+	TrendingWindow7d  = "7d"
+	TrendingWindow30d = "30d"
+)
+
+var trendingWindowColumns = map[string]string{
+	TrendingWindow24h: "score_24h",
+	TrendingWindow7d:  "score_7d",
+	TrendingWindow30d: "score_30d",
+}
+
+// ValidTrendingWindow reports whether w is one of the TrendingWindow constants.
+func ValidTrendingWindow(w string) bool {
+	_, ok := trendingWindowColumns[w]
+	return ok
+}
+
+// decayedSQL decays a stored score column to now.
+func decayedSQL(alias, column string) string {
+	tau := map[string]int{"score_24h": 86400, "score_7d": 7 * 86400, "score_30d": 30 * 86400}[column]
+	return fmt.Sprintf(`(%[1]s.%[2]s * exp(-EXTRACT(EPOCH FROM now() - %[1]s.updated_at) / %[3]d))`, alias, column, tau)
+}
+
+// popularitySQL selects the three decayed scores of alias, or zeros when it is NULL.
+func popularitySQL(alias string) string {
+	return fmt.Sprintf(`COALESCE(%s, 0), COALESCE(%s, 0), COALESCE(%s, 0)`,
+		decayedSQL(alias, "score_24h"), decayedSQL(alias, "score_7d"), decayedSQL(alias, "score_30d"))
+}
+
+// Popularity holds an anime's decayed activity scores as of the read.
+type Popularity struct {
+	Score24h float64
+	Score7d  float64
+	Score30d float64
+}
+
+// TrendingAnime is one entry of a GetTrending ranking.
+type TrendingAnime struct {
+	AnimeID    string
+	Popularity Popularity
+}
+
+// Popularity signal kinds.
+const (
+	SignalWatchProgress = "progress"
+	SignalPlayback      = "playback"
+	SignalRating        = "rating"
+)
+
+// PopularitySignal is one activity event attributed to an anime. Either AnimeID
+// or EpisodeID must be set; Actor (usually the user ID) deduplicates repeated
+// signals of the same kind within a UTC day.
+type PopularitySignal struct {
+	AnimeID   string
+	EpisodeID string
+	Actor     string
+	Kind      string
+	Weight    float64
+	At        time.Time
+}
+
+// RecordPopularitySignal adds sig to the anime's scores. It reports false when
+// the signal was a duplicate or its anime/episode is unknown.
+func (s *PostgresCatalogStore) RecordPopularitySignal(ctx context.Context, sig PopularitySignal) (bool, error) {
+	if sig.Actor == "" || sig.Kind == "" || sig.Weight <= 0 {
+		return false, status.Error(codes.InvalidArgument, "actor, kind and a positive weight are required")
+	}
+	if sig.At.IsZero() {
+		sig.At = time.Now()
+	}
+	at := sig.At.UTC()
+
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return false, status.Error(codes.Internal, "db begin")
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var animeID uuid.UUID
+	switch {
+	case sig.AnimeID != "":
+		id, err := uuid.Parse(strings.TrimSpace(sig.AnimeID))
+		if err != nil {
+			return false, nil
+		}
+		err = tx.QueryRow(ctx, `
+SELECT a.id FROM anime a
+WHERE a.id = COALESCE((SELECT target_anime_id FROM anime_redirects WHERE source_anime_id=$1), $1)`, id).Scan(&animeID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		if err != nil {
+			return false, status.Error(codes.Internal, "db")
+		}
+	case sig.EpisodeID != "":
+		id, err := uuid.Parse(strings.TrimSpace(sig.EpisodeID))
+		if err != nil {
+			return false, nil
+		}
+		err = tx.QueryRow(ctx, `SELECT anime_id FROM episodes WHERE id=$1`, id).Scan(&animeID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		if err != nil {
+			return false, status.Error(codes.Internal, "db")
+		}
+	default:
+		return false, status.Error(codes.InvalidArgument, "anime_id or episode_id is required")
+	}
+
+	tag, err := tx.Exec(ctx, `
+INSERT INTO anime_popularity_seen (anime_id, actor, signal, day) VALUES ($1,$2,$3,($4::timestamptz AT TIME ZONE 'UTC')::date)
+ON CONFLICT DO NOTHING`, animeID, sig.Actor, sig.Kind, at)
+	if err != nil {
+		return false, status.Error(codes.Internal, "db")
+	}
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	// Scores are kept as of updated_at: bring the stored score and the new
+	// event to the later of the two instants so late events decay correctly.
+	if _, err := tx.Exec(ctx, `
+INSERT INTO anime_popularity AS p (anime_id, score_24h, score_7d, score_30d, updated_at)
+VALUES ($1, $2, $2, $2, $3)
+ON CONFLICT (anime_id) DO UPDATE SET
+  score_24h = p.score_24h * exp(-GREATEST(EXTRACT(EPOCH FROM $3 - p.updated_at), 0) / 86400)
+            + $2 * exp(-GREATEST(EXTRACT(EPOCH FROM p.updated_at - $3), 0) / 86400),
+  score_7d  = p.score_7d  * exp(-GREATEST(EXTRACT(EPOCH FROM $3 - p.updated_at), 0) / 604800)
+            + $2 * exp(-GREATEST(EXTRACT(EPOCH FROM p.updated_at - $3), 0) / 604800),
+  score_30d = p.score_30d * exp(-GREATEST(EXTRACT(EPOCH FROM $3 - p.updated_at), 0) / 2592000)
+            + $2 * exp(-GREATEST(EXTRACT(EPOCH FROM p.updated_at - $3), 0) / 2592000),
+  updated_at = GREATEST(p.updated_at, $3)`, animeID, sig.Weight, at); err != nil {
+		return false, status.Error(codes.Internal, "db")
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return false, status.Error(codes.Internal, "db commit")
+	}
+	return true, nil
+}
+
+// GetTrending ranks visible anime by their decayed score for window.
+func (s *PostgresCatalogStore) GetTrending(ctx context.Context, window string, limit int, country string) ([]TrendingAnime, error) {
+	col, ok := trendingWindowColumns[window]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid window")
+	}
+	rows, err := s.db.Query(ctx, `
+SELECT p.anime_id::text, `+popularitySQL("p")+`
+FROM anime_popularity p
+JOIN anime a ON a.id = p.anime_id
+WHERE `+availableSQL("a", "$2::text")+`
+ORDER BY `+decayedSQL("p", col)+` DESC, p.anime_id
+LIMIT $1`, limit, country)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db query")
+	}
+	defer rows.Close()
+
+	var out []TrendingAnime
+	for rows.Next() {
+		var t TrendingAnime
+		if err := rows.Scan(&t.AnimeID, &t.Popularity.Score24h, &t.Popularity.Score7d, &t.Popularity.Score30d); err != nil {
+			return nil, status.Error(codes.Internal, "db scan")
+		}
+		out = append(out, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Error(codes.Internal, "db query")
+	}
+	return out, nil
+}
+
+// PrunePopularity drops dedupe rows older than keepDays (none when keepDays is
+// zero) and scores that have decayed to noise, so GetTrending only walks anime
+// with recent activity.
+func (s *PostgresCatalogStore) PrunePopularity(ctx context.Context, keepDays int) (int64, error) {
+	var n int64
+	if keepDays > 0 {
+		tag, err := s.db.Exec(ctx, `DELETE FROM anime_popularity_seen WHERE day < (now() AT TIME ZONE 'UTC')::date - $1::int`, keepDays)
+		if err != nil {
+			return 0, status.Error(codes.Internal, "db")
+		}
+		n = tag.RowsAffected()
+	}
+	tag, err := s.db.Exec(ctx, `DELETE FROM anime_popularity p WHERE `+decayedSQL("p", "score_30d")+` < 0.001`)
+	if err != nil {
+		return 0, status.Error(codes.Internal, "db")
+	}
+	return n + tag.RowsAffected(), nil
+}
+
+// mergePopularity folds src's scores into dst, aligning both to now.
+func mergePopularity(ctx context.Context, tx pgx.Tx, src, dst uuid.UUID) error {
+	_, err := tx.Exec(ctx, `
+INSERT INTO anime_popularity AS p (anime_id, score_24h, score_7d, score_30d, updated_at)
+SELECT $2, `+popularitySQL("s")+`, now() FROM anime_popularity s WHERE s.anime_id = $1
+ON CONFLICT (anime_id) DO UPDATE SET
+  score_24h = `+decayedSQL("p", "score_24h")+` + EXCLUDED.score_24h,
+  score_7d = `+decayedSQL("p", "score_7d")+` + EXCLUDED.score_7d,
+  score_30d = `+decayedSQL("p", "score_30d")+` + EXCLUDED.score_30d,
+  updated_at = now()`, src, dst)
+	return err
+}
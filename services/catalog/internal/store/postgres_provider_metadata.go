@@ -0,0 +1,145 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ProviderMetadata is supplementary data about an anime from one enrichment
+// provider. Score is normalized to 0-10; zero means the provider has none.
+type ProviderMetadata struct {
+	Provider    string  `json:"provider"`
+	BannerImage string  `json:"banner_image"`
+	Score       float32 `json:"score"`
+	ScoreCount  int32   `json:"score_count"`
+	Tags        []Tag   `json:"tags"`
+}
+
+// Tag is a descriptive provider tag. Rank is the provider's 0-100 relevance.
+type Tag struct {
+	Name     string `json:"name"`
+	Category string `json:"category"`
+	Rank     int32  `json:"rank"`
+	Spoiler  bool   `json:"spoiler"`
+}
+
+// ProviderScore is one provider's rating of an anime.
+type ProviderScore struct {
+	Provider   string
+	Score      float32
+	ScoreCount int32
+}
+
+// providerMetadataSQL selects the anime's provider metadata as a JSON array
+// ordered by provider, or NULL when there is none.
+func providerMetadataSQL(alias string) string {
+	return `(SELECT jsonb_agg(jsonb_build_object('provider', m.provider, 'banner_image', m.banner_image,
+    'score', m.score, 'score_count', m.score_count, 'tags', m.tags) ORDER BY m.provider)
+  FROM anime_provider_metadata m WHERE m.anime_id = ` + alias + `.id)`
+}
+
+// applyProviderMetadata fills the anime's banner, provider scores and tags. The
+// banner comes from the first provider that has one; tags are merged by name.
+func applyProviderMetadata(a *Anime, b []byte) {
+	if len(b) == 0 {
+		return
+	}
+	var metas []ProviderMetadata
+	if err := json.Unmarshal(b, &metas); err != nil {
+		return
+	}
+	seen := map[string]bool{}
+	for _, m := range metas {
+		if a.BannerImage == "" {
+			a.BannerImage = m.BannerImage
+		}
+		if m.Score > 0 {
+			a.ProviderScores = append(a.ProviderScores, ProviderScore{Provider: m.Provider, Score: m.Score, ScoreCount: m.ScoreCount})
+		}
+		for _, t := range m.Tags {
+			if t.Name == "" || seen[t.Name] {
+				continue
+			}
+			seen[t.Name] = true
+			a.Tags = append(a.Tags, t)
+		}
+	}
+}
+
+// UpsertProviderMetadata stores m for the anime that externalID is attached to
+// under m.Provider and returns that anime's ID. It fails with NotFound when the
+// external ID has not been attached yet.
+func (s *PostgresCatalogStore) UpsertProviderMetadata(ctx context.Context, externalID string, m ProviderMetadata) (string, error) {
+	tags := m.Tags
+	if tags == nil {
+		tags = []Tag{}
+	}
+	tagsJSON, err := json.Marshal(tags)
+	if err != nil {
+		return "", status.Error(codes.Internal, "encode tags")
+	}
+
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return "", status.Error(codes.Internal, "db begin")
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var animeID uuid.UUID
+	err = tx.QueryRow(ctx,
+		`SELECT anime_id FROM external_anime_ids WHERE provider=$1 AND provider_anime_id=$2`,
+		m.Provider, externalID,
+	).Scan(&animeID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", status.Error(codes.NotFound, "external id not attached")
+		}
+		return "", status.Error(codes.Internal, "db")
+	}
+
+	// The WHERE clause skips no-op refreshes so they emit no events.
+	var changed bool
+	err = tx.QueryRow(ctx, `
+INSERT INTO anime_provider_metadata AS m (anime_id, provider, banner_image, score, score_count, tags, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, now())
+ON CONFLICT (anime_id, provider) DO UPDATE SET
+  banner_image = EXCLUDED.banner_image,
+  score = EXCLUDED.score,
+  score_count = EXCLUDED.score_count,
+  tags = EXCLUDED.tags,
+  updated_at = now()
+WHERE (m.banner_image, m.score, m.score_count, m.tags)
+  IS DISTINCT FROM (EXCLUDED.banner_image, EXCLUDED.score, EXCLUDED.score_count, EXCLUDED.tags)
+RETURNING true`, animeID, m.Provider, m.BannerImage, m.Score, m.ScoreCount, tagsJSON).Scan(&changed)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return "", status.Error(codes.Internal, "db")
+	}
+	if changed {
+		if _, err := tx.Exec(ctx, `UPDATE anime SET updated_at=now() WHERE id=$1`, animeID); err != nil {
+			return "", status.Error(codes.Internal, "db")
+		}
+		if err := emitAnimeUpserted(ctx, tx, animeID.String(), HistoryReasonProviderMetadata); err != nil {
+			return "", status.Error(codes.Internal, "db outbox")
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return "", status.Error(codes.Internal, "db commit")
+	}
+	return animeID.String(), nil
+}
+
+// mergeProviderMetadata copies the source's provider metadata to the target for
+// providers the target has no data from.
+func mergeProviderMetadata(ctx context.Context, tx pgx.Tx, src, dst uuid.UUID) error {
+	_, err := tx.Exec(ctx, `
+INSERT INTO anime_provider_metadata (anime_id, provider, banner_image, score, score_count, tags, updated_at)
+SELECT $2, provider, banner_image, score, score_count, tags, updated_at FROM anime_provider_metadata WHERE anime_id=$1
+ON CONFLICT DO NOTHING`, src, dst)
+	return err
+}
@@ -0,0 +1,211 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Skip segment kinds.
+const (
+	SkipIntro   = "intro"
+	SkipOutro   = "outro"
+	SkipRecap   = "recap"
+	SkipPreview = "preview"
+)
+
+// SkipSourceUser is the source of community-submitted segments; provider
+// segments use the provider name.
+const SkipSourceUser = "user"
+
+// SkipSegment is a skippable stretch of an episode, in seconds.
+type SkipSegment struct {
+	ID          string
+	EpisodeID   string
+	Kind        string
+	Start       float32
+	End         float32
+	Source      string
+	SubmittedBy string
+	Score       int32
+	CreatedAt   time.Time
+}
+
+// SkipSegmentInput is one segment to store.
+type SkipSegmentInput struct {
+	Kind  string
+	Start float32
+	End   float32
+}
+
+const skipSegmentColumns = `id::text, episode_id::text, kind, start_seconds, end_seconds, source, submitted_by, score, created_at`
+
+func scanSkipSegment(row pgx.Row) (SkipSegment, error) {
+	var g SkipSegment
+	err := row.Scan(&g.ID, &g.EpisodeID, &g.Kind, &g.Start, &g.End, &g.Source, &g.SubmittedBy, &g.Score, &g.CreatedAt)
+	return g, err
+}
+
+// UpsertProviderSkipSegments replaces provider's segment of each kind in segs.
+// Kinds not in segs are left alone: a provider that stops reporting an outro
+// for one stream does not erase the one it reported before.
+func (s *PostgresCatalogStore) UpsertProviderSkipSegments(ctx context.Context, episodeID, provider string, segs []SkipSegmentInput) error {
+	epID, err := uuid.Parse(strings.TrimSpace(episodeID))
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid episode_id")
+	}
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return status.Error(codes.Internal, "db begin")
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := requireEpisode(ctx, tx, epID); err != nil {
+		return err
+	}
+	for _, g := range segs {
+		if _, err := tx.Exec(ctx, `
+INSERT INTO episode_skip_segments (id, episode_id, kind, start_seconds, end_seconds, source)
+VALUES ($1,$2,$3,$4,$5,$6)
+ON CONFLICT (episode_id, kind, source, submitted_by) DO UPDATE
+SET start_seconds=EXCLUDED.start_seconds, end_seconds=EXCLUDED.end_seconds, updated_at=now()
+WHERE episode_skip_segments.start_seconds IS DISTINCT FROM EXCLUDED.start_seconds
+   OR episode_skip_segments.end_seconds IS DISTINCT FROM EXCLUDED.end_seconds`,
+			uuid.New(), epID, g.Kind, g.Start, g.End, provider); err != nil {
+			return status.Error(codes.Internal, "db")
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return status.Error(codes.Internal, "db commit")
+	}
+	return nil
+}
+
+// ListSkipSegments returns the episode's segments: provider segments first,
+// then user segments by score, newest first among equals.
+func (s *PostgresCatalogStore) ListSkipSegments(ctx context.Context, episodeID string) ([]SkipSegment, error) {
+	epID, err := uuid.Parse(strings.TrimSpace(episodeID))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid episode_id")
+	}
+	rows, err := s.db.Query(ctx, `
+SELECT `+skipSegmentColumns+`
+FROM episode_skip_segments WHERE episode_id=$1
+ORDER BY source = $2, kind, score DESC, created_at DESC`, epID, SkipSourceUser)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db query")
+	}
+	defer rows.Close()
+
+	var out []SkipSegment
+	for rows.Next() {
+		g, err := scanSkipSegment(rows)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "db scan")
+		}
+		out = append(out, g)
+	}
+	if rows.Err() != nil {
+		return nil, status.Error(codes.Internal, "db rows")
+	}
+	return out, nil
+}
+
+// SubmitSkipSegment stores userID's segment of seg.Kind, replacing an earlier
+// submission of the same kind. Votes on the earlier times no longer apply, so
+// they are cleared and the submission starts with the submitter's own upvote.
+func (s *PostgresCatalogStore) SubmitSkipSegment(ctx context.Context, episodeID, userID string, seg SkipSegmentInput) (SkipSegment, error) {
+	epID, err := uuid.Parse(strings.TrimSpace(episodeID))
+	if err != nil {
+		return SkipSegment{}, status.Error(codes.InvalidArgument, "invalid episode_id")
+	}
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return SkipSegment{}, status.Error(codes.Internal, "db begin")
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := requireEpisode(ctx, tx, epID); err != nil {
+		return SkipSegment{}, err
+	}
+	g, err := scanSkipSegment(tx.QueryRow(ctx, `
+INSERT INTO episode_skip_segments (id, episode_id, kind, start_seconds, end_seconds, source, submitted_by, score)
+VALUES ($1,$2,$3,$4,$5,$6,$7,1)
+ON CONFLICT (episode_id, kind, source, submitted_by) DO UPDATE
+SET start_seconds=EXCLUDED.start_seconds, end_seconds=EXCLUDED.end_seconds, score=1, created_at=now(), updated_at=now()
+RETURNING `+skipSegmentColumns,
+		uuid.New(), epID, seg.Kind, seg.Start, seg.End, SkipSourceUser, userID))
+	if err != nil {
+		return SkipSegment{}, status.Error(codes.Internal, "db")
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM episode_skip_segment_votes WHERE segment_id=$1`, g.ID); err != nil {
+		return SkipSegment{}, status.Error(codes.Internal, "db")
+	}
+	if _, err := tx.Exec(ctx, `INSERT INTO episode_skip_segment_votes (segment_id, user_id, vote) VALUES ($1,$2,1)`, g.ID, userID); err != nil {
+		return SkipSegment{}, status.Error(codes.Internal, "db")
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return SkipSegment{}, status.Error(codes.Internal, "db commit")
+	}
+	return g, nil
+}
+
+// VoteSkipSegment sets userID's vote on a user segment, replacing any earlier
+// vote, and returns the segment with its new score.
+func (s *PostgresCatalogStore) VoteSkipSegment(ctx context.Context, segmentID, userID string, vote int16) (SkipSegment, error) {
+	id, err := uuid.Parse(strings.TrimSpace(segmentID))
+	if err != nil {
+		return SkipSegment{}, status.Error(codes.InvalidArgument, "invalid segment_id")
+	}
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return SkipSegment{}, status.Error(codes.Internal, "db begin")
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var source string
+	err = tx.QueryRow(ctx, `SELECT source FROM episode_skip_segments WHERE id=$1 FOR UPDATE`, id).Scan(&source)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return SkipSegment{}, status.Error(codes.NotFound, "skip segment not found")
+	}
+	if err != nil {
+		return SkipSegment{}, status.Error(codes.Internal, "db")
+	}
+	if source != SkipSourceUser {
+		return SkipSegment{}, status.Error(codes.FailedPrecondition, "only user-submitted segments take votes")
+	}
+	if _, err := tx.Exec(ctx, `
+INSERT INTO episode_skip_segment_votes (segment_id, user_id, vote) VALUES ($1,$2,$3)
+ON CONFLICT (segment_id, user_id) DO UPDATE SET vote=EXCLUDED.vote, created_at=now()`, id, userID, vote); err != nil {
+		return SkipSegment{}, status.Error(codes.Internal, "db")
+	}
+	g, err := scanSkipSegment(tx.QueryRow(ctx, `
+UPDATE episode_skip_segments
+SET score=(SELECT COALESCE(SUM(vote), 0) FROM episode_skip_segment_votes WHERE segment_id=$1), updated_at=now()
+WHERE id=$1
+RETURNING `+skipSegmentColumns, id))
+	if err != nil {
+		return SkipSegment{}, status.Error(codes.Internal, "db")
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return SkipSegment{}, status.Error(codes.Internal, "db commit")
+	}
+	return g, nil
+}
+
+func requireEpisode(ctx context.Context, tx pgx.Tx, id uuid.UUID) error {
+	var exists bool
+	if err := tx.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM episodes WHERE id=$1)`, id).Scan(&exists); err != nil {
+		return status.Error(codes.Internal, "db")
+	}
+	if !exists {
+		return status.Error(codes.NotFound, "episode not found")
+	}
+	return nil
+}
@@ -0,0 +1,513 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/example/anime-platform/internal/platform/geo"
+	"github.com/example/anime-platform/services/catalog/internal/snapshot"
+)
+
+// importBatchSize bounds how many snapshot records share one import transaction.
+const importBatchSize = 500
+
+// Extra ImportSnapshot counts, next to the per-record-type ones, for rows
+// that collided with data already in the catalog.
+const (
+	// ImportMergedLocalAnime counts local anime that held a provider mapping
+	// the snapshot gives to another anime. They are merged into the snapshot
+	// anime, leaving a redirect.
+	ImportMergedLocalAnime = "merged_local_anime"
+	// ImportMatchedLocalEpisodes counts snapshot episodes whose anime and
+	// number already existed locally under another ID. The local row is
+	// updated and keeps its ID.
+	ImportMatchedLocalEpisodes = "matched_local_episode"
+)
+
+// snapshotImport is the state ImportSnapshot carries across batches.
+type snapshotImport struct {
+	counts map[string]int
+	// episodeIDs maps snapshot episode IDs to the local IDs they matched.
+	episodeIDs map[string]string
+}
+
+// ExportSnapshot writes the whole catalog to w from one consistent read-only snapshot.
+func (s *PostgresCatalogStore) ExportSnapshot(ctx context.Context, w *snapshot.Writer) error {
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	for _, step := range []struct {
+		name string
+		fn   func(context.Context, pgx.Tx, *snapshot.Writer) error
+	}{
+		{"taxonomy", exportTaxa},
+		{"anime", exportAnime},
+		{"translations", exportTranslations},
+		{"external anime ids", exportExternalAnimeIDs},
+		{"redirects", exportRedirects},
+		{"relations", exportRelations},
+		{"franchises", exportFranchises},
+		{"franchise entries", exportFranchiseEntries},
+		{"episodes", exportEpisodes},
+		{"external episode ids", exportExternalEpisodeIDs},
+	} {
+		if err := step.fn(ctx, tx, w); err != nil {
+			return fmt.Errorf("export %s: %w", step.name, err)
+		}
+	}
+	return nil
+}
+
+// ImportSnapshot applies every record from r. Rows are upserted by primary key, so
+// importing the same snapshot twice leaves the catalog unchanged. Each imported anime
+// gets an outbox event so downstream indexes pick it up.
+//
+// Importing into a catalog that already has data reconciles collisions instead
+// of failing: episodes are matched by anime and number, and a local anime that
+// owns one of the snapshot's provider mappings is merged into the snapshot's
+// anime. Both are reported in the returned counts.
+func (s *PostgresCatalogStore) ImportSnapshot(ctx context.Context, r *snapshot.Reader) (map[string]int, error) {
+	counts := map[string]int{}
+	imp := &snapshotImport{counts: map[string]int{}, episodeIDs: map[string]string{}}
+	for done := false; !done; {
+		tx, err := s.db.Begin(ctx)
+		if err != nil {
+			return counts, err
+		}
+		batch := map[string]int{}
+		for n := 0; n < importBatchSize; n++ {
+			rec, err := r.Next()
+			if errors.Is(err, io.EOF) {
+				done = true
+				break
+			}
+			if err == nil {
+				err = imp.record(ctx, tx, rec)
+			}
+			if err != nil {
+				_ = tx.Rollback(ctx)
+				return counts, err
+			}
+			batch[rec.Type]++
+		}
+		if err := tx.Commit(ctx); err != nil {
+			return counts, err
+		}
+		for typ, n := range batch {
+			counts[typ] += n
+		}
+		// Conflict counts from a rolled-back batch never reach the caller.
+		for typ, n := range imp.counts {
+			counts[typ] += n
+		}
+		clear(imp.counts)
+	}
+	return counts, nil
+}
+
+func exportTaxa(ctx context.Context, tx pgx.Tx, w *snapshot.Writer) error {
+	for _, t := range taxonomyTables {
+		rows, err := tx.Query(ctx, fmt.Sprintf(`SELECT slug, name, mal_id FROM %s ORDER BY slug`, t.table))
+		if err != nil {
+			return err
+		}
+		err = forEachRow(rows, func() error {
+			rec := snapshot.Taxon{Kind: t.kind}
+			if err := rows.Scan(&rec.Slug, &rec.Name, &rec.MalID); err != nil {
+				return err
+			}
+			return w.Write(snapshot.TypeTaxon, rec)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func exportAnime(ctx context.Context, tx pgx.Tx, w *snapshot.Writer) error {
+	rows, err := tx.Query(ctx, `
+SELECT a.id, a.title, a.title_english, a.title_japanese, a.other_name, a.url, a.image, a.description,
+       a.genres, a.sub_or_dub, a.type, a.status, a.total_episodes, a.score,
+       a.visibility, a.visibility_reason, a.allowed_countries, a.blocked_countries,
+       ARRAY(SELECT genre_slug FROM anime_genres WHERE anime_id = a.id ORDER BY 1),
+       ARRAY(SELECT theme_slug FROM anime_themes WHERE anime_id = a.id ORDER BY 1),
+       ARRAY(SELECT demographic_slug FROM anime_demographics WHERE anime_id = a.id ORDER BY 1),
+       a.created_at, a.updated_at
+FROM anime a
+ORDER BY a.id`)
+	if err != nil {
+		return err
+	}
+	return forEachRow(rows, func() error {
+		var a snapshot.Anime
+		if err := rows.Scan(&a.ID, &a.Title, &a.TitleEnglish, &a.TitleJapanese, &a.OtherName, &a.URL, &a.Image, &a.Description,
+			&a.Genres, &a.SubOrDub, &a.Type, &a.Status, &a.TotalEpisodes, &a.Score,
+			&a.Visibility, &a.VisibilityReason, &a.AllowedCountries, &a.BlockedCountries,
+			&a.GenreSlugs, &a.ThemeSlugs, &a.DemographicSlugs,
+			&a.CreatedAt, &a.UpdatedAt); err != nil {
+			return err
+		}
+		return w.Write(snapshot.TypeAnime, a)
+	})
+}
+
+func exportTranslations(ctx context.Context, tx pgx.Tx, w *snapshot.Writer) error {
+	rows, err := tx.Query(ctx, `SELECT anime_id, locale, title, synopsis, updated_at FROM anime_translations ORDER BY anime_id, locale`)
+	if err != nil {
+		return err
+	}
+	return forEachRow(rows, func() error {
+		var t snapshot.Translation
+		if err := rows.Scan(&t.AnimeID, &t.Locale, &t.Title, &t.Synopsis, &t.UpdatedAt); err != nil {
+			return err
+		}
+		return w.Write(snapshot.TypeTranslation, t)
+	})
+}
+
+func exportExternalAnimeIDs(ctx context.Context, tx pgx.Tx, w *snapshot.Writer) error {
+	rows, err := tx.Query(ctx, `SELECT provider, provider_anime_id, anime_id, created_at FROM external_anime_ids ORDER BY provider, provider_anime_id`)
+	if err != nil {
+		return err
+	}
+	return forEachRow(rows, func() error {
+		var x snapshot.ExternalAnimeID
+		if err := rows.Scan(&x.Provider, &x.ProviderAnimeID, &x.AnimeID, &x.CreatedAt); err != nil {
+			return err
+		}
+		return w.Write(snapshot.TypeExternalAnimeID, x)
+	})
+}
+
+func exportRedirects(ctx context.Context, tx pgx.Tx, w *snapshot.Writer) error {
+	rows, err := tx.Query(ctx, `SELECT source_anime_id, target_anime_id, merged_at FROM anime_redirects ORDER BY source_anime_id`)
+	if err != nil {
+		return err
+	}
+	return forEachRow(rows, func() error {
+		var rd snapshot.Redirect
+		if err := rows.Scan(&rd.SourceAnimeID, &rd.TargetAnimeID, &rd.MergedAt); err != nil {
+			return err
+		}
+		return w.Write(snapshot.TypeRedirect, rd)
+	})
+}
+
+func exportRelations(ctx context.Context, tx pgx.Tx, w *snapshot.Writer) error {
+	rows, err := tx.Query(ctx, `
+SELECT anime_id, relation, provider, related_provider_anime_id, updated_at
+FROM anime_relations
+ORDER BY anime_id, provider, related_provider_anime_id, relation`)
+	if err != nil {
+		return err
+	}
+	return forEachRow(rows, func() error {
+		var rel snapshot.Relation
+		if err := rows.Scan(&rel.AnimeID, &rel.Relation, &rel.Provider, &rel.RelatedProviderAnimeID, &rel.UpdatedAt); err != nil {
+			return err
+		}
+		return w.Write(snapshot.TypeRelation, rel)
+	})
+}
+
+func exportFranchises(ctx context.Context, tx pgx.Tx, w *snapshot.Writer) error {
+	rows, err := tx.Query(ctx, `SELECT id, title, image, description, created_at, updated_at FROM franchises ORDER BY id`)
+	if err != nil {
+		return err
+	}
+	return forEachRow(rows, func() error {
+		var f snapshot.Franchise
+		if err := rows.Scan(&f.ID, &f.Title, &f.Image, &f.Description, &f.CreatedAt, &f.UpdatedAt); err != nil {
+			return err
+		}
+		return w.Write(snapshot.TypeFranchise, f)
+	})
+}
+
+func exportFranchiseEntries(ctx context.Context, tx pgx.Tx, w *snapshot.Writer) error {
+	rows, err := tx.Query(ctx, `SELECT franchise_id, anime_id, position FROM franchise_entries ORDER BY franchise_id, position, anime_id`)
+	if err != nil {
+		return err
+	}
+	return forEachRow(rows, func() error {
+		var e snapshot.FranchiseEntry
+		if err := rows.Scan(&e.FranchiseID, &e.AnimeID, &e.Position); err != nil {
+			return err
+		}
+		return w.Write(snapshot.TypeFranchiseEntry, e)
+	})
+}
+
+func exportEpisodes(ctx context.Context, tx pgx.Tx, w *snapshot.Writer) error {
+	rows, err := tx.Query(ctx, `
+SELECT id, anime_id, number, title, url, aired_at, is_filler, is_recap, duration_seconds, synopsis, thumbnail,
+       visibility, visibility_reason, allowed_countries, blocked_countries, created_at, updated_at
+FROM episodes
+ORDER BY anime_id, number`)
+	if err != nil {
+		return err
+	}
+	return forEachRow(rows, func() error {
+		var e snapshot.Episode
+		if err := rows.Scan(&e.ID, &e.AnimeID, &e.Number, &e.Title, &e.URL, &e.AiredAt, &e.IsFiller, &e.IsRecap, &e.DurationSeconds, &e.Synopsis, &e.Thumbnail,
+			&e.Visibility, &e.VisibilityReason, &e.AllowedCountries, &e.BlockedCountries, &e.CreatedAt, &e.UpdatedAt); err != nil {
+			return err
+		}
+		return w.Write(snapshot.TypeEpisode, e)
+	})
+}
+
+func exportExternalEpisodeIDs(ctx context.Context, tx pgx.Tx, w *snapshot.Writer) error {
+	rows, err := tx.Query(ctx, `
+SELECT provider, provider_episode_id, episode_id, has_sub, has_dub, created_at, updated_at
+FROM external_episode_ids
+ORDER BY provider, provider_episode_id`)
+	if err != nil {
+		return err
+	}
+	return forEachRow(rows, func() error {
+		var x snapshot.ExternalEpisodeID
+		if err := rows.Scan(&x.Provider, &x.ProviderEpisodeID, &x.EpisodeID, &x.HasSub, &x.HasDub, &x.CreatedAt, &x.UpdatedAt); err != nil {
+			return err
+		}
+		return w.Write(snapshot.TypeExternalEpisodeID, x)
+	})
+}
+
+func forEachRow(rows pgx.Rows, fn func() error) error {
+	defer rows.Close()
+	for rows.Next() {
+		if err := fn(); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func (imp *snapshotImport) record(ctx context.Context, tx pgx.Tx, rec snapshot.Record) error {
+	var err error
+	switch rec.Type {
+	case snapshot.TypeTaxon:
+		var t snapshot.Taxon
+		if err = json.Unmarshal(rec.Data, &t); err == nil {
+			err = importTaxon(ctx, tx, t)
+		}
+	case snapshot.TypeAnime:
+		var a snapshot.Anime
+		if err = json.Unmarshal(rec.Data, &a); err == nil {
+			err = importAnime(ctx, tx, a)
+		}
+	case snapshot.TypeTranslation:
+		var t snapshot.Translation
+		if err = json.Unmarshal(rec.Data, &t); err == nil {
+			_, err = tx.Exec(ctx, `
+INSERT INTO anime_translations (anime_id, locale, title, synopsis, updated_at) VALUES ($1,$2,$3,$4,$5)
+ON CONFLICT (anime_id, locale) DO UPDATE SET title=EXCLUDED.title, synopsis=EXCLUDED.synopsis, updated_at=EXCLUDED.updated_at`,
+				t.AnimeID, t.Locale, t.Title, t.Synopsis, t.UpdatedAt)
+		}
+	case snapshot.TypeExternalAnimeID:
+		var x snapshot.ExternalAnimeID
+		if err = json.Unmarshal(rec.Data, &x); err == nil {
+			err = imp.externalAnimeID(ctx, tx, x)
+		}
+	case snapshot.TypeRedirect:
+		var rd snapshot.Redirect
+		if err = json.Unmarshal(rec.Data, &rd); err == nil {
+			_, err = tx.Exec(ctx, `
+INSERT INTO anime_redirects (source_anime_id, target_anime_id, merged_at) VALUES ($1,$2,$3)
+ON CONFLICT (source_anime_id) DO UPDATE SET target_anime_id=EXCLUDED.target_anime_id, merged_at=EXCLUDED.merged_at`,
+				rd.SourceAnimeID, rd.TargetAnimeID, rd.MergedAt)
+		}
+	case snapshot.TypeRelation:
+		var rel snapshot.Relation
+		if err = json.Unmarshal(rec.Data, &rel); err == nil {
+			_, err = tx.Exec(ctx, `
+INSERT INTO anime_relations (anime_id, relation, provider, related_provider_anime_id, updated_at) VALUES ($1,$2,$3,$4,$5)
+ON CONFLICT (anime_id, provider, related_provider_anime_id, relation) DO UPDATE SET updated_at=EXCLUDED.updated_at`,
+				rel.AnimeID, rel.Relation, rel.Provider, rel.RelatedProviderAnimeID, rel.UpdatedAt)
+		}
+	case snapshot.TypeFranchise:
+		var f snapshot.Franchise
+		if err = json.Unmarshal(rec.Data, &f); err == nil {
+			_, err = tx.Exec(ctx, `
+INSERT INTO franchises (id, title, image, description, created_at, updated_at) VALUES ($1,$2,$3,$4,$5,$6)
+ON CONFLICT (id) DO UPDATE SET title=EXCLUDED.title, image=EXCLUDED.image, description=EXCLUDED.description, updated_at=EXCLUDED.updated_at`,
+				f.ID, f.Title, f.Image, f.Description, f.CreatedAt, f.UpdatedAt)
+		}
+	case snapshot.TypeFranchiseEntry:
+		var e snapshot.FranchiseEntry
+		if err = json.Unmarshal(rec.Data, &e); err == nil {
+			_, err = tx.Exec(ctx, `
+INSERT INTO franchise_entries (franchise_id, anime_id, position) VALUES ($1,$2,$3)
+ON CONFLICT (franchise_id, anime_id) DO UPDATE SET position=EXCLUDED.position`,
+				e.FranchiseID, e.AnimeID, e.Position)
+		}
+	case snapshot.TypeEpisode:
+		var e snapshot.Episode
+		if err = json.Unmarshal(rec.Data, &e); err == nil {
+			err = imp.episode(ctx, tx, e)
+		}
+	case snapshot.TypeExternalEpisodeID:
+		var x snapshot.ExternalEpisodeID
+		if err = json.Unmarshal(rec.Data, &x); err == nil {
+			if local, ok := imp.episodeIDs[x.EpisodeID]; ok {
+				x.EpisodeID = local
+			}
+			_, err = tx.Exec(ctx, `
+INSERT INTO external_episode_ids (provider, provider_episode_id, episode_id, has_sub, has_dub, created_at, updated_at)
+VALUES ($1,$2,$3,$4,$5,$6,$7)
+ON CONFLICT (provider, provider_episode_id) DO UPDATE SET
+  episode_id=EXCLUDED.episode_id, has_sub=EXCLUDED.has_sub, has_dub=EXCLUDED.has_dub, updated_at=EXCLUDED.updated_at`,
+				x.Provider, x.ProviderEpisodeID, x.EpisodeID, x.HasSub, x.HasDub, x.CreatedAt, x.UpdatedAt)
+		}
+	default:
+		return fmt.Errorf("import: unknown record type %q", rec.Type)
+	}
+	if err != nil {
+		return fmt.Errorf("import %s: %w", rec.Type, err)
+	}
+	return nil
+}
+
+// externalAnimeID records a snapshot provider mapping. A mapping already
+// owned by another local anime means that anime duplicates the snapshot's, so
+// it is merged into it, as an admin merge would, rather than having the
+// mapping moved away and its episodes stranded.
+func (imp *snapshotImport) externalAnimeID(ctx context.Context, tx pgx.Tx, x snapshot.ExternalAnimeID) error {
+	target, err := uuid.Parse(x.AnimeID)
+	if err != nil {
+		return fmt.Errorf("anime_id %q: %w", x.AnimeID, err)
+	}
+	var owner uuid.UUID
+	err = tx.QueryRow(ctx, `SELECT anime_id FROM external_anime_ids WHERE provider=$1 AND provider_anime_id=$2`,
+		x.Provider, x.ProviderAnimeID).Scan(&owner)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+	case err != nil:
+		return err
+	case owner != target:
+		if _, err := mergeAnimeTx(ctx, tx, owner, target); err != nil {
+			return fmt.Errorf("merge local anime %s owning %s:%s into %s: %w", owner, x.Provider, x.ProviderAnimeID, target, err)
+		}
+		imp.counts[ImportMergedLocalAnime]++
+	}
+	// After a merge the mapping already points at target.
+	_, err = tx.Exec(ctx, `
+INSERT INTO external_anime_ids (provider, provider_anime_id, anime_id, created_at) VALUES ($1,$2,$3,$4)
+ON CONFLICT (provider, provider_anime_id) DO NOTHING`,
+		x.Provider, x.ProviderAnimeID, target, x.CreatedAt)
+	return err
+}
+
+// episode upserts a snapshot episode. An episode with the same anime and
+// number under another ID is updated in place and keeps its ID; later
+// records referring to the snapshot ID are pointed at it.
+func (imp *snapshotImport) episode(ctx context.Context, tx pgx.Tx, e snapshot.Episode) error {
+	id, err := uuid.Parse(e.ID)
+	if err != nil {
+		return fmt.Errorf("id %q: %w", e.ID, err)
+	}
+	var local uuid.UUID
+	err = tx.QueryRow(ctx, `SELECT id FROM episodes WHERE anime_id=$1 AND number=$2`, e.AnimeID, e.Number).Scan(&local)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+	case err != nil:
+		return err
+	case local != id:
+		imp.episodeIDs[e.ID] = local.String()
+		imp.counts[ImportMatchedLocalEpisodes]++
+		id = local
+	}
+	_, err = tx.Exec(ctx, `
+INSERT INTO episodes (id, anime_id, number, title, url, aired_at, is_filler, is_recap, duration_seconds, synopsis, thumbnail,
+                      visibility, visibility_reason, allowed_countries, blocked_countries, created_at, updated_at)
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17)
+ON CONFLICT (id) DO UPDATE SET
+  anime_id=EXCLUDED.anime_id, number=EXCLUDED.number, title=EXCLUDED.title, url=EXCLUDED.url, aired_at=EXCLUDED.aired_at,
+  is_filler=EXCLUDED.is_filler, is_recap=EXCLUDED.is_recap, duration_seconds=EXCLUDED.duration_seconds,
+  synopsis=EXCLUDED.synopsis, thumbnail=EXCLUDED.thumbnail, visibility=EXCLUDED.visibility,
+  visibility_reason=EXCLUDED.visibility_reason, allowed_countries=EXCLUDED.allowed_countries,
+  blocked_countries=EXCLUDED.blocked_countries, updated_at=EXCLUDED.updated_at`,
+		id, e.AnimeID, e.Number, e.Title, e.URL, e.AiredAt, e.IsFiller, e.IsRecap, e.DurationSeconds, e.Synopsis, e.Thumbnail,
+		visibilityOrDefault(e.Visibility), e.VisibilityReason, geo.NormalizeList(e.AllowedCountries), geo.NormalizeList(e.BlockedCountries), e.CreatedAt, e.UpdatedAt)
+	return err
+}
+
+func importTaxon(ctx context.Context, tx pgx.Tx, t snapshot.Taxon) error {
+	table, ok := taxonomyTableByKind(t.Kind)
+	if !ok {
+		return fmt.Errorf("unknown taxonomy kind %q", t.Kind)
+	}
+	_, err := tx.Exec(ctx, fmt.Sprintf(`
+INSERT INTO %[1]s (slug, name, mal_id) VALUES ($1,$2,$3)
+ON CONFLICT (slug) DO UPDATE SET name=EXCLUDED.name, mal_id=COALESCE(EXCLUDED.mal_id, %[1]s.mal_id)`, table.table),
+		t.Slug, t.Name, t.MalID)
+	return err
+}
+
+func importAnime(ctx context.Context, tx pgx.Tx, a snapshot.Anime) error {
+	genres := []byte(a.Genres)
+	if len(genres) == 0 {
+		genres = []byte("[]")
+	}
+	if _, err := tx.Exec(ctx, `
+INSERT INTO anime (id, title, title_english, title_japanese, other_name, url, image, description, genres, sub_or_dub, type, status,
+                   total_episodes, score, visibility, visibility_reason, allowed_countries, blocked_countries, created_at, updated_at)
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20)
+ON CONFLICT (id) DO UPDATE SET
+  title=EXCLUDED.title, title_english=EXCLUDED.title_english, title_japanese=EXCLUDED.title_japanese,
+  other_name=EXCLUDED.other_name, url=EXCLUDED.url, image=EXCLUDED.image, description=EXCLUDED.description,
+  genres=EXCLUDED.genres, sub_or_dub=EXCLUDED.sub_or_dub, type=EXCLUDED.type, status=EXCLUDED.status,
+  total_episodes=EXCLUDED.total_episodes, score=EXCLUDED.score, visibility=EXCLUDED.visibility,
+  visibility_reason=EXCLUDED.visibility_reason, allowed_countries=EXCLUDED.allowed_countries,
+  blocked_countries=EXCLUDED.blocked_countries, updated_at=EXCLUDED.updated_at`,
+		a.ID, a.Title, a.TitleEnglish, a.TitleJapanese, a.OtherName, a.URL, a.Image, a.Description, genres, a.SubOrDub, a.Type, a.Status,
+		a.TotalEpisodes, a.Score, visibilityOrDefault(a.Visibility), a.VisibilityReason,
+		geo.NormalizeList(a.AllowedCountries), geo.NormalizeList(a.BlockedCountries), a.CreatedAt, a.UpdatedAt,
+	); err != nil {
+		return err
+	}
+	// Mirrored images are not part of the snapshot; the importing side mirrors
+	// the covers itself.
+	if err := enqueueAnimeImage(ctx, tx, a.ID, a.Image); err != nil {
+		return err
+	}
+
+	for _, link := range []struct {
+		table taxonomyTable
+		slugs []string
+	}{
+		{genreTable, a.GenreSlugs},
+		{themeTable, a.ThemeSlugs},
+		{demographicTable, a.DemographicSlugs},
+	} {
+		t := link.table
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE anime_id=$1`, t.join), a.ID); err != nil {
+			return err
+		}
+		for _, slug := range link.slugs {
+			if _, err := tx.Exec(ctx, fmt.Sprintf(`INSERT INTO %s (anime_id, %s) VALUES ($1,$2) ON CONFLICT DO NOTHING`, t.join, t.column), a.ID, slug); err != nil {
+				return err
+			}
+		}
+	}
+
+	return emitAnimeUpserted(ctx, tx, a.ID, HistoryReasonImport)
+}
+
+func visibilityOrDefault(v string) string {
+	if v == "" {
+		return VisibilityVisible
+	}
+	return v
+}
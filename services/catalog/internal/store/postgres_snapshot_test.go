@@ -0,0 +1,118 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/example/anime-platform/services/catalog/internal/snapshot"
+)
+
+type snapshotRecord struct {
+	typ string
+	v   any
+}
+
+func importRecords(t *testing.T, st *PostgresCatalogStore, recs ...snapshotRecord) map[string]int {
+	t.Helper()
+	var buf bytes.Buffer
+	w, err := snapshot.NewWriter(&buf, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, rec := range recs {
+		if err := w.Write(rec.typ, rec.v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	r, err := snapshot.NewReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	counts, err := st.ImportSnapshot(context.Background(), r)
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	return counts
+}
+
+const (
+	localAnime    = "11111111-1111-1111-1111-111111111111"
+	snapAnime     = "22222222-2222-2222-2222-222222222222"
+	localEpisode  = "33333333-3333-3333-3333-333333333333"
+	snapEpisode   = "44444444-4444-4444-4444-444444444444"
+	otherEpisode  = "55555555-5555-5555-5555-555555555555"
+	snapshotTitle = "Snapshot title"
+)
+
+func anime(id, title string) snapshotRecord {
+	return snapshotRecord{snapshot.TypeAnime, snapshot.Anime{ID: id, Title: title, Genres: json.RawMessage(`[]`)}}
+}
+
+func TestImportSnapshot_MatchesLocalEpisodeByNumber(t *testing.T) {
+	st, pool := testStore(t)
+	ctx := context.Background()
+	importRecords(t, st,
+		anime(localAnime, "Local"),
+		snapshotRecord{snapshot.TypeEpisode, snapshot.Episode{ID: localEpisode, AnimeID: localAnime, Number: 1, Title: "Local ep"}},
+	)
+
+	counts := importRecords(t, st,
+		anime(localAnime, snapshotTitle),
+		snapshotRecord{snapshot.TypeEpisode, snapshot.Episode{ID: snapEpisode, AnimeID: localAnime, Number: 1, Title: "Snapshot ep"}},
+		snapshotRecord{snapshot.TypeExternalEpisodeID, snapshot.ExternalEpisodeID{Provider: "hianime", ProviderEpisodeID: "show-1?ep=1", EpisodeID: snapEpisode}},
+	)
+	if counts[ImportMatchedLocalEpisodes] != 1 {
+		t.Fatalf("counts = %v, want one matched episode", counts)
+	}
+	var id, title string
+	if err := pool.QueryRow(ctx, `SELECT id::text, title FROM episodes WHERE anime_id=$1 AND number=1`, localAnime).Scan(&id, &title); err != nil {
+		t.Fatal(err)
+	}
+	if id != localEpisode || title != "Snapshot ep" {
+		t.Fatalf("episode = %s %q, want local id with snapshot fields", id, title)
+	}
+	var mapped string
+	if err := pool.QueryRow(ctx, `SELECT episode_id::text FROM external_episode_ids WHERE provider='hianime'`).Scan(&mapped); err != nil {
+		t.Fatal(err)
+	}
+	if mapped != localEpisode {
+		t.Fatalf("provider episode mapped to %s, want %s", mapped, localEpisode)
+	}
+}
+
+func TestImportSnapshot_MergesLocalAnimeOwningMapping(t *testing.T) {
+	st, pool := testStore(t)
+	ctx := context.Background()
+	importRecords(t, st,
+		anime(localAnime, "Local"),
+		snapshotRecord{snapshot.TypeExternalAnimeID, snapshot.ExternalAnimeID{Provider: "mal", ProviderAnimeID: "100", AnimeID: localAnime}},
+		snapshotRecord{snapshot.TypeEpisode, snapshot.Episode{ID: localEpisode, AnimeID: localAnime, Number: 1}},
+		snapshotRecord{snapshot.TypeEpisode, snapshot.Episode{ID: otherEpisode, AnimeID: localAnime, Number: 2}},
+	)
+
+	counts := importRecords(t, st,
+		anime(snapAnime, snapshotTitle),
+		snapshotRecord{snapshot.TypeExternalAnimeID, snapshot.ExternalAnimeID{Provider: "mal", ProviderAnimeID: "100", AnimeID: snapAnime}},
+		snapshotRecord{snapshot.TypeEpisode, snapshot.Episode{ID: snapEpisode, AnimeID: snapAnime, Number: 1}},
+	)
+	if counts[ImportMergedLocalAnime] != 1 {
+		t.Fatalf("counts = %v, want one merged anime", counts)
+	}
+	var target string
+	if err := pool.QueryRow(ctx, `SELECT target_anime_id::text FROM anime_redirects WHERE source_anime_id=$1`, localAnime).Scan(&target); err != nil {
+		t.Fatalf("redirect: %v", err)
+	}
+	if target != snapAnime {
+		t.Fatalf("redirect target = %s", target)
+	}
+	var episodes int
+	if err := pool.QueryRow(ctx, `SELECT count(*) FROM episodes WHERE anime_id=$1`, snapAnime).Scan(&episodes); err != nil {
+		t.Fatal(err)
+	}
+	if episodes != 2 {
+		t.Fatalf("snapshot anime has %d episodes, want the local two", episodes)
+	}
+}
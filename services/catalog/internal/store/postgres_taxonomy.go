@@ -0,0 +1,151 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/example/anime-platform/internal/platform/taxonomy"
+)
+
+// taxonomyTable describes one normalized taxonomy and its anime join table.
+// Names are constants, never user input, so they are safe to interpolate.
+type taxonomyTable struct {
+	kind   string
+	table  string
+	join   string
+	column string
+}
+
+var (
+	genreTable       = taxonomyTable{kind: taxonomy.KindGenre, table: "genres", join: "anime_genres", column: "genre_slug"}
+	themeTable       = taxonomyTable{kind: taxonomy.KindTheme, table: "themes", join: "anime_themes", column: "theme_slug"}
+	demographicTable = taxonomyTable{kind: taxonomy.KindDemographic, table: "demographics", join: "anime_demographics", column: "demographic_slug"}
+
+	taxonomyTables = []taxonomyTable{genreTable, themeTable, demographicTable}
+)
+
+func taxonomyTableByKind(kind string) (taxonomyTable, bool) {
+	for _, t := range taxonomyTables {
+		if t.kind == kind {
+			return t, true
+		}
+	}
+	return taxonomyTable{}, false
+}
+
+// jsonSQL selects the anime's entries as a JSON array of {slug, name} ordered by name.
+func (t taxonomyTable) jsonSQL(animeAlias string) string {
+	return fmt.Sprintf(`(SELECT COALESCE(jsonb_agg(jsonb_build_object('slug', x.slug, 'name', x.name) ORDER BY x.name), '[]'::jsonb)
+   FROM %[1]s j JOIN %[2]s x ON x.slug = j.%[3]s WHERE j.anime_id = %[4]s.id)`, t.join, t.table, t.column, animeAlias)
+}
+
+func (s *PostgresCatalogStore) ListGenres(ctx context.Context, kind string) ([]GenreCount, error) {
+	kind = strings.TrimSpace(kind)
+	if kind != "" && !taxonomy.ValidKind(kind) {
+		return nil, status.Error(codes.InvalidArgument, "unknown kind")
+	}
+
+	var parts []string
+	for _, t := range taxonomyTables {
+		if kind != "" && t.kind != kind {
+			continue
+		}
+		parts = append(parts, fmt.Sprintf(`
+SELECT x.slug, x.name, '%[1]s' AS kind, COUNT(a.id)::int AS anime_count
+FROM %[2]s x
+LEFT JOIN %[3]s j ON j.%[4]s = x.slug
+LEFT JOIN anime a ON a.id = j.anime_id AND a.visibility = 'visible'
+GROUP BY x.slug, x.name`, t.kind, t.table, t.join, t.column))
+	}
+
+	rows, err := s.db.Query(ctx, strings.Join(parts, "\nUNION ALL")+"\nORDER BY kind, name")
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db query")
+	}
+	defer rows.Close()
+
+	var out []GenreCount
+	for rows.Next() {
+		var g GenreCount
+		if err := rows.Scan(&g.Slug, &g.Name, &g.Kind, &g.AnimeCount); err != nil {
+			return nil, status.Error(codes.Internal, "db scan")
+		}
+		out = append(out, g)
+	}
+	return out, nil
+}
+
+// replaceAnimeTaxonomy makes entries the complete set of t-entries for the anime,
+// creating taxonomy rows for slugs seen for the first time.
+func replaceAnimeTaxonomy(ctx context.Context, tx pgx.Tx, animeID uuid.UUID, t taxonomyTable, entries []Taxon) error {
+	if _, err := tx.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE anime_id=$1`, t.join), animeID); err != nil {
+		return err
+	}
+	for _, e := range entries {
+		name := strings.TrimSpace(e.Name)
+		slug := taxonomy.Slug(name)
+		if slug == "" {
+			continue
+		}
+		var malID *int32
+		if e.MalID > 0 {
+			malID = &e.MalID
+		}
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`
+INSERT INTO %[1]s (slug, name, mal_id) VALUES ($1,$2,$3)
+ON CONFLICT (slug) DO UPDATE SET mal_id = COALESCE(%[1]s.mal_id, EXCLUDED.mal_id)`, t.table),
+			slug, name, malID,
+		); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`INSERT INTO %s (anime_id, %s) VALUES ($1,$2) ON CONFLICT DO NOTHING`, t.join, t.column),
+			animeID, slug,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeAnimeTaxonomy adds the source's genres, themes and demographics to the
+// target's.
+func mergeAnimeTaxonomy(ctx context.Context, tx pgx.Tx, src, dst uuid.UUID) error {
+	for _, t := range taxonomyTables {
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`
+INSERT INTO %[1]s (anime_id, %[2]s) SELECT $2, %[2]s FROM %[1]s WHERE anime_id=$1
+ON CONFLICT DO NOTHING`, t.join, t.column), src, dst); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// upsertJikanTaxonomy writes genres, themes and demographics for a Jikan anime.
+func upsertJikanTaxonomy(ctx context.Context, tx pgx.Tx, animeID uuid.UUID, a JikanAnimeInput) error {
+	genres := a.TypedGenres
+	if len(genres) == 0 {
+		for _, name := range a.Genres {
+			genres = append(genres, Taxon{Name: name})
+		}
+	}
+	if err := replaceAnimeTaxonomy(ctx, tx, animeID, genreTable, genres); err != nil {
+		return err
+	}
+	if err := replaceAnimeTaxonomy(ctx, tx, animeID, themeTable, a.Themes); err != nil {
+		return err
+	}
+	return replaceAnimeTaxonomy(ctx, tx, animeID, demographicTable, a.Demographics)
+}
+
+func decodeGenres(b []byte) []Genre {
+	var out []Genre
+	_ = json.Unmarshal(b, &out)
+	return out
+}
@@ -0,0 +1,166 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/example/anime-platform/internal/platform/geo"
+	"github.com/example/anime-platform/internal/platform/taxonomy"
+	"github.com/example/anime-platform/services/catalog/internal/dbtest"
+	"github.com/example/anime-platform/services/catalog/internal/snapshot"
+)
+
+// testStore returns a store on a fresh, migrated schema; see dbtest.Pool.
+func testStore(t *testing.T) (*PostgresCatalogStore, *pgxpool.Pool) {
+	t.Helper()
+	pool := dbtest.Pool(t)
+	return NewPostgresCatalogStore(pool), pool
+}
+
+func TestAvailability_UnknownCountryMatchesPlayback(t *testing.T) {
+	st, _ := testStore(t)
+	ctx := context.Background()
+	importRecords(t, st,
+		anime(localAnime, "Licensed in JP"),
+		snapshotRecord{snapshot.TypeEpisode, snapshot.Episode{ID: localEpisode, AnimeID: localAnime, Number: 1}},
+	)
+	if err := st.SetEpisodeAvailability(ctx, localEpisode, Availability{Visibility: VisibilityVisible, AllowedCountries: []string{"JP"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		country string
+		want    bool
+	}{{"", false}, {"US", false}, {"JP", true}, {geo.Anywhere, true}} {
+		playable, _, err := st.GetEpisodeAvailability(ctx, localEpisode, tc.country)
+		if err != nil {
+			t.Fatal(err)
+		}
+		byAnime, err := st.GetEpisodesByAnimeID(ctx, localAnime, tc.country)
+		if err != nil {
+			t.Fatal(err)
+		}
+		byID, err := st.GetEpisodesByIDs(ctx, []string{localEpisode}, tc.country)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if playable != tc.want || (len(byAnime) == 1) != tc.want || (len(byID) == 1) != tc.want {
+			t.Fatalf("country %q: playable=%v by anime=%d by id=%d, want %v", tc.country, playable, len(byAnime), len(byID), tc.want)
+		}
+	}
+
+	if err := st.SetAnimeAvailability(ctx, localAnime, Availability{Visibility: VisibilityVisible, AllowedCountries: []string{"JP"}, BlockedCountries: []string{"US"}}); err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		country string
+		want    bool
+	}{{"", false}, {"US", false}, {"JP", true}, {geo.Anywhere, true}} {
+		got, err := st.GetAnimeByIDs(ctx, []string{localAnime}, tc.country, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (len(got) == 1) != tc.want {
+			t.Fatalf("country %q: listed=%v, want %v", tc.country, len(got) == 1, tc.want)
+		}
+	}
+}
+
+func TestMergeAnime_KeepsOneMappingPerProvider(t *testing.T) {
+	st, pool := testStore(t)
+	ctx := context.Background()
+	importRecords(t, st,
+		anime(localAnime, "Source"),
+		anime(snapAnime, "Target"),
+		snapshotRecord{snapshot.TypeEpisode, snapshot.Episode{ID: localEpisode, AnimeID: localAnime, Number: 1}},
+		snapshotRecord{snapshot.TypeEpisode, snapshot.Episode{ID: snapEpisode, AnimeID: snapAnime, Number: 1}},
+		snapshotRecord{snapshot.TypeExternalEpisodeID, snapshot.ExternalEpisodeID{Provider: "hianime", ProviderEpisodeID: "source?ep=1", EpisodeID: localEpisode}},
+		snapshotRecord{snapshot.TypeExternalEpisodeID, snapshot.ExternalEpisodeID{Provider: "animepahe", ProviderEpisodeID: "pahe-1", EpisodeID: localEpisode}},
+		snapshotRecord{snapshot.TypeExternalEpisodeID, snapshot.ExternalEpisodeID{Provider: "hianime", ProviderEpisodeID: "target?ep=1", EpisodeID: snapEpisode}},
+	)
+
+	res, err := st.MergeAnime(ctx, localAnime, snapAnime)
+	if err != nil {
+		t.Fatalf("merge: %v", err)
+	}
+	if res.EpisodeIDMap[localEpisode] != snapEpisode || res.MergedEpisodes != 1 {
+		t.Fatalf("result = %+v", res)
+	}
+	providers, err := st.ListEpisodeProviders(ctx, snapEpisode)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]string{}
+	for _, p := range providers {
+		if _, dup := got[p.Provider]; dup {
+			t.Fatalf("two %s mappings: %+v", p.Provider, providers)
+		}
+		got[p.Provider] = p.ProviderEpisodeID
+	}
+	if got["hianime"] != "target?ep=1" || got["animepahe"] != "pahe-1" {
+		t.Fatalf("mappings = %v, want the target's hianime and the source's animepahe", got)
+	}
+	var redirected int
+	if err := pool.QueryRow(ctx, `SELECT count(*) FROM anime_redirects WHERE source_anime_id=$1`, localAnime).Scan(&redirected); err != nil || redirected != 1 {
+		t.Fatalf("redirect: %d %v", redirected, err)
+	}
+}
+
+func TestMergeAnime_MovesTranslationsAndTaxonomy(t *testing.T) {
+	st, pool := testStore(t)
+	ctx := context.Background()
+	source := snapshot.Anime{ID: localAnime, Title: "Source", Genres: json.RawMessage(`[]`), GenreSlugs: []string{"action"}, ThemeSlugs: []string{"school"}}
+	target := snapshot.Anime{ID: snapAnime, Title: "Target", Genres: json.RawMessage(`[]`), GenreSlugs: []string{"comedy"}}
+	importRecords(t, st,
+		snapshotRecord{snapshot.TypeTaxon, snapshot.Taxon{Kind: taxonomy.KindGenre, Slug: "action", Name: "Action"}},
+		snapshotRecord{snapshot.TypeTaxon, snapshot.Taxon{Kind: taxonomy.KindGenre, Slug: "comedy", Name: "Comedy"}},
+		snapshotRecord{snapshot.TypeTaxon, snapshot.Taxon{Kind: taxonomy.KindTheme, Slug: "school", Name: "School"}},
+		snapshotRecord{snapshot.TypeAnime, source},
+		snapshotRecord{snapshot.TypeAnime, target},
+		snapshotRecord{snapshot.TypeTranslation, snapshot.Translation{AnimeID: localAnime, Locale: "fr", Title: "Source FR"}},
+		snapshotRecord{snapshot.TypeTranslation, snapshot.Translation{AnimeID: localAnime, Locale: "de", Title: "Source DE", Synopsis: "Source synopsis"}},
+		snapshotRecord{snapshot.TypeTranslation, snapshot.Translation{AnimeID: snapAnime, Locale: "de", Title: "Target DE"}},
+	)
+
+	if _, err := st.MergeAnime(ctx, localAnime, snapAnime); err != nil {
+		t.Fatalf("merge: %v", err)
+	}
+
+	translations := map[string][2]string{}
+	rows, err := pool.Query(ctx, `SELECT locale, title, synopsis FROM anime_translations WHERE anime_id=$1`, snapAnime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for rows.Next() {
+		var loc, title, synopsis string
+		if err := rows.Scan(&loc, &title, &synopsis); err != nil {
+			t.Fatal(err)
+		}
+		translations[loc] = [2]string{title, synopsis}
+	}
+	rows.Close()
+	if translations["fr"] != [2]string{"Source FR", ""} || translations["de"] != [2]string{"Target DE", "Source synopsis"} {
+		t.Fatalf("translations = %v", translations)
+	}
+
+	got, err := st.GetAnimeByIDs(ctx, []string{snapAnime}, "", nil)
+	if err != nil || len(got) != 1 {
+		t.Fatalf("get target: %v %v", got, err)
+	}
+	var genres, themes []string
+	for _, g := range got[0].GenreTags {
+		genres = append(genres, g.Slug)
+	}
+	for _, g := range got[0].Themes {
+		themes = append(themes, g.Slug)
+	}
+	sort.Strings(genres)
+	if fmt.Sprint(genres) != "[action comedy]" || fmt.Sprint(themes) != "[school]" {
+		t.Fatalf("genres = %v, themes = %v", genres, themes)
+	}
+}
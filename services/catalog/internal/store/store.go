@@ -2,6 +2,9 @@ package store
 
 import (
 	"context"
+	"errors"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -21,16 +24,55 @@ type Anime struct {
 	OtherName     string
 	TotalEpisodes int32
 	Score         float32
+	// Locale is the locale Title and Description were resolved in.
+	Locale string
+	// GenreTags, Themes and Demographics come from the normalized taxonomy
+	// tables; Genres holds the genre display names for older clients.
+	GenreTags    []Genre
+	Themes       []Genre
+	Demographics []Genre
+	// ImageVariants are the mirrored copies of Image, empty until mirroring succeeds.
+	ImageVariants []ImageVariant
+	Popularity    Popularity
+	// BannerImage, ProviderScores and Tags come from enrichment providers.
+	BannerImage    string
+	ProviderScores []ProviderScore
+	Tags           []Tag
+}
+
+// Genre is a normalized taxonomy entry; the same shape is used for themes and demographics.
+type Genre struct {
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+}
+
+// GenreCount is a taxonomy entry with the number of visible anime tagged with it.
+type GenreCount struct {
+	Slug       string
+	Name       string
+	Kind       string
+	AnimeCount int32
+}
+
+// Taxon is a typed genre/theme/demographic entry as delivered by Jikan.
+type Taxon struct {
+	MalID int32
+	Name  string
 }
 
 // Episode is the internal catalog representation of a single episode.
 type Episode struct {
-	ID      string
-	AnimeID string
-	Number  int32
-	Title   string
-	URL     string
-	AiredAt *time.Time
+	ID              string
+	AnimeID         string
+	Number          int32
+	Title           string
+	URL             string
+	AiredAt         *time.Time
+	IsFiller        bool
+	IsRecap         bool
+	DurationSeconds int32
+	Synopsis        string
+	Thumbnail       string
 }
 
 // EpisodeInput carries provider-sourced episode data for upsert operations.
@@ -41,6 +83,56 @@ type EpisodeInput struct {
 	URL               string
 	IsFiller          bool
 	HasIsFiller       bool
+	HasSub            bool
+	HasDub            bool
+}
+
+// Visibility values for anime and episodes.
+const (
+	VisibilityVisible  = "visible"
+	VisibilityHidden   = "hidden"
+	VisibilityTakedown = "takedown"
+)
+
+// Availability controls whether content is served and where. Regional rules follow
+// geo.Allowed: a caller without a country only sees content that has no allow-list.
+type Availability struct {
+	Visibility       string
+	Reason           string
+	AllowedCountries []string
+	BlockedCountries []string
+}
+
+// EpisodeProvider is one provider's mapping for a catalog episode.
+type EpisodeProvider struct {
+	Provider          string
+	ProviderEpisodeID string
+	HasSub            bool
+	HasDub            bool
+}
+
+// ProviderEpisodeState is a provider's stored mapping of one catalog episode.
+type ProviderEpisodeState struct {
+	EpisodeID         string
+	ProviderEpisodeID string
+	Number            int32
+	Title             string
+	IsFiller          bool
+	HasSub            bool
+	HasDub            bool
+}
+
+// JikanEpisodeInput carries Jikan-sourced episode metadata, matched to catalog
+// episodes by number.
+type JikanEpisodeInput struct {
+	Number          int32
+	Title           string
+	AiredAt         *time.Time
+	IsFiller        bool
+	IsRecap         bool
+	DurationSeconds int32
+	Synopsis        string
+	Thumbnail       string
 }
 
 // JikanAnimeInput carries MAL/Jikan-sourced anime data.
@@ -56,24 +148,148 @@ type JikanAnimeInput struct {
 	Status        string
 	TotalEpisodes int32
 	Score         float32
+	// TypedGenres, Themes and Demographics feed the taxonomy tables. When
+	// TypedGenres is empty the plain Genres names are used instead.
+	TypedGenres  []Taxon
+	Themes       []Taxon
+	Demographics []Taxon
+	// Relations replace the anime's stored MAL relations when non-empty; list
+	// endpoints omit relations, so an empty slice leaves them untouched.
+	Relations []AnimeRelation
+}
+
+// MergeResult summarises what MergeAnime moved from the source onto the target.
+type MergeResult struct {
+	TargetAnimeID    string
+	MovedEpisodes    int32
+	MergedEpisodes   int32
+	MovedExternalIDs int32
+	// EpisodeIDMap maps source episode IDs that were folded into an existing
+	// target episode to that episode's ID. Moved episodes keep their ID.
+	EpisodeIDMap map[string]string
+}
+
+// ChangeCursor is a position in the catalog change feed. The zero value is the
+// start of the feed.
+type ChangeCursor struct {
+	TxID uint64
+	Seq  int64
+}
+
+func (c ChangeCursor) String() string {
+	if c == (ChangeCursor{}) {
+		return ""
+	}
+	return strconv.FormatUint(c.TxID, 10) + "." + strconv.FormatInt(c.Seq, 10)
+}
+
+// Before reports whether c sorts before o in the feed.
+func (c ChangeCursor) Before(o ChangeCursor) bool {
+	return c.TxID < o.TxID || (c.TxID == o.TxID && c.Seq < o.Seq)
+}
+
+// ParseChangeCursor parses a cursor produced by ChangeCursor.String.
+func ParseChangeCursor(s string) (ChangeCursor, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return ChangeCursor{}, nil
+	}
+	tx, seq, ok := strings.Cut(s, ".")
+	if !ok {
+		return ChangeCursor{}, errors.New("malformed cursor")
+	}
+	var c ChangeCursor
+	var err error
+	if c.TxID, err = strconv.ParseUint(tx, 10, 64); err != nil {
+		return ChangeCursor{}, errors.New("malformed cursor")
+	}
+	if c.Seq, err = strconv.ParseInt(seq, 10, 64); err != nil || c.Seq < 0 {
+		return ChangeCursor{}, errors.New("malformed cursor")
+	}
+	return c, nil
+}
+
+// CatalogChange is one outbox event as seen by the change feed.
+type CatalogChange struct {
+	Cursor    ChangeCursor
+	EventType string
+	Schema    string
+	Payload   []byte
+	CreatedAt time.Time
 }
 
 // CatalogStore defines all persistence operations for the catalog service.
 type CatalogStore interface {
 	// Anime reads
-	GetAnimeByIDs(ctx context.Context, ids []string) ([]Anime, error)
+	// GetAnimeByIDs localizes title and description using the first locale in
+	// locales that has a translation, falling back to the base record.
+	GetAnimeByIDs(ctx context.Context, ids []string, country string, locales []string) ([]Anime, error)
 	GetAllAnimeIDs(ctx context.Context) ([]string, error)
 	ResolveAnimeIDByExternalID(ctx context.Context, provider, externalID string) (string, error)
+	ListGenres(ctx context.Context, kind string) ([]GenreCount, error)
+	// GetTrending ranks anime available in country by their decayed activity
+	// score for window (one of the TrendingWindow constants).
+	GetTrending(ctx context.Context, window string, limit int, country string) ([]TrendingAnime, error)
 
 	// Anime writes
 	AttachExternalAnimeID(ctx context.Context, provider, externalID, animeID string) error
 	UpsertJikanAnime(ctx context.Context, a JikanAnimeInput) (animeID string, err error)
+	MergeAnime(ctx context.Context, sourceID, targetID string) (MergeResult, error)
+	SetAnimeAvailability(ctx context.Context, animeID string, a Availability) error
+	UpsertAnimeTranslation(ctx context.Context, animeID, locale, title, synopsis string) error
+	// RevertAnime restores content, taxonomy and translations from a recorded
+	// version and returns the version describing the anime afterwards.
+	RevertAnime(ctx context.Context, animeID string, version int32) (int32, error)
+	// UpsertProviderMetadata stores enrichment data for the anime attached to
+	// externalID under m.Provider and returns that anime's ID.
+	UpsertProviderMetadata(ctx context.Context, externalID string, m ProviderMetadata) (animeID string, err error)
+
+	// Franchises
+	CreateFranchise(ctx context.Context, in FranchiseInput, animeIDs []string) (Franchise, error)
+	UpdateFranchise(ctx context.Context, franchiseID string, in FranchiseInput) (Franchise, error)
+	SetFranchiseEntries(ctx context.Context, franchiseID string, animeIDs []string) (Franchise, error)
+	DeleteFranchise(ctx context.Context, franchiseID string) error
+	GetFranchise(ctx context.Context, franchiseID, country string, includeUnavailable bool) (Franchise, error)
+	ListFranchises(ctx context.Context, animeID string, limit, offset int) ([]Franchise, error)
+	LoadRelationGraph(ctx context.Context) (RelationGraph, error)
+
+	// History
+	ListAnimeVersions(ctx context.Context, animeID string, q HistoryQuery) ([]AnimeVersion, error)
+	ListEpisodeVersions(ctx context.Context, animeID string, q HistoryQuery) ([]EpisodeVersion, error)
 
 	// Episode reads
-	GetEpisodesByAnimeID(ctx context.Context, animeID string) ([]Episode, error)
-	GetEpisodesByIDs(ctx context.Context, ids []string) ([]Episode, error)
+	GetEpisodesByAnimeID(ctx context.Context, animeID, country string) ([]Episode, error)
+	// GetEpisodesByAnimeIDs is GetEpisodesByAnimeID for several anime, ordered
+	// by anime and number.
+	GetEpisodesByAnimeIDs(ctx context.Context, animeIDs []string, country string) ([]Episode, error)
+	GetEpisodesByIDs(ctx context.Context, ids []string, country string) ([]Episode, error)
+	// GetEpisodeAvailability reports whether an episode may be played in country and,
+	// if not, why ("hidden", "takedown" or "region").
+	GetEpisodeAvailability(ctx context.Context, episodeID, country string) (available bool, reason string, err error)
 	GetProviderEpisodeID(ctx context.Context, episodeID, provider string) (string, error)
+	ListEpisodeProviders(ctx context.Context, episodeID string) ([]EpisodeProvider, error)
+	// ListProviderEpisodes returns every episode of the anime mapped to provider,
+	// regardless of availability, ordered by number.
+	ListProviderEpisodes(ctx context.Context, provider, animeID string) ([]ProviderEpisodeState, error)
 
 	// Episode writes
-	UpsertHiAnimeEpisodes(ctx context.Context, animeID, slug string, episodes []EpisodeInput) (episodeIDs []string, err error)
+	UpsertProviderEpisodes(ctx context.Context, provider, animeID, providerAnimeID string, episodes []EpisodeInput) (episodeIDs []string, err error)
+	// RemoveProviderEpisodes deletes provider's mappings for the anime's episodes
+	// and returns the affected episode IDs. The episodes themselves are kept.
+	RemoveProviderEpisodes(ctx context.Context, provider, animeID string, providerEpisodeIDs []string) (episodeIDs []string, err error)
+	UpsertJikanEpisodes(ctx context.Context, animeID string, episodes []JikanEpisodeInput) (episodeIDs []string, err error)
+	SetEpisodeAvailability(ctx context.Context, episodeID string, a Availability) error
+
+	// Skip segments
+	UpsertProviderSkipSegments(ctx context.Context, episodeID, provider string, segs []SkipSegmentInput) error
+	ListSkipSegments(ctx context.Context, episodeID string) ([]SkipSegment, error)
+	SubmitSkipSegment(ctx context.Context, episodeID, userID string, seg SkipSegmentInput) (SkipSegment, error)
+	VoteSkipSegment(ctx context.Context, segmentID, userID string, vote int16) (SkipSegment, error)
+
+	// Change feed
+	// ListCatalogChanges returns up to limit committed changes after cursor, in feed order.
+	ListCatalogChanges(ctx context.Context, after ChangeCursor, limit int) ([]CatalogChange, error)
+	// SnapshotAnimeChanges returns an upserted change for every anime and the cursor
+	// from which later changes must be read to stay consistent with that snapshot.
+	SnapshotAnimeChanges(ctx context.Context) ([]CatalogChange, ChangeCursor, error)
 }
@@ -0,0 +1,33 @@
+package store
+
+import "testing"
+
+func TestChangeCursor_Before(t *testing.T) {
+	cases := []struct {
+		a, b ChangeCursor
+		want bool
+	}{
+		{ChangeCursor{}, ChangeCursor{TxID: 1}, true},
+		{ChangeCursor{TxID: 5, Seq: 9}, ChangeCursor{TxID: 6, Seq: 1}, true},
+		{ChangeCursor{TxID: 5, Seq: 1}, ChangeCursor{TxID: 5, Seq: 2}, true},
+		{ChangeCursor{TxID: 5, Seq: 2}, ChangeCursor{TxID: 5, Seq: 2}, false},
+		{ChangeCursor{TxID: 6, Seq: 1}, ChangeCursor{TxID: 5, Seq: 9}, false},
+	}
+	for _, c := range cases {
+		if got := c.a.Before(c.b); got != c.want {
+			t.Errorf("%v.Before(%v) = %v, want %v", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestParseChangeCursor_RoundTrip(t *testing.T) {
+	for _, c := range []ChangeCursor{{}, {TxID: 7, Seq: 3}} {
+		got, err := ParseChangeCursor(c.String())
+		if err != nil || got != c {
+			t.Fatalf("ParseChangeCursor(%q) = %v, %v", c.String(), got, err)
+		}
+	}
+	if _, err := ParseChangeCursor("7"); err == nil {
+		t.Fatal("accepted a cursor without a sequence")
+	}
+}
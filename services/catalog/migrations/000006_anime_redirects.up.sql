@@ -0,0 +1,8 @@
+-- merged anime ids -> surviving anime id, so stale references keep resolving
+CREATE TABLE IF NOT EXISTS anime_redirects (
+  source_anime_id UUID PRIMARY KEY,
+  target_anime_id UUID NOT NULL REFERENCES anime(id) ON DELETE CASCADE,
+  merged_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS anime_redirects_target_idx ON anime_redirects (target_anime_id);
@@ -0,0 +1,4 @@
+ALTER TABLE episodes DROP COLUMN IF EXISTS thumbnail;
+ALTER TABLE episodes DROP COLUMN IF EXISTS synopsis;
+ALTER TABLE episodes DROP COLUMN IF EXISTS duration_seconds;
+ALTER TABLE episodes DROP COLUMN IF EXISTS is_recap;
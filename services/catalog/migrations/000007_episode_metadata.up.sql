@@ -0,0 +1,4 @@
+ALTER TABLE episodes ADD COLUMN IF NOT EXISTS is_recap BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE episodes ADD COLUMN IF NOT EXISTS duration_seconds INT NOT NULL DEFAULT 0;
+ALTER TABLE episodes ADD COLUMN IF NOT EXISTS synopsis TEXT NOT NULL DEFAULT '';
+ALTER TABLE episodes ADD COLUMN IF NOT EXISTS thumbnail TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,3 @@
+ALTER TABLE external_episode_ids DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE external_episode_ids DROP COLUMN IF EXISTS has_dub;
+ALTER TABLE external_episode_ids DROP COLUMN IF EXISTS has_sub;
@@ -0,0 +1,6 @@
+ALTER TABLE external_episode_ids ADD COLUMN IF NOT EXISTS has_sub BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE external_episode_ids ADD COLUMN IF NOT EXISTS has_dub BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE external_episode_ids ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+-- Every HiAnime mapping written so far came from its subbed episode list.
+UPDATE external_episode_ids SET has_sub = TRUE WHERE provider = 'hianime';
@@ -0,0 +1,9 @@
+ALTER TABLE episodes DROP COLUMN IF EXISTS blocked_countries;
+ALTER TABLE episodes DROP COLUMN IF EXISTS allowed_countries;
+ALTER TABLE episodes DROP COLUMN IF EXISTS visibility_reason;
+ALTER TABLE episodes DROP COLUMN IF EXISTS visibility;
+
+ALTER TABLE anime DROP COLUMN IF EXISTS blocked_countries;
+ALTER TABLE anime DROP COLUMN IF EXISTS allowed_countries;
+ALTER TABLE anime DROP COLUMN IF EXISTS visibility_reason;
+ALTER TABLE anime DROP COLUMN IF EXISTS visibility;
@@ -0,0 +1,11 @@
+ALTER TABLE anime ADD COLUMN IF NOT EXISTS visibility TEXT NOT NULL DEFAULT 'visible'
+  CHECK (visibility IN ('visible', 'hidden', 'takedown'));
+ALTER TABLE anime ADD COLUMN IF NOT EXISTS visibility_reason TEXT NOT NULL DEFAULT '';
+ALTER TABLE anime ADD COLUMN IF NOT EXISTS allowed_countries TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE anime ADD COLUMN IF NOT EXISTS blocked_countries TEXT[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE episodes ADD COLUMN IF NOT EXISTS visibility TEXT NOT NULL DEFAULT 'visible'
+  CHECK (visibility IN ('visible', 'hidden', 'takedown'));
+ALTER TABLE episodes ADD COLUMN IF NOT EXISTS visibility_reason TEXT NOT NULL DEFAULT '';
+ALTER TABLE episodes ADD COLUMN IF NOT EXISTS allowed_countries TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE episodes ADD COLUMN IF NOT EXISTS blocked_countries TEXT[] NOT NULL DEFAULT '{}';
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS anime_translations (
+  anime_id UUID NOT NULL REFERENCES anime(id) ON DELETE CASCADE,
+  locale TEXT NOT NULL,
+  title TEXT NOT NULL DEFAULT '',
+  synopsis TEXT NOT NULL DEFAULT '',
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (anime_id, locale)
+);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS anime_demographics;
+DROP TABLE IF EXISTS anime_themes;
+DROP TABLE IF EXISTS anime_genres;
+DROP TABLE IF EXISTS demographics;
+DROP TABLE IF EXISTS themes;
+DROP TABLE IF EXISTS genres;
@@ -0,0 +1,57 @@
+-- Slugs follow internal/platform/taxonomy.Slug: lower-case ASCII letters and
+-- digits, every other run of characters collapsed into a single dash.
+CREATE TABLE IF NOT EXISTS genres (
+  slug TEXT PRIMARY KEY,
+  name TEXT NOT NULL,
+  mal_id INT NULL
+);
+
+CREATE TABLE IF NOT EXISTS themes (
+  slug TEXT PRIMARY KEY,
+  name TEXT NOT NULL,
+  mal_id INT NULL
+);
+
+CREATE TABLE IF NOT EXISTS demographics (
+  slug TEXT PRIMARY KEY,
+  name TEXT NOT NULL,
+  mal_id INT NULL
+);
+
+CREATE TABLE IF NOT EXISTS anime_genres (
+  anime_id UUID NOT NULL REFERENCES anime(id) ON DELETE CASCADE,
+  genre_slug TEXT NOT NULL REFERENCES genres(slug) ON DELETE CASCADE,
+  PRIMARY KEY (anime_id, genre_slug)
+);
+CREATE INDEX IF NOT EXISTS anime_genres_genre_slug_idx ON anime_genres (genre_slug);
+
+CREATE TABLE IF NOT EXISTS anime_themes (
+  anime_id UUID NOT NULL REFERENCES anime(id) ON DELETE CASCADE,
+  theme_slug TEXT NOT NULL REFERENCES themes(slug) ON DELETE CASCADE,
+  PRIMARY KEY (anime_id, theme_slug)
+);
+CREATE INDEX IF NOT EXISTS anime_themes_theme_slug_idx ON anime_themes (theme_slug);
+
+CREATE TABLE IF NOT EXISTS anime_demographics (
+  anime_id UUID NOT NULL REFERENCES anime(id) ON DELETE CASCADE,
+  demographic_slug TEXT NOT NULL REFERENCES demographics(slug) ON DELETE CASCADE,
+  PRIMARY KEY (anime_id, demographic_slug)
+);
+CREATE INDEX IF NOT EXISTS anime_demographics_demographic_slug_idx ON anime_demographics (demographic_slug);
+
+-- Backfill genres from the legacy JSONB column. Themes and demographics were
+-- never stored and arrive with the next Jikan sync.
+INSERT INTO genres (slug, name)
+SELECT DISTINCT ON (slug) slug, name FROM (
+  SELECT trim(both '-' from regexp_replace(lower(g.name), '[^a-z0-9]+', '-', 'g')) AS slug, trim(g.name) AS name
+  FROM anime a, jsonb_array_elements_text(a.genres) AS g(name)
+) s
+WHERE slug <> ''
+ORDER BY slug, name
+ON CONFLICT (slug) DO NOTHING;
+
+INSERT INTO anime_genres (anime_id, genre_slug)
+SELECT DISTINCT a.id, trim(both '-' from regexp_replace(lower(g.name), '[^a-z0-9]+', '-', 'g'))
+FROM anime a, jsonb_array_elements_text(a.genres) AS g(name)
+WHERE trim(both '-' from regexp_replace(lower(g.name), '[^a-z0-9]+', '-', 'g')) <> ''
+ON CONFLICT DO NOTHING;
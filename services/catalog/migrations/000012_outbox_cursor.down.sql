@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS catalog_outbox_cursor_idx;
+ALTER TABLE catalog_outbox DROP COLUMN IF EXISTS seq;
+ALTER TABLE catalog_outbox DROP COLUMN IF EXISTS tx_id;
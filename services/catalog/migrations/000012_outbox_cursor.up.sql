@@ -0,0 +1,7 @@
+-- Change-feed cursor for WatchCatalogChanges. Ordering by (tx_id, seq) and only
+-- reading rows whose transaction is older than every in-flight one means a
+-- reader never skips a row that commits after a later sequence number.
+ALTER TABLE catalog_outbox ADD COLUMN IF NOT EXISTS tx_id xid8 NOT NULL DEFAULT pg_current_xact_id();
+ALTER TABLE catalog_outbox ADD COLUMN IF NOT EXISTS seq BIGSERIAL;
+
+CREATE INDEX IF NOT EXISTS catalog_outbox_cursor_idx ON catalog_outbox (tx_id, seq);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS catalog_outbox_retention;
+DROP INDEX IF EXISTS catalog_outbox_published_idx;
+ALTER TABLE catalog_outbox DROP COLUMN IF EXISTS schema;
@@ -0,0 +1,13 @@
+-- Fully qualified protobuf message name of the payload (catalog.events.v1.*).
+ALTER TABLE catalog_outbox ADD COLUMN IF NOT EXISTS schema TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS catalog_outbox_published_idx ON catalog_outbox (published_at) WHERE published_at IS NOT NULL;
+
+-- Highest change-feed cursor removed by retention cleanup. Feed cursors below it
+-- may have missed events and must resync.
+CREATE TABLE IF NOT EXISTS catalog_outbox_retention (
+  id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+  tx_id xid8 NOT NULL,
+  seq BIGINT NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
@@ -0,0 +1,18 @@
+-- Mirrored cover images. source_url is the upstream image the variants were
+-- (or will be) generated from; variants holds [{name,width,height,format,key}]
+-- blob keys once status is 'ready'.
+CREATE TABLE IF NOT EXISTS anime_images (
+  anime_id UUID PRIMARY KEY REFERENCES anime(id) ON DELETE CASCADE,
+  source_url TEXT NOT NULL,
+  status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'processing', 'ready', 'failed')),
+  variants JSONB NOT NULL DEFAULT '[]'::jsonb,
+  attempts INT NOT NULL DEFAULT 0,
+  error TEXT NOT NULL DEFAULT '',
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS anime_images_work_idx ON anime_images (updated_at) WHERE status <> 'ready';
+
+INSERT INTO anime_images (anime_id, source_url)
+SELECT id, image FROM anime WHERE image <> ''
+ON CONFLICT (anime_id) DO NOTHING;
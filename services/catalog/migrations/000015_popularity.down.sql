@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS anime_popularity_seen;
+DROP TABLE IF EXISTS anime_popularity;
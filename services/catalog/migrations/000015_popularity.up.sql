@@ -0,0 +1,22 @@
+-- Exponentially decayed activity scores per anime. Each score is stored as of
+-- updated_at; readers decay it to the current time with the window's time
+-- constant (24h, 7d, 30d).
+CREATE TABLE IF NOT EXISTS anime_popularity (
+  anime_id UUID PRIMARY KEY REFERENCES anime(id) ON DELETE CASCADE,
+  score_24h DOUBLE PRECISION NOT NULL DEFAULT 0,
+  score_7d DOUBLE PRECISION NOT NULL DEFAULT 0,
+  score_30d DOUBLE PRECISION NOT NULL DEFAULT 0,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- One row per actor, anime, signal and UTC day so progress heartbeats and
+-- redelivered events count once. Rows older than a couple of days are pruned.
+CREATE TABLE IF NOT EXISTS anime_popularity_seen (
+  anime_id UUID NOT NULL REFERENCES anime(id) ON DELETE CASCADE,
+  actor TEXT NOT NULL,
+  signal TEXT NOT NULL,
+  day DATE NOT NULL,
+  PRIMARY KEY (anime_id, actor, signal, day)
+);
+
+CREATE INDEX IF NOT EXISTS anime_popularity_seen_day_idx ON anime_popularity_seen (day);
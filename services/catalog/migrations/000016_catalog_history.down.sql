@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS episode_history;
+DROP TABLE IF EXISTS anime_history;
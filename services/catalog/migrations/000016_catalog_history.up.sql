@@ -0,0 +1,25 @@
+-- Full snapshots of anime and episode rows, one per change. Rows are written in
+-- the same transaction as the matching outbox event and share its tx_id. There
+-- is no foreign key so history survives merges and deletions.
+CREATE TABLE IF NOT EXISTS anime_history (
+  anime_id UUID NOT NULL,
+  version INT NOT NULL,
+  data JSONB NOT NULL,
+  reason TEXT NOT NULL DEFAULT '',
+  tx_id xid8 NOT NULL DEFAULT pg_current_xact_id(),
+  recorded_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (anime_id, version)
+);
+
+CREATE TABLE IF NOT EXISTS episode_history (
+  episode_id UUID NOT NULL,
+  version INT NOT NULL,
+  anime_id UUID NOT NULL,
+  data JSONB NOT NULL,
+  reason TEXT NOT NULL DEFAULT '',
+  tx_id xid8 NOT NULL DEFAULT pg_current_xact_id(),
+  recorded_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (episode_id, version)
+);
+
+CREATE INDEX IF NOT EXISTS episode_history_anime_idx ON episode_history (anime_id, recorded_at);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS franchise_entries;
+DROP TABLE IF EXISTS franchises;
+DROP TABLE IF EXISTS anime_relations;
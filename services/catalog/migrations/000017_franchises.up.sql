@@ -0,0 +1,32 @@
+-- Provider-reported relations between anime (sequel, side story, ...). The
+-- related entry is kept as a provider ID so relations to titles not yet in the
+-- catalog resolve once they are ingested.
+CREATE TABLE IF NOT EXISTS anime_relations (
+  anime_id UUID NOT NULL REFERENCES anime(id) ON DELETE CASCADE,
+  relation TEXT NOT NULL,
+  provider TEXT NOT NULL,
+  related_provider_anime_id TEXT NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (anime_id, provider, related_provider_anime_id, relation)
+);
+
+CREATE INDEX IF NOT EXISTS anime_relations_related_idx ON anime_relations (provider, related_provider_anime_id);
+
+-- Franchises group anime entries (e.g. all of Monogatari) in an editorial order.
+CREATE TABLE IF NOT EXISTS franchises (
+  id UUID PRIMARY KEY,
+  title TEXT NOT NULL,
+  image TEXT NOT NULL DEFAULT '',
+  description TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS franchise_entries (
+  franchise_id UUID NOT NULL REFERENCES franchises(id) ON DELETE CASCADE,
+  anime_id UUID NOT NULL REFERENCES anime(id) ON DELETE CASCADE,
+  position INT NOT NULL,
+  PRIMARY KEY (franchise_id, anime_id)
+);
+
+CREATE INDEX IF NOT EXISTS franchise_entries_anime_idx ON franchise_entries (anime_id);
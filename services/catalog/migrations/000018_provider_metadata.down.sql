@@ -0,0 +1 @@
+DROP TABLE IF EXISTS anime_provider_metadata;
@@ -0,0 +1,15 @@
+-- Supplementary metadata from enrichment providers such as AniList, one row per
+-- anime and provider. The anime is found through external_anime_ids, so a row
+-- only exists once the provider's ID has been attached.
+CREATE TABLE IF NOT EXISTS anime_provider_metadata (
+  anime_id UUID NOT NULL REFERENCES anime(id) ON DELETE CASCADE,
+  provider TEXT NOT NULL,
+  banner_image TEXT NOT NULL DEFAULT '',
+  -- Normalized to 0-10 like anime.score; 0 when the provider has no score.
+  score REAL NOT NULL DEFAULT 0,
+  score_count INT NOT NULL DEFAULT 0,
+  -- [{"name": "...", "category": "...", "rank": 0-100, "spoiler": bool}]
+  tags JSONB NOT NULL DEFAULT '[]',
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (anime_id, provider)
+);
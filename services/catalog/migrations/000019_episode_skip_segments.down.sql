@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS episode_skip_segment_votes;
+DROP TABLE IF EXISTS episode_skip_segments;
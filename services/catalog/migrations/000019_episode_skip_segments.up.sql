@@ -0,0 +1,27 @@
+-- Skippable stretches of an episode, in seconds. Provider rows (source is the
+-- provider name) are recorded from playback data, one per kind; user rows
+-- (source 'user') are community submissions, one per user and kind, ranked by
+-- the net score of their votes.
+CREATE TABLE IF NOT EXISTS episode_skip_segments (
+  id UUID PRIMARY KEY,
+  episode_id UUID NOT NULL REFERENCES episodes(id) ON DELETE CASCADE,
+  kind TEXT NOT NULL CHECK (kind IN ('intro', 'outro', 'recap', 'preview')),
+  start_seconds REAL NOT NULL CHECK (start_seconds >= 0),
+  end_seconds REAL NOT NULL,
+  source TEXT NOT NULL,
+  -- Empty for provider rows.
+  submitted_by TEXT NOT NULL DEFAULT '',
+  score INT NOT NULL DEFAULT 0,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CHECK (end_seconds > start_seconds),
+  UNIQUE (episode_id, kind, source, submitted_by)
+);
+
+CREATE TABLE IF NOT EXISTS episode_skip_segment_votes (
+  segment_id UUID NOT NULL REFERENCES episode_skip_segments(id) ON DELETE CASCADE,
+  user_id TEXT NOT NULL,
+  vote SMALLINT NOT NULL CHECK (vote IN (-1, 1)),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (segment_id, user_id)
+);
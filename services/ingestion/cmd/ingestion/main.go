@@ -3,6 +3,8 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"strings"
@@ -10,22 +12,29 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	"go.uber.org/zap"
-
-	"github.com/nats-io/nats.go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
 
 	catalogv1 "github.com/example/anime-platform/gen/catalog/v1"
+	ingestionv1 "github.com/example/anime-platform/gen/ingestion/v1"
+	"github.com/example/anime-platform/internal/platform/cassette"
 	"github.com/example/anime-platform/internal/platform/config"
+	"github.com/example/anime-platform/internal/platform/db"
 	"github.com/example/anime-platform/internal/platform/httpserver"
+	"github.com/example/anime-platform/internal/platform/ingestjob"
 	"github.com/example/anime-platform/internal/platform/logging"
 	"github.com/example/anime-platform/internal/platform/natsconn"
+	platformratelimit "github.com/example/anime-platform/internal/platform/ratelimit"
 	"github.com/example/anime-platform/internal/platform/run"
 	inkcfg "github.com/example/anime-platform/services/ingestion/internal/config"
+	grpcapi "github.com/example/anime-platform/services/ingestion/internal/grpc"
 	"github.com/example/anime-platform/services/ingestion/internal/grpcclient"
 	"github.com/example/anime-platform/services/ingestion/internal/hianime"
 	"github.com/example/anime-platform/services/ingestion/internal/jikan"
 	"github.com/example/anime-platform/services/ingestion/internal/jobs"
 	"github.com/example/anime-platform/services/ingestion/internal/queue"
-	"github.com/example/anime-platform/services/ingestion/internal/ratelimit"
+	"github.com/example/anime-platform/services/ingestion/internal/schedule"
+	"github.com/example/anime-platform/services/ingestion/internal/store"
 )
 
 func main() {
@@ -45,6 +54,18 @@ func main() {
 		run.Exit(1)
 	}
 
+	pool, err := db.Open(context.Background())
+	if err != nil {
+		log.Error("db open", zap.Error(err))
+		run.Exit(1)
+	}
+	defer pool.Close()
+	st := store.NewPostgresStore(pool)
+
+	// ctx stops background loops once the runner below sees a shutdown signal.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
 	catc, err := grpcclient.NewCatalogClient(ink.CatalogGRPCAddr)
 	if err != nil {
 		log.Error("init catalog grpc client", zap.Error(err))
@@ -55,9 +76,28 @@ func main() {
 	r := chi.NewRouter()
 	httpserver.SetupRouter(r)
 
+	// Every Jikan, HiAnime and AniList request goes through the shared limiter,
+	// which also backs off all replicas when the upstream answers 429.
+	upstreams, err := platformratelimit.New(ink.RedisURL, map[string]platformratelimit.Limit{
+		platformratelimit.UpstreamJikan:   {Rate: float64(ink.JikanRPS), Burst: 1},
+		platformratelimit.UpstreamHiAnime: {Rate: float64(ink.HiAnimeRPS), Burst: 1},
+		platformratelimit.UpstreamAniList: {Rate: float64(ink.AniListRPS), Burst: 1},
+	})
+	if err != nil {
+		log.Error("rate limiter", zap.Error(err))
+		run.Exit(1)
+	}
+	// HTTP_CASSETTE_MODE records upstream responses or replays them offline.
+	cas, err := cassette.FromEnv()
+	if err != nil {
+		log.Error("cassette", zap.Error(err))
+		run.Exit(1)
+	}
 	jc := jikan.New(ink.JikanBaseURL)
+	jc.HTTPClient = cas.Client(platformratelimit.NewHTTPClient(upstreams, platformratelimit.UpstreamJikan, 10*time.Second), "jikan")
 	hc := hianime.New(ink.HiAnimeBaseURL)
-	hijob := jobs.HiAnimeSync{HiAnime: hc, Catalog: catc.Client, Jikan: jc}
+	hc.HTTPClient = cas.Client(platformratelimit.NewHTTPClient(upstreams, platformratelimit.UpstreamHiAnime, 10*time.Second), "hianime")
+	hijob := jobs.HiAnimeSync{HiAnime: hc, Catalog: catc.Client, Jikan: jc, Mappings: st, Results: st}
 
 	// Optional HTTP triggers for local debugging. Prefer NATS jobs in production.
 	if strings.TrimSpace(os.Getenv("ENABLE_HTTP_TRIGGERS")) == "true" {
@@ -79,16 +119,21 @@ func main() {
 		run.Exit(1)
 	}
 
-	jikanLimiter := ratelimit.NewRPS(ink.JikanRPS)
-	defer jikanLimiter.Stop()
-	hiaLimiter := ratelimit.NewRPS(ink.HiAnimeRPS)
-	defer hiaLimiter.Stop()
+	providers, err := newMetadataRegistry(ink, cas, upstreams)
+	if err != nil {
+		log.Error("metadata providers", zap.Error(err))
+		run.Exit(1)
+	}
+	metajob := jobs.MetadataSync{Providers: providers, Catalog: catc.Client}
+
+	// pub records a job per enqueue so the admin API can follow it through the worker.
+	pub := &queue.Publisher{Log: log, JS: js, Jobs: st}
+
+	epjob := jobs.JikanEpisodesSync{Log: log, Jikan: jc, Catalog: catc.Client, FetchDetails: ink.JikanEpisodeDetails}
+	dryjob := jobs.JikanDryRun{Jikan: jc, Catalog: catc.Client}
 
 	wrk, err := queue.NewWorker(log, nc, queue.Handlers{
 		JikanSync: func(ctx context.Context, malID int) error {
-			if err := jikanLimiter.Wait(ctx); err != nil {
-				return err
-			}
 			resp, err := jc.GetAnime(ctx, malID)
 			if err != nil {
 				return err
@@ -97,22 +142,62 @@ func main() {
 			if _, err := catc.Client.UpsertJikanAnime(ctx, &catalogv1.UpsertJikanAnimeRequest{Anime: pb}); err != nil {
 				return err
 			}
-			b, _ := json.Marshal(queue.HiAnimeSyncJob{MALID: malID})
-			_, err = js.Publish("ingestion.hianime.sync", b)
+			// Follow-ups stay in the lane of the sync that triggered them. They
+			// are deduplicated per job, so a retry after a failed publish
+			// does not enqueue the ones that already went out again.
+			lane := queue.LaneFromContext(ctx)
+			if _, err := pub.PublishFollowUp(ctx, ingestjob.LaneSubject("ingestion.hianime.sync", lane), queue.HiAnimeSyncJob{MALID: malID}); err != nil {
+				return err
+			}
+			if _, err := pub.PublishFollowUp(ctx, ingestjob.LaneSubject("ingestion.jikan.episodes", lane), queue.JikanEpisodesSyncJob{MALID: malID}); err != nil {
+				return err
+			}
+			for _, name := range providers.Names() {
+				if _, err := pub.PublishFollowUp(ctx, ingestjob.LaneSubject("ingestion.metadata.sync", lane), queue.MetadataSyncJob{Provider: name, MALID: malID}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		JikanEpisodesSync: func(ctx context.Context, malID int) error {
+			_, err := epjob.SyncByMALID(ctx, malID)
 			return err
 		},
 		HiAnimeSync: func(ctx context.Context, malID int) error {
-			if err := hiaLimiter.Wait(ctx); err != nil {
-				return err
-			}
 			_, _, _, err := hijob.SyncEpisodesByMALID(ctx, malID, "")
 			return err
 		},
+		MetadataSync: func(ctx context.Context, provider string, malID int) error {
+			_, err := metajob.SyncByMALID(ctx, provider, malID)
+			return err
+		},
+		JikanSyncDryRun: func(ctx context.Context, malID int, dryRunID string) (any, error) {
+			report, err := dryjob.DiffByMALID(ctx, malID)
+			if err != nil {
+				return nil, err
+			}
+			// Follow the real sync's fan-out to HiAnime so the dry run also
+			// reports titles without a matching slug. A retry republishes the
+			// same follow-up job, so the title's HiAnime result is counted once.
+			subj := ingestjob.LaneSubject("ingestion.hianime.sync", queue.LaneFromContext(ctx))
+			if _, err := pub.PublishFollowUp(ctx, subj, queue.HiAnimeSyncJob{MALID: malID, DryRunID: dryRunID}); err != nil {
+				return nil, err
+			}
+			return report, nil
+		},
+		HiAnimeSyncDryRun: func(ctx context.Context, malID int) (any, error) {
+			return hijob.DryRunByMALID(ctx, malID)
+		},
 	})
 	if err != nil {
 		log.Error("worker init", zap.Error(err))
 		run.Exit(1)
 	}
+	wrk.Jobs, wrk.DLQ = st, st
+	if wrk.Lanes, err = queue.ConfigureLanes(ink.LaneWeights, ink.LaneConcurrency); err != nil {
+		log.Error("ingestion lanes", zap.Error(err))
+		run.Exit(1)
+	}
 	if err := wrk.EnsureStream(context.Background()); err != nil {
 		log.Error("ensure stream", zap.Error(err))
 		run.Exit(1)
@@ -136,27 +221,41 @@ func main() {
 			return
 		}
 		log.Info("bulk import: catalog is empty, enqueueing top-500 anime")
-		published := publishJikanPages(context.Background(), log, jc, js, "top", 20)
+		published := publishJikanPages(context.Background(), log, jc, pub, "top", 20)
 		log.Info("bulk import: done", zap.Int("published", published))
 	}()
 
-	// Cron: sync current season every 24h, refresh top-100 every 7 days.
+	// Recurring jobs come from the schedules table; only the lock holder fires them.
+	sched := &schedule.Scheduler{
+		Log:   log,
+		Store: st,
+		Lock:  schedule.NewAdvisoryLock(pool, schedulerLockKey),
+		Runners: map[string]schedule.Runner{
+			"jikan.season": jikanPagesRunner(log, jc, pub, "season"),
+			"jikan.top":    jikanPagesRunner(log, jc, pub, "top"),
+			// Airing titles get new episodes every week; refreshing them often
+			// makes episodes show up minutes after release.
+			"hianime.airing": hianimeAiringRunner(log, st, pub),
+		},
+	}
 	go func() {
-		seasonTicker := time.NewTicker(24 * time.Hour)
-		topTicker := time.NewTicker(7 * 24 * time.Hour)
-		defer seasonTicker.Stop()
-		defer topTicker.Stop()
-		for {
-			select {
-			case <-seasonTicker.C:
-				log.Info("cron: syncing current season")
-				n := publishJikanPages(context.Background(), log, jc, js, "season", 2)
-				log.Info("cron: season sync done", zap.Int("published", n))
-			case <-topTicker.C:
-				log.Info("cron: refreshing top-100")
-				n := publishJikanPages(context.Background(), log, jc, js, "top", 4)
-				log.Info("cron: top refresh done", zap.Int("published", n))
-			}
+		if err := sched.Run(ctx); err != nil {
+			log.Error("scheduler stopped", zap.Error(err))
+		}
+	}()
+
+	lis, err := net.Listen("tcp", ink.GRPCAddr)
+	if err != nil {
+		log.Error("listen", zap.Error(err))
+		run.Exit(1)
+	}
+	grpcSrv := grpc.NewServer()
+	ingestionv1.RegisterIngestionAdminServiceServer(grpcSrv, &grpcapi.AdminService{Schedules: st, Jobs: st, DLQ: st, Mappings: st, Results: st, HiAnime: hc, Publisher: pub})
+	reflection.Register(grpcSrv)
+	go func() {
+		log.Info("grpc server starting", zap.String("addr", ink.GRPCAddr))
+		if err := grpcSrv.Serve(lis); err != nil {
+			log.Error("grpc serve", zap.Error(err))
 		}
 	}()
 
@@ -171,6 +270,8 @@ func main() {
 	code := runner.WithSignals(func(ctx context.Context) error {
 		go func() {
 			<-ctx.Done()
+			cancel()
+			grpcSrv.GracefulStop()
 			_ = srv.Shutdown(context.Background())
 		}()
 		return srv.Start(log)
@@ -180,9 +281,9 @@ func main() {
 	run.Exit(code)
 }
 
-// publishJikanPages fetches pages from Jikan (top or season) and publishes mal_ids to NATS.
+// publishJikanPages fetches pages from Jikan (top or season) and enqueues a sync job per mal_id.
 // kind: "top" → /top/anime, "season" → /seasons/now.
-func publishJikanPages(ctx context.Context, log *zap.Logger, jc *jikan.Client, js natsJS, kind string, pages int) int {
+func publishJikanPages(ctx context.Context, log *zap.Logger, jc *jikan.Client, pub *queue.Publisher, kind string, pages int) int {
 	dedup := make(map[int32]struct{}, pages*25)
 	for p := 1; p <= pages; p++ {
 		var list *jikan.AnimeListResponse
@@ -204,14 +305,11 @@ func publishJikanPages(ctx context.Context, log *zap.Logger, jc *jikan.Client, j
 		if !list.Pagination.HasNextPage {
 			break
 		}
-		// brief pause to respect Jikan rate limit (3 req/s free tier)
-		time.Sleep(400 * time.Millisecond)
 	}
 
 	published := 0
 	for malID := range dedup {
-		b, _ := json.Marshal(queue.JikanSyncJob{MALID: int(malID)})
-		if _, err := js.Publish("ingestion.jikan.sync", b); err != nil {
+		if _, err := pub.Publish(ctx, "ingestion.jikan.sync", queue.JikanSyncJob{MALID: int(malID)}); err != nil {
 			log.Warn("publishJikanPages: nats publish error", zap.Int32("mal_id", malID), zap.Error(err))
 			continue
 		}
@@ -220,7 +318,46 @@ func publishJikanPages(ctx context.Context, log *zap.Logger, jc *jikan.Client, j
 	return published
 }
 
-// natsJS is the subset of nats.JetStreamContext used by publishJikanPages.
-type natsJS interface {
-	Publish(subj string, data []byte, opts ...nats.PubOpt) (*nats.PubAck, error)
+// schedulerLockKey is the Postgres advisory lock key held by the replica that fires schedules.
+const schedulerLockKey int64 = 0x696e67657374 // "ingest"
+
+// hianimeAiringRunner enqueues a HiAnime sync for every title the last sync
+// saw airing.
+func hianimeAiringRunner(log *zap.Logger, results store.SyncResultStore, pub *queue.Publisher) schedule.Runner {
+	return func(ctx context.Context, _ json.RawMessage) error {
+		ids, err := results.AiringMALIDs(ctx, "hianime")
+		if err != nil {
+			return err
+		}
+		for _, malID := range ids {
+			if _, err := pub.Publish(ctx, "ingestion.hianime.sync", queue.HiAnimeSyncJob{MALID: malID}); err != nil {
+				return err
+			}
+		}
+		log.Info("schedule: airing hianime syncs published", zap.Int("published", len(ids)))
+		return nil
+	}
+}
+
+// jikanPagesRunner runs publishJikanPages for a schedule with args {"pages": n}.
+func jikanPagesRunner(log *zap.Logger, jc *jikan.Client, pub *queue.Publisher, kind string) schedule.Runner {
+	return func(ctx context.Context, args json.RawMessage) error {
+		var a struct {
+			Pages int `json:"pages"`
+		}
+		if len(args) > 0 {
+			if err := json.Unmarshal(args, &a); err != nil {
+				return fmt.Errorf("invalid args: %w", err)
+			}
+		}
+		if a.Pages <= 0 {
+			a.Pages = 1
+		}
+		n := publishJikanPages(ctx, log, jc, pub, kind, a.Pages)
+		log.Info("schedule: jikan pages published", zap.String("kind", kind), zap.Int("published", n))
+		if n == 0 {
+			return fmt.Errorf("no anime published for %s", kind)
+		}
+		return nil
+	}
 }
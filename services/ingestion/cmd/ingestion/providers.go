@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/example/anime-platform/internal/platform/cassette"
+	platformratelimit "github.com/example/anime-platform/internal/platform/ratelimit"
+	"github.com/example/anime-platform/services/ingestion/internal/anilist"
+	inkcfg "github.com/example/anime-platform/services/ingestion/internal/config"
+	"github.com/example/anime-platform/services/ingestion/internal/provider"
+)
+
+// newMetadataRegistry registers the providers enabled in cfg.MetadataProviders.
+// Each provider's HTTP client waits on its key in upstreams, so the budget is
+// shared by every ingestion replica.
+func newMetadataRegistry(cfg inkcfg.Config, cas cassette.Config, upstreams platformratelimit.Limiter) (*provider.Registry, error) {
+	reg := provider.NewRegistry()
+	for _, name := range cfg.MetadataProviders {
+		var p provider.MetadataProvider
+		switch name {
+		case anilist.ProviderName:
+			c := anilist.New(cfg.AniListBaseURL)
+			c.HTTPClient = cas.Client(platformratelimit.NewHTTPClient(upstreams, platformratelimit.UpstreamAniList, 10*time.Second), anilist.ProviderName)
+			p = c
+		default:
+			return nil, fmt.Errorf("unknown metadata provider %q", name)
+		}
+		if err := reg.Register(p); err != nil {
+			return nil, err
+		}
+	}
+	return reg, nil
+}
@@ -0,0 +1,144 @@
+// Package anilist is a metadata provider backed by the AniList GraphQL API. It
+// contributes banner images, AniList scores and tags to anime synced from MAL.
+package anilist
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/example/anime-platform/services/ingestion/internal/provider"
+)
+
+// ProviderName is the key AniList IDs are stored under in external_anime_ids.
+const ProviderName = "anilist"
+
+const mediaByMALQuery = `query ($idMal: Int) {
+  Media(idMal: $idMal, type: ANIME) {
+    id
+    idMal
+    bannerImage
+    averageScore
+    meanScore
+    stats { scoreDistribution { score amount } }
+    tags { name category rank isMediaSpoiler isGeneralSpoiler }
+  }
+}`
+
+type Client struct {
+	BaseURL    string
+	HTTPClient *http.Client
+}
+
+func New(baseURL string) *Client {
+	if baseURL == "" {
+		baseURL = "https://graphql.anilist.co"
+	}
+	return &Client{BaseURL: strings.TrimRight(baseURL, "/"), HTTPClient: &http.Client{Timeout: 10 * time.Second}}
+}
+
+// Media is the subset of AniList's Media type the provider reads.
+type Media struct {
+	ID           int    `json:"id"`
+	IDMal        int    `json:"idMal"`
+	BannerImage  string `json:"bannerImage"`
+	AverageScore int    `json:"averageScore"` // weighted, 0-100
+	MeanScore    int    `json:"meanScore"`    // 0-100
+	Stats        struct {
+		ScoreDistribution []struct {
+			Score  int `json:"score"`
+			Amount int `json:"amount"`
+		} `json:"scoreDistribution"`
+	} `json:"stats"`
+	Tags []MediaTag `json:"tags"`
+}
+
+type MediaTag struct {
+	Name             string `json:"name"`
+	Category         string `json:"category"`
+	Rank             int    `json:"rank"`
+	IsMediaSpoiler   bool   `json:"isMediaSpoiler"`
+	IsGeneralSpoiler bool   `json:"isGeneralSpoiler"`
+}
+
+type graphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables"`
+}
+
+type graphQLError struct {
+	Message string `json:"message"`
+	Status  int    `json:"status"`
+}
+
+type mediaResponse struct {
+	Data struct {
+		Media *Media `json:"Media"`
+	} `json:"data"`
+	Errors []graphQLError `json:"errors"`
+}
+
+// GetMediaByMALID looks up the AniList entry for a MAL anime ID. It returns
+// provider.ErrNotFound when AniList has no such anime.
+func (c *Client) GetMediaByMALID(ctx context.Context, malID int) (*Media, error) {
+	if malID <= 0 {
+		return nil, fmt.Errorf("malID required")
+	}
+	body, _ := json.Marshal(graphQLRequest{Query: mediaByMALQuery, Variables: map[string]any{"idMal": malID}})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.BaseURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "anime-platform-ingestion/1.0")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(io.LimitReader(resp.Body, 2<<20))
+	if err != nil {
+		return nil, err
+	}
+	// AniList reports a missing Media as HTTP 404 with a GraphQL error body.
+	var out mediaResponse
+	decodeErr := json.Unmarshal(b, &out)
+	for _, e := range out.Errors {
+		if e.Status == http.StatusNotFound {
+			return nil, provider.ErrNotFound
+		}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("anilist: status %d body=%q", resp.StatusCode, string(b[:min(len(b), 200)]))
+	}
+	if decodeErr != nil {
+		return nil, fmt.Errorf("anilist: decode error: %w body=%q", decodeErr, string(b[:min(len(b), 200)]))
+	}
+	if len(out.Errors) > 0 {
+		return nil, fmt.Errorf("anilist: %s", out.Errors[0].Message)
+	}
+	if out.Data.Media == nil {
+		return nil, provider.ErrNotFound
+	}
+	return out.Data.Media, nil
+}
+
+// Name implements provider.MetadataProvider.
+func (c *Client) Name() string { return ProviderName }
+
+// FetchByMALID implements provider.MetadataProvider.
+func (c *Client) FetchByMALID(ctx context.Context, malID int) (*provider.Metadata, error) {
+	m, err := c.GetMediaByMALID(ctx, malID)
+	if err != nil {
+		return nil, err
+	}
+	return ToMetadata(m), nil
+}
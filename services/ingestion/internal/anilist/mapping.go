@@ -0,0 +1,44 @@
+package anilist
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/example/anime-platform/services/ingestion/internal/provider"
+)
+
+// ToMetadata converts an AniList Media to provider metadata. Scores are scaled
+// from AniList's 0-100 to the catalog's 0-10, preferring the weighted average.
+func ToMetadata(m *Media) *provider.Metadata {
+	if m == nil {
+		return nil
+	}
+	score := m.AverageScore
+	if score == 0 {
+		score = m.MeanScore
+	}
+	var votes int
+	for _, d := range m.Stats.ScoreDistribution {
+		votes += d.Amount
+	}
+	tags := make([]provider.Tag, 0, len(m.Tags))
+	for _, t := range m.Tags {
+		name := strings.TrimSpace(t.Name)
+		if name == "" {
+			continue
+		}
+		tags = append(tags, provider.Tag{
+			Name:     name,
+			Category: strings.TrimSpace(t.Category),
+			Rank:     int32(t.Rank),
+			Spoiler:  t.IsMediaSpoiler || t.IsGeneralSpoiler,
+		})
+	}
+	return &provider.Metadata{
+		ExternalID:  strconv.Itoa(m.ID),
+		BannerImage: strings.TrimSpace(m.BannerImage),
+		Score:       float32(score) / 10,
+		ScoreCount:  int32(votes),
+		Tags:        tags,
+	}
+}
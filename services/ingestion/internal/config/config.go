@@ -2,18 +2,36 @@ package config
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"strconv"
 	"strings"
 )
 
 type Config struct {
+	// GRPCAddr serves the admin API used by the BFF.
+	GRPCAddr        string
 	CatalogGRPCAddr string
 	HiAnimeBaseURL  string
 	JikanBaseURL    string
 	NATSURL         string
-	JikanRPS        int
-	HiAnimeRPS      int
+	// RedisURL backs the Jikan rate limit shared with other replicas and the
+	// BFF; empty limits each process on its own.
+	RedisURL   string
+	JikanRPS   int
+	HiAnimeRPS int
+	// JikanEpisodeDetails enables one extra Jikan request per episode for synopsis/duration.
+	JikanEpisodeDetails bool
+	// MetadataProviders lists the enrichment providers to run after each Jikan
+	// sync (METADATA_PROVIDERS, comma-separated; "none" disables them).
+	MetadataProviders []string
+	AniListBaseURL    string
+	AniListRPS        int
+	// LaneWeights and LaneConcurrency override the worker's priority lanes by
+	// name (INGESTION_LANE_WEIGHTS, INGESTION_LANE_CONCURRENCY, e.g.
+	// "interactive=6,backfill=1").
+	LaneWeights     map[string]int
+	LaneConcurrency map[string]int
 }
 
 func Load() (Config, error) {
@@ -21,6 +39,10 @@ func Load() (Config, error) {
 	if addr == "" {
 		return Config{}, errors.New("CATALOG_GRPC_ADDR is required")
 	}
+	grpcAddr := strings.TrimSpace(os.Getenv("GRPC_ADDR"))
+	if grpcAddr == "" {
+		grpcAddr = ":9097"
+	}
 	hia := strings.TrimSpace(os.Getenv("HIANIME_BASE_URL"))
 	if hia == "" {
 		hia = "https://void-roan-six.vercel.app/api/v2"
@@ -35,6 +57,8 @@ func Load() (Config, error) {
 		natsURL = "nats://nats:4222"
 	}
 
+	redisURL := strings.TrimSpace(os.Getenv("REDIS_URL"))
+
 	jikanRPS := 1
 	if v := strings.TrimSpace(os.Getenv("JIKAN_RPS")); v != "" {
 		if n, err := strconv.Atoi(v); err == nil && n > 0 {
@@ -48,5 +72,68 @@ func Load() (Config, error) {
 		}
 	}
 
-	return Config{CatalogGRPCAddr: addr, HiAnimeBaseURL: hia, JikanBaseURL: jikanURL, NATSURL: natsURL, JikanRPS: jikanRPS, HiAnimeRPS: hiaRPS}, nil
+	episodeDetails := strings.TrimSpace(os.Getenv("JIKAN_EPISODE_DETAILS")) == "true"
+
+	providers := []string{"anilist"}
+	if v, ok := os.LookupEnv("METADATA_PROVIDERS"); ok {
+		providers = nil
+		for _, p := range strings.Split(v, ",") {
+			if p = strings.ToLower(strings.TrimSpace(p)); p != "" && p != "none" {
+				providers = append(providers, p)
+			}
+		}
+	}
+	anilistURL := strings.TrimSpace(os.Getenv("ANILIST_BASE_URL"))
+	if anilistURL == "" {
+		anilistURL = "https://graphql.anilist.co"
+	}
+	anilistRPS := 1
+	if v := strings.TrimSpace(os.Getenv("ANILIST_RPS")); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			anilistRPS = n
+		}
+	}
+
+	laneWeights, err := parseLaneInts("INGESTION_LANE_WEIGHTS")
+	if err != nil {
+		return Config{}, err
+	}
+	laneConcurrency, err := parseLaneInts("INGESTION_LANE_CONCURRENCY")
+	if err != nil {
+		return Config{}, err
+	}
+
+	return Config{
+		GRPCAddr:            grpcAddr,
+		CatalogGRPCAddr:     addr,
+		HiAnimeBaseURL:      hia,
+		JikanBaseURL:        jikanURL,
+		NATSURL:             natsURL,
+		RedisURL:            redisURL,
+		JikanRPS:            jikanRPS,
+		HiAnimeRPS:          hiaRPS,
+		JikanEpisodeDetails: episodeDetails,
+		MetadataProviders:   providers,
+		AniListBaseURL:      anilistURL,
+		AniListRPS:          anilistRPS,
+		LaneWeights:         laneWeights,
+		LaneConcurrency:     laneConcurrency,
+	}, nil
+}
+
+// parseLaneInts reads a "lane=n,lane=n" list from env.
+func parseLaneInts(env string) (map[string]int, error) {
+	out := map[string]int{}
+	for _, kv := range strings.Split(os.Getenv(env), ",") {
+		if kv = strings.TrimSpace(kv); kv == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(kv, "=")
+		n, err := strconv.Atoi(strings.TrimSpace(v))
+		if !ok || err != nil {
+			return nil, fmt.Errorf("%s: want lane=n, got %q", env, kv)
+		}
+		out[strings.ToLower(strings.TrimSpace(k))] = n
+	}
+	return out, nil
 }
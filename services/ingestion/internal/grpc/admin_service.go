@@ -0,0 +1,128 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ingestionv1 "github.com/example/anime-platform/gen/ingestion/v1"
+	"github.com/example/anime-platform/services/ingestion/internal/hianime"
+	"github.com/example/anime-platform/services/ingestion/internal/schedule"
+	"github.com/example/anime-platform/services/ingestion/internal/store"
+)
+
+// AdminService exposes ingestion operations to the BFF admin API.
+type AdminService struct {
+	ingestionv1.UnimplementedIngestionAdminServiceServer
+
+	Schedules store.ScheduleStore
+	Jobs      store.JobStore
+	DLQ       store.DLQStore
+	Mappings  store.HiAnimeMappingStore
+	Results   store.SyncResultStore
+	// HiAnime checks slugs before they are pinned.
+	HiAnime hianime.Provider
+	// Publisher re-publishes replayed dead-letter entries and enqueues syncs.
+	Publisher JobPublisher
+}
+
+// JobPublisher enqueues jobs.
+type JobPublisher interface {
+	// Publish records a new job and publishes it, returning its id.
+	Publish(ctx context.Context, subject string, job any) (string, error)
+	// PublishJob publishes a job under an id that is already recorded.
+	PublishJob(ctx context.Context, subject, id string, data []byte) error
+}
+
+func (s *AdminService) ListSchedules(ctx context.Context, _ *ingestionv1.ListSchedulesRequest) (*ingestionv1.ListSchedulesResponse, error) {
+	list, err := s.Schedules.ListSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &ingestionv1.ListSchedulesResponse{Schedules: make([]*ingestionv1.Schedule, 0, len(list))}
+	for _, sc := range list {
+		resp.Schedules = append(resp.Schedules, scheduleToProto(sc))
+	}
+	return resp, nil
+}
+
+func (s *AdminService) UpdateSchedule(ctx context.Context, req *ingestionv1.UpdateScheduleRequest) (*ingestionv1.UpdateScheduleResponse, error) {
+	name := strings.TrimSpace(req.GetName())
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	expr := strings.TrimSpace(req.GetCron())
+	if _, err := schedule.ParseCron(expr); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	sc, err := s.Schedules.UpdateSchedule(ctx, name, expr, schedule.NextRun(expr, time.Now()))
+	if err != nil {
+		return nil, err
+	}
+	return &ingestionv1.UpdateScheduleResponse{Schedule: scheduleToProto(sc)}, nil
+}
+
+func (s *AdminService) PauseSchedule(ctx context.Context, req *ingestionv1.PauseScheduleRequest) (*ingestionv1.PauseScheduleResponse, error) {
+	name := strings.TrimSpace(req.GetName())
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	sc, err := s.Schedules.SetSchedulePaused(ctx, name, true, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ingestionv1.PauseScheduleResponse{Schedule: scheduleToProto(sc)}, nil
+}
+
+func (s *AdminService) ResumeSchedule(ctx context.Context, req *ingestionv1.ResumeScheduleRequest) (*ingestionv1.ResumeScheduleResponse, error) {
+	name := strings.TrimSpace(req.GetName())
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	cur, err := s.Schedules.GetSchedule(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	sc, err := s.Schedules.SetSchedulePaused(ctx, name, false, schedule.NextRun(cur.Cron, time.Now()))
+	if err != nil {
+		return nil, err
+	}
+	return &ingestionv1.ResumeScheduleResponse{Schedule: scheduleToProto(sc)}, nil
+}
+
+func (s *AdminService) TriggerSchedule(ctx context.Context, req *ingestionv1.TriggerScheduleRequest) (*ingestionv1.TriggerScheduleResponse, error) {
+	name := strings.TrimSpace(req.GetName())
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	sc, err := s.Schedules.TriggerSchedule(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return &ingestionv1.TriggerScheduleResponse{Schedule: scheduleToProto(sc)}, nil
+}
+
+func scheduleToProto(sc store.Schedule) *ingestionv1.Schedule {
+	return &ingestionv1.Schedule{
+		Name:             sc.Name,
+		Cron:             sc.Cron,
+		Job:              sc.Job,
+		ArgsJson:         string(sc.Args),
+		Paused:           sc.Paused,
+		NextRunAtRfc3339: formatTime(sc.NextRunAt),
+		LastRunAtRfc3339: formatTime(sc.LastRunAt),
+		LastDurationMs:   sc.LastDurationMs,
+		LastStatus:       sc.LastStatus,
+		LastError:        sc.LastError,
+	}
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
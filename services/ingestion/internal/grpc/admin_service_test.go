@@ -0,0 +1,63 @@
+package grpcapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ingestionv1 "github.com/example/anime-platform/gen/ingestion/v1"
+	"github.com/example/anime-platform/services/ingestion/internal/store"
+)
+
+type stubScheduleStore struct {
+	store.ScheduleStore
+
+	cur       store.Schedule
+	gotPaused *bool
+	gotNext   *time.Time
+}
+
+func (s *stubScheduleStore) GetSchedule(context.Context, string) (store.Schedule, error) {
+	return s.cur, nil
+}
+
+func (s *stubScheduleStore) SetSchedulePaused(_ context.Context, name string, paused bool, next *time.Time) (store.Schedule, error) {
+	s.gotPaused, s.gotNext = &paused, next
+	return store.Schedule{Name: name, Paused: paused, NextRunAt: next}, nil
+}
+
+func TestUpdateSchedule_Validation(t *testing.T) {
+	svc := &AdminService{Schedules: &stubScheduleStore{}}
+
+	cases := []*ingestionv1.UpdateScheduleRequest{
+		{},
+		{Name: "jikan-top"},
+		{Name: "jikan-top", Cron: "every day"},
+		{Cron: "@daily"},
+	}
+	for _, req := range cases {
+		_, err := svc.UpdateSchedule(context.Background(), req)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("req %+v: expected InvalidArgument, got %v", req, err)
+		}
+	}
+}
+
+func TestResumeSchedule_SetsNextRun(t *testing.T) {
+	st := &stubScheduleStore{cur: store.Schedule{Name: "jikan-top", Cron: "@hourly", Paused: true}}
+	svc := &AdminService{Schedules: st}
+
+	resp, err := svc.ResumeSchedule(context.Background(), &ingestionv1.ResumeScheduleRequest{Name: "jikan-top"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.gotPaused == nil || *st.gotPaused || st.gotNext == nil || st.gotNext.Minute() != 0 || !st.gotNext.After(time.Now()) {
+		t.Fatalf("unexpected resume: paused=%v next=%v", st.gotPaused, st.gotNext)
+	}
+	if resp.GetSchedule().GetPaused() || resp.GetSchedule().GetNextRunAtRfc3339() == "" {
+		t.Fatalf("unexpected response: %+v", resp.GetSchedule())
+	}
+}
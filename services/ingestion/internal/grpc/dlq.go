@@ -0,0 +1,173 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ingestionv1 "github.com/example/anime-platform/gen/ingestion/v1"
+	"github.com/example/anime-platform/services/ingestion/internal/store"
+)
+
+const (
+	defaultDLQBulkLimit = 100
+	maxDLQBulkLimit     = 1000
+)
+
+var dlqStatuses = map[string]bool{
+	store.DLQStatusPending:   true,
+	store.DLQStatusReplayed:  true,
+	store.DLQStatusDiscarded: true,
+}
+
+func (s *AdminService) ListDLQEntries(ctx context.Context, req *ingestionv1.ListDLQEntriesRequest) (*ingestionv1.ListDLQEntriesResponse, error) {
+	f := store.DLQFilter{
+		Type:   strings.TrimSpace(req.GetType()),
+		Status: strings.TrimSpace(req.GetStatus()),
+		Limit:  int(req.GetLimit()),
+		Offset: int(req.GetOffset()),
+	}
+	if f.Status != "" && !dlqStatuses[f.Status] {
+		return nil, status.Error(codes.InvalidArgument, "unknown status")
+	}
+	if f.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset must not be negative")
+	}
+	if f.Limit <= 0 {
+		f.Limit = defaultJobsLimit
+	}
+	if f.Limit > maxJobsLimit {
+		f.Limit = maxJobsLimit
+	}
+	var err error
+	if f.Since, err = parseTime(req.GetSinceRfc3339()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "since must be RFC 3339")
+	}
+	if f.Until, err = parseTime(req.GetUntilRfc3339()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "until must be RFC 3339")
+	}
+
+	list, err := s.DLQ.ListDLQEntries(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	resp := &ingestionv1.ListDLQEntriesResponse{Entries: make([]*ingestionv1.DLQEntry, 0, len(list))}
+	for _, e := range list {
+		resp.Entries = append(resp.Entries, dlqEntryToProto(e))
+	}
+	return resp, nil
+}
+
+func (s *AdminService) ReplayDLQEntry(ctx context.Context, req *ingestionv1.ReplayDLQEntryRequest) (*ingestionv1.ReplayDLQEntryResponse, error) {
+	id := strings.TrimSpace(req.GetId())
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid dlq entry id")
+	}
+	e, err := s.replay(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &ingestionv1.ReplayDLQEntryResponse{Entry: dlqEntryToProto(e)}, nil
+}
+
+func (s *AdminService) ReplayDLQEntries(ctx context.Context, req *ingestionv1.ReplayDLQEntriesRequest) (*ingestionv1.ReplayDLQEntriesResponse, error) {
+	f, err := dlqBulkFilter(req.GetFilter(), req.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+	pending, err := s.DLQ.ListDLQEntries(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	resp := &ingestionv1.ReplayDLQEntriesResponse{Replayed: make([]*ingestionv1.DLQEntry, 0, len(pending))}
+	for _, p := range pending {
+		e, err := s.replay(ctx, p.ID)
+		if err != nil {
+			// Entries resolved concurrently are skipped rather than counted as failures.
+			if status.Code(err) != codes.FailedPrecondition {
+				resp.Failed++
+			}
+			continue
+		}
+		resp.Replayed = append(resp.Replayed, dlqEntryToProto(e))
+	}
+	return resp, nil
+}
+
+func (s *AdminService) DiscardDLQEntry(ctx context.Context, req *ingestionv1.DiscardDLQEntryRequest) (*ingestionv1.DiscardDLQEntryResponse, error) {
+	id := strings.TrimSpace(req.GetId())
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid dlq entry id")
+	}
+	if err := s.DLQ.DiscardDLQEntry(ctx, id); err != nil {
+		return nil, err
+	}
+	return &ingestionv1.DiscardDLQEntryResponse{}, nil
+}
+
+func (s *AdminService) DiscardDLQEntries(ctx context.Context, req *ingestionv1.DiscardDLQEntriesRequest) (*ingestionv1.DiscardDLQEntriesResponse, error) {
+	f, err := dlqBulkFilter(req.GetFilter(), req.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+	n, err := s.DLQ.DiscardDLQEntries(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	return &ingestionv1.DiscardDLQEntriesResponse{Discarded: int32(n)}, nil
+}
+
+// replay records a new job replaying the entry, then publishes it to the
+// entry's original subject. A failed publish puts the entry back to pending.
+func (s *AdminService) replay(ctx context.Context, id string) (store.DLQEntry, error) {
+	jobID := uuid.NewString()
+	e, err := s.DLQ.ReplayDLQEntry(ctx, id, jobID)
+	if err != nil {
+		return store.DLQEntry{}, err
+	}
+	if err := s.Publisher.PublishJob(ctx, e.Subject, jobID, e.Payload); err != nil {
+		_ = s.DLQ.ReleaseDLQEntry(ctx, id, jobID, "publish: "+err.Error())
+		return store.DLQEntry{}, status.Error(codes.Unavailable, "publish replay")
+	}
+	return e, nil
+}
+
+func dlqBulkFilter(in *ingestionv1.DLQFilter, limit int32) (store.DLQFilter, error) {
+	f := store.DLQFilter{Type: strings.TrimSpace(in.GetType()), Status: store.DLQStatusPending, Limit: int(limit)}
+	var err error
+	if f.Since, err = parseTime(in.GetSinceRfc3339()); err != nil {
+		return f, status.Error(codes.InvalidArgument, "since must be RFC 3339")
+	}
+	if f.Until, err = parseTime(in.GetUntilRfc3339()); err != nil {
+		return f, status.Error(codes.InvalidArgument, "until must be RFC 3339")
+	}
+	if f.Type == "" && f.Since.IsZero() && f.Until.IsZero() {
+		return f, status.Error(codes.InvalidArgument, "filter needs a type, since or until")
+	}
+	if f.Limit <= 0 {
+		f.Limit = defaultDLQBulkLimit
+	}
+	if f.Limit > maxDLQBulkLimit {
+		f.Limit = maxDLQBulkLimit
+	}
+	return f, nil
+}
+
+func dlqEntryToProto(e store.DLQEntry) *ingestionv1.DLQEntry {
+	return &ingestionv1.DLQEntry{
+		Id:                e.ID,
+		Subject:           e.Subject,
+		Type:              e.Type,
+		Reason:            e.Reason,
+		PayloadJson:       string(e.Payload),
+		JobId:             e.JobID,
+		Status:            e.Status,
+		ReplayedJobId:     e.ReplayedJobID,
+		FailedAtRfc3339:   e.FailedAt.UTC().Format(time.RFC3339),
+		ResolvedAtRfc3339: formatTime(e.ResolvedAt),
+	}
+}
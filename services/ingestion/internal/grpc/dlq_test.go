@@ -0,0 +1,95 @@
+package grpcapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ingestionv1 "github.com/example/anime-platform/gen/ingestion/v1"
+	"github.com/example/anime-platform/services/ingestion/internal/store"
+)
+
+type stubDLQStore struct {
+	store.DLQStore
+
+	pending  []store.DLQEntry
+	released []string
+}
+
+func (s *stubDLQStore) ListDLQEntries(context.Context, store.DLQFilter) ([]store.DLQEntry, error) {
+	return s.pending, nil
+}
+
+func (s *stubDLQStore) ReplayDLQEntry(_ context.Context, id, jobID string) (store.DLQEntry, error) {
+	for _, e := range s.pending {
+		if e.ID == id {
+			e.Status, e.ReplayedJobID = store.DLQStatusReplayed, jobID
+			return e, nil
+		}
+	}
+	return store.DLQEntry{}, status.Error(codes.NotFound, "dlq entry not found")
+}
+
+func (s *stubDLQStore) ReleaseDLQEntry(_ context.Context, id, _, _ string) error {
+	s.released = append(s.released, id)
+	return nil
+}
+
+type publishedJob struct {
+	subject, id string
+}
+
+type stubPublisher struct {
+	failFor string
+	sent    []publishedJob
+}
+
+func (p *stubPublisher) Publish(context.Context, string, any) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+func (p *stubPublisher) PublishJob(_ context.Context, subject, id string, data []byte) error {
+	if string(data) == p.failFor {
+		return errors.New("nats down")
+	}
+	p.sent = append(p.sent, publishedJob{subject: subject, id: id})
+	return nil
+}
+
+func TestReplayDLQEntries(t *testing.T) {
+	st := &stubDLQStore{pending: []store.DLQEntry{
+		{ID: "a", Subject: "ingestion.hianime.sync", Payload: json.RawMessage(`{"mal_id":1}`), Status: store.DLQStatusPending},
+		{ID: "b", Subject: "ingestion.hianime.sync", Payload: json.RawMessage(`{"mal_id":2}`), Status: store.DLQStatusPending},
+	}}
+	pub := &stubPublisher{failFor: `{"mal_id":2}`}
+	svc := &AdminService{DLQ: st, Publisher: pub}
+
+	resp, err := svc.ReplayDLQEntries(context.Background(), &ingestionv1.ReplayDLQEntriesRequest{
+		Filter: &ingestionv1.DLQFilter{Type: "hianime.sync"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.GetReplayed()) != 1 || resp.GetFailed() != 1 {
+		t.Fatalf("replayed=%v failed=%d", resp.GetReplayed(), resp.GetFailed())
+	}
+	got := resp.GetReplayed()[0]
+	if got.GetId() != "a" || len(pub.sent) != 1 || pub.sent[0].id != got.GetReplayedJobId() || pub.sent[0].subject != "ingestion.hianime.sync" {
+		t.Fatalf("unexpected replay %+v, sent %+v", got, pub.sent)
+	}
+	if len(st.released) != 1 || st.released[0] != "b" {
+		t.Fatalf("released = %v", st.released)
+	}
+}
+
+func TestReplayDLQEntries_RequiresFilter(t *testing.T) {
+	svc := &AdminService{DLQ: &stubDLQStore{}, Publisher: &stubPublisher{}}
+	_, err := svc.ReplayDLQEntries(context.Background(), &ingestionv1.ReplayDLQEntriesRequest{})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+}
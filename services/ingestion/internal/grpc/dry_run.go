@@ -0,0 +1,93 @@
+package grpcapi
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ingestionv1 "github.com/example/anime-platform/gen/ingestion/v1"
+	"github.com/example/anime-platform/services/ingestion/internal/jobs"
+	"github.com/example/anime-platform/services/ingestion/internal/store"
+)
+
+func (s *AdminService) GetDryRunReport(ctx context.Context, req *ingestionv1.GetDryRunReportRequest) (*ingestionv1.GetDryRunReportResponse, error) {
+	id := strings.TrimSpace(req.GetId())
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id must be a UUID")
+	}
+	list, err := s.Jobs.DryRunJobs(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, status.Error(codes.NotFound, "dry run not found")
+	}
+	return &ingestionv1.GetDryRunReportResponse{Report: buildDryRunReport(id, list)}, nil
+}
+
+// buildDryRunReport folds the reports of finished dry-run jobs into one.
+func buildDryRunReport(id string, list []store.Job) *ingestionv1.DryRunReport {
+	r := &ingestionv1.DryRunReport{Id: id, Jobs: int32(len(list))}
+	for _, j := range list {
+		switch j.Status {
+		case store.JobStatusSucceeded:
+		case store.JobStatusFailed:
+			r.Failed++
+			continue
+		default:
+			r.Pending++
+			continue
+		}
+		if len(j.Report) == 0 {
+			continue
+		}
+		switch j.Type {
+		case "jikan.sync":
+			var a jobs.AnimeDryRun
+			if json.Unmarshal(j.Report, &a) != nil {
+				continue
+			}
+			t := &ingestionv1.DryRunTitle{MalId: int32(a.MALID), AnimeId: a.AnimeID, Title: a.Title}
+			for _, c := range a.Changes {
+				t.Changes = append(t.Changes, &ingestionv1.FieldChange{Field: c.Field, From: c.From, To: c.To})
+			}
+			switch {
+			case a.New:
+				r.NewTitles = append(r.NewTitles, t)
+			case a.Unavailable:
+				r.UnavailableMalIds = append(r.UnavailableMalIds, int32(a.MALID))
+			case len(t.Changes) > 0:
+				r.ChangedTitles = append(r.ChangedTitles, t)
+			default:
+				r.UnchangedTitles++
+			}
+		case "hianime.sync":
+			var h jobs.HiAnimeDryRun
+			if json.Unmarshal(j.Report, &h) != nil {
+				continue
+			}
+			slug := &ingestionv1.DryRunSlug{
+				MalId:           int32(h.MALID),
+				AnimeId:         h.AnimeID,
+				Slug:            h.Slug,
+				Source:          h.Source,
+				Error:           h.Error,
+				Episodes:        int32(h.Episodes),
+				EpisodesAdded:   int32(h.Added),
+				EpisodesChanged: int32(h.Changed),
+				EpisodesRemoved: int32(h.Removed),
+			}
+			switch {
+			case !h.Matched:
+				r.UnmatchedSlugs = append(r.UnmatchedSlugs, slug)
+			case h.Added+h.Changed+h.Removed > 0:
+				r.EpisodeChanges = append(r.EpisodeChanges, slug)
+			}
+		}
+	}
+	return r
+}
@@ -0,0 +1,71 @@
+package grpcapi
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/example/anime-platform/services/ingestion/internal/jobs"
+	"github.com/example/anime-platform/services/ingestion/internal/store"
+)
+
+func dryRunJob(t *testing.T, typ, status string, report any) store.Job {
+	t.Helper()
+	j := store.Job{Type: typ, Status: status}
+	if report != nil {
+		b, err := json.Marshal(report)
+		if err != nil {
+			t.Fatal(err)
+		}
+		j.Report = b
+	}
+	return j
+}
+
+func TestBuildDryRunReport(t *testing.T) {
+	list := []store.Job{
+		dryRunJob(t, "jikan.sync", store.JobStatusSucceeded, jobs.AnimeDryRun{MALID: 1, Title: "New", New: true}),
+		dryRunJob(t, "jikan.sync", store.JobStatusSucceeded, jobs.AnimeDryRun{
+			MALID: 2, AnimeID: "a2", Title: "Changed",
+			Changes: []jobs.FieldChange{{Field: "score", From: "8.1", To: "8.3"}},
+		}),
+		dryRunJob(t, "jikan.sync", store.JobStatusSucceeded, jobs.AnimeDryRun{MALID: 3, AnimeID: "a3", Title: "Same"}),
+		dryRunJob(t, "jikan.sync", store.JobStatusSucceeded, jobs.AnimeDryRun{MALID: 4, AnimeID: "a4", Unavailable: true}),
+		dryRunJob(t, "hianime.sync", store.JobStatusSucceeded, jobs.HiAnimeDryRun{MALID: 1, Error: "no match"}),
+		dryRunJob(t, "hianime.sync", store.JobStatusSucceeded, jobs.HiAnimeDryRun{MALID: 2, AnimeID: "a2", Slug: "changed-2", Matched: true, Episodes: 12, Added: 1}),
+		dryRunJob(t, "hianime.sync", store.JobStatusSucceeded, jobs.HiAnimeDryRun{MALID: 3, AnimeID: "a3", Slug: "same-3", Matched: true, Episodes: 12}),
+		dryRunJob(t, "jikan.sync", store.JobStatusFailed, nil),
+		dryRunJob(t, "hianime.sync", store.JobStatusRetrying, nil),
+		dryRunJob(t, "jikan.sync", store.JobStatusQueued, nil),
+		{Type: "jikan.sync", Status: store.JobStatusSucceeded, Report: json.RawMessage(`{"mal_id":`)},
+	}
+
+	r := buildDryRunReport("d1", list)
+
+	if r.GetId() != "d1" || r.GetJobs() != int32(len(list)) {
+		t.Fatalf("id/jobs = %q/%d", r.GetId(), r.GetJobs())
+	}
+	if r.GetFailed() != 1 || r.GetPending() != 2 {
+		t.Fatalf("failed/pending = %d/%d, want 1/2", r.GetFailed(), r.GetPending())
+	}
+	if len(r.GetNewTitles()) != 1 || r.GetNewTitles()[0].GetMalId() != 1 {
+		t.Fatalf("new titles = %v", r.GetNewTitles())
+	}
+	if len(r.GetChangedTitles()) != 1 {
+		t.Fatalf("changed titles = %v", r.GetChangedTitles())
+	}
+	if c := r.GetChangedTitles()[0]; c.GetAnimeId() != "a2" || len(c.GetChanges()) != 1 || c.GetChanges()[0].GetTo() != "8.3" {
+		t.Fatalf("changed title = %v", c)
+	}
+	if r.GetUnchangedTitles() != 1 {
+		t.Fatalf("unchanged = %d, want 1", r.GetUnchangedTitles())
+	}
+	if got := r.GetUnavailableMalIds(); len(got) != 1 || got[0] != 4 {
+		t.Fatalf("unavailable = %v", got)
+	}
+	if got := r.GetUnmatchedSlugs(); len(got) != 1 || got[0].GetMalId() != 1 || got[0].GetError() != "no match" {
+		t.Fatalf("unmatched = %v", got)
+	}
+	if got := r.GetEpisodeChanges(); len(got) != 1 || got[0].GetSlug() != "changed-2" || got[0].GetEpisodesAdded() != 1 {
+		t.Fatalf("episode changes = %v", got)
+	}
+}
@@ -0,0 +1,112 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ingestionv1 "github.com/example/anime-platform/gen/ingestion/v1"
+	"github.com/example/anime-platform/services/ingestion/internal/store"
+)
+
+const (
+	defaultJobsLimit = 50
+	maxJobsLimit     = 500
+)
+
+var jobStatuses = map[string]bool{
+	store.JobStatusQueued:    true,
+	store.JobStatusRunning:   true,
+	store.JobStatusRetrying:  true,
+	store.JobStatusSucceeded: true,
+	store.JobStatusFailed:    true,
+}
+
+func (s *AdminService) ListJobs(ctx context.Context, req *ingestionv1.ListJobsRequest) (*ingestionv1.ListJobsResponse, error) {
+	f := store.JobFilter{
+		Type:   strings.TrimSpace(req.GetType()),
+		Status: strings.TrimSpace(req.GetStatus()),
+		MALID:  int(req.GetMalId()),
+		Limit:  int(req.GetLimit()),
+		Offset: int(req.GetOffset()),
+	}
+	if f.Status != "" && !jobStatuses[f.Status] {
+		return nil, status.Error(codes.InvalidArgument, "unknown status")
+	}
+	if f.MALID < 0 || f.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "mal_id and offset must not be negative")
+	}
+	if f.Limit <= 0 {
+		f.Limit = defaultJobsLimit
+	}
+	if f.Limit > maxJobsLimit {
+		f.Limit = maxJobsLimit
+	}
+	var err error
+	if f.Since, err = parseTime(req.GetSinceRfc3339()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "since must be RFC 3339")
+	}
+	if f.Until, err = parseTime(req.GetUntilRfc3339()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "until must be RFC 3339")
+	}
+
+	list, err := s.Jobs.ListJobs(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	resp := &ingestionv1.ListJobsResponse{Jobs: make([]*ingestionv1.Job, 0, len(list))}
+	for _, j := range list {
+		resp.Jobs = append(resp.Jobs, jobToProto(j))
+	}
+	return resp, nil
+}
+
+func (s *AdminService) GetJob(ctx context.Context, req *ingestionv1.GetJobRequest) (*ingestionv1.GetJobResponse, error) {
+	id := strings.TrimSpace(req.GetId())
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid job id")
+	}
+	j, attempts, err := s.Jobs.GetJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	resp := &ingestionv1.GetJobResponse{Job: jobToProto(j), Attempts: make([]*ingestionv1.JobAttempt, 0, len(attempts))}
+	for _, a := range attempts {
+		resp.Attempts = append(resp.Attempts, &ingestionv1.JobAttempt{
+			Attempt:           int32(a.Attempt),
+			StartedAtRfc3339:  a.StartedAt.UTC().Format(time.RFC3339),
+			FinishedAtRfc3339: formatTime(a.FinishedAt),
+			Error:             a.Error,
+		})
+	}
+	return resp, nil
+}
+
+func jobToProto(j store.Job) *ingestionv1.Job {
+	return &ingestionv1.Job{
+		Id:                j.ID,
+		Type:              j.Type,
+		PayloadJson:       string(j.Payload),
+		Status:            j.Status,
+		Attempts:          int32(j.Attempts),
+		LastError:         j.LastError,
+		EnqueuedAtRfc3339: j.EnqueuedAt.UTC().Format(time.RFC3339),
+		StartedAtRfc3339:  formatTime(j.StartedAt),
+		FinishedAtRfc3339: formatTime(j.FinishedAt),
+		UpdatedAtRfc3339:  j.UpdatedAt.UTC().Format(time.RFC3339),
+		ReplayOfDlqId:     j.ReplayOf,
+		ReportJson:        string(j.Report),
+	}
+}
+
+func parseTime(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
@@ -0,0 +1,95 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ingestionv1 "github.com/example/anime-platform/gen/ingestion/v1"
+	"github.com/example/anime-platform/internal/platform/ingestjob"
+	"github.com/example/anime-platform/services/ingestion/internal/queue"
+	"github.com/example/anime-platform/services/ingestion/internal/store"
+)
+
+func (s *AdminService) ListHiAnimeMappings(ctx context.Context, req *ingestionv1.ListHiAnimeMappingsRequest) (*ingestionv1.ListHiAnimeMappingsResponse, error) {
+	limit, offset := int(req.GetLimit()), int(req.GetOffset())
+	if offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset must not be negative")
+	}
+	if limit <= 0 {
+		limit = defaultJobsLimit
+	}
+	if limit > maxJobsLimit {
+		limit = maxJobsLimit
+	}
+	list, err := s.Mappings.ListHiAnimeMappings(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	resp := &ingestionv1.ListHiAnimeMappingsResponse{Mappings: make([]*ingestionv1.HiAnimeMapping, 0, len(list))}
+	for _, m := range list {
+		resp.Mappings = append(resp.Mappings, mappingToProto(m))
+	}
+	return resp, nil
+}
+
+func (s *AdminService) SetHiAnimeMapping(ctx context.Context, req *ingestionv1.SetHiAnimeMappingRequest) (*ingestionv1.SetHiAnimeMappingResponse, error) {
+	m := store.HiAnimeMapping{
+		MALID: int(req.GetMalId()),
+		Slug:  strings.TrimSpace(req.GetSlug()),
+		Note:  strings.TrimSpace(req.GetNote()),
+	}
+	if m.MALID <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "mal_id is required")
+	}
+	if m.Slug == "" {
+		return nil, status.Error(codes.InvalidArgument, "slug is required")
+	}
+
+	resp := &ingestionv1.SetHiAnimeMappingResponse{}
+	// Check the slug before pinning it: a typo would otherwise silently break
+	// every later sync for the MAL ID.
+	info, err := s.HiAnime.GetAnime(ctx, m.Slug)
+	if err != nil || info.Data.Anime.Info.ID == "" {
+		return nil, status.Error(codes.InvalidArgument, "slug not found on hianime")
+	}
+	resp.HianimeName = info.Data.Anime.Info.Name
+	resp.HianimeMalId = int32(info.Data.Anime.Info.MalID)
+
+	saved, err := s.Mappings.SetHiAnimeMapping(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+	resp.Mapping = mappingToProto(saved)
+
+	if req.GetSync() {
+		id, err := s.Publisher.Publish(ctx, ingestjob.LaneSubject("ingestion.hianime.sync", ingestjob.LaneInteractive), queue.HiAnimeSyncJob{MALID: m.MALID})
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, "publish sync")
+		}
+		resp.JobId = id
+	}
+	return resp, nil
+}
+
+func (s *AdminService) DeleteHiAnimeMapping(ctx context.Context, req *ingestionv1.DeleteHiAnimeMappingRequest) (*ingestionv1.DeleteHiAnimeMappingResponse, error) {
+	if req.GetMalId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "mal_id is required")
+	}
+	if err := s.Mappings.DeleteHiAnimeMapping(ctx, int(req.GetMalId())); err != nil {
+		return nil, err
+	}
+	return &ingestionv1.DeleteHiAnimeMappingResponse{}, nil
+}
+
+func mappingToProto(m store.HiAnimeMapping) *ingestionv1.HiAnimeMapping {
+	return &ingestionv1.HiAnimeMapping{
+		MalId:            int32(m.MALID),
+		Slug:             m.Slug,
+		Note:             m.Note,
+		CreatedAtRfc3339: formatTime(&m.CreatedAt),
+		UpdatedAtRfc3339: formatTime(&m.UpdatedAt),
+	}
+}
@@ -0,0 +1,62 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ingestionv1 "github.com/example/anime-platform/gen/ingestion/v1"
+	"github.com/example/anime-platform/services/ingestion/internal/store"
+)
+
+func (s *AdminService) ListEpisodeSyncResults(ctx context.Context, req *ingestionv1.ListEpisodeSyncResultsRequest) (*ingestionv1.ListEpisodeSyncResultsResponse, error) {
+	f := store.SyncResultFilter{
+		Provider:   strings.TrimSpace(req.GetProvider()),
+		MALID:      int(req.GetMalId()),
+		AiringOnly: req.GetAiringOnly(),
+		FailedOnly: req.GetFailedOnly(),
+		Limit:      int(req.GetLimit()),
+		Offset:     int(req.GetOffset()),
+	}
+	if f.MALID < 0 || f.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "mal_id and offset must not be negative")
+	}
+	if f.Limit <= 0 {
+		f.Limit = defaultJobsLimit
+	}
+	if f.Limit > maxJobsLimit {
+		f.Limit = maxJobsLimit
+	}
+
+	list, err := s.Results.ListSyncResults(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	resp := &ingestionv1.ListEpisodeSyncResultsResponse{Results: make([]*ingestionv1.EpisodeSyncResult, 0, len(list))}
+	for _, r := range list {
+		resp.Results = append(resp.Results, syncResultToProto(r))
+	}
+	return resp, nil
+}
+
+func syncResultToProto(r store.SyncResult) *ingestionv1.EpisodeSyncResult {
+	return &ingestionv1.EpisodeSyncResult{
+		Provider:            r.Provider,
+		MalId:               int32(r.MALID),
+		AnimeId:             r.AnimeID,
+		ProviderAnimeId:     r.ProviderAnimeID,
+		Airing:              r.Airing,
+		Episodes:            int32(r.Episodes),
+		Added:               int32(r.Added),
+		Renamed:             int32(r.Renamed),
+		Renumbered:          int32(r.Renumbered),
+		FillerChanged:       int32(r.FillerChanged),
+		AvailabilityChanged: int32(r.AvailabilityChanged),
+		Removed:             int32(r.Removed),
+		Error:               r.Error,
+		SyncedAtRfc3339:     formatTime(&r.SyncedAt),
+		ChangedAtRfc3339:    formatTime(r.ChangedAt),
+	}
+}
@@ -26,14 +26,25 @@ func New(baseURL string) *Client {
 type SearchResponse struct {
 	Status int `json:"status"`
 	Data   struct {
-		Animes []struct {
-			ID    string `json:"id"`
-			Name  string `json:"name"`
-			JName string `json:"jname"`
-		} `json:"animes"`
+		Animes      []SearchAnime `json:"animes"`
+		CurrentPage int           `json:"currentPage"`
+		TotalPages  int           `json:"totalPages"`
+		HasNextPage bool          `json:"hasNextPage"`
 	} `json:"data"`
 }
 
+// SearchAnime is one search result.
+type SearchAnime struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	JName    string `json:"jname"`
+	Type     string `json:"type"`
+	Episodes struct {
+		Sub int `json:"sub"`
+		Dub int `json:"dub"`
+	} `json:"episodes"`
+}
+
 type AnimeInfoResponse struct {
 	Status int `json:"status"`
 	Data   struct {
@@ -43,7 +54,21 @@ type AnimeInfoResponse struct {
 				Name      string `json:"name"`
 				MalID     int    `json:"malId"`
 				AnilistID int    `json:"anilistId"`
+				Stats     struct {
+					Episodes struct {
+						Sub int `json:"sub"`
+						Dub int `json:"dub"`
+					} `json:"episodes"`
+				} `json:"stats"`
 			} `json:"info"`
+			MoreInfo struct {
+				Japanese  string   `json:"japanese"`
+				Synonyms  string   `json:"synonyms"`
+				Aired     string   `json:"aired"`
+				Premiered string   `json:"premiered"`
+				Status    string   `json:"status"`
+				Genres    []string `json:"genres"`
+			} `json:"moreInfo"`
 		} `json:"anime"`
 	} `json:"data"`
 }
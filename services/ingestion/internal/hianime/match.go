@@ -0,0 +1,279 @@
+package hianime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ErrNoMatch is returned by Matcher.Match when no candidate could be tied to
+// the target.
+var ErrNoMatch = errors.New("hianime: no matching anime")
+
+// Target describes the anime to find on HiAnime.
+type Target struct {
+	MALID int
+	// Titles are searched in order; put the most likely HiAnime title first.
+	Titles   []string
+	Episodes int
+	Year     int
+}
+
+// Match is the slug chosen for a Target. ByMALID is set when HiAnime's own
+// malId confirmed it; otherwise Score is the similarity that accepted it.
+type Match struct {
+	Slug    string
+	Info    *AnimeInfoResponse
+	Score   float64
+	ByMALID bool
+}
+
+// Matcher pages through search results for every title variant, ranks the
+// candidates and verifies the best ones against their HiAnime page.
+type Matcher struct {
+	HiAnime Provider
+	// MaxPages bounds the search pages fetched per title (default 3).
+	MaxPages int
+	// MaxVerify bounds the GetAnime calls per match (default 15).
+	MaxVerify int
+	// MinScore is the score a candidate without a malId needs to be accepted
+	// (default 0.85).
+	MinScore float64
+}
+
+const (
+	defaultMaxPages  = 3
+	defaultMaxVerify = 15
+	defaultMinScore  = 0.85
+	// candidateFloor is the search score below which a result is not worth a
+	// GetAnime call. A page without any such result ends paging for that title.
+	candidateFloor = 0.5
+)
+
+type candidate struct {
+	slug   string
+	title  float64
+	eps    float64
+	search float64
+}
+
+func (m Matcher) Match(ctx context.Context, t Target) (Match, error) {
+	maxPages := m.MaxPages
+	if maxPages <= 0 {
+		maxPages = defaultMaxPages
+	}
+	maxVerify := m.MaxVerify
+	if maxVerify <= 0 {
+		maxVerify = defaultMaxVerify
+	}
+	minScore := m.MinScore
+	if minScore <= 0 {
+		minScore = defaultMinScore
+	}
+
+	titles := variants(t.Titles)
+	if len(titles) == 0 {
+		return Match{}, fmt.Errorf("hianime: no title to search for malId=%d", t.MALID)
+	}
+
+	seen := map[string]bool{}
+	verified := 0
+	var best Match
+	var searchErr error
+	for _, q := range titles {
+		for page := 1; page <= maxPages; page++ {
+			res, err := m.HiAnime.Search(ctx, q, page)
+			if err != nil {
+				if ctx.Err() != nil {
+					return Match{}, ctx.Err()
+				}
+				searchErr = err
+				break
+			}
+
+			var cands []candidate
+			for _, a := range res.Data.Animes {
+				slug := strings.TrimSpace(a.ID)
+				if slug == "" || seen[slug] {
+					continue
+				}
+				seen[slug] = true
+				c := candidate{
+					slug:  slug,
+					title: max(bestSimilarity(titles, a.Name), bestSimilarity(titles, a.JName)),
+					eps:   episodeScore(t.Episodes, max(a.Episodes.Sub, a.Episodes.Dub)),
+				}
+				c.search = 0.75*c.title + 0.25*c.eps
+				cands = append(cands, c)
+			}
+			sort.SliceStable(cands, func(i, j int) bool { return cands[i].search > cands[j].search })
+
+			plausible := false
+			for _, c := range cands {
+				if c.search < candidateFloor {
+					continue
+				}
+				plausible = true
+				if verified >= maxVerify {
+					break
+				}
+				verified++
+				info, err := m.HiAnime.GetAnime(ctx, c.slug)
+				if err != nil {
+					continue
+				}
+				got := info.Data.Anime.Info.MalID
+				if t.MALID > 0 && got == t.MALID {
+					return Match{Slug: c.slug, Info: info, Score: 1, ByMALID: true}, nil
+				}
+				if got > 0 {
+					// HiAnime knows this entry and it is a different show.
+					continue
+				}
+				score := 0.6*c.title + 0.2*c.eps + 0.2*yearScore(t.Year, infoYear(info))
+				if score > best.Score {
+					best = Match{Slug: c.slug, Info: info, Score: score}
+				}
+			}
+			if !plausible || !res.Data.HasNextPage {
+				break
+			}
+		}
+	}
+	if best.Slug != "" && best.Score >= minScore {
+		return best, nil
+	}
+	if len(seen) == 0 && searchErr != nil {
+		return Match{}, searchErr
+	}
+	return Match{}, ErrNoMatch
+}
+
+// variants drops empty titles and titles that normalize to one already listed.
+func variants(titles []string) []string {
+	seen := map[string]bool{}
+	var out []string
+	for _, t := range titles {
+		t = strings.TrimSpace(t)
+		n := Normalize(t)
+		if n == "" || seen[n] {
+			continue
+		}
+		seen[n] = true
+		out = append(out, t)
+	}
+	return out
+}
+
+// Normalize lowercases s and reduces it to letters and digits separated by
+// single spaces, so punctuation and spacing differences between providers
+// ("Re:Zero", "Re: Zero") do not matter.
+func Normalize(s string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			space = false
+			b.WriteRune(r)
+			continue
+		}
+		space = true
+	}
+	return b.String()
+}
+
+// Similarity is the Sørensen–Dice coefficient of the character bigrams of the
+// normalized titles: 1 for identical titles, 0 for nothing in common.
+func Similarity(a, b string) float64 {
+	a, b = Normalize(a), Normalize(b)
+	if a == "" || b == "" {
+		return 0
+	}
+	if a == b {
+		return 1
+	}
+	ba, bb := bigrams(a), bigrams(b)
+	if len(ba) == 0 || len(bb) == 0 {
+		return 0
+	}
+	counts := map[string]int{}
+	for _, g := range ba {
+		counts[g]++
+	}
+	shared := 0
+	for _, g := range bb {
+		if counts[g] > 0 {
+			counts[g]--
+			shared++
+		}
+	}
+	return 2 * float64(shared) / float64(len(ba)+len(bb))
+}
+
+func bigrams(s string) []string {
+	r := []rune(strings.ReplaceAll(s, " ", ""))
+	if len(r) < 2 {
+		return []string{string(r)}
+	}
+	out := make([]string, 0, len(r)-1)
+	for i := 0; i+1 < len(r); i++ {
+		out = append(out, string(r[i:i+2]))
+	}
+	return out
+}
+
+func bestSimilarity(titles []string, name string) float64 {
+	var best float64
+	for _, t := range titles {
+		best = max(best, Similarity(t, name))
+	}
+	return best
+}
+
+// episodeScore compares episode counts; unknown counts (airing shows, missing
+// data) are neutral.
+func episodeScore(want, got int) float64 {
+	if want <= 0 || got <= 0 {
+		return 0.5
+	}
+	diff := want - got
+	if diff < 0 {
+		diff = -diff
+	}
+	return 1 - float64(diff)/float64(max(want, got))
+}
+
+func yearScore(want, got int) float64 {
+	switch {
+	case want <= 0 || got <= 0:
+		return 0.5
+	case want == got:
+		return 1
+	case want-got == 1 || got-want == 1:
+		return 0.5
+	default:
+		return 0
+	}
+}
+
+var yearPattern = regexp.MustCompile(`\b(19|20)\d{2}\b`)
+
+// infoYear reads the premiere year from "Fall 2023" or, failing that, the
+// first year in the aired range ("Oct 1, 2023 to ?").
+func infoYear(info *AnimeInfoResponse) int {
+	for _, s := range []string{info.Data.Anime.MoreInfo.Premiered, info.Data.Anime.MoreInfo.Aired} {
+		if y := yearPattern.FindString(s); y != "" {
+			n, _ := strconv.Atoi(y)
+			return n
+		}
+	}
+	return 0
+}
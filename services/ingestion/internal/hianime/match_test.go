@@ -0,0 +1,114 @@
+package hianime
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type searchResult struct {
+	slug, name string
+	eps        int
+}
+
+type stubProvider struct {
+	// pages maps a query to its result pages.
+	pages map[string][][]searchResult
+	malID map[string]int
+	year  map[string]string
+}
+
+func (p stubProvider) Search(_ context.Context, q string, page int) (*SearchResponse, error) {
+	pages := p.pages[q]
+	res := &SearchResponse{Status: 200}
+	if page > len(pages) {
+		return res, nil
+	}
+	for _, r := range pages[page-1] {
+		a := SearchAnime{ID: r.slug, Name: r.name}
+		a.Episodes.Sub = r.eps
+		res.Data.Animes = append(res.Data.Animes, a)
+	}
+	res.Data.HasNextPage = page < len(pages)
+	return res, nil
+}
+
+func (p stubProvider) GetAnime(_ context.Context, slug string) (*AnimeInfoResponse, error) {
+	info := &AnimeInfoResponse{Status: 200}
+	info.Data.Anime.Info.ID = slug
+	info.Data.Anime.Info.MalID = p.malID[slug]
+	info.Data.Anime.MoreInfo.Premiered = p.year[slug]
+	return info, nil
+}
+
+func (p stubProvider) GetEpisodes(context.Context, string) (*EpisodesResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestMatcher_PagesAndVariants(t *testing.T) {
+	p := stubProvider{
+		pages: map[string][][]searchResult{
+			// The English title only finds the sequel on page 1; the show
+			// itself is on page 2.
+			"Frieren: Beyond Journey's End": {
+				{{"frieren-season-2-20001", "Frieren: Beyond Journey's End Season 2", 10}},
+				{{"frieren-beyond-journeys-end-18542", "Frieren: Beyond Journey's End", 28}},
+			},
+		},
+		malID: map[string]int{"frieren-season-2-20001": 59978, "frieren-beyond-journeys-end-18542": 52991},
+	}
+	m, err := Matcher{HiAnime: p}.Match(context.Background(), Target{
+		MALID:    52991,
+		Titles:   []string{"Frieren: Beyond Journey's End", "Sousou no Frieren"},
+		Episodes: 28,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Slug != "frieren-beyond-journeys-end-18542" || !m.ByMALID {
+		t.Fatalf("unexpected match %+v", m)
+	}
+}
+
+func TestMatcher_ScoresWithoutMALID(t *testing.T) {
+	p := stubProvider{
+		pages: map[string][][]searchResult{
+			"Re:Zero kara Hajimeru Isekai Seikatsu": {{
+				{"rezero-movie-1", "Re:Zero kara Hajimeru Isekai Seikatsu: Memory Snow", 1},
+				{"rezero-starting-life-in-another-world-3", "Re:ZERO -Starting Life in Another World-", 25},
+			}},
+			"Re:ZERO -Starting Life in Another World-": {{
+				{"rezero-starting-life-in-another-world-3", "Re:ZERO -Starting Life in Another World-", 25},
+			}},
+		},
+		year: map[string]string{"rezero-starting-life-in-another-world-3": "Spring 2016", "rezero-movie-1": "Fall 2018"},
+	}
+	target := Target{
+		MALID:    31240,
+		Titles:   []string{"Re:ZERO -Starting Life in Another World-", "Re:Zero kara Hajimeru Isekai Seikatsu"},
+		Episodes: 25,
+		Year:     2016,
+	}
+	m, err := Matcher{HiAnime: p}.Match(context.Background(), target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Slug != "rezero-starting-life-in-another-world-3" || m.ByMALID {
+		t.Fatalf("unexpected match %+v", m)
+	}
+
+	// Nothing close enough is a miss, not a guess.
+	target.Titles = []string{"Kaguya-sama: Love is War"}
+	if _, err := (Matcher{HiAnime: p}).Match(context.Background(), target); !errors.Is(err, ErrNoMatch) {
+		t.Fatalf("expected ErrNoMatch, got %v", err)
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	if s := Similarity("Re:Zero", "re zero"); s != 1 {
+		t.Fatalf("normalized titles should be identical, got %v", s)
+	}
+	if s := Similarity("One Piece", "Naruto"); s > 0.2 {
+		t.Fatalf("unrelated titles scored %v", s)
+	}
+}
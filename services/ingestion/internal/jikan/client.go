@@ -26,25 +26,57 @@ func New(baseURL string) *Client {
 
 // AnimeData is the shared data block returned by single and list endpoints.
 type AnimeData struct {
-	MalID         int32   `json:"mal_id"`
-	Title         string  `json:"title"`
-	TitleEnglish  string  `json:"title_english"`
-	TitleJapanese string  `json:"title_japanese"`
-	Synopsis      string  `json:"synopsis"`
-	Type          string  `json:"type"`
-	Status        string  `json:"status"`
-	Episodes      int32   `json:"episodes"`
-	Score         float32 `json:"score"`
-	Genres        []struct {
-		Name string `json:"name"`
-	} `json:"genres"`
-	Images struct {
+	MalID         int32    `json:"mal_id"`
+	Title         string   `json:"title"`
+	TitleEnglish  string   `json:"title_english"`
+	TitleJapanese string   `json:"title_japanese"`
+	TitleSynonyms []string `json:"title_synonyms"`
+	Synopsis      string   `json:"synopsis"`
+	Type          string   `json:"type"`
+	Status        string   `json:"status"`
+	Episodes      int32    `json:"episodes"`
+	Year          int      `json:"year"`
+	Aired         struct {
+		Prop struct {
+			From struct {
+				Year int `json:"year"`
+			} `json:"from"`
+		} `json:"prop"`
+	} `json:"aired"`
+	Duration       string  `json:"duration"`
+	Score          float32 `json:"score"`
+	Genres         []Entry `json:"genres"`
+	ExplicitGenres []Entry `json:"explicit_genres"`
+	Themes         []Entry `json:"themes"`
+	Demographics   []Entry `json:"demographics"`
+	// Relations is only present on the full endpoint used by GetAnime.
+	Relations []Relation `json:"relations"`
+	Images    struct {
 		JPG struct {
 			LargeImageURL string `json:"large_image_url"`
 		} `json:"jpg"`
 	} `json:"images"`
 }
 
+// Entry is a MAL taxonomy reference (genre, theme or demographic).
+type Entry struct {
+	MalID int32  `json:"mal_id"`
+	Name  string `json:"name"`
+}
+
+// Relation groups the entries related to an anime in one way ("Sequel",
+// "Side Story", ...). Entries may be anime or manga.
+type Relation struct {
+	Relation string          `json:"relation"`
+	Entry    []RelationEntry `json:"entry"`
+}
+
+type RelationEntry struct {
+	MalID int32  `json:"mal_id"`
+	Type  string `json:"type"`
+	Name  string `json:"name"`
+}
+
 type AnimeResponse struct {
 	Data AnimeData `json:"data"`
 }
@@ -61,7 +93,8 @@ func (c *Client) GetAnime(ctx context.Context, malID int) (*AnimeResponse, error
 		return nil, fmt.Errorf("malID required")
 	}
 
-	u, _ := url.Parse(c.BaseURL + "/anime/" + strconv.Itoa(malID))
+	// The full variant adds relations at no extra request cost.
+	u, _ := url.Parse(c.BaseURL + "/anime/" + strconv.Itoa(malID) + "/full")
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
 	if err != nil {
 		return nil, err
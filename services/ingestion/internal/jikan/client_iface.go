@@ -8,4 +8,7 @@ type Provider interface {
 	GetTopAnime(ctx context.Context, page int) (*AnimeListResponse, error)
 	GetSeasonNow(ctx context.Context, page int) (*AnimeListResponse, error)
 	Search(ctx context.Context, q string, limit int) (*AnimeListResponse, error)
+	GetAnimeEpisodes(ctx context.Context, malID int, page int) (*EpisodeListResponse, error)
+	GetAnimeEpisode(ctx context.Context, malID int, number int) (*EpisodeResponse, error)
+	GetAnimeVideoEpisodes(ctx context.Context, malID int, page int) (*VideoEpisodeListResponse, error)
 }
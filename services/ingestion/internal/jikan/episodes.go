@@ -0,0 +1,113 @@
+package jikan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// EpisodeData is a single entry from /anime/{id}/episodes. MalID is the episode number.
+type EpisodeData struct {
+	MalID    int32  `json:"mal_id"`
+	Title    string `json:"title"`
+	Aired    string `json:"aired"`
+	Filler   bool   `json:"filler"`
+	Recap    bool   `json:"recap"`
+	Synopsis string `json:"synopsis"`
+	Duration int32  `json:"duration"`
+}
+
+type EpisodeListResponse struct {
+	Data       []EpisodeData `json:"data"`
+	Pagination struct {
+		HasNextPage bool `json:"has_next_page"`
+	} `json:"pagination"`
+}
+
+type EpisodeResponse struct {
+	Data EpisodeData `json:"data"`
+}
+
+// VideoEpisodeData is a single entry from /anime/{id}/videos/episodes, which is the only
+// endpoint exposing per-episode thumbnails.
+type VideoEpisodeData struct {
+	MalID   int32  `json:"mal_id"`
+	Episode string `json:"episode"`
+	Images  struct {
+		JPG struct {
+			ImageURL string `json:"image_url"`
+		} `json:"jpg"`
+	} `json:"images"`
+}
+
+type VideoEpisodeListResponse struct {
+	Data       []VideoEpisodeData `json:"data"`
+	Pagination struct {
+		HasNextPage bool `json:"has_next_page"`
+	} `json:"pagination"`
+}
+
+// GetAnimeEpisodes returns a page (100 entries) of episode metadata for an anime.
+func (c *Client) GetAnimeEpisodes(ctx context.Context, malID int, page int) (*EpisodeListResponse, error) {
+	if malID <= 0 {
+		return nil, fmt.Errorf("malID required")
+	}
+	var out EpisodeListResponse
+	if err := c.getJSON(ctx, fmt.Sprintf("%s/anime/%d/episodes?page=%d", c.BaseURL, malID, page), &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAnimeEpisode returns the detail view of a single episode, including synopsis and duration.
+func (c *Client) GetAnimeEpisode(ctx context.Context, malID int, number int) (*EpisodeResponse, error) {
+	if malID <= 0 || number <= 0 {
+		return nil, fmt.Errorf("malID and episode number required")
+	}
+	var out EpisodeResponse
+	if err := c.getJSON(ctx, fmt.Sprintf("%s/anime/%d/episodes/%d", c.BaseURL, malID, number), &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAnimeVideoEpisodes returns a page of episode thumbnails for an anime.
+func (c *Client) GetAnimeVideoEpisodes(ctx context.Context, malID int, page int) (*VideoEpisodeListResponse, error) {
+	if malID <= 0 {
+		return nil, fmt.Errorf("malID required")
+	}
+	var out VideoEpisodeListResponse
+	if err := c.getJSON(ctx, fmt.Sprintf("%s/anime/%d/videos/episodes?page=%d", c.BaseURL, malID, page), &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) getJSON(ctx context.Context, rawURL string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "anime-platform-ingestion/1.0")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(io.LimitReader(resp.Body, 4<<20))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("jikan: status %d body=%q", resp.StatusCode, string(b[:min(len(b), 200)]))
+	}
+	if err := json.Unmarshal(b, out); err != nil {
+		return fmt.Errorf("jikan: decode error: %w body=%q", err, string(b[:min(len(b), 200)]))
+	}
+	return nil
+}
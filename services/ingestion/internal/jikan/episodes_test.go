@@ -0,0 +1,58 @@
+package jikan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGetAnimeEpisodes(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/anime/52991/episodes" || r.URL.Query().Get("page") != "2" {
+			t.Errorf("unexpected request: %s", r.URL)
+		}
+		_, _ = w.Write([]byte(`{"data":[{"mal_id":101,"title":"Ep 101","aired":"2024-01-05T00:00:00+00:00","filler":true}],
+"pagination":{"has_next_page":true}}`))
+	}))
+	defer srv.Close()
+
+	list, err := New(srv.URL).GetAnimeEpisodes(context.Background(), 52991, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Data) != 1 || list.Data[0].MalID != 101 || !list.Data[0].Filler || !list.Pagination.HasNextPage {
+		t.Fatalf("list = %+v", list)
+	}
+}
+
+func TestGetAnimeEpisode(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/anime/52991/episodes/3" {
+			t.Errorf("unexpected request: %s", r.URL)
+		}
+		_, _ = w.Write([]byte(`{"data":{"mal_id":3,"synopsis":"Third.","duration":1440}}`))
+	}))
+	defer srv.Close()
+
+	ep, err := New(srv.URL).GetAnimeEpisode(context.Background(), 52991, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ep.Data.Synopsis != "Third." || ep.Data.Duration != 1440 {
+		t.Fatalf("episode = %+v", ep.Data)
+	}
+}
+
+func TestGetAnimeEpisodes_Status(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"status":429}`, http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	_, err := New(srv.URL).GetAnimeEpisodes(context.Background(), 52991, 1)
+	if err == nil || !strings.Contains(err.Error(), "status 429") {
+		t.Fatalf("err = %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package jikan
 
 import (
+	"strconv"
 	"strings"
 
 	catalogv1 "github.com/example/anime-platform/gen/catalog/v1"
@@ -35,9 +36,44 @@ func AnimeDataToProto(data AnimeData) *catalogv1.JikanAnime {
 		Episodes:      data.Episodes,
 		Image:         strings.TrimSpace(data.Images.JPG.LargeImageURL),
 		Score:         data.Score,
+		TypedGenres:   entriesToProto(data.Genres, data.ExplicitGenres),
+		Themes:        entriesToProto(data.Themes),
+		Demographics:  entriesToProto(data.Demographics),
+		Relations:     relationsToProto(data.Relations),
 	}
 }
 
+// relationsToProto keeps anime entries only; manga adaptations have no place
+// in the catalog.
+func relationsToProto(relations []Relation) []*catalogv1.JikanRelation {
+	var out []*catalogv1.JikanRelation
+	for _, r := range relations {
+		kind := strings.TrimSpace(r.Relation)
+		if kind == "" {
+			continue
+		}
+		for _, e := range r.Entry {
+			if e.MalID > 0 && strings.EqualFold(e.Type, "anime") {
+				out = append(out, &catalogv1.JikanRelation{Relation: kind, MalId: e.MalID})
+			}
+		}
+	}
+	return out
+}
+
+func entriesToProto(lists ...[]Entry) []*catalogv1.JikanGenre {
+	var out []*catalogv1.JikanGenre
+	for _, list := range lists {
+		for _, e := range list {
+			name := strings.TrimSpace(e.Name)
+			if name != "" {
+				out = append(out, &catalogv1.JikanGenre{MalId: e.MalID, Name: name})
+			}
+		}
+	}
+	return out
+}
+
 func BestTitle(resp *AnimeResponse) string {
 	if resp == nil {
 		return ""
@@ -50,3 +86,79 @@ func BestTitle(resp *AnimeResponse) string {
 	}
 	return strings.TrimSpace(resp.Data.TitleJapanese)
 }
+
+// Titles lists every known title for the anime, most useful for searching
+// other providers first: English, romaji, synonyms, then Japanese. Duplicates
+// are dropped.
+func Titles(resp *AnimeResponse) []string {
+	if resp == nil {
+		return nil
+	}
+	d := resp.Data
+	candidates := append([]string{d.TitleEnglish, d.Title}, d.TitleSynonyms...)
+	candidates = append(candidates, d.TitleJapanese)
+	seen := map[string]bool{}
+	var out []string
+	for _, t := range candidates {
+		t = strings.TrimSpace(t)
+		if t == "" || seen[strings.ToLower(t)] {
+			continue
+		}
+		seen[strings.ToLower(t)] = true
+		out = append(out, t)
+	}
+	return out
+}
+
+// Year is the premiere year, falling back to the first air date for entries
+// without a season (movies, ONAs). 0 when unknown.
+func Year(resp *AnimeResponse) int {
+	if resp == nil {
+		return 0
+	}
+	if resp.Data.Year > 0 {
+		return resp.Data.Year
+	}
+	return resp.Data.Aired.Prop.From.Year
+}
+
+// DurationSeconds parses Jikan's human duration ("24 min per ep", "1 hr 55 min", "30 sec")
+// into seconds. Unknown formats yield 0.
+func DurationSeconds(s string) int32 {
+	fields := strings.Fields(strings.ToLower(s))
+	var total int32
+	for i := 0; i+1 < len(fields); i++ {
+		n, err := strconv.Atoi(fields[i])
+		if err != nil {
+			continue
+		}
+		switch strings.TrimSuffix(fields[i+1], ".") {
+		case "hr", "hrs", "hour", "hours":
+			total += int32(n) * 3600
+		case "min", "mins", "minute", "minutes":
+			total += int32(n) * 60
+		case "sec", "secs", "second", "seconds":
+			total += int32(n)
+		}
+	}
+	return total
+}
+
+// EpisodeDataToProto converts a Jikan episode entry. defaultDuration is used when the entry
+// itself carries no duration (list endpoint).
+func EpisodeDataToProto(data EpisodeData, thumbnail string, defaultDuration int32) *catalogv1.JikanEpisode {
+	duration := data.Duration
+	if duration <= 0 {
+		duration = defaultDuration
+	}
+	return &catalogv1.JikanEpisode{
+		Number:          data.MalID,
+		Title:           strings.TrimSpace(data.Title),
+		AiredAtRfc3339:  strings.TrimSpace(data.Aired),
+		IsFiller:        data.Filler,
+		IsRecap:         data.Recap,
+		DurationSeconds: duration,
+		Synopsis:        strings.TrimSpace(data.Synopsis),
+		Thumbnail:       strings.TrimSpace(thumbnail),
+	}
+}
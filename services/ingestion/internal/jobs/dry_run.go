@@ -0,0 +1,124 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	catalogv1 "github.com/example/anime-platform/gen/catalog/v1"
+	"github.com/example/anime-platform/internal/platform/geo"
+	"github.com/example/anime-platform/services/ingestion/internal/jikan"
+)
+
+// FieldChange is one catalog field a sync would overwrite. Lists are joined
+// with ", " so every value reads as a string.
+type FieldChange struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// AnimeDryRun reports what a Jikan sync would do to one anime.
+type AnimeDryRun struct {
+	MALID   int    `json:"mal_id"`
+	AnimeID string `json:"anime_id,omitempty"`
+	Title   string `json:"title"`
+	// New is set when the catalog has no anime for the MAL ID yet.
+	New bool `json:"new"`
+	// Unavailable is set when the catalog has the anime but does not serve
+	// it (hidden or taken down), so its fields could not be compared.
+	Unavailable bool          `json:"unavailable,omitempty"`
+	Changes     []FieldChange `json:"changes,omitempty"`
+}
+
+// JikanDryRun compares Jikan's data for an anime with the catalog without
+// writing anything.
+type JikanDryRun struct {
+	Jikan   jikan.Provider
+	Catalog catalogv1.CatalogServiceClient
+}
+
+func (j JikanDryRun) DiffByMALID(ctx context.Context, malID int) (AnimeDryRun, error) {
+	resp, err := j.Jikan.GetAnime(ctx, malID)
+	if err != nil {
+		return AnimeDryRun{}, err
+	}
+	next := jikan.ToCatalogProto(resp)
+	out := AnimeDryRun{MALID: malID, Title: jikan.BestTitle(resp)}
+
+	res, err := j.Catalog.ResolveAnimeIDByExternalID(ctx, &catalogv1.ResolveAnimeIDByExternalIDRequest{Provider: "mal", ExternalId: strconv.Itoa(malID)})
+	if status.Code(err) == codes.NotFound {
+		out.New = true
+		return out, nil
+	}
+	if err != nil {
+		return AnimeDryRun{}, err
+	}
+	out.AnimeID = res.GetAnimeId()
+
+	cur, err := j.Catalog.GetAnimeByIDs(geo.Unrestricted(ctx), &catalogv1.GetAnimeByIDsRequest{AnimeIds: []string{out.AnimeID}})
+	if err != nil {
+		return AnimeDryRun{}, err
+	}
+	if len(cur.GetAnime()) == 0 {
+		out.Unavailable = true
+		return out, nil
+	}
+	out.Changes = DiffJikanAnime(cur.GetAnime()[0], next)
+	return out, nil
+}
+
+// DiffJikanAnime lists the fields UpsertJikanAnime would change on cur when
+// writing next. Genre-like lists compare as sets.
+func DiffJikanAnime(cur *catalogv1.Anime, next *catalogv1.JikanAnime) []FieldChange {
+	var out []FieldChange
+	add := func(field, from, to string) {
+		if from != to {
+			out = append(out, FieldChange{Field: field, From: from, To: to})
+		}
+	}
+	add("title", cur.GetTitle(), next.GetTitle())
+	add("title_english", cur.GetTitleEnglish(), next.GetTitleEnglish())
+	add("title_japanese", cur.GetTitleJapanese(), next.GetTitleJapanese())
+	add("description", cur.GetDescription(), next.GetSynopsis())
+	add("image", cur.GetImage(), next.GetImage())
+	add("type", cur.GetType(), next.GetType())
+	add("status", cur.GetStatus(), next.GetStatus())
+	add("total_episodes", strconv.Itoa(int(cur.GetTotalEpisodes())), strconv.Itoa(int(next.GetEpisodes())))
+	add("score", formatScore(cur.GetScore()), formatScore(next.GetScore()))
+	add("genres", joinSorted(cur.GetGenres()), joinSorted(next.GetGenres()))
+	add("themes", joinSorted(genreNames(cur.GetThemes())), joinSorted(jikanGenreNames(next.GetThemes())))
+	add("demographics", joinSorted(genreNames(cur.GetDemographics())), joinSorted(jikanGenreNames(next.GetDemographics())))
+	return out
+}
+
+func formatScore(f float32) string {
+	return fmt.Sprintf("%.2f", f)
+}
+
+func joinSorted(in []string) string {
+	s := append([]string(nil), in...)
+	sort.Strings(s)
+	return strings.Join(s, ", ")
+}
+
+func genreNames(in []*catalogv1.Genre) []string {
+	out := make([]string, 0, len(in))
+	for _, g := range in {
+		out = append(out, g.GetName())
+	}
+	return out
+}
+
+func jikanGenreNames(in []*catalogv1.JikanGenre) []string {
+	out := make([]string, 0, len(in))
+	for _, g := range in {
+		out = append(out, g.GetName())
+	}
+	return out
+}
@@ -0,0 +1,36 @@
+package jobs
+
+import (
+	"reflect"
+	"testing"
+
+	catalogv1 "github.com/example/anime-platform/gen/catalog/v1"
+)
+
+func TestDiffJikanAnime(t *testing.T) {
+	cur := &catalogv1.Anime{
+		Title:         "Sousou no Frieren",
+		Status:        "Currently Airing",
+		TotalEpisodes: 0,
+		Score:         9.1,
+		Genres:        []string{"Fantasy", "Adventure"},
+		Themes:        []*catalogv1.Genre{{Name: "Mythology"}},
+	}
+	next := &catalogv1.JikanAnime{
+		Title:    "Sousou no Frieren",
+		Status:   "Finished Airing",
+		Episodes: 28,
+		Score:    9.1,
+		Genres:   []string{"Adventure", "Fantasy"},
+		Themes:   []*catalogv1.JikanGenre{{Name: "Mythology"}},
+	}
+
+	got := DiffJikanAnime(cur, next)
+	want := []FieldChange{
+		{Field: "status", From: "Currently Airing", To: "Finished Airing"},
+		{Field: "total_episodes", From: "0", To: "28"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("changes = %+v", got)
+	}
+}
@@ -0,0 +1,88 @@
+package jobs
+
+import (
+	catalogv1 "github.com/example/anime-platform/gen/catalog/v1"
+)
+
+// EpisodeDiff is what changed between a provider's stored episode list and
+// a fresh fetch. Episodes are keyed by provider episode ID, so an episode
+// the provider re-identified shows up as one removal and one addition.
+type EpisodeDiff struct {
+	// Added and Changed are the episodes to upsert.
+	Added   []*catalogv1.ProviderEpisode
+	Changed []*catalogv1.ProviderEpisode
+	// Removed are provider episode IDs the provider no longer lists.
+	Removed []string
+
+	// Per-kind counts of Changed; one episode can count under several.
+	Renamed             int
+	Renumbered          int
+	FillerChanged       int
+	AvailabilityChanged int
+	Unchanged           int
+}
+
+// Empty reports whether applying the diff would change nothing.
+func (d EpisodeDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Changed) == 0 && len(d.Removed) == 0
+}
+
+// Upserts returns the episodes to send to UpsertProviderEpisodes.
+func (d EpisodeDiff) Upserts() []*catalogv1.ProviderEpisode {
+	out := make([]*catalogv1.ProviderEpisode, 0, len(d.Added)+len(d.Changed))
+	out = append(out, d.Added...)
+	return append(out, d.Changed...)
+}
+
+// DiffProviderEpisodes compares the stored list with the fetched one. An empty
+// fetched title never counts as a rename: providers drop titles more often
+// than they clear them on purpose.
+func DiffProviderEpisodes(stored, fetched []*catalogv1.ProviderEpisode) EpisodeDiff {
+	byID := make(map[string]*catalogv1.ProviderEpisode, len(stored))
+	for _, e := range stored {
+		byID[e.GetProviderEpisodeId()] = e
+	}
+
+	var d EpisodeDiff
+	seen := make(map[string]bool, len(fetched))
+	for _, e := range fetched {
+		id := e.GetProviderEpisodeId()
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		old, ok := byID[id]
+		if !ok {
+			d.Added = append(d.Added, e)
+			continue
+		}
+		changed := false
+		if e.GetTitle() != "" && e.GetTitle() != old.GetTitle() {
+			d.Renamed++
+			changed = true
+		}
+		if e.GetNumber() != old.GetNumber() {
+			d.Renumbered++
+			changed = true
+		}
+		if e.GetIsFiller() != old.GetIsFiller() {
+			d.FillerChanged++
+			changed = true
+		}
+		if e.GetHasSub() != old.GetHasSub() || e.GetHasDub() != old.GetHasDub() {
+			d.AvailabilityChanged++
+			changed = true
+		}
+		if changed {
+			d.Changed = append(d.Changed, e)
+		} else {
+			d.Unchanged++
+		}
+	}
+	for _, e := range stored {
+		if !seen[e.GetProviderEpisodeId()] {
+			d.Removed = append(d.Removed, e.GetProviderEpisodeId())
+		}
+	}
+	return d
+}
@@ -0,0 +1,42 @@
+package jobs
+
+import (
+	"reflect"
+	"testing"
+
+	catalogv1 "github.com/example/anime-platform/gen/catalog/v1"
+)
+
+func TestDiffProviderEpisodes(t *testing.T) {
+	stored := []*catalogv1.ProviderEpisode{
+		{ProviderEpisodeId: "ep-1", Number: 1, Title: "Start", HasSub: true, HasDub: true},
+		{ProviderEpisodeId: "ep-2", Number: 2, Title: "Episode 2", HasSub: true},
+		{ProviderEpisodeId: "ep-3", Number: 3, Title: "Beach", HasSub: true},
+		{ProviderEpisodeId: "ep-old", Number: 4, Title: "Gone", HasSub: true},
+	}
+	fetched := []*catalogv1.ProviderEpisode{
+		{ProviderEpisodeId: "ep-1", Number: 1, Title: "Start", HasSub: true, HasDub: true},
+		{ProviderEpisodeId: "ep-2", Number: 2, Title: "The Journey", HasSub: true, HasDub: true},
+		{ProviderEpisodeId: "ep-3", Number: 3, Title: "", IsFiller: true, HasSub: true},
+		{ProviderEpisodeId: "ep-5", Number: 5, Title: "New", HasSub: true},
+	}
+
+	d := DiffProviderEpisodes(stored, fetched)
+	if len(d.Added) != 1 || d.Added[0].GetProviderEpisodeId() != "ep-5" {
+		t.Fatalf("added = %v", d.Added)
+	}
+	if len(d.Changed) != 2 || d.Changed[0].GetProviderEpisodeId() != "ep-2" || d.Changed[1].GetProviderEpisodeId() != "ep-3" {
+		t.Fatalf("changed = %v", d.Changed)
+	}
+	if !reflect.DeepEqual(d.Removed, []string{"ep-old"}) {
+		t.Fatalf("removed = %v", d.Removed)
+	}
+	// ep-3 lost its title upstream; that is not a rename.
+	if d.Renamed != 1 || d.FillerChanged != 1 || d.AvailabilityChanged != 1 || d.Renumbered != 0 || d.Unchanged != 1 {
+		t.Fatalf("counts = %+v", d)
+	}
+
+	if d := DiffProviderEpisodes(fetched, fetched); !d.Empty() || d.Unchanged != len(fetched) {
+		t.Fatalf("identical lists produced %+v", d)
+	}
+}
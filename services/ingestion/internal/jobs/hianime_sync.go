@@ -2,41 +2,58 @@ package jobs
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	catalogv1 "github.com/example/anime-platform/gen/catalog/v1"
+	"github.com/example/anime-platform/internal/platform/geo"
 	"github.com/example/anime-platform/services/ingestion/internal/hianime"
 	"github.com/example/anime-platform/services/ingestion/internal/jikan"
+	"github.com/example/anime-platform/services/ingestion/internal/store"
 )
 
 type HiAnimeSync struct {
 	HiAnime hianime.Provider
 	Catalog catalogv1.CatalogServiceClient
 	Jikan   jikan.Provider
+	// Mappings holds admin-pinned slugs that bypass matching; optional.
+	Mappings store.HiAnimeMappingStore
+	// Results records each sync's outcome and remembers the matched slug so
+	// later syncs skip matching; optional.
+	Results store.SyncResultStore
 }
 
-// SyncEpisodesByMALID finds HiAnime slug by search+malId verification and upserts episodes in Catalog.
+// hianimeProvider is the catalog provider name for HiAnime episodes.
+const hianimeProvider = "hianime"
+
+// SyncEpisodesByMALID finds the HiAnime slug for malID and applies the episode changes
+// since the last sync to Catalog. A pinned mapping wins, then the slug the last sync used;
+// otherwise the anime is matched by title (queryTitle first, then every Jikan title) and
+// verified by HiAnime's malId or a similarity score. episodeIDs are the catalog episodes
+// added or changed.
 func (j HiAnimeSync) SyncEpisodesByMALID(ctx context.Context, malID int, queryTitle string) (animeID string, slug string, episodeIDs []string, err error) {
 	if malID <= 0 {
 		return "", "", nil, fmt.Errorf("malID required")
 	}
-	queryTitle = strings.TrimSpace(queryTitle)
-	if queryTitle == "" {
-		if j.Jikan == nil {
-			return "", "", nil, fmt.Errorf("queryTitle required")
+
+	result := store.SyncResult{Provider: hianimeProvider, MALID: malID}
+	defer func() {
+		if j.Results == nil {
+			return
 		}
-		jr, err := j.Jikan.GetAnime(ctx, malID)
+		result.AnimeID, result.ProviderAnimeID, result.SyncedAt = animeID, slug, time.Now().UTC()
 		if err != nil {
-			return "", "", nil, err
-		}
-		queryTitle = jikan.BestTitle(jr)
-		queryTitle = strings.TrimSpace(queryTitle)
-		if queryTitle == "" {
-			return "", "", nil, fmt.Errorf("queryTitle required")
+			result.Error = err.Error()
 		}
-	}
+		// Best-effort: a lost result only costs a re-match on the next sync.
+		_ = j.Results.RecordSyncResult(context.WithoutCancel(ctx), result)
+	}()
 
 	res, err := j.Catalog.ResolveAnimeIDByExternalID(ctx, &catalogv1.ResolveAnimeIDByExternalIDRequest{Provider: "mal", ExternalId: strconv.Itoa(malID)})
 	if err != nil {
@@ -44,53 +61,226 @@ func (j HiAnimeSync) SyncEpisodesByMALID(ctx context.Context, malID int, queryTi
 	}
 	animeID = res.GetAnimeId()
 
-	search, err := j.HiAnime.Search(ctx, queryTitle, 1)
+	slug, info, _, err := j.resolve(ctx, malID, queryTitle)
 	if err != nil {
 		return animeID, "", nil, err
 	}
-	if search.Status != 200 || len(search.Data.Animes) == 0 {
-		return animeID, "", nil, fmt.Errorf("no results for %q", queryTitle)
+	pbEpisodes, err := j.providerEpisodes(ctx, slug, info)
+	if err != nil {
+		return animeID, slug, nil, err
 	}
 
-	// Scan up to 15 candidates; verify malID match via full anime fetch to avoid title collisions.
-	for i, a := range search.Data.Animes {
-		if i >= 15 {
-			break
-		}
-		cand := strings.TrimSpace(a.ID)
-		if cand == "" {
-			continue
-		}
-		ai, err := j.HiAnime.GetAnime(ctx, cand)
+	diff, err := j.diff(ctx, animeID, pbEpisodes)
+	if err != nil {
+		return animeID, slug, nil, err
+	}
+
+	result.Airing = strings.EqualFold(strings.TrimSpace(info.Data.Anime.MoreInfo.Status), "Currently Airing")
+	result.Episodes = len(pbEpisodes)
+	result.Added, result.Removed = len(diff.Added), len(diff.Removed)
+	result.Renamed, result.Renumbered = diff.Renamed, diff.Renumbered
+	result.FillerChanged, result.AvailabilityChanged = diff.FillerChanged, diff.AvailabilityChanged
+
+	if upserts := diff.Upserts(); len(upserts) > 0 {
+		up, err := j.Catalog.UpsertProviderEpisodes(ctx, &catalogv1.UpsertProviderEpisodesRequest{Provider: hianimeProvider, AnimeId: animeID, ProviderAnimeId: slug, Episodes: upserts})
 		if err != nil {
-			continue
+			return animeID, slug, nil, err
+		}
+		episodeIDs = up.GetEpisodeIds()
+	}
+	if len(diff.Removed) > 0 {
+		if _, err := j.Catalog.RemoveProviderEpisodes(ctx, &catalogv1.RemoveProviderEpisodesRequest{Provider: hianimeProvider, AnimeId: animeID, ProviderEpisodeIds: diff.Removed}); err != nil {
+			return animeID, slug, episodeIDs, err
 		}
-		if ai.Data.Anime.Info.MalID == malID {
-			slug = cand
-			break
+	}
+	return animeID, slug, episodeIDs, nil
+}
+
+// HiAnimeDryRun reports what a HiAnime sync would do for one anime.
+type HiAnimeDryRun struct {
+	MALID   int    `json:"mal_id"`
+	AnimeID string `json:"anime_id,omitempty"`
+	Slug    string `json:"slug,omitempty"`
+	Matched bool   `json:"matched"`
+	// Source is how the slug was found: "pinned", "known" or "match".
+	Source string `json:"source,omitempty"`
+	// Error says why no slug matched.
+	Error    string `json:"error,omitempty"`
+	Episodes int    `json:"episodes"`
+	Added    int    `json:"added"`
+	Changed  int    `json:"changed"`
+	Removed  int    `json:"removed"`
+}
+
+// DryRunByMALID resolves the slug and diffs the episodes like SyncEpisodesByMALID
+// without writing to Catalog or recording a sync result. Anime the catalog does
+// not have yet diff against an empty episode list. Failing to match a slug is a
+// result, not an error.
+func (j HiAnimeSync) DryRunByMALID(ctx context.Context, malID int) (HiAnimeDryRun, error) {
+	out := HiAnimeDryRun{MALID: malID}
+	res, err := j.Catalog.ResolveAnimeIDByExternalID(ctx, &catalogv1.ResolveAnimeIDByExternalIDRequest{Provider: "mal", ExternalId: strconv.Itoa(malID)})
+	if err != nil && status.Code(err) != codes.NotFound {
+		return out, err
+	}
+	out.AnimeID = res.GetAnimeId()
+
+	slug, info, source, err := j.resolve(ctx, malID, "")
+	if errors.Is(err, hianime.ErrNoMatch) {
+		out.Error = err.Error()
+		return out, nil
+	}
+	if err != nil {
+		return out, err
+	}
+	out.Slug, out.Source, out.Matched = slug, source, true
+
+	pbEpisodes, err := j.providerEpisodes(ctx, slug, info)
+	if err != nil {
+		return out, err
+	}
+	diff := DiffProviderEpisodes(nil, pbEpisodes)
+	if out.AnimeID != "" {
+		if diff, err = j.diff(ctx, out.AnimeID, pbEpisodes); err != nil {
+			return out, err
 		}
 	}
-	if slug == "" {
-		return animeID, "", nil, fmt.Errorf("no hianime slug matched malId=%d", malID)
+	out.Episodes, out.Added, out.Changed, out.Removed = len(pbEpisodes), len(diff.Added), len(diff.Changed), len(diff.Removed)
+	return out, nil
+}
+
+// diff compares fetched with the episodes Catalog has stored for HiAnime.
+func (j HiAnimeSync) diff(ctx context.Context, animeID string, fetched []*catalogv1.ProviderEpisode) (EpisodeDiff, error) {
+	stored, err := j.Catalog.GetEpisodesByAnimeID(geo.Unrestricted(ctx), &catalogv1.GetEpisodesByAnimeIDRequest{AnimeId: animeID, Provider: hianimeProvider})
+	if err != nil {
+		return EpisodeDiff{}, err
+	}
+	d := DiffProviderEpisodes(stored.GetProviderEpisodes(), fetched)
+	if len(fetched) == 0 {
+		// An empty list is far more likely a HiAnime hiccup than a takedown of
+		// every episode; keep what we have.
+		d.Removed = nil
+	}
+	return d, nil
+}
+
+// resolve finds the slug for malID and reports how: "pinned", "known" or "match".
+func (j HiAnimeSync) resolve(ctx context.Context, malID int, queryTitle string) (string, *hianime.AnimeInfoResponse, string, error) {
+	slug, info, err := j.pinned(ctx, malID)
+	if err != nil {
+		return "", nil, "", err
+	}
+	if slug != "" {
+		return slug, info, "pinned", nil
+	}
+	if slug, info = j.known(ctx, malID); slug != "" {
+		return slug, info, "known", nil
+	}
+	target, err := j.target(ctx, malID, queryTitle)
+	if err != nil {
+		return "", nil, "", err
+	}
+	m, err := hianime.Matcher{HiAnime: j.HiAnime}.Match(ctx, target)
+	if err != nil {
+		if errors.Is(err, hianime.ErrNoMatch) {
+			return "", nil, "", fmt.Errorf("no hianime slug matched malId=%d: %w", malID, err)
+		}
+		return "", nil, "", err
 	}
+	return m.Slug, m.Info, "match", nil
+}
 
+// providerEpisodes fetches slug's episode list in catalog form.
+func (j HiAnimeSync) providerEpisodes(ctx context.Context, slug string, info *hianime.AnimeInfoResponse) ([]*catalogv1.ProviderEpisode, error) {
 	eps, err := j.HiAnime.GetEpisodes(ctx, slug)
 	if err != nil {
-		return animeID, slug, nil, err
+		return nil, err
 	}
 
-	pbEpisodes := make([]*catalogv1.HiAnimeEpisode, 0, len(eps.Data.Episodes))
+	// HiAnime releases sub and dub in order, so the stats counts tell us which
+	// episode numbers have each track.
+	subCount := info.Data.Anime.Info.Stats.Episodes.Sub
+	dubCount := info.Data.Anime.Info.Stats.Episodes.Dub
+	if subCount == 0 && dubCount == 0 {
+		subCount = len(eps.Data.Episodes)
+	}
+
+	out := make([]*catalogv1.ProviderEpisode, 0, len(eps.Data.Episodes))
 	for _, e := range eps.Data.Episodes {
 		id := strings.TrimSpace(e.EpisodeID)
 		if id == "" {
 			continue
 		}
-		pbEpisodes = append(pbEpisodes, &catalogv1.HiAnimeEpisode{ProviderEpisodeId: id, Number: e.Number, Title: strings.TrimSpace(e.Title), IsFiller: e.IsFiller})
+		out = append(out, &catalogv1.ProviderEpisode{
+			ProviderEpisodeId: id,
+			Number:            e.Number,
+			Title:             strings.TrimSpace(e.Title),
+			IsFiller:          e.IsFiller,
+			HasSub:            int(e.Number) <= subCount,
+			HasDub:            int(e.Number) <= dubCount,
+		})
+	}
+	return out, nil
+}
+
+// known returns the slug the last successful sync used for malID if HiAnime
+// still agrees it is the same anime, or "" to fall back to matching.
+func (j HiAnimeSync) known(ctx context.Context, malID int) (string, *hianime.AnimeInfoResponse) {
+	if j.Results == nil {
+		return "", nil
+	}
+	r, err := j.Results.GetSyncResult(ctx, hianimeProvider, malID)
+	if err != nil || r.ProviderAnimeID == "" {
+		return "", nil
+	}
+	info, err := j.HiAnime.GetAnime(ctx, r.ProviderAnimeID)
+	if err != nil {
+		return "", nil
+	}
+	if got := info.Data.Anime.Info.MalID; got != 0 && got != malID {
+		return "", nil
 	}
+	return r.ProviderAnimeID, info
+}
 
-	up, err := j.Catalog.UpsertHiAnimeEpisodes(ctx, &catalogv1.UpsertHiAnimeEpisodesRequest{AnimeId: animeID, HianimeSlug: slug, Episodes: pbEpisodes})
+// pinned returns the admin-mapped slug for malID, or "" when there is none.
+func (j HiAnimeSync) pinned(ctx context.Context, malID int) (string, *hianime.AnimeInfoResponse, error) {
+	if j.Mappings == nil {
+		return "", nil, nil
+	}
+	m, err := j.Mappings.GetHiAnimeMapping(ctx, malID)
+	if status.Code(err) == codes.NotFound {
+		return "", nil, nil
+	}
 	if err != nil {
-		return animeID, slug, nil, err
+		return "", nil, err
+	}
+	info, err := j.HiAnime.GetAnime(ctx, m.Slug)
+	if err != nil {
+		return "", nil, err
+	}
+	return m.Slug, info, nil
+}
+
+// target describes malID for matching. Jikan supplies the title variants,
+// episode count and year; without it only queryTitle is searched.
+func (j HiAnimeSync) target(ctx context.Context, malID int, queryTitle string) (hianime.Target, error) {
+	t := hianime.Target{MALID: malID}
+	if q := strings.TrimSpace(queryTitle); q != "" {
+		t.Titles = append(t.Titles, q)
+	}
+	if j.Jikan != nil {
+		jr, err := j.Jikan.GetAnime(ctx, malID)
+		switch {
+		case err == nil:
+			t.Titles = append(t.Titles, jikan.Titles(jr)...)
+			t.Episodes = int(jr.Data.Episodes)
+			t.Year = jikan.Year(jr)
+		case len(t.Titles) == 0:
+			return t, err
+		}
+	}
+	if len(t.Titles) == 0 {
+		return t, fmt.Errorf("queryTitle required")
 	}
-	return animeID, slug, up.GetEpisodeIds(), nil
+	return t, nil
 }
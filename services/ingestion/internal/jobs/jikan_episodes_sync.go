@@ -0,0 +1,100 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	catalogv1 "github.com/example/anime-platform/gen/catalog/v1"
+	"github.com/example/anime-platform/services/ingestion/internal/jikan"
+)
+
+// maxEpisodePages caps pagination for very long-running shows (100 episodes per page).
+const maxEpisodePages = 20
+
+type JikanEpisodesSync struct {
+	Log     *zap.Logger
+	Jikan   jikan.Provider
+	Catalog catalogv1.CatalogServiceClient
+	// FetchDetails additionally requests /anime/{id}/episodes/{n} for every episode to get
+	// synopsis and exact duration. One extra Jikan call per episode; off by default.
+	FetchDetails bool
+}
+
+// SyncByMALID fetches episode metadata (titles, air dates, filler/recap flags, thumbnails)
+// from Jikan and merges it into catalog episodes keyed by episode number.
+func (j JikanEpisodesSync) SyncByMALID(ctx context.Context, malID int) ([]string, error) {
+	if malID <= 0 {
+		return nil, fmt.Errorf("malID required")
+	}
+
+	res, err := j.Catalog.ResolveAnimeIDByExternalID(ctx, &catalogv1.ResolveAnimeIDByExternalIDRequest{Provider: "mal", ExternalId: strconv.Itoa(malID)})
+	if err != nil {
+		return nil, err
+	}
+	animeID := res.GetAnimeId()
+
+	anime, err := j.Jikan.GetAnime(ctx, malID)
+	if err != nil {
+		return nil, err
+	}
+	defaultDuration := jikan.DurationSeconds(anime.Data.Duration)
+
+	var episodes []jikan.EpisodeData
+	for page := 1; page <= maxEpisodePages; page++ {
+		list, err := j.Jikan.GetAnimeEpisodes(ctx, malID, page)
+		if err != nil {
+			return nil, err
+		}
+		episodes = append(episodes, list.Data...)
+		if !list.Pagination.HasNextPage {
+			break
+		}
+	}
+	if len(episodes) == 0 {
+		return nil, nil
+	}
+
+	thumbnails := make(map[int32]string, len(episodes))
+	for page := 1; page <= maxEpisodePages; page++ {
+		list, err := j.Jikan.GetAnimeVideoEpisodes(ctx, malID, page)
+		if err != nil {
+			// Thumbnails are best-effort; keep the rest of the metadata.
+			break
+		}
+		for _, v := range list.Data {
+			if v.MalID > 0 && v.Images.JPG.ImageURL != "" {
+				thumbnails[v.MalID] = v.Images.JPG.ImageURL
+			}
+		}
+		if !list.Pagination.HasNextPage {
+			break
+		}
+	}
+
+	pbEpisodes := make([]*catalogv1.JikanEpisode, 0, len(episodes))
+	for _, e := range episodes {
+		if e.MalID <= 0 {
+			continue
+		}
+		if j.FetchDetails {
+			// Details are best-effort too: the episode keeps its list metadata.
+			d, err := j.Jikan.GetAnimeEpisode(ctx, malID, int(e.MalID))
+			if err != nil {
+				j.Log.Warn("jikan episode details failed", zap.Int("mal_id", malID), zap.Int32("episode", e.MalID), zap.Error(err))
+			} else {
+				e.Synopsis = d.Data.Synopsis
+				e.Duration = d.Data.Duration
+			}
+		}
+		pbEpisodes = append(pbEpisodes, jikan.EpisodeDataToProto(e, thumbnails[e.MalID], defaultDuration))
+	}
+
+	up, err := j.Catalog.UpsertJikanEpisodes(ctx, &catalogv1.UpsertJikanEpisodesRequest{AnimeId: animeID, Episodes: pbEpisodes})
+	if err != nil {
+		return nil, err
+	}
+	return up.GetEpisodeIds(), nil
+}
@@ -0,0 +1,111 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+
+	catalogv1 "github.com/example/anime-platform/gen/catalog/v1"
+	"github.com/example/anime-platform/services/ingestion/internal/jikan"
+)
+
+// stubJikan serves episode pages from memory. Unset methods panic.
+type stubJikan struct {
+	jikan.Provider
+	pages      [][]jikan.EpisodeData
+	thumbnails map[int32]string
+	details    map[int32]jikan.EpisodeData
+	pageCalls  int
+}
+
+func (s *stubJikan) GetAnime(context.Context, int) (*jikan.AnimeResponse, error) {
+	var res jikan.AnimeResponse
+	res.Data.Duration = "24 min per ep"
+	return &res, nil
+}
+
+func (s *stubJikan) GetAnimeEpisodes(_ context.Context, _ int, page int) (*jikan.EpisodeListResponse, error) {
+	s.pageCalls++
+	var res jikan.EpisodeListResponse
+	res.Data = s.pages[page-1]
+	res.Pagination.HasNextPage = page < len(s.pages)
+	return &res, nil
+}
+
+func (s *stubJikan) GetAnimeVideoEpisodes(context.Context, int, int) (*jikan.VideoEpisodeListResponse, error) {
+	var res jikan.VideoEpisodeListResponse
+	for n, url := range s.thumbnails {
+		v := jikan.VideoEpisodeData{MalID: n}
+		v.Images.JPG.ImageURL = url
+		res.Data = append(res.Data, v)
+	}
+	return &res, nil
+}
+
+func (s *stubJikan) GetAnimeEpisode(_ context.Context, _ int, number int) (*jikan.EpisodeResponse, error) {
+	d, ok := s.details[int32(number)]
+	if !ok {
+		return nil, errors.New("jikan: status 500")
+	}
+	return &jikan.EpisodeResponse{Data: d}, nil
+}
+
+// stubCatalog records the episodes the sync upserts. Unset methods panic.
+type stubCatalog struct {
+	catalogv1.CatalogServiceClient
+	upserted []*catalogv1.JikanEpisode
+}
+
+func (c *stubCatalog) ResolveAnimeIDByExternalID(context.Context, *catalogv1.ResolveAnimeIDByExternalIDRequest, ...grpc.CallOption) (*catalogv1.ResolveAnimeIDByExternalIDResponse, error) {
+	return &catalogv1.ResolveAnimeIDByExternalIDResponse{AnimeId: "anime-1"}, nil
+}
+
+func (c *stubCatalog) UpsertJikanEpisodes(_ context.Context, req *catalogv1.UpsertJikanEpisodesRequest, _ ...grpc.CallOption) (*catalogv1.UpsertJikanEpisodesResponse, error) {
+	c.upserted = req.GetEpisodes()
+	return &catalogv1.UpsertJikanEpisodesResponse{}, nil
+}
+
+func TestJikanEpisodesSync_WalksPagesAndMergesDetails(t *testing.T) {
+	jk := &stubJikan{
+		pages: [][]jikan.EpisodeData{
+			{{MalID: 1, Title: "One"}, {MalID: 2, Title: "Two"}},
+			{{MalID: 3, Title: "Three", Recap: true}},
+		},
+		thumbnails: map[int32]string{2: "https://cdn.example/2.jpg"},
+		details: map[int32]jikan.EpisodeData{
+			1: {MalID: 1, Synopsis: "First.", Duration: 1500},
+			3: {MalID: 3, Synopsis: "Third."},
+		},
+	}
+	cat := &stubCatalog{}
+	core, logs := observer.New(zap.WarnLevel)
+	job := JikanEpisodesSync{Log: zap.New(core), Jikan: jk, Catalog: cat, FetchDetails: true}
+
+	if _, err := job.SyncByMALID(context.Background(), 52991); err != nil {
+		t.Fatal(err)
+	}
+	if jk.pageCalls != 2 {
+		t.Fatalf("fetched %d episode pages, want 2", jk.pageCalls)
+	}
+	if len(cat.upserted) != 3 {
+		t.Fatalf("upserted %d episodes, want 3", len(cat.upserted))
+	}
+	one, two, three := cat.upserted[0], cat.upserted[1], cat.upserted[2]
+	if one.GetSynopsis() != "First." || one.GetDurationSeconds() != 1500 {
+		t.Errorf("episode 1 = %v, want its details", one)
+	}
+	// Episode 2's details failed: it keeps the list data and the anime's duration.
+	if two.GetTitle() != "Two" || two.GetSynopsis() != "" || two.GetDurationSeconds() != 24*60 || two.GetThumbnail() != "https://cdn.example/2.jpg" {
+		t.Errorf("episode 2 = %v", two)
+	}
+	if three.GetSynopsis() != "Third." || !three.GetIsRecap() || three.GetDurationSeconds() != 24*60 {
+		t.Errorf("episode 3 = %v", three)
+	}
+	if logs.FilterMessage("jikan episode details failed").Len() != 1 {
+		t.Fatalf("logged %v, want one details failure", logs.All())
+	}
+}
@@ -0,0 +1,61 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	catalogv1 "github.com/example/anime-platform/gen/catalog/v1"
+	"github.com/example/anime-platform/services/ingestion/internal/provider"
+)
+
+type MetadataSync struct {
+	Providers *provider.Registry
+	Catalog   catalogv1.CatalogServiceClient
+}
+
+// SyncByMALID enriches the catalog anime for malID with one provider's metadata.
+// The provider's ID is attached to the anime first so the catalog can resolve it.
+// Anime the provider does not know are skipped and return an empty animeID.
+func (j MetadataSync) SyncByMALID(ctx context.Context, providerName string, malID int) (animeID string, err error) {
+	if malID <= 0 {
+		return "", fmt.Errorf("malID required")
+	}
+	res, err := j.Catalog.ResolveAnimeIDByExternalID(ctx, &catalogv1.ResolveAnimeIDByExternalIDRequest{Provider: "mal", ExternalId: strconv.Itoa(malID)})
+	if err != nil {
+		return "", err
+	}
+	animeID = res.GetAnimeId()
+
+	md, err := j.Providers.Fetch(ctx, providerName, malID)
+	if errors.Is(err, provider.ErrNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if md.ExternalID == "" {
+		return "", fmt.Errorf("%s: no external id for mal %d", providerName, malID)
+	}
+
+	if _, err := j.Catalog.AttachExternalAnimeID(ctx, &catalogv1.AttachExternalAnimeIDRequest{AnimeId: animeID, Provider: providerName, ExternalId: md.ExternalID}); err != nil {
+		return "", err
+	}
+	tags := make([]*catalogv1.Tag, 0, len(md.Tags))
+	for _, t := range md.Tags {
+		tags = append(tags, &catalogv1.Tag{Name: t.Name, Category: t.Category, Rank: t.Rank, Spoiler: t.Spoiler})
+	}
+	up, err := j.Catalog.UpsertProviderMetadata(ctx, &catalogv1.UpsertProviderMetadataRequest{
+		Provider:    providerName,
+		ExternalId:  md.ExternalID,
+		BannerImage: md.BannerImage,
+		Score:       md.Score,
+		ScoreCount:  md.ScoreCount,
+		Tags:        tags,
+	})
+	if err != nil {
+		return "", err
+	}
+	return up.GetAnimeId(), nil
+}
@@ -0,0 +1,85 @@
+// Package provider defines the port for metadata providers that enrich catalog
+// anime after the Jikan sync, and a registry of the enabled ones.
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrNotFound is returned by FetchByMALID when the provider has no entry for the anime.
+var ErrNotFound = errors.New("provider: anime not found")
+
+// Metadata is the data a provider contributes to a catalog anime. Fields the
+// provider does not know are left zero.
+type Metadata struct {
+	// ExternalID is the provider's own anime ID, stored in external_anime_ids.
+	ExternalID  string
+	BannerImage string
+	// Score is normalized to 0-10.
+	Score      float32
+	ScoreCount int32
+	Tags       []Tag
+}
+
+// Tag is a descriptive provider tag; Rank is the provider's 0-100 relevance.
+type Tag struct {
+	Name     string
+	Category string
+	Rank     int32
+	Spoiler  bool
+}
+
+// MetadataProvider is the port for a source of supplementary anime metadata.
+type MetadataProvider interface {
+	// Name is the provider key used in external_anime_ids and job payloads.
+	Name() string
+	FetchByMALID(ctx context.Context, malID int) (*Metadata, error)
+}
+
+// Registry holds the enabled metadata providers in registration order.
+type Registry struct {
+	mu      sync.RWMutex
+	entries map[string]MetadataProvider
+	names   []string
+}
+
+func NewRegistry() *Registry {
+	return &Registry{entries: map[string]MetadataProvider{}}
+}
+
+// Register adds p. Providers rate-limit their own upstream requests.
+func (r *Registry) Register(p MetadataProvider) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	name := p.Name()
+	if name == "" {
+		return errors.New("provider: empty name")
+	}
+	if _, ok := r.entries[name]; ok {
+		return fmt.Errorf("provider: %q already registered", name)
+	}
+	r.entries[name] = p
+	r.names = append(r.names, name)
+	return nil
+}
+
+// Names returns the registered provider names in registration order.
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]string(nil), r.names...)
+}
+
+// Fetch looks up malID with the named provider.
+func (r *Registry) Fetch(ctx context.Context, name string, malID int) (*Metadata, error) {
+	r.mu.RLock()
+	p, ok := r.entries[name]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("provider: %q not registered", name)
+	}
+	return p.FetchByMALID(ctx, malID)
+}
@@ -1,9 +1,34 @@
 package queue
 
+import "encoding/json"
+
 type JikanSyncJob struct {
 	MALID int `json:"mal_id"`
+	// DryRunID makes the job a dry run: it reports what it would change under
+	// this id instead of writing to the catalog.
+	DryRunID string `json:"dry_run_id,omitempty"`
 }
 
 type HiAnimeSyncJob struct {
+	MALID    int    `json:"mal_id"`
+	DryRunID string `json:"dry_run_id,omitempty"`
+}
+
+type JikanEpisodesSyncJob struct {
 	MALID int `json:"mal_id"`
 }
+
+// MetadataSyncJob asks one registered metadata provider to enrich an anime.
+type MetadataSyncJob struct {
+	Provider string `json:"provider"`
+	MALID    int    `json:"mal_id"`
+}
+
+// DLQMessage is published to ingestion.dlq when a job exhausts its deliveries.
+type DLQMessage struct {
+	Subject string          `json:"subject"`
+	Reason  string          `json:"reason"`
+	Payload json.RawMessage `json:"payload"`
+	// JobID is the failed job's id, empty if it was published without one.
+	JobID string `json:"job_id,omitempty"`
+}
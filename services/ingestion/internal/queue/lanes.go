@@ -0,0 +1,103 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/example/anime-platform/internal/platform/ingestjob"
+)
+
+// Lane configures how one priority lane is consumed.
+type Lane struct {
+	Name string
+	// Weight is the lane's share of pulls while several lanes have work.
+	Weight int
+	// Concurrency bounds the lane's jobs in flight per subject.
+	Concurrency int
+}
+
+// DefaultLanes returns the lanes in priority order with their default weights
+// and concurrency.
+func DefaultLanes() []Lane {
+	return []Lane{
+		{Name: ingestjob.LaneInteractive, Weight: 6, Concurrency: 2},
+		{Name: ingestjob.LaneScheduled, Weight: 3, Concurrency: 1},
+		{Name: ingestjob.LaneBackfill, Weight: 1, Concurrency: 1},
+	}
+}
+
+// ConfigureLanes overrides the default lanes' weights and concurrency by lane
+// name. Unknown names and values below 1 are rejected.
+func ConfigureLanes(weights, concurrency map[string]int) ([]Lane, error) {
+	lanes := DefaultLanes()
+	byName := make(map[string]*Lane, len(lanes))
+	for i := range lanes {
+		byName[lanes[i].Name] = &lanes[i]
+	}
+	for name, v := range weights {
+		l, ok := byName[name]
+		if !ok || v < 1 {
+			return nil, fmt.Errorf("lane weight %s=%d: unknown lane or below 1", name, v)
+		}
+		l.Weight = v
+	}
+	for name, v := range concurrency {
+		l, ok := byName[name]
+		if !ok || v < 1 {
+			return nil, fmt.Errorf("lane concurrency %s=%d: unknown lane or below 1", name, v)
+		}
+		l.Concurrency = v
+	}
+	return lanes, nil
+}
+
+type laneKey struct{}
+
+// LaneFromContext returns the lane of the job being handled, so follow-up
+// jobs can be published in the same lane. It is ingestjob.LaneScheduled outside a job.
+func LaneFromContext(ctx context.Context) string {
+	if l, ok := ctx.Value(laneKey{}).(string); ok {
+		return l
+	}
+	return ingestjob.LaneScheduled
+}
+
+func withLane(ctx context.Context, lane string) context.Context {
+	return context.WithValue(ctx, laneKey{}, lane)
+}
+
+// laneScheduler picks the lane to pull from next by smooth weighted round
+// robin: over any run of picks where the same lanes are eligible, each lane
+// is picked in proportion to its weight, and picks are interleaved.
+type laneScheduler struct {
+	weights []int
+	current []int
+}
+
+func newLaneScheduler(lanes []Lane) *laneScheduler {
+	s := &laneScheduler{weights: make([]int, len(lanes)), current: make([]int, len(lanes))}
+	for i, l := range lanes {
+		s.weights[i] = max(l.Weight, 1)
+	}
+	return s
+}
+
+// next returns the index of the lane to pull from among those with eligible
+// set, or -1 when none is.
+func (s *laneScheduler) next(eligible []bool) int {
+	best, total := -1, 0
+	for i, w := range s.weights {
+		if !eligible[i] {
+			continue
+		}
+		s.current[i] += w
+		total += w
+		if best < 0 || s.current[i] > s.current[best] {
+			best = i
+		}
+	}
+	if best >= 0 {
+		s.current[best] -= total
+	}
+	return best
+}
@@ -0,0 +1,33 @@
+package queue
+
+import "testing"
+
+func TestLaneSchedulerWeights(t *testing.T) {
+	s := newLaneScheduler(DefaultLanes())
+	all := []bool{true, true, true}
+	counts := make([]int, 3)
+	for i := 0; i < 20; i++ {
+		counts[s.next(all)]++
+	}
+	if counts[0] != 12 || counts[1] != 6 || counts[2] != 2 {
+		t.Fatalf("picks = %v, want 12/6/2 for weights 6/3/1", counts)
+	}
+
+	// An empty interactive lane leaves the others their relative shares.
+	counts = make([]int, 3)
+	for i := 0; i < 8; i++ {
+		counts[s.next([]bool{false, true, true})]++
+	}
+	if counts[0] != 0 || counts[1] != 6 || counts[2] != 2 {
+		t.Fatalf("picks = %v, want 0/6/2", counts)
+	}
+	if s.next([]bool{false, false, false}) != -1 {
+		t.Fatal("expected no pick without eligible lanes")
+	}
+}
+
+func TestJobType(t *testing.T) {
+	if JobType("ingestion.jikan.sync.interactive") != "jikan.sync" {
+		t.Fatal("job type should not include the lane")
+	}
+}
@@ -0,0 +1,119 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+
+	"github.com/example/anime-platform/internal/platform/ingestjob"
+	"github.com/example/anime-platform/services/ingestion/internal/store"
+)
+
+// FollowUpDedupeWindow is how long JetStream remembers follow-up message ids.
+// It outlasts a job's retries, so a retried handler's follow-ups are dropped
+// as duplicates.
+const FollowUpDedupeWindow = 10 * time.Minute
+
+// DLQSubject receives jobs that exhausted their deliveries, as DLQMessage.
+const DLQSubject = "ingestion.dlq"
+
+// JobType is the job type recorded for a subject, e.g. "jikan.sync" for
+// ingestion.jikan.sync in any lane.
+func JobType(subject string) string {
+	base, _ := ingestjob.SplitLane(subject)
+	return strings.TrimPrefix(base, "ingestion.")
+}
+
+// JetStream is the subset of nats.JetStreamContext used to publish jobs.
+type JetStream interface {
+	PublishMsg(m *nats.Msg, opts ...nats.PubOpt) (*nats.PubAck, error)
+}
+
+// Publisher enqueues ingestion jobs and records each one in Jobs. Tracking is
+// best effort: a failed insert is logged and the job is still published.
+type Publisher struct {
+	Log  *zap.Logger
+	JS   JetStream
+	Jobs store.JobStore
+}
+
+// Publish marshals job, publishes it to subject and returns the job id.
+func (p *Publisher) Publish(ctx context.Context, subject string, job any) (string, error) {
+	b, err := json.Marshal(job)
+	if err != nil {
+		return "", err
+	}
+	id := uuid.NewString()
+	if p.Jobs != nil {
+		if err := p.Jobs.CreateJob(ctx, id, JobType(subject), b); err != nil {
+			p.Log.Warn("job record create failed", zap.String("subject", subject), zap.String("job_id", id), zap.Error(err))
+		}
+	}
+	if err := p.PublishJob(ctx, subject, id, b); err != nil {
+		if p.Jobs != nil {
+			_ = p.Jobs.FailJob(ctx, id, "publish: "+err.Error())
+		}
+		return "", err
+	}
+	return id, nil
+}
+
+// PublishFollowUp is Publish for jobs enqueued by a job handler. The id is
+// derived from the handling job, subject and job, and doubles as the JetStream
+// message id, so a handler that is retried after publishing enqueues each
+// follow-up once. Outside a handler it behaves like Publish.
+func (p *Publisher) PublishFollowUp(ctx context.Context, subject string, job any) (string, error) {
+	parent, ok := parentFromContext(ctx)
+	if !ok {
+		return p.Publish(ctx, subject, job)
+	}
+	b, err := json.Marshal(job)
+	if err != nil {
+		return "", err
+	}
+	id := followUpID(parent, subject, b)
+	if p.Jobs != nil {
+		if err := p.Jobs.CreateJob(ctx, id, JobType(subject), b); err != nil {
+			p.Log.Warn("job record create failed", zap.String("subject", subject), zap.String("job_id", id), zap.Error(err))
+		}
+	}
+	if err := p.publish(ctx, subject, id, b, true); err != nil {
+		if p.Jobs != nil {
+			_ = p.Jobs.FailJob(ctx, id, "publish: "+err.Error())
+		}
+		return "", err
+	}
+	return id, nil
+}
+
+// followUpID is a name-based UUID for the follow-up of parent on subject.
+func followUpID(parent, subject string, data []byte) string {
+	name := make([]byte, 0, len(parent)+len(subject)+len(data)+2)
+	name = append(append(append(name, parent...), 0), subject...)
+	name = append(append(name, 0), data...)
+	return uuid.NewSHA1(uuid.NameSpaceOID, name).String()
+}
+
+// PublishJob publishes data under an existing job id without recording the
+// job; callers that recorded it themselves (e.g. DLQ replays) use this.
+func (p *Publisher) PublishJob(ctx context.Context, subject, id string, data []byte) error {
+	return p.publish(ctx, subject, id, data, false)
+}
+
+// publish sends data under job id; dedupe also makes id the JetStream message
+// id so the stream drops repeats within FollowUpDedupeWindow.
+func (p *Publisher) publish(ctx context.Context, subject, id string, data []byte, dedupe bool) error {
+	msg := nats.NewMsg(subject)
+	msg.Header.Set(ingestjob.IDHeader, id)
+	if dedupe {
+		msg.Header.Set(nats.MsgIdHdr, id)
+	}
+	msg.Data = data
+	_, err := p.JS.PublishMsg(msg, nats.Context(ctx))
+	return err
+}
@@ -0,0 +1,128 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+
+	"github.com/example/anime-platform/internal/platform/ingestjob"
+)
+
+// fakeJetStream records published messages.
+type fakeJetStream struct {
+	msgs []*nats.Msg
+}
+
+func (f *fakeJetStream) PublishMsg(m *nats.Msg, _ ...nats.PubOpt) (*nats.PubAck, error) {
+	f.msgs = append(f.msgs, m)
+	return &nats.PubAck{}, nil
+}
+
+func TestPublishFollowUp_StableAcrossRetries(t *testing.T) {
+	js := &fakeJetStream{}
+	p := &Publisher{Log: zap.NewNop(), JS: js}
+	ctx := withParent(context.Background(), "7f1d5d8e-4a1b-4c7e-9a51-6f3f0d2f4b01")
+
+	first, err := p.PublishFollowUp(ctx, "ingestion.hianime.sync", HiAnimeSyncJob{MALID: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A retried handler publishes the same follow-up again.
+	again, err := p.PublishFollowUp(ctx, "ingestion.hianime.sync", HiAnimeSyncJob{MALID: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := p.PublishFollowUp(ctx, "ingestion.jikan.episodes", JikanEpisodesSyncJob{MALID: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != again {
+		t.Fatalf("retry changed the follow-up id: %s then %s", first, again)
+	}
+	if other == first {
+		t.Fatal("different follow-ups share an id")
+	}
+	for _, m := range js.msgs {
+		if m.Header.Get(nats.MsgIdHdr) != m.Header.Get(ingestjob.IDHeader) {
+			t.Fatalf("message id %q, job id %q", m.Header.Get(nats.MsgIdHdr), m.Header.Get(ingestjob.IDHeader))
+		}
+	}
+
+	// Another parent job gets its own follow-ups.
+	next, err := p.PublishFollowUp(withParent(context.Background(), "seq:42"), "ingestion.hianime.sync", HiAnimeSyncJob{MALID: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next == first {
+		t.Fatal("follow-ups of different jobs share an id")
+	}
+}
+
+func TestPublishFollowUp_OutsideJobIsUnique(t *testing.T) {
+	js := &fakeJetStream{}
+	p := &Publisher{Log: zap.NewNop(), JS: js}
+	a, _ := p.PublishFollowUp(context.Background(), "ingestion.jikan.sync", JikanSyncJob{MALID: 1})
+	b, _ := p.PublishFollowUp(context.Background(), "ingestion.jikan.sync", JikanSyncJob{MALID: 1})
+	if a == b {
+		t.Fatal("publishes outside a job should get fresh ids")
+	}
+	if js.msgs[0].Header.Get(nats.MsgIdHdr) != "" {
+		t.Fatal("publishes outside a job should not be deduplicated")
+	}
+}
+
+func TestHandleMsg_FollowUpsKeyedOnJob(t *testing.T) {
+	js := &fakeJetStream{}
+	p := &Publisher{Log: zap.NewNop(), JS: js}
+	w := &Worker{
+		Log: zap.NewNop(),
+		Handlers: Handlers{
+			JikanSync: func(ctx context.Context, malID int) error {
+				if _, err := p.PublishFollowUp(ctx, "ingestion.hianime.sync", HiAnimeSyncJob{MALID: malID}); err != nil {
+					return err
+				}
+				_, err := p.PublishFollowUp(ctx, "ingestion.jikan.episodes", JikanEpisodesSyncJob{MALID: malID})
+				return err
+			},
+		},
+	}
+	const id = "7f1d5d8e-4a1b-4c7e-9a51-6f3f0d2f4b01"
+	msg := jobMsg("ingestion.jikan.sync", id, `{"mal_id":5}`)
+	// First delivery: the second publish fails, so the job is retried.
+	p.JS = &failNth{fakeJetStream: js, n: 2}
+	if err := w.handleMsg(context.Background(), msg, "ingestion.jikan.sync"); err == nil {
+		t.Fatal("expected the failed publish to fail the job")
+	}
+	p.JS = js
+	if err := w.handleMsg(context.Background(), msg, "ingestion.jikan.sync"); err != nil {
+		t.Fatal(err)
+	}
+	if len(js.msgs) != 3 {
+		t.Fatalf("expected 3 publishes, got %d", len(js.msgs))
+	}
+	if a, b := js.msgs[0].Header.Get(nats.MsgIdHdr), js.msgs[1].Header.Get(nats.MsgIdHdr); a != b {
+		t.Fatalf("retried hianime follow-up got a new message id: %s then %s", a, b)
+	}
+	var j HiAnimeSyncJob
+	if err := json.Unmarshal(js.msgs[1].Data, &j); err != nil || j.MALID != 5 {
+		t.Fatalf("unexpected follow-up %s", js.msgs[1].Data)
+	}
+}
+
+// failNth fails the nth publish and forwards the others.
+type failNth struct {
+	*fakeJetStream
+	n, calls int
+}
+
+func (f *failNth) PublishMsg(m *nats.Msg, opts ...nats.PubOpt) (*nats.PubAck, error) {
+	f.calls++
+	if f.calls == f.n {
+		return nil, errors.New("nats: timeout")
+	}
+	return f.fakeJetStream.PublishMsg(m, opts...)
+}
@@ -5,15 +5,29 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
+	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/nats-io/nats.go"
 	"go.uber.org/zap"
+
+	"github.com/example/anime-platform/internal/platform/ingestjob"
+	"github.com/example/anime-platform/services/ingestion/internal/store"
 )
 
 type Handlers struct {
-	JikanSync   func(ctx context.Context, malID int) error
-	HiAnimeSync func(ctx context.Context, malID int) error
+	JikanSync         func(ctx context.Context, malID int) error
+	JikanEpisodesSync func(ctx context.Context, malID int) error
+	HiAnimeSync       func(ctx context.Context, malID int) error
+	MetadataSync      func(ctx context.Context, provider string, malID int) error
+
+	// Dry-run handlers fetch and compare like their sync counterparts but
+	// return a report instead of writing. A dry-run job whose handler is nil
+	// fails without retries.
+	JikanSyncDryRun   func(ctx context.Context, malID int, dryRunID string) (report any, err error)
+	HiAnimeSyncDryRun func(ctx context.Context, malID int) (report any, err error)
 }
 
 type Worker struct {
@@ -21,10 +35,34 @@ type Worker struct {
 	NATS     *nats.Conn
 	JS       nats.JetStreamContext
 	Handlers Handlers
+	// Jobs records job attempts and outcomes; nil disables tracking.
+	Jobs store.JobStore
+	// DLQ receives copies of dead-lettered jobs; nil leaves ingestion.dlq unconsumed.
+	DLQ store.DLQStore
+	// Lanes are consumed for every job subject; nil means DefaultLanes.
+	Lanes []Lane
 
 	MaxDeliver int
 }
 
+// jobDurables maps each job subject to the durable consumer of its scheduled
+// lane; other lanes append the lane name.
+var jobDurables = []struct{ subject, durable string }{
+	{"ingestion.jikan.sync", "ingestion_jikan"},
+	{"ingestion.jikan.episodes", "ingestion_jikan_episodes"},
+	{"ingestion.hianime.sync", "ingestion_hianime"},
+	{"ingestion.metadata.sync", "ingestion_metadata"},
+}
+
+const (
+	// laneFetchWait is how long a pull waits on one lane before the next
+	// eligible lane is tried.
+	laneFetchWait = 100 * time.Millisecond
+	// Idle polling backs off between these once every lane came up empty.
+	minLaneIdleWait = 250 * time.Millisecond
+	maxLaneIdleWait = 2 * time.Second
+)
+
 func NewWorker(log *zap.Logger, nc *nats.Conn, handlers Handlers) (*Worker, error) {
 	js, err := nc.JetStream()
 	if err != nil {
@@ -37,7 +75,8 @@ func NewWorker(log *zap.Logger, nc *nats.Conn, handlers Handlers) (*Worker, erro
 func (w *Worker) EnsureStream(ctx context.Context) error {
 	info, err := w.JS.StreamInfo("INGESTION_JOBS")
 	if err == nil {
-		// Ensure subjects cover ingestion.>
+		// Ensure subjects cover ingestion.> and the duplicate window covers
+		// follow-up retries.
 		needsUpdate := true
 		for _, s := range info.Config.Subjects {
 			if s == "ingestion.>" {
@@ -45,9 +84,15 @@ func (w *Worker) EnsureStream(ctx context.Context) error {
 				break
 			}
 		}
+		cfg := info.Config
 		if needsUpdate {
-			cfg := info.Config
 			cfg.Subjects = []string{"ingestion.>"}
+		}
+		if cfg.Duplicates < FollowUpDedupeWindow {
+			cfg.Duplicates = FollowUpDedupeWindow
+			needsUpdate = true
+		}
+		if needsUpdate {
 			_, err := w.JS.UpdateStream(&cfg)
 			return err
 		}
@@ -57,10 +102,11 @@ func (w *Worker) EnsureStream(ctx context.Context) error {
 		return err
 	}
 	_, err = w.JS.AddStream(&nats.StreamConfig{
-		Name:     "INGESTION_JOBS",
-		Subjects: []string{"ingestion.>"},
-		Storage:  nats.FileStorage,
-		MaxAge:   7 * 24 * time.Hour,
+		Name:       "INGESTION_JOBS",
+		Subjects:   []string{"ingestion.>"},
+		Storage:    nats.FileStorage,
+		MaxAge:     7 * 24 * time.Hour,
+		Duplicates: FollowUpDedupeWindow,
 	})
 	return err
 }
@@ -70,19 +116,34 @@ func (w *Worker) Run(ctx context.Context) error {
 		return err
 	}
 
-	// Create pull subscriptions (durable)
-	jikanSub, err := w.JS.PullSubscribe("ingestion.jikan.sync", "ingestion_jikan")
-	if err != nil {
-		return err
+	lanes := w.Lanes
+	if len(lanes) == 0 {
+		lanes = DefaultLanes()
 	}
-	hiaSub, err := w.JS.PullSubscribe("ingestion.hianime.sync", "ingestion_hianime")
-	if err != nil {
-		return err
+	errCh := make(chan error, len(jobDurables)+1)
+	for _, jd := range jobDurables {
+		subs := make([]*nats.Subscription, len(lanes))
+		for i, l := range lanes {
+			durable := jd.durable
+			if l.Name != ingestjob.LaneScheduled {
+				durable += "_" + l.Name
+			}
+			sub, err := w.JS.PullSubscribe(ingestjob.LaneSubject(jd.subject, l.Name), durable)
+			if err != nil {
+				return err
+			}
+			subs[i] = sub
+		}
+		go func() { errCh <- w.consumeLanes(ctx, jd.subject, lanes, subs) }()
 	}
 
-	errCh := make(chan error, 2)
-	go func() { errCh <- w.consumeLoop(ctx, jikanSub, "ingestion.jikan.sync") }()
-	go func() { errCh <- w.consumeLoop(ctx, hiaSub, "ingestion.hianime.sync") }()
+	if w.DLQ != nil {
+		dlqSub, err := w.JS.PullSubscribe(DLQSubject, "ingestion_dlq")
+		if err != nil {
+			return err
+		}
+		go func() { errCh <- w.consumeLoop(ctx, dlqSub, DLQSubject) }()
+	}
 
 	select {
 	case <-ctx.Done():
@@ -92,6 +153,111 @@ func (w *Worker) Run(ctx context.Context) error {
 	}
 }
 
+// consumeLanes pulls base's jobs from every lane. While several lanes have
+// work each gets pulls in proportion to its weight; a lane at its concurrency
+// limit is skipped until one of its jobs finishes.
+func (w *Worker) consumeLanes(ctx context.Context, base string, lanes []Lane, subs []*nats.Subscription) error {
+	w.Log.Info("consumer started", zap.String("subject", base), zap.Int("lanes", len(lanes)))
+	sched := newLaneScheduler(lanes)
+	inflight := make([]int, len(lanes))
+	slots := 0
+	for _, l := range lanes {
+		slots += max(l.Concurrency, 1)
+	}
+	// done never blocks a finishing job: it holds one entry per slot.
+	done := make(chan int, slots)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	// fetch pulls a job from lane i and starts it; false means the lane had
+	// none within wait.
+	fetch := func(i int, wait time.Duration) (bool, error) {
+		msgs, err := subs[i].Fetch(1, nats.MaxWait(wait))
+		if errors.Is(err, nats.ErrTimeout) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		subj := ingestjob.LaneSubject(base, lanes[i].Name)
+		for _, m := range msgs {
+			inflight[i]++
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_ = w.handleMsg(ctx, m, subj)
+				done <- i
+			}()
+		}
+		return len(msgs) > 0, nil
+	}
+
+	idle := minLaneIdleWait
+	eligible := make([]bool, len(lanes))
+	for {
+		for drained := false; !drained; {
+			select {
+			case i := <-done:
+				inflight[i]--
+			default:
+				drained = true
+			}
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		free := false
+		for i, l := range lanes {
+			eligible[i] = inflight[i] < max(l.Concurrency, 1)
+			free = free || eligible[i]
+		}
+		if !free {
+			select {
+			case i := <-done:
+				inflight[i]--
+			case <-ctx.Done():
+				return nil
+			}
+			continue
+		}
+
+		// The first free lane in priority order long-polls once every lane
+		// came up empty, so its jobs start as soon as they arrive.
+		top := -1
+		for i := range lanes {
+			if eligible[i] {
+				top = i
+				break
+			}
+		}
+		pulled := false
+		for i := sched.next(eligible); i >= 0; i = sched.next(eligible) {
+			ok, err := fetch(i, laneFetchWait)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				eligible[i] = false
+				continue
+			}
+			pulled = true
+			break
+		}
+		if !pulled {
+			ok, err := fetch(top, idle)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				idle = min(idle*2, maxLaneIdleWait)
+				continue
+			}
+		}
+		idle = minLaneIdleWait
+	}
+}
+
 func (w *Worker) consumeLoop(ctx context.Context, sub *nats.Subscription, subj string) error {
 	w.Log.Info("consumer started", zap.String("subject", subj))
 	for {
@@ -109,73 +275,260 @@ func (w *Worker) consumeLoop(ctx context.Context, sub *nats.Subscription, subj s
 			return err
 		}
 		for _, m := range msgs {
+			if subj == DLQSubject {
+				w.handleDLQMsg(ctx, m)
+				continue
+			}
 			_ = w.handleMsg(ctx, m, subj)
 		}
 	}
 }
 
+// errBadPayload marks messages that can never succeed; they are acked without retry.
+var errBadPayload = errors.New("bad payload")
+
 func (w *Worker) handleMsg(ctx context.Context, m *nats.Msg, subj string) error {
 	md, _ := m.Metadata()
 	numDelivered := uint64(1)
 	if md != nil {
 		numDelivered = md.NumDelivered
 	}
+	jobID := jobIDFromMsg(m)
 
 	if w.MaxDeliver > 0 && int(numDelivered) > w.MaxDeliver {
-		_ = w.publishDLQ(subj, m.Data, fmt.Sprintf("max deliveries exceeded: %d", numDelivered))
+		reason := fmt.Sprintf("max deliveries exceeded: %d", numDelivered)
+		_ = w.publishDLQ(subj, m.Data, reason, jobID)
+		w.trackFailed(ctx, jobID, reason)
 		_ = m.Ack()
 		return nil
 	}
 
+	w.trackStart(ctx, jobID, subj, m.Data, int(numDelivered))
+	base, lane := ingestjob.SplitLane(subj)
+	report, err := w.dispatch(withParent(withLane(ctx, lane), parentKey(jobID, md)), base, m.Data, numDelivered)
+	switch {
+	case err == nil:
+		_ = m.Ack()
+		w.trackReport(ctx, jobID, report)
+		w.trackFinish(ctx, jobID, int(numDelivered), store.JobStatusSucceeded, "")
+		return nil
+	case errors.Is(err, errBadPayload):
+		_ = m.Ack()
+		w.trackFinish(ctx, jobID, int(numDelivered), store.JobStatusFailed, err.Error())
+		return nil
+	default:
+		_ = m.NakWithDelay(backoffDelay(numDelivered))
+		w.trackFinish(ctx, jobID, int(numDelivered), store.JobStatusRetrying, err.Error())
+		return err
+	}
+}
+
+// dispatch decodes a job and runs its handler. Dry-run jobs return the
+// handler's report.
+func (w *Worker) dispatch(ctx context.Context, subj string, data []byte, numDelivered uint64) (any, error) {
 	switch subj {
 	case "ingestion.jikan.sync":
 		var j JikanSyncJob
-		if err := json.Unmarshal(m.Data, &j); err != nil {
+		if err := json.Unmarshal(data, &j); err != nil {
 			w.Log.Warn("bad payload", zap.String("subject", subj), zap.Error(err))
-			_ = m.Ack()
-			return nil
+			return nil, fmt.Errorf("%w: %v", errBadPayload, err)
 		}
 		if j.MALID <= 0 {
 			w.Log.Warn("bad mal_id", zap.Int("mal_id", j.MALID))
-			_ = m.Ack()
-			return nil
+			return nil, fmt.Errorf("%w: mal_id %d", errBadPayload, j.MALID)
+		}
+		if j.DryRunID != "" {
+			if w.Handlers.JikanSyncDryRun == nil {
+				return nil, fmt.Errorf("%w: dry run not supported", errBadPayload)
+			}
+			report, err := w.Handlers.JikanSyncDryRun(ctx, j.MALID, j.DryRunID)
+			if err != nil {
+				w.Log.Warn("jikan sync dry run failed", zap.Int("mal_id", j.MALID), zap.Uint64("attempt", numDelivered), zap.Error(err))
+			}
+			return report, err
 		}
 		if err := w.Handlers.JikanSync(ctx, j.MALID); err != nil {
 			w.Log.Warn("jikan sync failed", zap.Int("mal_id", j.MALID), zap.Uint64("attempt", numDelivered), zap.Error(err))
-			_ = m.NakWithDelay(backoffDelay(numDelivered))
-			return err
+			return nil, err
 		}
-		_ = m.Ack()
-		return nil
+		return nil, nil
+
+	case "ingestion.jikan.episodes":
+		var j JikanEpisodesSyncJob
+		if err := json.Unmarshal(data, &j); err != nil {
+			w.Log.Warn("bad payload", zap.String("subject", subj), zap.Error(err))
+			return nil, fmt.Errorf("%w: %v", errBadPayload, err)
+		}
+		if j.MALID <= 0 {
+			w.Log.Warn("bad mal_id", zap.Int("mal_id", j.MALID))
+			return nil, fmt.Errorf("%w: mal_id %d", errBadPayload, j.MALID)
+		}
+		if err := w.Handlers.JikanEpisodesSync(ctx, j.MALID); err != nil {
+			w.Log.Warn("jikan episodes sync failed", zap.Int("mal_id", j.MALID), zap.Uint64("attempt", numDelivered), zap.Error(err))
+			return nil, err
+		}
+		return nil, nil
 
 	case "ingestion.hianime.sync":
 		var j HiAnimeSyncJob
-		if err := json.Unmarshal(m.Data, &j); err != nil {
+		if err := json.Unmarshal(data, &j); err != nil {
 			w.Log.Warn("bad payload", zap.String("subject", subj), zap.Error(err))
-			_ = m.Ack()
-			return nil
+			return nil, fmt.Errorf("%w: %v", errBadPayload, err)
 		}
 		if j.MALID <= 0 {
 			w.Log.Warn("bad mal_id", zap.Int("mal_id", j.MALID))
-			_ = m.Ack()
-			return nil
+			return nil, fmt.Errorf("%w: mal_id %d", errBadPayload, j.MALID)
+		}
+		if j.DryRunID != "" {
+			if w.Handlers.HiAnimeSyncDryRun == nil {
+				return nil, fmt.Errorf("%w: dry run not supported", errBadPayload)
+			}
+			report, err := w.Handlers.HiAnimeSyncDryRun(ctx, j.MALID)
+			if err != nil {
+				w.Log.Warn("hianime sync dry run failed", zap.Int("mal_id", j.MALID), zap.Uint64("attempt", numDelivered), zap.Error(err))
+			}
+			return report, err
 		}
 		if err := w.Handlers.HiAnimeSync(ctx, j.MALID); err != nil {
 			w.Log.Warn("hianime sync failed", zap.Int("mal_id", j.MALID), zap.Uint64("attempt", numDelivered), zap.Error(err))
-			_ = m.NakWithDelay(backoffDelay(numDelivered))
-			return err
+			return nil, err
 		}
-		_ = m.Ack()
-		return nil
+		return nil, nil
+
+	case "ingestion.metadata.sync":
+		var j MetadataSyncJob
+		if err := json.Unmarshal(data, &j); err != nil {
+			w.Log.Warn("bad payload", zap.String("subject", subj), zap.Error(err))
+			return nil, fmt.Errorf("%w: %v", errBadPayload, err)
+		}
+		if j.MALID <= 0 || j.Provider == "" {
+			w.Log.Warn("bad metadata job", zap.String("provider", j.Provider), zap.Int("mal_id", j.MALID))
+			return nil, fmt.Errorf("%w: provider %q, mal_id %d", errBadPayload, j.Provider, j.MALID)
+		}
+		if err := w.Handlers.MetadataSync(ctx, j.Provider, j.MALID); err != nil {
+			w.Log.Warn("metadata sync failed", zap.String("provider", j.Provider), zap.Int("mal_id", j.MALID), zap.Uint64("attempt", numDelivered), zap.Error(err))
+			return nil, err
+		}
+		return nil, nil
 	default:
-		_ = m.Ack()
-		return nil
+		return nil, nil
+	}
+}
+
+// jobIDFromMsg returns the job id header, or "" for messages published
+// without one (e.g. before job tracking existed); those run untracked.
+func jobIDFromMsg(m *nats.Msg) string {
+	if m.Header == nil {
+		return ""
+	}
+	id := m.Header.Get(ingestjob.IDHeader)
+	if _, err := uuid.Parse(id); err != nil {
+		return ""
+	}
+	return id
+}
+
+type parentKeyCtx struct{}
+
+// parentKey identifies a job across redeliveries: its job id, or its stream
+// sequence when it runs untracked.
+func parentKey(jobID string, md *nats.MsgMetadata) string {
+	if jobID != "" || md == nil {
+		return jobID
+	}
+	return "seq:" + strconv.FormatUint(md.Sequence.Stream, 10)
+}
+
+func withParent(ctx context.Context, parent string) context.Context {
+	if parent == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, parentKeyCtx{}, parent)
+}
+
+// parentFromContext returns the key of the job being handled, if any.
+func parentFromContext(ctx context.Context) (string, bool) {
+	p, ok := ctx.Value(parentKeyCtx{}).(string)
+	return p, ok
+}
+
+func (w *Worker) trackStart(ctx context.Context, jobID, subj string, data []byte, attempt int) {
+	if w.Jobs == nil || jobID == "" {
+		return
+	}
+	if err := w.Jobs.StartJobAttempt(ctx, jobID, JobType(subj), data, attempt); err != nil {
+		w.Log.Warn("job record start failed", zap.String("job_id", jobID), zap.Error(err))
+	}
+}
+
+func (w *Worker) trackReport(ctx context.Context, jobID string, report any) {
+	if w.Jobs == nil || jobID == "" || report == nil {
+		return
+	}
+	b, err := json.Marshal(report)
+	if err != nil {
+		w.Log.Warn("job report encode failed", zap.String("job_id", jobID), zap.Error(err))
+		return
+	}
+	if err := w.Jobs.SetJobReport(ctx, jobID, b); err != nil {
+		w.Log.Warn("job report store failed", zap.String("job_id", jobID), zap.Error(err))
+	}
+}
+
+func (w *Worker) trackFinish(ctx context.Context, jobID string, attempt int, status, errMsg string) {
+	if w.Jobs == nil || jobID == "" {
+		return
+	}
+	if err := w.Jobs.FinishJobAttempt(ctx, jobID, attempt, status, errMsg); err != nil {
+		w.Log.Warn("job record finish failed", zap.String("job_id", jobID), zap.Error(err))
 	}
 }
 
-func (w *Worker) publishDLQ(subject string, data []byte, reason string) error {
-	msg := map[string]any{"subject": subject, "reason": reason, "payload": json.RawMessage(data)}
-	b, _ := json.Marshal(msg)
-	_, err := w.JS.Publish("ingestion.dlq", b)
+func (w *Worker) trackFailed(ctx context.Context, jobID, reason string) {
+	if w.Jobs == nil || jobID == "" {
+		return
+	}
+	if err := w.Jobs.FailJob(ctx, jobID, reason); err != nil {
+		w.Log.Warn("job record dlq update failed", zap.String("job_id", jobID), zap.Error(err))
+	}
+}
+
+func (w *Worker) publishDLQ(subject string, data []byte, reason, jobID string) error {
+	b, _ := json.Marshal(DLQMessage{Subject: subject, Reason: reason, Payload: json.RawMessage(data), JobID: jobID})
+	_, err := w.JS.Publish(DLQSubject, b)
 	return err
 }
+
+// handleDLQMsg copies a dead-lettered job into the DLQ store for inspection
+// and replay. Store failures are retried; DLQ messages are never dead-lettered.
+func (w *Worker) handleDLQMsg(ctx context.Context, m *nats.Msg) {
+	var d DLQMessage
+	if err := json.Unmarshal(m.Data, &d); err != nil || d.Subject == "" {
+		w.Log.Warn("bad dlq message", zap.Error(err))
+		_ = m.Ack()
+		return
+	}
+	e := store.DLQEntry{
+		Subject: d.Subject,
+		Type:    JobType(d.Subject),
+		Reason:  d.Reason,
+		Payload: d.Payload,
+		JobID:   d.JobID,
+	}
+	md, err := m.Metadata()
+	if err != nil {
+		w.Log.Warn("dlq message without metadata", zap.Error(err))
+		_ = m.Ack()
+		return
+	}
+	e.StreamSeq, e.FailedAt = md.Sequence.Stream, md.Timestamp
+	if _, err := uuid.Parse(e.JobID); err != nil {
+		e.JobID = ""
+	}
+	if err := w.DLQ.RecordDLQEntry(ctx, e); err != nil {
+		w.Log.Warn("dlq record failed", zap.String("subject", d.Subject), zap.Error(err))
+		_ = m.NakWithDelay(backoffDelay(md.NumDelivered))
+		return
+	}
+	_ = m.Ack()
+}
@@ -0,0 +1,165 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+
+	"github.com/example/anime-platform/internal/platform/ingestjob"
+	"github.com/example/anime-platform/services/ingestion/internal/store"
+)
+
+type finishedAttempt struct {
+	attempt int
+	status  string
+	errMsg  string
+}
+
+type fakeJobStore struct {
+	store.JobStore
+	started  map[string]string
+	finished map[string]finishedAttempt
+	reports  map[string]json.RawMessage
+}
+
+func (f *fakeJobStore) SetJobReport(_ context.Context, id string, report json.RawMessage) error {
+	f.reports[id] = report
+	return nil
+}
+
+func (f *fakeJobStore) StartJobAttempt(_ context.Context, id, jobType string, _ json.RawMessage, _ int) error {
+	f.started[id] = jobType
+	return nil
+}
+
+func (f *fakeJobStore) FinishJobAttempt(_ context.Context, id string, attempt int, status, errMsg string) error {
+	f.finished[id] = finishedAttempt{attempt: attempt, status: status, errMsg: errMsg}
+	return nil
+}
+
+func jobMsg(subject, id, data string) *nats.Msg {
+	m := nats.NewMsg(subject)
+	m.Header.Set(ingestjob.IDHeader, id)
+	m.Data = []byte(data)
+	return m
+}
+
+func TestHandleMsg_TracksJobOutcome(t *testing.T) {
+	jobs := &fakeJobStore{started: map[string]string{}, finished: map[string]finishedAttempt{}}
+	w := &Worker{
+		Log:  zap.NewNop(),
+		Jobs: jobs,
+		Handlers: Handlers{
+			JikanSync: func(_ context.Context, malID int) error {
+				if malID == 2 {
+					return errors.New("jikan 503")
+				}
+				return nil
+			},
+		},
+	}
+
+	const (
+		okID  = "7f1d5d8e-4a1b-4c7e-9a51-6f3f0d2f4b01"
+		errID = "7f1d5d8e-4a1b-4c7e-9a51-6f3f0d2f4b02"
+		badID = "7f1d5d8e-4a1b-4c7e-9a51-6f3f0d2f4b03"
+	)
+	ctx := context.Background()
+	_ = w.handleMsg(ctx, jobMsg("ingestion.jikan.sync", okID, `{"mal_id":1}`), "ingestion.jikan.sync")
+	_ = w.handleMsg(ctx, jobMsg("ingestion.jikan.sync", errID, `{"mal_id":2}`), "ingestion.jikan.sync")
+	_ = w.handleMsg(ctx, jobMsg("ingestion.jikan.sync", badID, `{"mal_id":0}`), "ingestion.jikan.sync")
+	// Messages without a job id are processed untracked.
+	_ = w.handleMsg(ctx, nats.NewMsg("ingestion.jikan.sync"), "ingestion.jikan.sync")
+
+	if jobs.started[okID] != "jikan.sync" {
+		t.Fatalf("started = %v", jobs.started)
+	}
+	if len(jobs.started) != 3 {
+		t.Fatalf("expected 3 tracked jobs, got %v", jobs.started)
+	}
+	if got := jobs.finished[okID]; got.status != store.JobStatusSucceeded || got.attempt != 1 {
+		t.Fatalf("ok job = %+v", got)
+	}
+	if got := jobs.finished[errID]; got.status != store.JobStatusRetrying || got.errMsg != "jikan 503" {
+		t.Fatalf("failing job = %+v", got)
+	}
+	if got := jobs.finished[badID]; got.status != store.JobStatusFailed {
+		t.Fatalf("bad payload job = %+v", got)
+	}
+}
+
+func TestHandleMsg_DryRunSkipsWritesAndStoresReport(t *testing.T) {
+	jobs := &fakeJobStore{started: map[string]string{}, finished: map[string]finishedAttempt{}, reports: map[string]json.RawMessage{}}
+	js := &fakeJetStream{}
+	pub := &Publisher{Log: zap.NewNop(), JS: js}
+	write := func(name string) error {
+		t.Errorf("dry run called the %s write handler", name)
+		return nil
+	}
+	failFirst := true
+	w := &Worker{
+		Log:  zap.NewNop(),
+		Jobs: jobs,
+		Handlers: Handlers{
+			JikanSync:         func(context.Context, int) error { return write("jikan") },
+			JikanEpisodesSync: func(context.Context, int) error { return write("jikan episodes") },
+			HiAnimeSync:       func(context.Context, int) error { return write("hianime") },
+			MetadataSync:      func(context.Context, string, int) error { return write("metadata") },
+			JikanSyncDryRun: func(ctx context.Context, malID int, dryRunID string) (any, error) {
+				if _, err := pub.PublishFollowUp(ctx, "ingestion.hianime.sync", HiAnimeSyncJob{MALID: malID, DryRunID: dryRunID}); err != nil {
+					return nil, err
+				}
+				// The first attempt fails after its follow-up went out.
+				if failFirst {
+					failFirst = false
+					return nil, errors.New("catalog unavailable")
+				}
+				return map[string]any{"mal_id": malID, "new": true}, nil
+			},
+			HiAnimeSyncDryRun: func(_ context.Context, malID int) (any, error) {
+				return map[string]any{"mal_id": malID, "matched": false}, nil
+			},
+		},
+	}
+
+	const (
+		jikanID = "7f1d5d8e-4a1b-4c7e-9a51-6f3f0d2f4b11"
+		hiaID   = "7f1d5d8e-4a1b-4c7e-9a51-6f3f0d2f4b12"
+	)
+	ctx := context.Background()
+	msg := jobMsg("ingestion.jikan.sync", jikanID, `{"mal_id":9,"dry_run_id":"d1"}`)
+	if err := w.handleMsg(ctx, msg, "ingestion.jikan.sync"); err == nil {
+		t.Fatal("expected the first attempt to fail")
+	}
+	if _, ok := jobs.reports[jikanID]; ok {
+		t.Fatal("a failed attempt should not store a report")
+	}
+	if err := w.handleMsg(ctx, msg, "ingestion.jikan.sync"); err != nil {
+		t.Fatal(err)
+	}
+	_ = w.handleMsg(ctx, jobMsg("ingestion.hianime.sync", hiaID, `{"mal_id":9,"dry_run_id":"d1"}`), "ingestion.hianime.sync")
+
+	if got := string(jobs.reports[jikanID]); got != `{"mal_id":9,"new":true}` {
+		t.Fatalf("jikan report = %s", got)
+	}
+	if got := string(jobs.reports[hiaID]); got != `{"mal_id":9,"matched":false}` {
+		t.Fatalf("hianime report = %s", got)
+	}
+	for _, id := range []string{jikanID, hiaID} {
+		if got := jobs.finished[id]; got.status != store.JobStatusSucceeded {
+			t.Fatalf("job %s = %+v", id, got)
+		}
+	}
+	// Both attempts published the HiAnime follow-up under one job id, so the
+	// stream keeps one copy and the report counts the title once.
+	if len(js.msgs) != 2 {
+		t.Fatalf("follow-up publishes = %d, want 2", len(js.msgs))
+	}
+	if a, b := js.msgs[0].Header.Get(ingestjob.IDHeader), js.msgs[1].Header.Get(ingestjob.IDHeader); a != b {
+		t.Fatalf("retry published a new follow-up job: %s then %s", a, b)
+	}
+}
@@ -0,0 +1,62 @@
+package schedule
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Lock elects the replica that fires schedules.
+type Lock interface {
+	// Acquire reports whether this process holds the lock, taking it if free.
+	Acquire(ctx context.Context) (bool, error)
+	Release(ctx context.Context)
+}
+
+// AdvisoryLock is a Lock backed by a Postgres session advisory lock. The lock
+// lives as long as the dedicated connection holding it, so a crashed leader
+// releases it when its connection drops.
+type AdvisoryLock struct {
+	pool *pgxpool.Pool
+	key  int64
+	conn *pgxpool.Conn
+}
+
+func NewAdvisoryLock(pool *pgxpool.Pool, key int64) *AdvisoryLock {
+	return &AdvisoryLock{pool: pool, key: key}
+}
+
+func (l *AdvisoryLock) Acquire(ctx context.Context) (bool, error) {
+	if l.conn != nil {
+		// Still leader as long as the session is alive.
+		if _, err := l.conn.Exec(ctx, `SELECT 1`); err == nil {
+			return true, nil
+		}
+		l.conn.Release()
+		l.conn = nil
+	}
+	conn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return false, err
+	}
+	var ok bool
+	if err := conn.QueryRow(ctx, `SELECT pg_try_advisory_lock($1)`, l.key).Scan(&ok); err != nil {
+		conn.Release()
+		return false, err
+	}
+	if !ok {
+		conn.Release()
+		return false, nil
+	}
+	l.conn = conn
+	return true, nil
+}
+
+func (l *AdvisoryLock) Release(ctx context.Context) {
+	if l.conn == nil {
+		return
+	}
+	_, _ = l.conn.Exec(ctx, `SELECT pg_advisory_unlock($1)`, l.key)
+	l.conn.Release()
+	l.conn = nil
+}
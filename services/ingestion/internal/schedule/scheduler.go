@@ -0,0 +1,116 @@
+// Package schedule fires recurring ingestion jobs from cron schedules stored in
+// Postgres. Only the replica holding the leader lock fires them.
+package schedule
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/example/anime-platform/services/ingestion/internal/store"
+)
+
+// Runner executes one scheduled job with the schedule's args.
+type Runner func(ctx context.Context, args json.RawMessage) error
+
+type Scheduler struct {
+	Log     *zap.Logger
+	Store   store.ScheduleStore
+	Lock    Lock
+	Runners map[string]Runner
+	// Interval is how often due schedules are checked; defaults to 15s.
+	Interval time.Duration
+	// Now is the clock; defaults to time.Now.
+	Now func() time.Time
+}
+
+// NextRun returns the next run of expr after now, or nil if expr is invalid
+// or never fires.
+func NextRun(expr string, now time.Time) *time.Time {
+	c, err := ParseCron(expr)
+	if err != nil {
+		return nil
+	}
+	next := c.Next(now)
+	if next.IsZero() {
+		return nil
+	}
+	return &next
+}
+
+func (s *Scheduler) now() time.Time {
+	if s.Now != nil {
+		return s.Now()
+	}
+	return time.Now()
+}
+
+// Run checks for due schedules every Interval while this replica is leader.
+func (s *Scheduler) Run(ctx context.Context) error {
+	interval := s.Interval
+	if interval <= 0 {
+		interval = 15 * time.Second
+	}
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	defer s.Lock.Release(context.Background())
+
+	leader := false
+	for {
+		ok, err := s.Lock.Acquire(ctx)
+		if err != nil && ctx.Err() == nil {
+			s.Log.Warn("scheduler: leader lock", zap.Error(err))
+		}
+		if ok != leader {
+			leader = ok
+			s.Log.Info("scheduler: leadership changed", zap.Bool("leader", leader))
+		}
+		if leader {
+			s.Tick(ctx)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+	}
+}
+
+// Tick fires every due schedule once and records the outcome.
+func (s *Scheduler) Tick(ctx context.Context) {
+	if err := s.Store.InitSchedules(ctx, func(expr string) *time.Time { return NextRun(expr, s.now()) }); err != nil {
+		s.Log.Warn("scheduler: init schedules", zap.Error(err))
+	}
+	due, err := s.Store.DueSchedules(ctx, s.now())
+	if err != nil {
+		s.Log.Warn("scheduler: due schedules", zap.Error(err))
+		return
+	}
+	for _, sc := range due {
+		if ctx.Err() != nil {
+			return
+		}
+		started := s.now()
+		runErr := s.run(ctx, sc)
+		dur := s.now().Sub(started)
+		if runErr != nil {
+			s.Log.Warn("scheduler: run failed", zap.String("schedule", sc.Name), zap.Error(runErr))
+		} else {
+			s.Log.Info("scheduler: run done", zap.String("schedule", sc.Name), zap.Duration("duration", dur))
+		}
+		if err := s.Store.RecordRun(ctx, sc.Name, started, dur, runErr, NextRun(sc.Cron, s.now())); err != nil {
+			s.Log.Warn("scheduler: record run", zap.String("schedule", sc.Name), zap.Error(err))
+		}
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context, sc store.Schedule) error {
+	r, ok := s.Runners[sc.Job]
+	if !ok {
+		return fmt.Errorf("unknown job %q", sc.Job)
+	}
+	return r(ctx, sc.Args)
+}
@@ -0,0 +1,77 @@
+package schedule
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/example/anime-platform/services/ingestion/internal/store"
+)
+
+type runRecord struct {
+	err  error
+	next *time.Time
+}
+
+type fakeScheduleStore struct {
+	store.ScheduleStore
+	due  []store.Schedule
+	runs map[string]runRecord
+}
+
+func (f *fakeScheduleStore) InitSchedules(context.Context, func(string) *time.Time) error {
+	return nil
+}
+
+func (f *fakeScheduleStore) DueSchedules(context.Context, time.Time) ([]store.Schedule, error) {
+	return f.due, nil
+}
+
+func (f *fakeScheduleStore) RecordRun(_ context.Context, name string, _ time.Time, _ time.Duration, runErr error, next *time.Time) error {
+	f.runs[name] = runRecord{err: runErr, next: next}
+	return nil
+}
+
+func TestSchedulerTick(t *testing.T) {
+	now := time.Date(2026, 3, 14, 10, 30, 0, 0, time.UTC)
+	st := &fakeScheduleStore{
+		due: []store.Schedule{
+			{Name: "season", Cron: "0 3 * * *", Job: "jikan.season", Args: json.RawMessage(`{"pages":2}`)},
+			{Name: "broken", Cron: "0 3 * * *", Job: "jikan.top"},
+			{Name: "unknown", Cron: "@hourly", Job: "nope"},
+		},
+		runs: map[string]runRecord{},
+	}
+	var gotArgs string
+	s := &Scheduler{
+		Log:   zap.NewNop(),
+		Store: st,
+		Now:   func() time.Time { return now },
+		Runners: map[string]Runner{
+			"jikan.season": func(_ context.Context, args json.RawMessage) error {
+				gotArgs = string(args)
+				return nil
+			},
+			"jikan.top": func(context.Context, json.RawMessage) error { return errors.New("jikan down") },
+		},
+	}
+	s.Tick(context.Background())
+
+	if gotArgs != `{"pages":2}` {
+		t.Fatalf("args = %q", gotArgs)
+	}
+	want := time.Date(2026, 3, 15, 3, 0, 0, 0, time.UTC)
+	if r := st.runs["season"]; r.err != nil || r.next == nil || !r.next.Equal(want) {
+		t.Fatalf("season run = %+v", r)
+	}
+	if r := st.runs["broken"]; r.err == nil || r.err.Error() != "jikan down" {
+		t.Fatalf("broken run = %+v", r)
+	}
+	if r := st.runs["unknown"]; r.err == nil {
+		t.Fatalf("expected unknown job error, got %+v", r)
+	}
+}
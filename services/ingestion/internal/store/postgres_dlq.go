@@ -0,0 +1,177 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const dlqColumns = `id::text, subject, type, reason, payload, COALESCE(job_id::text, ''), status, COALESCE(replayed_job_id::text, ''), failed_at, resolved_at`
+
+func scanDLQEntry(row pgx.Row) (DLQEntry, error) {
+	var e DLQEntry
+	err := row.Scan(&e.ID, &e.Subject, &e.Type, &e.Reason, &e.Payload, &e.JobID, &e.Status, &e.ReplayedJobID, &e.FailedAt, &e.ResolvedAt)
+	return e, err
+}
+
+// dlqConditions renders f's filters as SQL conditions, appending their values to args.
+func dlqConditions(f DLQFilter, args *[]any) string {
+	arg := func(v any) string {
+		*args = append(*args, v)
+		return "$" + strconv.Itoa(len(*args))
+	}
+	q := ""
+	if f.Type != "" {
+		q += " AND type = " + arg(f.Type)
+	}
+	if f.Status != "" {
+		q += " AND status = " + arg(f.Status)
+	}
+	if !f.Since.IsZero() {
+		q += " AND failed_at >= " + arg(f.Since)
+	}
+	if !f.Until.IsZero() {
+		q += " AND failed_at < " + arg(f.Until)
+	}
+	return q
+}
+
+func (s *PostgresStore) RecordDLQEntry(ctx context.Context, e DLQEntry) error {
+	var jobID *string
+	if e.JobID != "" {
+		jobID = &e.JobID
+	}
+	_, err := s.db.Exec(ctx, `
+INSERT INTO dlq_entries (stream_seq, subject, type, reason, payload, job_id, failed_at)
+VALUES ($1, $2, $3, $4, $5, $6::uuid, $7)
+ON CONFLICT (stream_seq) DO NOTHING`,
+		int64(e.StreamSeq), e.Subject, e.Type, e.Reason, jobPayload(e.Payload), jobID, e.FailedAt)
+	if err != nil {
+		return status.Error(codes.Internal, "db")
+	}
+	return nil
+}
+
+func (s *PostgresStore) ListDLQEntries(ctx context.Context, f DLQFilter) ([]DLQEntry, error) {
+	var args []any
+	q := `SELECT ` + dlqColumns + ` FROM dlq_entries WHERE TRUE` + dlqConditions(f, &args)
+	args = append(args, f.Limit, f.Offset)
+	q += " ORDER BY failed_at DESC, id LIMIT $" + strconv.Itoa(len(args)-1) + " OFFSET $" + strconv.Itoa(len(args))
+
+	rows, err := s.db.Query(ctx, q, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db")
+	}
+	defer rows.Close()
+	var out []DLQEntry
+	for rows.Next() {
+		e, err := scanDLQEntry(rows)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "db scan")
+		}
+		out = append(out, e)
+	}
+	if rows.Err() != nil {
+		return nil, status.Error(codes.Internal, "db")
+	}
+	return out, nil
+}
+
+func (s *PostgresStore) ReplayDLQEntry(ctx context.Context, id, jobID string) (DLQEntry, error) {
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return DLQEntry{}, status.Error(codes.Internal, "db begin")
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	e, err := scanDLQEntry(tx.QueryRow(ctx, `SELECT `+dlqColumns+` FROM dlq_entries WHERE id=$1::uuid FOR UPDATE`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return DLQEntry{}, status.Error(codes.NotFound, "dlq entry not found")
+		}
+		return DLQEntry{}, status.Error(codes.Internal, "db")
+	}
+	if e.Status != DLQStatusPending {
+		return DLQEntry{}, status.Error(codes.FailedPrecondition, "dlq entry already "+e.Status)
+	}
+	if _, err := tx.Exec(ctx, `
+INSERT INTO jobs (id, type, payload, replay_of) VALUES ($1::uuid, $2, $3, $4::uuid)`, jobID, e.Type, jobPayload(e.Payload), id); err != nil {
+		return DLQEntry{}, status.Error(codes.Internal, "db")
+	}
+	if err := tx.QueryRow(ctx, `
+UPDATE dlq_entries SET status='replayed', replayed_job_id=$2::uuid, resolved_at=now()
+WHERE id=$1::uuid
+RETURNING status, COALESCE(replayed_job_id::text, ''), resolved_at`, id, jobID).Scan(&e.Status, &e.ReplayedJobID, &e.ResolvedAt); err != nil {
+		return DLQEntry{}, status.Error(codes.Internal, "db")
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return DLQEntry{}, status.Error(codes.Internal, "db commit")
+	}
+	return e, nil
+}
+
+func (s *PostgresStore) ReleaseDLQEntry(ctx context.Context, id, jobID, reason string) error {
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return status.Error(codes.Internal, "db begin")
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err := tx.Exec(ctx, `
+UPDATE dlq_entries SET status='pending', replayed_job_id=NULL, resolved_at=NULL
+WHERE id=$1::uuid AND replayed_job_id=$2::uuid`, id, jobID); err != nil {
+		return status.Error(codes.Internal, "db")
+	}
+	if _, err := tx.Exec(ctx, `
+UPDATE jobs SET status='failed', last_error=$2, finished_at=now(), updated_at=now()
+WHERE id=$1::uuid`, jobID, reason); err != nil {
+		return status.Error(codes.Internal, "db")
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return status.Error(codes.Internal, "db commit")
+	}
+	return nil
+}
+
+func (s *PostgresStore) DiscardDLQEntry(ctx context.Context, id string) error {
+	var st string
+	err := s.db.QueryRow(ctx, `SELECT status FROM dlq_entries WHERE id=$1::uuid`, id).Scan(&st)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return status.Error(codes.NotFound, "dlq entry not found")
+		}
+		return status.Error(codes.Internal, "db")
+	}
+	tag, err := s.db.Exec(ctx, `
+UPDATE dlq_entries SET status='discarded', resolved_at=now()
+WHERE id=$1::uuid AND status='pending'`, id)
+	if err != nil {
+		return status.Error(codes.Internal, "db")
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Error(codes.FailedPrecondition, "dlq entry already "+st)
+	}
+	return nil
+}
+
+func (s *PostgresStore) DiscardDLQEntries(ctx context.Context, f DLQFilter) (int, error) {
+	f.Status = DLQStatusPending
+	var args []any
+	cond := dlqConditions(f, &args)
+	args = append(args, f.Limit)
+	tag, err := s.db.Exec(ctx, `
+UPDATE dlq_entries SET status='discarded', resolved_at=now()
+WHERE id IN (
+  SELECT id FROM dlq_entries WHERE TRUE`+cond+`
+  ORDER BY failed_at LIMIT $`+strconv.Itoa(len(args))+`
+  FOR UPDATE SKIP LOCKED
+)`, args...)
+	if err != nil {
+		return 0, status.Error(codes.Internal, "db")
+	}
+	return int(tag.RowsAffected()), nil
+}
@@ -0,0 +1,203 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const jobColumns = `id::text, type, payload, status, attempts, last_error, enqueued_at, started_at, finished_at, updated_at, COALESCE(replay_of::text, ''), COALESCE(report::text, '')`
+
+func scanJob(row pgx.Row) (Job, error) {
+	var j Job
+	var report string
+	err := row.Scan(&j.ID, &j.Type, &j.Payload, &j.Status, &j.Attempts, &j.LastError, &j.EnqueuedAt, &j.StartedAt, &j.FinishedAt, &j.UpdatedAt, &j.ReplayOf, &report)
+	if report != "" {
+		j.Report = json.RawMessage(report)
+	}
+	return j, err
+}
+
+// jobPayload makes payload storable as JSONB. Messages that are not valid JSON
+// (which the worker rejects) are kept as a JSON string so they stay visible.
+func jobPayload(payload json.RawMessage) json.RawMessage {
+	if len(payload) == 0 {
+		return json.RawMessage(`{}`)
+	}
+	if !json.Valid(payload) {
+		b, _ := json.Marshal(string(payload))
+		return b
+	}
+	return payload
+}
+
+func (s *PostgresStore) CreateJob(ctx context.Context, id, jobType string, payload json.RawMessage) error {
+	_, err := s.db.Exec(ctx, `
+INSERT INTO jobs (id, type, payload) VALUES ($1::uuid, $2, $3)
+ON CONFLICT (id) DO NOTHING`, id, jobType, jobPayload(payload))
+	if err != nil {
+		return status.Error(codes.Internal, "db")
+	}
+	return nil
+}
+
+func (s *PostgresStore) StartJobAttempt(ctx context.Context, id, jobType string, payload json.RawMessage, attempt int) error {
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return status.Error(codes.Internal, "db begin")
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	_, err = tx.Exec(ctx, `
+INSERT INTO jobs (id, type, payload, status, attempts, started_at, updated_at)
+VALUES ($1::uuid, $2, $3, 'running', $4, now(), now())
+ON CONFLICT (id) DO UPDATE SET
+  status='running',
+  attempts=GREATEST(jobs.attempts, EXCLUDED.attempts),
+  started_at=COALESCE(jobs.started_at, now()),
+  finished_at=NULL,
+  updated_at=now()`, id, jobType, jobPayload(payload), attempt)
+	if err != nil {
+		return status.Error(codes.Internal, "db")
+	}
+	_, err = tx.Exec(ctx, `
+INSERT INTO job_attempts (job_id, attempt) VALUES ($1::uuid, $2)
+ON CONFLICT (job_id, attempt) DO UPDATE SET started_at=now(), finished_at=NULL, error=''`, id, attempt)
+	if err != nil {
+		return status.Error(codes.Internal, "db")
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return status.Error(codes.Internal, "db commit")
+	}
+	return nil
+}
+
+func (s *PostgresStore) FinishJobAttempt(ctx context.Context, id string, attempt int, jobStatus string, errMsg string) error {
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return status.Error(codes.Internal, "db begin")
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	// last_error is kept after a successful retry so flaky jobs stay visible.
+	_, err = tx.Exec(ctx, `
+UPDATE jobs SET status=$2::text,
+  last_error=CASE WHEN $3::text = '' THEN last_error ELSE $3::text END,
+  finished_at=CASE WHEN $2::text IN ('succeeded', 'failed') THEN now() ELSE NULL END,
+  updated_at=now()
+WHERE id=$1::uuid`, id, jobStatus, errMsg)
+	if err != nil {
+		return status.Error(codes.Internal, "db")
+	}
+	_, err = tx.Exec(ctx, `UPDATE job_attempts SET finished_at=now(), error=$3 WHERE job_id=$1::uuid AND attempt=$2`, id, attempt, errMsg)
+	if err != nil {
+		return status.Error(codes.Internal, "db")
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return status.Error(codes.Internal, "db commit")
+	}
+	return nil
+}
+
+func (s *PostgresStore) FailJob(ctx context.Context, id, reason string) error {
+	_, err := s.db.Exec(ctx, `
+UPDATE jobs SET status='failed', last_error=$2, finished_at=now(), updated_at=now()
+WHERE id=$1::uuid`, id, reason)
+	if err != nil {
+		return status.Error(codes.Internal, "db")
+	}
+	return nil
+}
+
+func (s *PostgresStore) ListJobs(ctx context.Context, f JobFilter) ([]Job, error) {
+	q := `SELECT ` + jobColumns + ` FROM jobs WHERE TRUE`
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+	if f.Type != "" {
+		q += " AND type = " + arg(f.Type)
+	}
+	if f.Status != "" {
+		q += " AND status = " + arg(f.Status)
+	}
+	if f.MALID > 0 {
+		q += " AND payload->>'mal_id' = " + arg(strconv.Itoa(f.MALID))
+	}
+	if !f.Since.IsZero() {
+		q += " AND enqueued_at >= " + arg(f.Since)
+	}
+	if !f.Until.IsZero() {
+		q += " AND enqueued_at < " + arg(f.Until)
+	}
+	q += " ORDER BY enqueued_at DESC, id"
+	q += " LIMIT " + arg(f.Limit) + " OFFSET " + arg(f.Offset)
+	return s.queryJobs(ctx, q, args...)
+}
+
+func (s *PostgresStore) SetJobReport(ctx context.Context, id string, report json.RawMessage) error {
+	_, err := s.db.Exec(ctx, `UPDATE jobs SET report=$2, updated_at=now() WHERE id=$1::uuid`, id, report)
+	if err != nil {
+		return status.Error(codes.Internal, "db")
+	}
+	return nil
+}
+
+func (s *PostgresStore) DryRunJobs(ctx context.Context, dryRunID string) ([]Job, error) {
+	return s.queryJobs(ctx, `SELECT `+jobColumns+` FROM jobs WHERE payload->>'dry_run_id' = $1 ORDER BY enqueued_at, id`, dryRunID)
+}
+
+func (s *PostgresStore) queryJobs(ctx context.Context, q string, args ...any) ([]Job, error) {
+	rows, err := s.db.Query(ctx, q, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db")
+	}
+	defer rows.Close()
+	var out []Job
+	for rows.Next() {
+		j, err := scanJob(rows)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "db scan")
+		}
+		out = append(out, j)
+	}
+	if rows.Err() != nil {
+		return nil, status.Error(codes.Internal, "db")
+	}
+	return out, nil
+}
+
+func (s *PostgresStore) GetJob(ctx context.Context, id string) (Job, []JobAttempt, error) {
+	j, err := scanJob(s.db.QueryRow(ctx, `SELECT `+jobColumns+` FROM jobs WHERE id=$1::uuid`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Job{}, nil, status.Error(codes.NotFound, "job not found")
+		}
+		return Job{}, nil, status.Error(codes.Internal, "db")
+	}
+	rows, err := s.db.Query(ctx, `
+SELECT attempt, started_at, finished_at, error FROM job_attempts
+WHERE job_id=$1::uuid ORDER BY attempt`, id)
+	if err != nil {
+		return Job{}, nil, status.Error(codes.Internal, "db")
+	}
+	defer rows.Close()
+	var attempts []JobAttempt
+	for rows.Next() {
+		var a JobAttempt
+		if err := rows.Scan(&a.Attempt, &a.StartedAt, &a.FinishedAt, &a.Error); err != nil {
+			return Job{}, nil, status.Error(codes.Internal, "db scan")
+		}
+		attempts = append(attempts, a)
+	}
+	if rows.Err() != nil {
+		return Job{}, nil, status.Error(codes.Internal, "db")
+	}
+	return j, attempts, nil
+}
@@ -0,0 +1,71 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const mappingColumns = `mal_id, slug, note, created_at, updated_at`
+
+func scanHiAnimeMapping(row pgx.Row) (HiAnimeMapping, error) {
+	var m HiAnimeMapping
+	err := row.Scan(&m.MALID, &m.Slug, &m.Note, &m.CreatedAt, &m.UpdatedAt)
+	return m, err
+}
+
+func (s *PostgresStore) GetHiAnimeMapping(ctx context.Context, malID int) (HiAnimeMapping, error) {
+	m, err := scanHiAnimeMapping(s.db.QueryRow(ctx, `SELECT `+mappingColumns+` FROM hianime_mappings WHERE mal_id=$1`, malID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return HiAnimeMapping{}, status.Error(codes.NotFound, "mapping not found")
+		}
+		return HiAnimeMapping{}, status.Error(codes.Internal, "db")
+	}
+	return m, nil
+}
+
+func (s *PostgresStore) ListHiAnimeMappings(ctx context.Context, limit, offset int) ([]HiAnimeMapping, error) {
+	rows, err := s.db.Query(ctx, `SELECT `+mappingColumns+` FROM hianime_mappings ORDER BY mal_id LIMIT $1 OFFSET $2`, limit, offset)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db")
+	}
+	defer rows.Close()
+	var out []HiAnimeMapping
+	for rows.Next() {
+		m, err := scanHiAnimeMapping(rows)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "db scan")
+		}
+		out = append(out, m)
+	}
+	if rows.Err() != nil {
+		return nil, status.Error(codes.Internal, "db")
+	}
+	return out, nil
+}
+
+func (s *PostgresStore) SetHiAnimeMapping(ctx context.Context, m HiAnimeMapping) (HiAnimeMapping, error) {
+	out, err := scanHiAnimeMapping(s.db.QueryRow(ctx, `
+INSERT INTO hianime_mappings (mal_id, slug, note) VALUES ($1, $2, $3)
+ON CONFLICT (mal_id) DO UPDATE SET slug=EXCLUDED.slug, note=EXCLUDED.note, updated_at=now()
+RETURNING `+mappingColumns, m.MALID, m.Slug, m.Note))
+	if err != nil {
+		return HiAnimeMapping{}, status.Error(codes.Internal, "db")
+	}
+	return out, nil
+}
+
+func (s *PostgresStore) DeleteHiAnimeMapping(ctx context.Context, malID int) error {
+	tag, err := s.db.Exec(ctx, `DELETE FROM hianime_mappings WHERE mal_id=$1`, malID)
+	if err != nil {
+		return status.Error(codes.Internal, "db")
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Error(codes.NotFound, "mapping not found")
+	}
+	return nil
+}
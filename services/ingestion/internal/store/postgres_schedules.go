@@ -0,0 +1,146 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PostgresStore is the Postgres-backed ingestion state store.
+type PostgresStore struct {
+	db *pgxpool.Pool
+}
+
+func NewPostgresStore(db *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+const scheduleColumns = `name, cron, job, args, paused, next_run_at, last_run_at, last_duration_ms, last_status, last_error, updated_at`
+
+func scanSchedule(row pgx.Row) (Schedule, error) {
+	var s Schedule
+	err := row.Scan(&s.Name, &s.Cron, &s.Job, &s.Args, &s.Paused, &s.NextRunAt, &s.LastRunAt, &s.LastDurationMs, &s.LastStatus, &s.LastError, &s.UpdatedAt)
+	return s, err
+}
+
+func (s *PostgresStore) ListSchedules(ctx context.Context) ([]Schedule, error) {
+	rows, err := s.db.Query(ctx, `SELECT `+scheduleColumns+` FROM schedules ORDER BY name`)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db")
+	}
+	defer rows.Close()
+	var out []Schedule
+	for rows.Next() {
+		sc, err := scanSchedule(rows)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "db scan")
+		}
+		out = append(out, sc)
+	}
+	if rows.Err() != nil {
+		return nil, status.Error(codes.Internal, "db")
+	}
+	return out, nil
+}
+
+func (s *PostgresStore) GetSchedule(ctx context.Context, name string) (Schedule, error) {
+	return s.scheduleRow(s.db.QueryRow(ctx, `SELECT `+scheduleColumns+` FROM schedules WHERE name=$1`, name))
+}
+
+func (s *PostgresStore) UpdateSchedule(ctx context.Context, name, cron string, nextRunAt *time.Time) (Schedule, error) {
+	return s.scheduleRow(s.db.QueryRow(ctx, `
+UPDATE schedules SET cron=$2, next_run_at=$3, updated_at=now() WHERE name=$1
+RETURNING `+scheduleColumns, name, cron, nextRunAt))
+}
+
+func (s *PostgresStore) SetSchedulePaused(ctx context.Context, name string, paused bool, nextRunAt *time.Time) (Schedule, error) {
+	// Pausing keeps next_run_at for display; resuming replaces it so missed runs
+	// are not fired all at once.
+	return s.scheduleRow(s.db.QueryRow(ctx, `
+UPDATE schedules SET paused=$2::bool, next_run_at=CASE WHEN $2::bool THEN next_run_at ELSE $3::timestamptz END, updated_at=now()
+WHERE name=$1
+RETURNING `+scheduleColumns, name, paused, nextRunAt))
+}
+
+func (s *PostgresStore) TriggerSchedule(ctx context.Context, name string) (Schedule, error) {
+	return s.scheduleRow(s.db.QueryRow(ctx, `
+UPDATE schedules SET triggered=TRUE, next_run_at=now(), updated_at=now() WHERE name=$1
+RETURNING `+scheduleColumns, name))
+}
+
+func (s *PostgresStore) DueSchedules(ctx context.Context, now time.Time) ([]Schedule, error) {
+	rows, err := s.db.Query(ctx, `
+SELECT `+scheduleColumns+` FROM schedules
+WHERE next_run_at <= $1 AND (NOT paused OR triggered)
+ORDER BY next_run_at, name`, now)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db")
+	}
+	defer rows.Close()
+	var out []Schedule
+	for rows.Next() {
+		sc, err := scanSchedule(rows)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "db scan")
+		}
+		out = append(out, sc)
+	}
+	if rows.Err() != nil {
+		return nil, status.Error(codes.Internal, "db")
+	}
+	return out, nil
+}
+
+func (s *PostgresStore) InitSchedules(ctx context.Context, next func(cron string) *time.Time) error {
+	rows, err := s.db.Query(ctx, `SELECT name, cron FROM schedules WHERE next_run_at IS NULL`)
+	if err != nil {
+		return status.Error(codes.Internal, "db")
+	}
+	pending := map[string]string{}
+	for rows.Next() {
+		var name, cron string
+		if err := rows.Scan(&name, &cron); err != nil {
+			rows.Close()
+			return status.Error(codes.Internal, "db scan")
+		}
+		pending[name] = cron
+	}
+	rows.Close()
+	for name, cron := range pending {
+		if _, err := s.db.Exec(ctx, `UPDATE schedules SET next_run_at=$2 WHERE name=$1 AND next_run_at IS NULL`, name, next(cron)); err != nil {
+			return status.Error(codes.Internal, "db")
+		}
+	}
+	return nil
+}
+
+func (s *PostgresStore) RecordRun(ctx context.Context, name string, startedAt time.Time, duration time.Duration, runErr error, nextRunAt *time.Time) error {
+	st, msg := RunStatusOK, ""
+	if runErr != nil {
+		st, msg = RunStatusFailed, runErr.Error()
+	}
+	_, err := s.db.Exec(ctx, `
+UPDATE schedules SET last_run_at=$2, last_duration_ms=$3, last_status=$4, last_error=$5,
+  next_run_at=$6, triggered=FALSE, updated_at=now()
+WHERE name=$1`, name, startedAt, duration.Milliseconds(), st, msg, nextRunAt)
+	if err != nil {
+		return status.Error(codes.Internal, "db")
+	}
+	return nil
+}
+
+func (s *PostgresStore) scheduleRow(row pgx.Row) (Schedule, error) {
+	sc, err := scanSchedule(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Schedule{}, status.Error(codes.NotFound, "schedule not found")
+		}
+		return Schedule{}, status.Error(codes.Internal, "db")
+	}
+	return sc, nil
+}
@@ -0,0 +1,131 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const syncResultColumns = `provider, mal_id, COALESCE(anime_id::text, ''), provider_anime_id, airing, episodes,
+  added, renamed, renumbered, filler_changed, availability_changed, removed, error, synced_at, changed_at`
+
+func scanSyncResult(row pgx.Row) (SyncResult, error) {
+	var r SyncResult
+	err := row.Scan(&r.Provider, &r.MALID, &r.AnimeID, &r.ProviderAnimeID, &r.Airing, &r.Episodes,
+		&r.Added, &r.Renamed, &r.Renumbered, &r.FillerChanged, &r.AvailabilityChanged, &r.Removed,
+		&r.Error, &r.SyncedAt, &r.ChangedAt)
+	return r, err
+}
+
+func (s *PostgresStore) RecordSyncResult(ctx context.Context, r SyncResult) error {
+	var animeID any
+	if r.AnimeID != "" {
+		animeID = r.AnimeID
+	}
+	var err error
+	if r.Error != "" {
+		_, err = s.db.Exec(ctx, `
+INSERT INTO episode_sync_results (provider, mal_id, anime_id, error, synced_at)
+VALUES ($1, $2, $3::uuid, $4, $5)
+ON CONFLICT (provider, mal_id) DO UPDATE SET
+  anime_id=COALESCE(EXCLUDED.anime_id, episode_sync_results.anime_id), error=EXCLUDED.error, synced_at=EXCLUDED.synced_at`,
+			r.Provider, r.MALID, animeID, r.Error, r.SyncedAt)
+	} else {
+		var changedAt any
+		if r.Changed() {
+			changedAt = r.SyncedAt
+		}
+		_, err = s.db.Exec(ctx, `
+INSERT INTO episode_sync_results (provider, mal_id, anime_id, provider_anime_id, airing, episodes,
+  added, renamed, renumbered, filler_changed, availability_changed, removed, error, synced_at, changed_at)
+VALUES ($1, $2, $3::uuid, $4, $5, $6, $7, $8, $9, $10, $11, $12, '', $13, $14::timestamptz)
+ON CONFLICT (provider, mal_id) DO UPDATE SET
+  anime_id=EXCLUDED.anime_id, provider_anime_id=EXCLUDED.provider_anime_id, airing=EXCLUDED.airing,
+  episodes=EXCLUDED.episodes, added=EXCLUDED.added, renamed=EXCLUDED.renamed, renumbered=EXCLUDED.renumbered,
+  filler_changed=EXCLUDED.filler_changed, availability_changed=EXCLUDED.availability_changed,
+  removed=EXCLUDED.removed, error='', synced_at=EXCLUDED.synced_at,
+  changed_at=COALESCE(EXCLUDED.changed_at, episode_sync_results.changed_at)`,
+			r.Provider, r.MALID, animeID, r.ProviderAnimeID, r.Airing, r.Episodes,
+			r.Added, r.Renamed, r.Renumbered, r.FillerChanged, r.AvailabilityChanged, r.Removed,
+			r.SyncedAt, changedAt)
+	}
+	if err != nil {
+		return status.Error(codes.Internal, "db")
+	}
+	return nil
+}
+
+func (s *PostgresStore) GetSyncResult(ctx context.Context, provider string, malID int) (SyncResult, error) {
+	r, err := scanSyncResult(s.db.QueryRow(ctx, `SELECT `+syncResultColumns+` FROM episode_sync_results WHERE provider=$1 AND mal_id=$2`, provider, malID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return SyncResult{}, status.Error(codes.NotFound, "sync result not found")
+		}
+		return SyncResult{}, status.Error(codes.Internal, "db")
+	}
+	return r, nil
+}
+
+func (s *PostgresStore) ListSyncResults(ctx context.Context, f SyncResultFilter) ([]SyncResult, error) {
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+	q := `SELECT ` + syncResultColumns + ` FROM episode_sync_results WHERE TRUE`
+	if f.Provider != "" {
+		q += " AND provider = " + arg(f.Provider)
+	}
+	if f.MALID > 0 {
+		q += " AND mal_id = " + arg(f.MALID)
+	}
+	if f.AiringOnly {
+		q += " AND airing"
+	}
+	if f.FailedOnly {
+		q += " AND error <> ''"
+	}
+	q += " ORDER BY synced_at DESC, mal_id LIMIT " + arg(f.Limit) + " OFFSET " + arg(f.Offset)
+
+	rows, err := s.db.Query(ctx, q, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db")
+	}
+	defer rows.Close()
+	var out []SyncResult
+	for rows.Next() {
+		r, err := scanSyncResult(rows)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "db scan")
+		}
+		out = append(out, r)
+	}
+	if rows.Err() != nil {
+		return nil, status.Error(codes.Internal, "db")
+	}
+	return out, nil
+}
+
+func (s *PostgresStore) AiringMALIDs(ctx context.Context, provider string) ([]int, error) {
+	rows, err := s.db.Query(ctx, `SELECT mal_id FROM episode_sync_results WHERE provider=$1 AND airing ORDER BY mal_id`, provider)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db")
+	}
+	defer rows.Close()
+	var out []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, status.Error(codes.Internal, "db scan")
+		}
+		out = append(out, id)
+	}
+	if rows.Err() != nil {
+		return nil, status.Error(codes.Internal, "db")
+	}
+	return out, nil
+}
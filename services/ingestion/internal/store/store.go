@@ -0,0 +1,239 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Schedule is a recurring ingestion job. Job names a runner registered with
+// the scheduler; Args is passed to it unchanged.
+type Schedule struct {
+	Name           string
+	Cron           string
+	Job            string
+	Args           json.RawMessage
+	Paused         bool
+	NextRunAt      *time.Time
+	LastRunAt      *time.Time
+	LastDurationMs int64
+	LastStatus     string
+	LastError      string
+	UpdatedAt      time.Time
+}
+
+// Schedule run outcomes recorded in LastStatus.
+const (
+	RunStatusOK     = "ok"
+	RunStatusFailed = "failed"
+)
+
+// ScheduleStore persists schedules and their run state.
+type ScheduleStore interface {
+	ListSchedules(ctx context.Context) ([]Schedule, error)
+	GetSchedule(ctx context.Context, name string) (Schedule, error)
+	// UpdateSchedule changes the cron expression and sets the next run.
+	UpdateSchedule(ctx context.Context, name, cron string, nextRunAt *time.Time) (Schedule, error)
+	// SetSchedulePaused pauses or resumes a schedule. Resuming sets the next run.
+	SetSchedulePaused(ctx context.Context, name string, paused bool, nextRunAt *time.Time) (Schedule, error)
+	// TriggerSchedule makes the schedule due now, even if it is paused.
+	TriggerSchedule(ctx context.Context, name string) (Schedule, error)
+	// DueSchedules returns schedules whose next run is at or before now. Paused
+	// schedules are only due when triggered manually.
+	DueSchedules(ctx context.Context, now time.Time) ([]Schedule, error)
+	// InitSchedules sets the next run of schedules that have none.
+	InitSchedules(ctx context.Context, next func(cron string) *time.Time) error
+	// RecordRun stores a run's outcome and the following run time.
+	RecordRun(ctx context.Context, name string, startedAt time.Time, duration time.Duration, runErr error, nextRunAt *time.Time) error
+}
+
+// Job is one enqueued ingestion job and its latest outcome.
+type Job struct {
+	ID         string
+	Type       string
+	Payload    json.RawMessage
+	Status     string
+	Attempts   int
+	LastError  string
+	EnqueuedAt time.Time
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+	UpdatedAt  time.Time
+	// ReplayOf is the dead-letter entry this job replays, if any.
+	ReplayOf string
+	// Report is what a dry-run job would have changed; empty for other jobs.
+	Report json.RawMessage
+}
+
+// JobAttempt is one delivery of a job to its handler.
+type JobAttempt struct {
+	Attempt    int
+	StartedAt  time.Time
+	FinishedAt *time.Time
+	Error      string
+}
+
+// Job statuses. Retrying means the last attempt failed and the message was
+// handed back to NATS for redelivery.
+const (
+	JobStatusQueued    = "queued"
+	JobStatusRunning   = "running"
+	JobStatusRetrying  = "retrying"
+	JobStatusSucceeded = "succeeded"
+	JobStatusFailed    = "failed"
+)
+
+// JobFilter narrows ListJobs. Zero values match everything.
+type JobFilter struct {
+	Type   string
+	Status string
+	MALID  int
+	Since  time.Time
+	Until  time.Time
+	Limit  int
+	Offset int
+}
+
+// JobStore persists ingestion job records.
+type JobStore interface {
+	// CreateJob records a job at enqueue time. Creating an existing job is a no-op.
+	CreateJob(ctx context.Context, id, jobType string, payload json.RawMessage) error
+	// StartJobAttempt marks the job running for the given delivery attempt,
+	// creating the job if it was published without a record.
+	StartJobAttempt(ctx context.Context, id, jobType string, payload json.RawMessage, attempt int) error
+	// FinishJobAttempt stores the attempt's outcome and the resulting job status.
+	FinishJobAttempt(ctx context.Context, id string, attempt int, status string, errMsg string) error
+	// FailJob marks a job failed without a new attempt, e.g. when it is moved
+	// to the dead-letter queue.
+	FailJob(ctx context.Context, id, reason string) error
+	ListJobs(ctx context.Context, f JobFilter) ([]Job, error)
+	GetJob(ctx context.Context, id string) (Job, []JobAttempt, error)
+	// SetJobReport stores a dry-run job's report.
+	SetJobReport(ctx context.Context, id string, report json.RawMessage) error
+	// DryRunJobs returns every job enqueued for a dry run, oldest first.
+	DryRunJobs(ctx context.Context, dryRunID string) ([]Job, error)
+}
+
+// DLQEntry is a job that exhausted its deliveries and was dead-lettered.
+type DLQEntry struct {
+	ID        string
+	StreamSeq uint64
+	Subject   string
+	Type      string
+	Reason    string
+	Payload   json.RawMessage
+	// JobID is the failed job, empty for messages published without a job id.
+	JobID         string
+	Status        string
+	ReplayedJobID string
+	FailedAt      time.Time
+	ResolvedAt    *time.Time
+}
+
+// Dead-letter entry statuses. Only pending entries can be replayed or discarded.
+const (
+	DLQStatusPending   = "pending"
+	DLQStatusReplayed  = "replayed"
+	DLQStatusDiscarded = "discarded"
+)
+
+// DLQFilter narrows dead-letter queries by failure time. Zero values match everything.
+type DLQFilter struct {
+	Type   string
+	Status string
+	Since  time.Time
+	Until  time.Time
+	Limit  int
+	Offset int
+}
+
+// DLQStore persists dead-letter entries.
+type DLQStore interface {
+	// RecordDLQEntry stores an entry; recording the same stream sequence twice is a no-op.
+	RecordDLQEntry(ctx context.Context, e DLQEntry) error
+	ListDLQEntries(ctx context.Context, f DLQFilter) ([]DLQEntry, error)
+	// ReplayDLQEntry marks a pending entry replayed and records jobID as a new
+	// job replaying it. Entries that are not pending fail with FailedPrecondition.
+	ReplayDLQEntry(ctx context.Context, id, jobID string) (DLQEntry, error)
+	// ReleaseDLQEntry undoes ReplayDLQEntry when the replay could not be
+	// published: the entry is pending again and the job is failed with reason.
+	ReleaseDLQEntry(ctx context.Context, id, jobID, reason string) error
+	// DiscardDLQEntry marks a pending entry discarded.
+	DiscardDLQEntry(ctx context.Context, id string) error
+	// DiscardDLQEntries discards up to f.Limit pending entries matching f and
+	// returns how many were discarded.
+	DiscardDLQEntries(ctx context.Context, f DLQFilter) (int, error)
+}
+
+// HiAnimeMapping pins a MAL ID to a HiAnime slug, overriding title matching.
+type HiAnimeMapping struct {
+	MALID     int
+	Slug      string
+	Note      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// HiAnimeMappingStore persists manual HiAnime mappings.
+type HiAnimeMappingStore interface {
+	// GetHiAnimeMapping fails with NotFound when malID has no mapping.
+	GetHiAnimeMapping(ctx context.Context, malID int) (HiAnimeMapping, error)
+	ListHiAnimeMappings(ctx context.Context, limit, offset int) ([]HiAnimeMapping, error)
+	// SetHiAnimeMapping creates or replaces the mapping for m.MALID.
+	SetHiAnimeMapping(ctx context.Context, m HiAnimeMapping) (HiAnimeMapping, error)
+	// DeleteHiAnimeMapping fails with NotFound when malID has no mapping.
+	DeleteHiAnimeMapping(ctx context.Context, malID int) error
+}
+
+// SyncResult is the latest episode sync outcome for one anime and provider.
+type SyncResult struct {
+	Provider        string
+	MALID           int
+	AnimeID         string
+	ProviderAnimeID string
+	// Airing is the provider's view; airing titles are refreshed more often.
+	Airing   bool
+	Episodes int
+
+	Added               int
+	Renamed             int
+	Renumbered          int
+	FillerChanged       int
+	AvailabilityChanged int
+	Removed             int
+
+	Error    string
+	SyncedAt time.Time
+	// ChangedAt is the last sync that changed any episode.
+	ChangedAt *time.Time
+}
+
+// Changed reports whether the sync changed any episode.
+func (r SyncResult) Changed() bool {
+	return r.Added+r.Renamed+r.Renumbered+r.FillerChanged+r.AvailabilityChanged+r.Removed > 0
+}
+
+// SyncResultFilter narrows ListSyncResults. Zero values match everything.
+type SyncResultFilter struct {
+	Provider string
+	MALID    int
+	// AiringOnly and FailedOnly restrict to airing titles and failed syncs.
+	AiringOnly bool
+	FailedOnly bool
+	Limit      int
+	Offset     int
+}
+
+// SyncResultStore persists per-anime episode sync results.
+type SyncResultStore interface {
+	// RecordSyncResult replaces the result for r's anime. A failed result
+	// (Error set) only updates the error and time and keeps the last good
+	// provider anime ID, airing flag and counts.
+	RecordSyncResult(ctx context.Context, r SyncResult) error
+	// GetSyncResult fails with NotFound when the anime was never synced.
+	GetSyncResult(ctx context.Context, provider string, malID int) (SyncResult, error)
+	// ListSyncResults returns results, most recently synced first.
+	ListSyncResults(ctx context.Context, f SyncResultFilter) ([]SyncResult, error)
+	// AiringMALIDs lists the titles provider last reported as airing.
+	AiringMALIDs(ctx context.Context, provider string) ([]int, error)
+}
@@ -0,0 +1,25 @@
+-- Recurring ingestion jobs. job names a runner registered by the ingestion
+-- service and args is passed to it as-is. next_run_at is recomputed from cron
+-- after every run; a manual trigger sets it to now() and sets triggered so the
+-- run also happens while paused.
+CREATE TABLE IF NOT EXISTS schedules (
+  name TEXT PRIMARY KEY,
+  cron TEXT NOT NULL,
+  job TEXT NOT NULL,
+  args JSONB NOT NULL DEFAULT '{}',
+  paused BOOLEAN NOT NULL DEFAULT FALSE,
+  triggered BOOLEAN NOT NULL DEFAULT FALSE,
+  next_run_at TIMESTAMPTZ,
+  last_run_at TIMESTAMPTZ,
+  last_duration_ms BIGINT NOT NULL DEFAULT 0,
+  last_status TEXT NOT NULL DEFAULT '',
+  last_error TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- The schedules previously hard-coded as tickers in the ingestion service.
+INSERT INTO schedules (name, cron, job, args) VALUES
+  ('jikan-season-now', '0 3 * * *', 'jikan.season', '{"pages": 2}'),
+  ('jikan-top', '0 4 * * 1', 'jikan.top', '{"pages": 4}')
+ON CONFLICT (name) DO NOTHING;
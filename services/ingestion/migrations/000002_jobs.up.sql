@@ -0,0 +1,31 @@
+-- One row per enqueued ingestion job. The id travels with the NATS message in
+-- the Ingestion-Job-Id header; the worker creates the row on first delivery
+-- if the publisher did not. type is the subject without the "ingestion."
+-- prefix, e.g. "jikan.sync". status is queued, running, retrying, succeeded
+-- or failed.
+CREATE TABLE IF NOT EXISTS jobs (
+  id UUID PRIMARY KEY,
+  type TEXT NOT NULL,
+  payload JSONB NOT NULL DEFAULT '{}',
+  status TEXT NOT NULL DEFAULT 'queued',
+  attempts INT NOT NULL DEFAULT 0,
+  last_error TEXT NOT NULL DEFAULT '',
+  enqueued_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  started_at TIMESTAMPTZ,
+  finished_at TIMESTAMPTZ,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS jobs_enqueued_idx ON jobs (enqueued_at DESC);
+CREATE INDEX IF NOT EXISTS jobs_status_idx ON jobs (status, enqueued_at DESC);
+CREATE INDEX IF NOT EXISTS jobs_type_idx ON jobs (type, enqueued_at DESC);
+
+-- One row per delivery of a job to a worker handler.
+CREATE TABLE IF NOT EXISTS job_attempts (
+  job_id UUID NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+  attempt INT NOT NULL,
+  started_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  finished_at TIMESTAMPTZ,
+  error TEXT NOT NULL DEFAULT '',
+  PRIMARY KEY (job_id, attempt)
+);
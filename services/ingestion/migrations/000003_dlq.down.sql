@@ -0,0 +1,2 @@
+ALTER TABLE jobs DROP COLUMN IF EXISTS replay_of;
+DROP TABLE IF EXISTS dlq_entries;
@@ -0,0 +1,22 @@
+-- Dead-lettered ingestion jobs, copied from the ingestion.dlq subject by the
+-- worker. stream_seq is the DLQ message's JetStream sequence and makes the copy
+-- idempotent. job_id is the failed job; replaying creates a new job whose
+-- replay_of points back at the entry.
+CREATE TABLE IF NOT EXISTS dlq_entries (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  stream_seq BIGINT NOT NULL UNIQUE,
+  subject TEXT NOT NULL,
+  type TEXT NOT NULL,
+  reason TEXT NOT NULL DEFAULT '',
+  payload JSONB NOT NULL DEFAULT '{}',
+  job_id UUID,
+  status TEXT NOT NULL DEFAULT 'pending',
+  replayed_job_id UUID,
+  failed_at TIMESTAMPTZ NOT NULL,
+  resolved_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS dlq_entries_status_idx ON dlq_entries (status, failed_at DESC);
+CREATE INDEX IF NOT EXISTS dlq_entries_type_idx ON dlq_entries (type, failed_at DESC);
+
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS replay_of UUID REFERENCES dlq_entries(id) ON DELETE SET NULL;
@@ -0,0 +1,8 @@
+-- Admin-pinned HiAnime slugs. A mapping bypasses title matching for its MAL ID.
+CREATE TABLE IF NOT EXISTS hianime_mappings (
+  mal_id INT PRIMARY KEY,
+  slug TEXT NOT NULL,
+  note TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
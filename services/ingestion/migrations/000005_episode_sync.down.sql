@@ -0,0 +1,2 @@
+DELETE FROM schedules WHERE name = 'hianime-airing';
+DROP TABLE IF EXISTS episode_sync_results;
@@ -0,0 +1,28 @@
+-- Latest episode sync outcome per anime and provider. provider_anime_id is
+-- reused by the next sync so known titles skip matching; airing selects the
+-- titles refreshed by the hianime-airing schedule.
+CREATE TABLE IF NOT EXISTS episode_sync_results (
+  provider TEXT NOT NULL,
+  mal_id INT NOT NULL,
+  anime_id UUID,
+  provider_anime_id TEXT NOT NULL DEFAULT '',
+  airing BOOLEAN NOT NULL DEFAULT FALSE,
+  episodes INT NOT NULL DEFAULT 0,
+  added INT NOT NULL DEFAULT 0,
+  renamed INT NOT NULL DEFAULT 0,
+  renumbered INT NOT NULL DEFAULT 0,
+  filler_changed INT NOT NULL DEFAULT 0,
+  availability_changed INT NOT NULL DEFAULT 0,
+  removed INT NOT NULL DEFAULT 0,
+  error TEXT NOT NULL DEFAULT '',
+  synced_at TIMESTAMPTZ NOT NULL,
+  changed_at TIMESTAMPTZ,
+  PRIMARY KEY (provider, mal_id)
+);
+
+CREATE INDEX IF NOT EXISTS episode_sync_results_airing_idx ON episode_sync_results (provider) WHERE airing;
+CREATE INDEX IF NOT EXISTS episode_sync_results_synced_idx ON episode_sync_results (synced_at DESC);
+
+INSERT INTO schedules (name, cron, job, args) VALUES
+  ('hianime-airing', '*/10 * * * *', 'hianime.airing', '{}')
+ON CONFLICT (name) DO NOTHING;
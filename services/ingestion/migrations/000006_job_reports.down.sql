@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS jobs_dry_run_idx;
+ALTER TABLE jobs DROP COLUMN IF EXISTS report;
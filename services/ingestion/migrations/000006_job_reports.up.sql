@@ -0,0 +1,5 @@
+-- Dry-run jobs store what they would have changed in report. Their payload
+-- carries dry_run_id, shared by every job of one dry run.
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS report JSONB;
+
+CREATE INDEX IF NOT EXISTS jobs_dry_run_idx ON jobs ((payload->>'dry_run_id')) WHERE payload ? 'dry_run_id';
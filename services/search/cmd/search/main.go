@@ -67,7 +67,7 @@ func main() {
 	}
 	analyticsPublisher := analytics.New(js, log)
 
-	idx := &indexer.Indexer{CatalogClient: catalogClient, Meili: meiliClient, Log: log, NATS: nc, ReindexEvery: cfg.ReindexInterval}
+	idx := &indexer.Indexer{CatalogClient: catalogClient, Meili: meiliClient, Log: log, NATS: nc, ReindexEvery: cfg.ReindexInterval, TrendingEvery: cfg.TrendingSyncInterval}
 	if cfg.ReindexOnce {
 		if err := idx.ReindexAll(context.Background()); err != nil {
 			log.Error("reindex failed", zap.Error(err))
@@ -17,6 +17,9 @@ type Config struct {
 	MeiliAPIKey     string
 	ReindexInterval time.Duration
 	ReindexOnce     bool
+	// TrendingSyncInterval is how often trending scores are copied from the
+	// catalog into the index; zero disables the sync.
+	TrendingSyncInterval time.Duration
 }
 
 func Load() (Config, error) {
@@ -52,6 +55,13 @@ func Load() (Config, error) {
 		}
 	}
 
+	trendingSync := 10 * time.Minute
+	if v := strings.TrimSpace(os.Getenv("TRENDING_SYNC_INTERVAL")); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			trendingSync = d
+		}
+	}
+
 	reindexOnce := false
 	if v := strings.TrimSpace(os.Getenv("REINDEX_ONCE")); v == "true" || v == "1" {
 		reindexOnce = true
@@ -67,5 +77,7 @@ func Load() (Config, error) {
 		MeiliAPIKey:     meiliKey,
 		ReindexInterval: interval,
 		ReindexOnce:     reindexOnce,
+
+		TrendingSyncInterval: trendingSync,
 	}, nil
 }
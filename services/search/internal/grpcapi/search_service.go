@@ -11,6 +11,7 @@ import (
 
 	searchv1 "github.com/example/anime-platform/gen/search/v1"
 	"github.com/example/anime-platform/internal/platform/analytics"
+	"github.com/example/anime-platform/internal/platform/taxonomy"
 	"github.com/example/anime-platform/services/search/internal/meili"
 )
 
@@ -32,6 +33,8 @@ type meiliAnime struct {
 	Status        string   `json:"status"`
 	Type          string   `json:"type"`
 	TotalEpisodes int32    `json:"total_episodes"`
+	Themes        []string `json:"themes"`
+	Demographics  []string `json:"demographics"`
 }
 
 func (s *SearchService) SearchAnime(ctx context.Context, req *searchv1.SearchAnimeRequest) (*searchv1.SearchAnimeResponse, error) {
@@ -45,12 +48,20 @@ func (s *SearchService) SearchAnime(ctx context.Context, req *searchv1.SearchAni
 		offset = 0
 	}
 
+	sort := strings.ToLower(strings.TrimSpace(req.GetSort()))
+	if sort != "" && !sortableFields[sort] {
+		return nil, status.Error(codes.InvalidArgument, "sort must be score, trending_24h, trending_7d or trending_30d")
+	}
+
 	filters := buildFilters(req)
 
 	payload := map[string]any{"q": q, "limit": limit, "offset": offset}
 	if filters != "" {
 		payload["filter"] = filters
 	}
+	if sort != "" {
+		payload["sort"] = []string{sort + ":desc"}
+	}
 
 	resp, err := s.Meili.Search(ctx, "anime", payload)
 	if err != nil {
@@ -75,6 +86,8 @@ func (s *SearchService) SearchAnime(ctx context.Context, req *searchv1.SearchAni
 			Status:        doc.Status,
 			Type:          doc.Type,
 			TotalEpisodes: doc.TotalEpisodes,
+			Themes:        doc.Themes,
+			Demographics:  doc.Demographics,
 		})
 	}
 
@@ -87,18 +100,26 @@ func (s *SearchService) SearchAnime(ctx context.Context, req *searchv1.SearchAni
 	return out, nil
 }
 
+// sortableFields must match the indexer's sortableAttributes.
+var sortableFields = map[string]bool{
+	"score":        true,
+	"trending_24h": true,
+	"trending_7d":  true,
+	"trending_30d": true,
+}
+
 func buildFilters(req *searchv1.SearchAnimeRequest) string {
 	filters := []string{}
-	if len(req.GetGenres()) > 0 {
-		vals := make([]string, 0, len(req.GetGenres()))
-		for _, g := range req.GetGenres() {
-			g = strings.TrimSpace(g)
-			if g != "" {
-				vals = append(vals, "genres = \""+g+"\"")
-			}
-		}
-		if len(vals) > 0 {
-			filters = append(filters, "("+strings.Join(vals, " OR ")+")")
+	for _, f := range []struct {
+		attr string
+		vals []string
+	}{
+		{"genre_slugs", req.GetGenres()},
+		{"theme_slugs", req.GetThemes()},
+		{"demographic_slugs", req.GetDemographics()},
+	} {
+		if clause := taxonomyFilter(f.attr, f.vals); clause != "" {
+			filters = append(filters, clause)
 		}
 	}
 	if v := strings.TrimSpace(req.GetStatus()); v != "" {
@@ -116,6 +137,20 @@ func buildFilters(req *searchv1.SearchAnimeRequest) string {
 	return strings.Join(filters, " AND ")
 }
 
+// taxonomyFilter ORs the slugs of vals (names or slugs) against attr.
+func taxonomyFilter(attr string, vals []string) string {
+	clauses := make([]string, 0, len(vals))
+	for _, v := range vals {
+		if slug := taxonomy.Slug(v); slug != "" {
+			clauses = append(clauses, attr+" = \""+slug+"\"")
+		}
+	}
+	if len(clauses) == 0 {
+		return ""
+	}
+	return "(" + strings.Join(clauses, " OR ") + ")"
+}
+
 func formatFloat(v float32) string {
 	return strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.2f", v), "0"), ".")
 }
@@ -12,12 +12,15 @@ import (
 	"go.uber.org/zap"
 
 	catalogv1 "github.com/example/anime-platform/gen/catalog/v1"
+	"github.com/example/anime-platform/internal/platform/geo"
+	"github.com/example/anime-platform/internal/platform/taxonomy"
 	"github.com/example/anime-platform/services/search/internal/meili"
 )
 
 const (
-	catalogSubject = "catalog.anime.upserted"
-	indexName      = "anime"
+	catalogSubject       = "catalog.anime.upserted"
+	catalogMergedSubject = "catalog.anime.merged"
+	indexName            = "anime"
 )
 
 type Indexer struct {
@@ -26,12 +29,20 @@ type Indexer struct {
 	Log           *zap.Logger
 	NATS          *nats.Conn
 	ReindexEvery  time.Duration
+	// TrendingEvery refreshes the trending_* fields between full reindexes.
+	TrendingEvery time.Duration
 }
 
 type EventPayload struct {
 	AnimeID string `json:"anime_id"`
 }
 
+// MergedPayload is published by catalog when a duplicate anime is folded into another.
+type MergedPayload struct {
+	SourceAnimeID string `json:"source_anime_id"`
+	TargetAnimeID string `json:"target_anime_id"`
+}
+
 type AnimeDoc struct {
 	AnimeID       string   `json:"anime_id"`
 	Title         string   `json:"title"`
@@ -44,16 +55,44 @@ type AnimeDoc struct {
 	Status        string   `json:"status"`
 	Type          string   `json:"type"`
 	TotalEpisodes int32    `json:"total_episodes"`
+
+	Themes           []string `json:"themes"`
+	Demographics     []string `json:"demographics"`
+	GenreSlugs       []string `json:"genre_slugs"`
+	ThemeSlugs       []string `json:"theme_slugs"`
+	DemographicSlugs []string `json:"demographic_slugs"`
+
+	TrendingScores
+}
+
+// TrendingScores are the catalog's decayed activity scores, sortable in search.
+type TrendingScores struct {
+	Trending24h float64 `json:"trending_24h"`
+	Trending7d  float64 `json:"trending_7d"`
+	Trending30d float64 `json:"trending_30d"`
 }
 
+// trendingSyncLimit bounds one sync; anime outside the top entries by 30d
+// score are reset to zero.
+const trendingSyncLimit = 1000
+
+// trendingFilter matches documents that still carry a trending score.
+const trendingFilter = "trending_24h > 0 OR trending_7d > 0 OR trending_30d > 0"
+
+// Page sizes for SyncTrending's reads.
+const (
+	trendingFetchPage = 500
+	animeBatchSize    = 100
+)
+
 func (c *Indexer) EnsureIndex(ctx context.Context) error {
 	if err := c.Meili.EnsureIndex(ctx, indexName, "anime_id"); err != nil {
 		return err
 	}
 	settings := map[string]any{
 		"searchableAttributes": []string{"title", "title_english", "title_japanese", "description"},
-		"filterableAttributes": []string{"genres", "status", "type", "score", "total_episodes"},
-		"sortableAttributes":   []string{"score"},
+		"filterableAttributes": []string{"genres", "genre_slugs", "theme_slugs", "demographic_slugs", "status", "type", "score", "total_episodes", "trending_24h", "trending_7d", "trending_30d"},
+		"sortableAttributes":   []string{"score", "trending_24h", "trending_7d", "trending_30d"},
 	}
 	return c.Meili.UpdateSettings(ctx, indexName, settings)
 }
@@ -81,12 +120,32 @@ func (c *Indexer) Run(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	mergedSub, err := js.PullSubscribe(catalogMergedSubject, "search_indexer_merged")
+	if err != nil {
+		return err
+	}
 
-	log := c.Log
 	if c.ReindexEvery > 0 {
 		go c.reindexLoop(ctx)
 	}
+	if c.TrendingEvery > 0 {
+		go c.trendingLoop(ctx)
+	}
+
+	errCh := make(chan error, 2)
+	go func() { errCh <- c.consume(ctx, sub, c.handleMsg) }()
+	go func() { errCh <- c.consume(ctx, mergedSub, c.handleMerged) }()
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-errCh:
+		return err
+	}
+}
 
+func (c *Indexer) consume(ctx context.Context, sub *nats.Subscription, handle func(context.Context, *nats.Msg) error) error {
+	log := c.Log
 	for {
 		select {
 		case <-ctx.Done():
@@ -102,7 +161,7 @@ func (c *Indexer) Run(ctx context.Context) error {
 			return err
 		}
 		for _, m := range msgs {
-			if err := c.handleMsg(ctx, m); err != nil {
+			if err := handle(ctx, m); err != nil {
 				log.Warn("index event failed", zap.Error(err))
 				_ = m.Nak()
 				continue
@@ -124,6 +183,22 @@ func (c *Indexer) handleMsg(ctx context.Context, msg *nats.Msg) error {
 	return c.indexAnime(ctx, payload.AnimeID)
 }
 
+func (c *Indexer) handleMerged(ctx context.Context, msg *nats.Msg) error {
+	var payload MergedPayload
+	if err := json.Unmarshal(msg.Data, &payload); err != nil {
+		return err
+	}
+	source := strings.TrimSpace(payload.SourceAnimeID)
+	target := strings.TrimSpace(payload.TargetAnimeID)
+	if source == "" || target == "" {
+		return fmt.Errorf("missing source_anime_id or target_anime_id")
+	}
+	if err := c.Meili.DeleteDocument(ctx, indexName, source); err != nil {
+		return err
+	}
+	return c.indexAnime(ctx, target)
+}
+
 func (c *Indexer) reindexLoop(ctx context.Context) {
 	ticker := time.NewTicker(c.ReindexEvery)
 	defer ticker.Stop()
@@ -139,6 +214,113 @@ func (c *Indexer) reindexLoop(ctx context.Context) {
 	}
 }
 
+func (c *Indexer) trendingLoop(ctx context.Context) {
+	ticker := time.NewTicker(c.TrendingEvery)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.SyncTrending(ctx); err != nil {
+				c.Log.Warn("trending sync failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// SyncTrending copies current trending scores onto indexed documents. The 30d
+// ranking is used because every signal contributes to all three windows.
+//
+// Documents that still carry a score but left the ranking are found in the
+// index itself, so scores are cleared after a restart too. Every affected
+// anime is re-read from the catalog and written as a whole document: a
+// partial update would recreate documents for anime the catalog has since
+// hidden, and those are deleted instead.
+func (c *Indexer) SyncTrending(ctx context.Context) error {
+	resp, err := c.CatalogClient.GetTrending(geo.Unrestricted(ctx), &catalogv1.GetTrendingRequest{Window: "30d", Limit: trendingSyncLimit})
+	if err != nil {
+		return err
+	}
+	scores := make(map[string]TrendingScores, len(resp.GetAnime()))
+	ids := make([]string, 0, len(resp.GetAnime()))
+	for _, t := range resp.GetAnime() {
+		scores[t.GetAnimeId()] = trendingScores(t.GetPopularity())
+		ids = append(ids, t.GetAnimeId())
+	}
+	stale, err := c.trendingDocIDs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, id := range stale {
+		if _, ok := scores[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+
+	for start := 0; start < len(ids); start += animeBatchSize {
+		batch := ids[start:min(start+animeBatchSize, len(ids))]
+		res, err := c.CatalogClient.GetAnimeByIDs(geo.Unrestricted(ctx), &catalogv1.GetAnimeByIDsRequest{AnimeIds: batch})
+		if err != nil {
+			return err
+		}
+		live := make(map[string]bool, len(res.GetAnime()))
+		docs := make([]AnimeDoc, 0, len(res.GetAnime()))
+		for _, a := range res.GetAnime() {
+			live[a.GetId()] = true
+			doc := animeDoc(a)
+			doc.TrendingScores = scores[a.GetId()]
+			docs = append(docs, doc)
+		}
+		if len(docs) > 0 {
+			if err := c.Meili.AddDocuments(ctx, indexName, docs); err != nil {
+				return err
+			}
+		}
+		for _, id := range batch {
+			if live[id] {
+				continue
+			}
+			if err := c.Meili.DeleteDocument(ctx, indexName, id); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// trendingDocIDs returns the indexed anime that carry a trending score.
+func (c *Indexer) trendingDocIDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	for offset := 0; ; offset += trendingFetchPage {
+		page, err := c.Meili.FetchDocuments(ctx, indexName, map[string]any{
+			"filter": trendingFilter,
+			"fields": []string{"anime_id"},
+			"limit":  trendingFetchPage,
+			"offset": offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, raw := range page.Results {
+			var doc struct {
+				AnimeID string `json:"anime_id"`
+			}
+			if err := json.Unmarshal(raw, &doc); err != nil {
+				return nil, err
+			}
+			ids = append(ids, doc.AnimeID)
+		}
+		if len(page.Results) < trendingFetchPage || offset+len(page.Results) >= page.Total {
+			return ids, nil
+		}
+	}
+}
+
+func trendingScores(p *catalogv1.Popularity) TrendingScores {
+	return TrendingScores{Trending24h: p.GetScore_24H(), Trending7d: p.GetScore_7D(), Trending30d: p.GetScore_30D()}
+}
+
 func (c *Indexer) ReindexAll(ctx context.Context) error {
 	ids, err := c.fetchAllAnimeIDs(ctx)
 	if err != nil {
@@ -161,14 +343,18 @@ func (c *Indexer) fetchAllAnimeIDs(ctx context.Context) ([]string, error) {
 }
 
 func (c *Indexer) indexAnime(ctx context.Context, animeID string) error {
-	resp, err := c.CatalogClient.GetAnimeByIDs(ctx, &catalogv1.GetAnimeByIDsRequest{AnimeIds: []string{animeID}})
+	resp, err := c.CatalogClient.GetAnimeByIDs(geo.Unrestricted(ctx), &catalogv1.GetAnimeByIDsRequest{AnimeIds: []string{animeID}})
 	if err != nil {
 		return err
 	}
 	if len(resp.Anime) == 0 {
-		return nil
+		// Catalog omits hidden and taken-down titles; make sure they leave the index too.
+		return c.Meili.DeleteDocument(ctx, indexName, animeID)
 	}
-	anime := resp.Anime[0]
+	return c.Meili.AddDocuments(ctx, indexName, []AnimeDoc{animeDoc(resp.Anime[0])})
+}
+
+func animeDoc(anime *catalogv1.Anime) AnimeDoc {
 	doc := AnimeDoc{
 		AnimeID:       anime.Id,
 		Title:         anime.Title,
@@ -181,6 +367,27 @@ func (c *Indexer) indexAnime(ctx context.Context, animeID string) error {
 		Status:        anime.Status,
 		Type:          anime.Type,
 		TotalEpisodes: anime.TotalEpisodes,
+
+		TrendingScores: trendingScores(anime.GetPopularity()),
+	}
+	if len(anime.GenreTags) > 0 {
+		doc.Genres, doc.GenreSlugs = splitTaxonomy(anime.GenreTags)
+	} else {
+		for _, g := range anime.Genres {
+			doc.GenreSlugs = append(doc.GenreSlugs, taxonomy.Slug(g))
+		}
+	}
+	doc.Themes, doc.ThemeSlugs = splitTaxonomy(anime.Themes)
+	doc.Demographics, doc.DemographicSlugs = splitTaxonomy(anime.Demographics)
+	return doc
+}
+
+func splitTaxonomy(entries []*catalogv1.Genre) (names, slugs []string) {
+	names = make([]string, 0, len(entries))
+	slugs = make([]string, 0, len(entries))
+	for _, e := range entries {
+		names = append(names, e.GetName())
+		slugs = append(slugs, e.GetSlug())
 	}
-	return c.Meili.AddDocuments(ctx, indexName, []AnimeDoc{doc})
+	return names, slugs
 }
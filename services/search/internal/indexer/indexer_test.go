@@ -0,0 +1,130 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	catalogv1 "github.com/example/anime-platform/gen/catalog/v1"
+	"github.com/example/anime-platform/services/search/internal/meili"
+)
+
+// fakeMeili keeps the anime index in memory. Document fetches ignore the
+// filter text and return every document with a trending score.
+type fakeMeili struct {
+	mu   sync.Mutex
+	docs map[string]AnimeDoc
+}
+
+func (f *fakeMeili) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/indexes/anime/documents":
+		var docs []AnimeDoc
+		_ = json.NewDecoder(r.Body).Decode(&docs)
+		for _, d := range docs {
+			f.docs[d.AnimeID] = d
+		}
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/indexes/anime/documents/"):
+		delete(f.docs, strings.TrimPrefix(r.URL.Path, "/indexes/anime/documents/"))
+	case r.Method == http.MethodPost && r.URL.Path == "/indexes/anime/documents/fetch":
+		out := meili.FetchResponse{}
+		for id, d := range f.docs {
+			if d.Trending24h > 0 || d.Trending7d > 0 || d.Trending30d > 0 {
+				out.Results = append(out.Results, json.RawMessage(`{"anime_id":"`+id+`"}`))
+			}
+		}
+		out.Total = len(out.Results)
+		_ = json.NewEncoder(w).Encode(out)
+		return
+	default:
+		http.Error(w, "unexpected "+r.Method+" "+r.URL.Path, http.StatusNotFound)
+		return
+	}
+	_, _ = w.Write([]byte(`{"taskUid":1}`))
+}
+
+type fakeCatalog struct {
+	catalogv1.CatalogServiceClient
+	live     map[string]bool
+	trending []string
+}
+
+func (f *fakeCatalog) GetTrending(context.Context, *catalogv1.GetTrendingRequest, ...grpc.CallOption) (*catalogv1.GetTrendingResponse, error) {
+	out := &catalogv1.GetTrendingResponse{}
+	for _, id := range f.trending {
+		out.Anime = append(out.Anime, &catalogv1.TrendingAnime{AnimeId: id, Popularity: &catalogv1.Popularity{Score_30D: 10}})
+	}
+	return out, nil
+}
+
+func (f *fakeCatalog) GetAnimeByIDs(_ context.Context, req *catalogv1.GetAnimeByIDsRequest, _ ...grpc.CallOption) (*catalogv1.GetAnimeByIDsResponse, error) {
+	out := &catalogv1.GetAnimeByIDsResponse{}
+	for _, id := range req.GetAnimeIds() {
+		if f.live[id] {
+			out.Anime = append(out.Anime, &catalogv1.Anime{Id: id, Title: "Anime " + id})
+		}
+	}
+	return out, nil
+}
+
+func TestSyncTrending_HiddenAnimeNotRecreated(t *testing.T) {
+	store := &fakeMeili{docs: map[string]AnimeDoc{}}
+	srv := httptest.NewServer(store)
+	defer srv.Close()
+	cat := &fakeCatalog{live: map[string]bool{"a1": true, "a2": true, "a3": true}, trending: []string{"a1", "a2", "a3"}}
+	idx := &Indexer{CatalogClient: cat, Meili: meili.New(srv.URL, ""), Log: zap.NewNop()}
+	ctx := context.Background()
+
+	if err := idx.SyncTrending(ctx); err != nil {
+		t.Fatalf("first sync: %v", err)
+	}
+	if len(store.docs) != 3 || store.docs["a2"].Trending30d != 10 {
+		t.Fatalf("after first sync: %+v", store.docs)
+	}
+
+	// a2 is hidden, so the catalog drops it from both the ranking and lookups,
+	// and the hide event removes its document. a3 just stops trending.
+	delete(cat.live, "a2")
+	cat.trending = []string{"a1"}
+	delete(store.docs, "a2")
+
+	// A fresh indexer, as after a restart, still clears a3's score.
+	idx = &Indexer{CatalogClient: cat, Meili: meili.New(srv.URL, ""), Log: zap.NewNop()}
+	if err := idx.SyncTrending(ctx); err != nil {
+		t.Fatalf("second sync: %v", err)
+	}
+	if _, ok := store.docs["a2"]; ok {
+		t.Fatal("hidden anime was recreated in the index")
+	}
+	if d := store.docs["a3"]; d.Trending30d != 0 || d.Title != "Anime a3" {
+		t.Fatalf("a3 = %+v, want a full document with its score cleared", d)
+	}
+	if store.docs["a1"].Trending30d != 10 {
+		t.Fatalf("a1 lost its score: %+v", store.docs["a1"])
+	}
+}
+
+func TestSyncTrending_DeletesDocStillScoredForHiddenAnime(t *testing.T) {
+	// The hide event was missed, so a2's document still carries a score.
+	store := &fakeMeili{docs: map[string]AnimeDoc{"a2": {AnimeID: "a2", TrendingScores: TrendingScores{Trending30d: 4}}}}
+	srv := httptest.NewServer(store)
+	defer srv.Close()
+	cat := &fakeCatalog{live: map[string]bool{}}
+	idx := &Indexer{CatalogClient: cat, Meili: meili.New(srv.URL, ""), Log: zap.NewNop()}
+
+	if err := idx.SyncTrending(context.Background()); err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+	if _, ok := store.docs["a2"]; ok {
+		t.Fatal("hidden anime left in the index")
+	}
+}
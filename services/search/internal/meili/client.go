@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 )
@@ -22,6 +23,12 @@ type SearchResponse struct {
 	EstimatedTotalHits int               `json:"estimatedTotalHits"`
 }
 
+// FetchResponse is one page of documents matching a filter.
+type FetchResponse struct {
+	Results []json.RawMessage `json:"results"`
+	Total   int               `json:"total"`
+}
+
 func New(baseURL, apiKey string) *Client {
 	return &Client{baseURL: strings.TrimRight(baseURL, "/"), apiKey: apiKey, http: &http.Client{Timeout: 5 * time.Second}}
 }
@@ -57,6 +64,11 @@ func (c *Client) AddDocuments(ctx context.Context, index string, docs any) error
 	return err
 }
 
+func (c *Client) DeleteDocument(ctx context.Context, index, id string) error {
+	_, err := c.do(ctx, http.MethodDelete, fmt.Sprintf("/indexes/%s/documents/%s", index, url.PathEscape(id)), nil)
+	return err
+}
+
 func (c *Client) Search(ctx context.Context, index string, payload any) (SearchResponse, error) {
 	b, err := json.Marshal(payload)
 	if err != nil {
@@ -73,6 +85,24 @@ func (c *Client) Search(ctx context.Context, index string, payload any) (SearchR
 	return out, nil
 }
 
+// FetchDocuments lists documents by filter rather than relevance; payload
+// takes filter, fields, limit and offset.
+func (c *Client) FetchDocuments(ctx context.Context, index string, payload any) (FetchResponse, error) {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return FetchResponse{}, fmt.Errorf("marshal fetch payload: %w", err)
+	}
+	resp, err := c.do(ctx, http.MethodPost, fmt.Sprintf("/indexes/%s/documents/fetch", index), bytes.NewReader(b))
+	if err != nil {
+		return FetchResponse{}, err
+	}
+	var out FetchResponse
+	if err := json.Unmarshal(resp, &out); err != nil {
+		return FetchResponse{}, err
+	}
+	return out, nil
+}
+
 func (c *Client) do(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
 	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
 	if err != nil {
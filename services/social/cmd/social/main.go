@@ -95,6 +95,7 @@ func main() {
 			log.Error("nats connect", zap.Error(err))
 		} else {
 			go worker.StartCommentsConsumer(ctx, nc)
+			go worker.StartCatalogMergeConsumer(ctx, nc)
 			defer nc.Close()
 		}
 
@@ -0,0 +1,127 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nats-io/nats.go"
+)
+
+// AnimeMergedEvent is published by catalog when a duplicate anime is folded into another.
+type AnimeMergedEvent struct {
+	SourceAnimeID string `json:"source_anime_id"`
+	TargetAnimeID string `json:"target_anime_id"`
+}
+
+// StartCatalogMergeConsumer subscribes to catalog.anime.merged and moves ratings and
+// comments from the merged-away anime ID onto the surviving one.
+func StartCatalogMergeConsumer(ctx context.Context, nc *nats.Conn) {
+	js, err := nc.JetStream()
+	if err != nil {
+		log.Printf("catalog_merge_consumer: jetstream: %v", err)
+		return
+	}
+
+	_, err = js.AddStream(&nats.StreamConfig{
+		Name:     "CATALOG_EVENTS",
+		Subjects: []string{"catalog.>"},
+		Storage:  nats.FileStorage,
+		MaxAge:   7 * 24 * time.Hour,
+	})
+	if err != nil && !strings.Contains(err.Error(), "already in use") {
+		log.Printf("catalog_merge_consumer: add stream error: %v", err)
+		return
+	}
+
+	sub, err := js.PullSubscribe("catalog.anime.merged", "social_catalog_merged")
+	if err != nil {
+		log.Printf("catalog_merge_consumer: subscribe: %v", err)
+		return
+	}
+
+	dsn := getenv("DATABASE_URL")
+	if dsn == "" {
+		log.Printf("catalog_merge_consumer: DATABASE_URL not set")
+		return
+	}
+	pool, err := pgxpool.New(context.Background(), dsn)
+	if err != nil {
+		log.Printf("catalog_merge_consumer: pgxpool.New: %v", err)
+		return
+	}
+
+	go func() {
+		defer pool.Close()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+
+			msgs, err := sub.Fetch(10, nats.MaxWait(2*time.Second))
+			if err != nil {
+				if err == nats.ErrTimeout {
+					continue
+				}
+				log.Printf("catalog_merge_consumer: fetch: %v", err)
+				time.Sleep(1 * time.Second)
+				continue
+			}
+
+			for _, m := range msgs {
+				var ev AnimeMergedEvent
+				if err := json.Unmarshal(m.Data, &ev); err != nil {
+					log.Printf("catalog_merge_consumer: invalid event: %v", err)
+					_ = m.Term()
+					continue
+				}
+				if err := rekeyAnime(ctx, pool, ev.SourceAnimeID, ev.TargetAnimeID); err != nil {
+					log.Printf("catalog_merge_consumer: rekey %s: %v", ev.SourceAnimeID, err)
+					_ = m.Nak()
+					continue
+				}
+				if err := m.Ack(); err != nil {
+					log.Printf("catalog_merge_consumer: ack error: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// rekeyAnime moves ratings and comments to the target anime. A user who rated both keeps
+// the most recently updated score. Replaying the same event is a no-op.
+func rekeyAnime(ctx context.Context, pool *pgxpool.Pool, sourceID, targetID string) error {
+	sourceID = strings.TrimSpace(sourceID)
+	targetID = strings.TrimSpace(targetID)
+	if sourceID == "" || targetID == "" || sourceID == targetID {
+		return nil
+	}
+
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err := tx.Exec(ctx, `
+INSERT INTO ratings (user_id, anime_id, score, created_at, updated_at)
+SELECT user_id, $2, score, created_at, updated_at FROM ratings WHERE anime_id = $1
+ON CONFLICT (user_id, anime_id) DO UPDATE SET
+  score = EXCLUDED.score,
+  updated_at = EXCLUDED.updated_at
+WHERE ratings.updated_at < EXCLUDED.updated_at`, sourceID, targetID); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM ratings WHERE anime_id = $1`, sourceID); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, `UPDATE comments SET anime_id = $2 WHERE anime_id = $1`, sourceID, targetID); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
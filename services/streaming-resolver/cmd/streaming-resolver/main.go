@@ -16,6 +16,7 @@ import (
 
 	catalogv1 "github.com/example/anime-platform/gen/catalog/v1"
 	streamingv1 "github.com/example/anime-platform/gen/streaming/v1"
+	"github.com/example/anime-platform/internal/platform/cassette"
 	"github.com/example/anime-platform/internal/platform/logging"
 	"github.com/example/anime-platform/internal/platform/run"
 	"github.com/example/anime-platform/services/streaming-resolver/internal/cache"
@@ -55,26 +56,48 @@ func main() {
 		run.Exit(1)
 	}
 
-	cb := gobreaker.NewCircuitBreaker(gobreaker.Settings{
-		Name:        "hianime",
-		MaxRequests: cfg.CBMaxRequests,
-		Interval:    cfg.CBInterval,
-		Timeout:     cfg.CBTimeout,
-		ReadyToTrip: func(counts gobreaker.Counts) bool {
-			return counts.ConsecutiveFailures >= cfg.CBFailureThreshold
-		},
-		OnStateChange: func(name string, from, to gobreaker.State) {
-			log.Info("circuit-breaker state change", zap.String("name", name), zap.String("from", from.String()), zap.String("to", to.String()))
-		},
-	})
+	// HTTP_CASSETTE_MODE records upstream responses or replays them offline.
+	cas, err := cassette.FromEnv()
+	if err != nil {
+		log.Error("cassette", zap.Error(err))
+		run.Exit(1)
+	}
+
+	// Each provider gets its own circuit breaker, so an open breaker on one
+	// sends requests straight on to the next provider in the chain.
+	byName := make(map[string]grpcapi.Provider, len(cfg.Providers))
+	chains := grpcapi.ProviderChains{ByCategory: map[string][]grpcapi.Provider{}}
+	for _, pc := range cfg.Providers {
+		cb := gobreaker.NewCircuitBreaker(gobreaker.Settings{
+			Name:        pc.Name,
+			MaxRequests: cfg.CBMaxRequests,
+			Interval:    cfg.CBInterval,
+			Timeout:     cfg.CBTimeout,
+			ReadyToTrip: func(counts gobreaker.Counts) bool {
+				return counts.ConsecutiveFailures >= cfg.CBFailureThreshold
+			},
+			OnStateChange: func(name string, from, to gobreaker.State) {
+				log.Info("circuit-breaker state change", zap.String("name", name), zap.String("from", from.String()), zap.String("to", to.String()))
+			},
+		})
+		client := hianime.New(pc.BaseURL, hianime.ClientConfig{
+			UserAgent:      cfg.HiAnimeUserAgent,
+			MaxRetries:     cfg.MaxRetries,
+			RetryBaseDelay: cfg.RetryBaseDelay,
+		}, hianime.WithCircuitBreaker(cb), hianime.WithLogger(log))
+		client.HTTPClient = cas.Client(client.HTTPClient, pc.Name)
 
-	hiAnimeClient := hianime.New(cfg.HiAnimeBaseURL, hianime.ClientConfig{
-		UserAgent:      cfg.HiAnimeUserAgent,
-		MaxRetries:     cfg.MaxRetries,
-		RetryBaseDelay: cfg.RetryBaseDelay,
-	}, hianime.WithCircuitBreaker(cb), hianime.WithLogger(log))
+		p := grpcapi.Provider{Name: pc.Name, Provider: client}
+		byName[pc.Name] = p
+		chains.Default = append(chains.Default, p)
+	}
+	for category, names := range cfg.ProviderChains {
+		for _, name := range names {
+			chains.ByCategory[category] = append(chains.ByCategory[category], byName[name])
+		}
+	}
 
-	resolver := &grpcapi.ResolverService{Catalog: catalogClient, HiAnime: hiAnimeClient, Cache: cacheClient, Log: log}
+	resolver := &grpcapi.ResolverService{Catalog: catalogClient, Providers: chains, Cache: cacheClient, Log: log}
 	grpcSrv := grpc.NewServer()
 	streamingv1.RegisterStreamingResolverServiceServer(grpcSrv, resolver)
 	reflection.Register(grpcSrv)
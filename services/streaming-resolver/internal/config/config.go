@@ -2,6 +2,7 @@ package config
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"strconv"
 	"strings"
@@ -26,6 +27,18 @@ type Config struct {
 	CBInterval         time.Duration
 	CBTimeout          time.Duration
 	CBFailureThreshold uint32
+	// Providers are the upstreams playback can come from, in default
+	// failover order. All of them speak the HiAnime API.
+	Providers []ProviderConfig
+	// ProviderChains overrides the failover order per category.
+	ProviderChains map[string][]string
+}
+
+// ProviderConfig is one upstream. Name is the provider its episode mappings
+// are stored under in the catalog.
+type ProviderConfig struct {
+	Name    string
+	BaseURL string
 }
 
 func Load() (Config, error) {
@@ -66,6 +79,14 @@ func Load() (Config, error) {
 	cbInterval := envDuration("CB_INTERVAL", 60*time.Second)
 	cbTimeout := envDuration("CB_TIMEOUT", 30*time.Second)
 	cbFailureThreshold := uint32(envInt("CB_FAILURE_THRESHOLD", 5))
+	providers, err := parseProviders(os.Getenv("RESOLVER_PROVIDERS"), baseURL)
+	if err != nil {
+		return Config{}, err
+	}
+	chains, err := parseProviderChains(os.Getenv("RESOLVER_PROVIDER_CHAINS"), providers)
+	if err != nil {
+		return Config{}, err
+	}
 
 	return Config{
 		ServiceName:        serviceName,
@@ -82,9 +103,71 @@ func Load() (Config, error) {
 		CBInterval:         cbInterval,
 		CBTimeout:          cbTimeout,
 		CBFailureThreshold: cbFailureThreshold,
+		Providers:          providers,
+		ProviderChains:     chains,
 	}, nil
 }
 
+// parseProviders reads RESOLVER_PROVIDERS, e.g.
+// "hianime=https://a.example/api/v2,hianime-mirror=https://b.example/api/v2".
+// Unset, the only provider is "hianime" at HIANIME_BASE_URL.
+func parseProviders(v, hianimeBaseURL string) ([]ProviderConfig, error) {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return []ProviderConfig{{Name: "hianime", BaseURL: hianimeBaseURL}}, nil
+	}
+	var out []ProviderConfig
+	seen := map[string]bool{}
+	for _, part := range strings.Split(v, ",") {
+		name, u, ok := strings.Cut(strings.TrimSpace(part), "=")
+		name, u = strings.ToLower(strings.TrimSpace(name)), strings.TrimSpace(u)
+		if !ok || name == "" || u == "" {
+			return nil, fmt.Errorf("RESOLVER_PROVIDERS: %q is not name=url", part)
+		}
+		// "user" is the catalog's source for community skip segments.
+		if name == "user" {
+			return nil, errors.New("RESOLVER_PROVIDERS: provider must not be named \"user\"")
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("RESOLVER_PROVIDERS: %s listed twice", name)
+		}
+		seen[name] = true
+		out = append(out, ProviderConfig{Name: name, BaseURL: u})
+	}
+	return out, nil
+}
+
+// parseProviderChains reads RESOLVER_PROVIDER_CHAINS, e.g.
+// "sub=hianime,hianime-mirror;dub=hianime-mirror,hianime". Categories it
+// leaves out try every provider in RESOLVER_PROVIDERS order.
+func parseProviderChains(v string, providers []ProviderConfig) (map[string][]string, error) {
+	known := map[string]bool{}
+	for _, p := range providers {
+		known[p.Name] = true
+	}
+	out := map[string][]string{}
+	for _, part := range strings.Split(v, ";") {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		category, list, ok := strings.Cut(part, "=")
+		category = strings.ToLower(strings.TrimSpace(category))
+		if !ok || category == "" {
+			return nil, fmt.Errorf("RESOLVER_PROVIDER_CHAINS: %q is not category=provider,...", part)
+		}
+		var chain []string
+		for _, name := range strings.Split(list, ",") {
+			name = strings.ToLower(strings.TrimSpace(name))
+			if !known[name] {
+				return nil, fmt.Errorf("RESOLVER_PROVIDER_CHAINS: unknown provider %q for %s", name, category)
+			}
+			chain = append(chain, name)
+		}
+		out[category] = chain
+	}
+	return out, nil
+}
+
 func envInt(key string, def int) int {
 	v := strings.TrimSpace(os.Getenv(key))
 	if v == "" {
@@ -0,0 +1,26 @@
+package grpcapi
+
+import "github.com/example/anime-platform/services/streaming-resolver/internal/hianime"
+
+// Provider is one upstream in a resolver chain. Name is the provider its
+// episode mappings are stored under in the catalog, and is reported with the
+// playback it serves.
+type Provider struct {
+	Name string
+	hianime.Provider
+}
+
+// ProviderChains lists the providers to try, in order, for each category.
+// Categories without a chain of their own use Default.
+type ProviderChains struct {
+	Default    []Provider
+	ByCategory map[string][]Provider
+}
+
+// For returns the chain for category.
+func (c ProviderChains) For(category string) []Provider {
+	if chain, ok := c.ByCategory[category]; ok {
+		return chain
+	}
+	return c.Default
+}
@@ -4,13 +4,16 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	catalogv1 "github.com/example/anime-platform/gen/catalog/v1"
 	streamingv1 "github.com/example/anime-platform/gen/streaming/v1"
+	"github.com/example/anime-platform/internal/platform/geo"
 	"github.com/example/anime-platform/services/streaming-resolver/internal/cache"
 	"github.com/example/anime-platform/services/streaming-resolver/internal/hianime"
 )
@@ -18,9 +21,11 @@ import (
 type ResolverService struct {
 	streamingv1.UnimplementedStreamingResolverServiceServer
 	Catalog catalogv1.CatalogServiceClient
-	HiAnime hianime.Provider
-	Cache   *cache.RedisCache
-	Log     *zap.Logger
+	// Providers are tried in order until one serves sources for the
+	// episode, so one upstream being down does not fail playback.
+	Providers ProviderChains
+	Cache     *cache.RedisCache
+	Log       *zap.Logger
 }
 
 type cachedPlayback struct {
@@ -28,10 +33,20 @@ type cachedPlayback struct {
 	Tracks            []streamingv1.PlaybackTrack     `json:"tracks"`
 	Intro             *streamingv1.PlaybackIntroOutro `json:"intro"`
 	Outro             *streamingv1.PlaybackIntroOutro `json:"outro"`
+	Recap             *streamingv1.PlaybackIntroOutro `json:"recap,omitempty"`
+	Preview           *streamingv1.PlaybackIntroOutro `json:"preview,omitempty"`
 	Headers           map[string]string               `json:"headers"`
 	ProviderEpisodeID string                          `json:"provider_episode_id"`
+	Provider          string                          `json:"provider"`
 }
 
+// minUserSkipScore is the net vote score a community segment needs before
+// it is served.
+const minUserSkipScore = 1
+
+// skipRecordTimeout bounds the background write of provider skip times.
+const skipRecordTimeout = 5 * time.Second
+
 func (s *ResolverService) GetPlayback(ctx context.Context, req *streamingv1.GetPlaybackRequest) (*streamingv1.GetPlaybackResponse, error) {
 	episodeID := strings.TrimSpace(req.GetEpisodeId())
 	if episodeID == "" {
@@ -46,6 +61,12 @@ func (s *ResolverService) GetPlayback(ctx context.Context, req *streamingv1.GetP
 		server = "hd-1"
 	}
 
+	// Availability is checked before the cache so takedowns and regional blocks apply
+	// to already-cached playback as well.
+	if err := s.checkAvailability(ctx, episodeID); err != nil {
+		return nil, err
+	}
+
 	cacheKey := fmt.Sprintf("playback:%s:%s:%s", episodeID, category, server)
 	if s.Cache != nil {
 		var cached cachedPlayback
@@ -54,43 +75,90 @@ func (s *ResolverService) GetPlayback(ctx context.Context, req *streamingv1.GetP
 		}
 	}
 
-	providerEpisodeID, err := s.resolveProviderEpisode(ctx, episodeID)
+	out, err := s.resolveChain(ctx, s.Providers.For(category), episodeID, category, server)
+	if err != nil {
+		return nil, err
+	}
+	s.recordSkipSegments(ctx, episodeID, out)
+	// Stored segments are merged before caching, so new community segments
+	// show up once the cached entry expires.
+	s.mergeSkipSegments(ctx, episodeID, out)
+
+	if s.Cache != nil {
+		_ = s.Cache.Set(ctx, cacheKey, out)
+	}
+	return toResponse(out), nil
+}
+
+// resolveChain tries each provider in chain until one returns sources. When
+// all fail it returns the first error other than NotFound, so an outage is
+// not reported as a missing episode just because a later provider has no
+// mapping for it.
+func (s *ResolverService) resolveChain(ctx context.Context, chain []Provider, episodeID, category, server string) (*cachedPlayback, error) {
+	if len(chain) == 0 {
+		return nil, status.Errorf(codes.Unavailable, "no providers for category %s", category)
+	}
+	var notFound, failed error
+	for _, p := range chain {
+		out, err := s.resolveWith(ctx, p, episodeID, category, server)
+		if err == nil {
+			return out, nil
+		}
+		if s.Log != nil {
+			s.Log.Debug("provider failed, trying next", zap.String("provider", p.Name), zap.String("episodeID", episodeID), zap.Error(err))
+		}
+		switch {
+		case status.Code(err) == codes.NotFound:
+			if notFound == nil {
+				notFound = err
+			}
+		case failed == nil:
+			failed = err
+		}
+	}
+	if failed != nil {
+		return nil, failed
+	}
+	return nil, notFound
+}
+
+// resolveWith fetches playback for episodeID from p. Empty sources count as a
+// failure so the chain moves on.
+func (s *ResolverService) resolveWith(ctx context.Context, p Provider, episodeID, category, server string) (*cachedPlayback, error) {
+	providerEpisodeID, err := s.resolveProviderEpisode(ctx, episodeID, p.Name)
 	if err != nil {
 		return nil, err
 	}
 	if s.Log != nil {
-		s.Log.Debug("resolved provider episode", zap.String("providerEpisodeID", providerEpisodeID))
+		s.Log.Debug("resolved provider episode", zap.String("provider", p.Name), zap.String("providerEpisodeID", providerEpisodeID))
 	}
-	servers, err := s.HiAnime.GetServers(ctx, providerEpisodeID)
+	servers, err := p.GetServers(ctx, providerEpisodeID)
 	if err != nil {
-		return nil, status.Errorf(codes.Unavailable, "provider servers: %v", err)
+		return nil, status.Errorf(codes.Unavailable, "%s servers: %v", p.Name, err)
 	}
 	if servers.Status != 200 {
-		return nil, status.Errorf(codes.Unavailable, "provider servers: status %d", servers.Status)
+		return nil, status.Errorf(codes.Unavailable, "%s servers: status %d", p.Name, servers.Status)
 	}
 	server = selectServer(server, servers)
 	if server == "" {
-		return nil, status.Error(codes.NotFound, "server not found")
+		return nil, status.Errorf(codes.NotFound, "%s: server not found", p.Name)
 	}
 
-	sources, err := s.HiAnime.GetSources(ctx, providerEpisodeID, server, category)
+	sources, err := p.GetSources(ctx, providerEpisodeID, server, category)
 	if err != nil {
-		return nil, status.Errorf(codes.Unavailable, "provider sources: %v", err)
+		return nil, status.Errorf(codes.Unavailable, "%s sources: %v", p.Name, err)
 	}
 	if sources.Status != 200 {
-		return nil, status.Errorf(codes.Unavailable, "provider sources: status %d", sources.Status)
+		return nil, status.Errorf(codes.Unavailable, "%s sources: status %d", p.Name, sources.Status)
 	}
-
-	out := buildCachedPlayback(providerEpisodeID, sources)
-
-	if s.Cache != nil {
-		_ = s.Cache.Set(ctx, cacheKey, out)
+	if len(sources.Data.Sources) == 0 {
+		return nil, status.Errorf(codes.Unavailable, "%s sources: none returned", p.Name)
 	}
-	return toResponse(out), nil
+	return buildCachedPlayback(p.Name, providerEpisodeID, sources), nil
 }
 
-func buildCachedPlayback(providerEpisodeID string, sources *hianime.SourcesResponse) *cachedPlayback {
-	out := &cachedPlayback{ProviderEpisodeID: providerEpisodeID, Headers: sources.Data.Headers}
+func buildCachedPlayback(provider, providerEpisodeID string, sources *hianime.SourcesResponse) *cachedPlayback {
+	out := &cachedPlayback{Provider: provider, ProviderEpisodeID: providerEpisodeID, Headers: sources.Data.Headers}
 	for _, src := range sources.Data.Sources {
 		out.Sources = append(out.Sources, streamingv1.PlaybackSource{Url: src.URL, Quality: src.Type, IsM3U8: src.IsM3U8})
 	}
@@ -106,13 +174,112 @@ func buildCachedPlayback(providerEpisodeID string, sources *hianime.SourcesRespo
 	return out
 }
 
-func (s *ResolverService) resolveProviderEpisode(ctx context.Context, episodeID string) (string, error) {
-	res, err := s.Catalog.GetProviderEpisodeID(ctx, &catalogv1.GetProviderEpisodeIDRequest{EpisodeId: episodeID, Provider: "hianime"})
+// recordSkipSegments stores the skip times the provider reported so
+// episodes keep them when a later source response omits them. The write runs
+// in the background and failures are only logged.
+func (s *ResolverService) recordSkipSegments(ctx context.Context, episodeID string, p *cachedPlayback) {
+	var segs []*catalogv1.SkipSegmentInput
+	if p.Intro != nil {
+		segs = append(segs, &catalogv1.SkipSegmentInput{Kind: "intro", Start: p.Intro.Start, End: p.Intro.End})
+	}
+	if p.Outro != nil {
+		segs = append(segs, &catalogv1.SkipSegmentInput{Kind: "outro", Start: p.Outro.Start, End: p.Outro.End})
+	}
+	if len(segs) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), skipRecordTimeout)
+	go func() {
+		defer cancel()
+		_, err := s.Catalog.UpsertProviderSkipSegments(ctx, &catalogv1.UpsertProviderSkipSegmentsRequest{
+			EpisodeId: episodeID,
+			Provider:  p.Provider,
+			Segments:  segs,
+		})
+		if err != nil && s.Log != nil {
+			s.Log.Warn("record skip segments", zap.String("episodeID", episodeID), zap.Error(err))
+		}
+	}()
+}
+
+// mergeSkipSegments fills the kinds the provider did not report from the
+// catalog. Playback works without them, so a catalog error is only logged.
+func (s *ResolverService) mergeSkipSegments(ctx context.Context, episodeID string, p *cachedPlayback) {
+	if p.Intro != nil && p.Outro != nil && p.Recap != nil && p.Preview != nil {
+		return
+	}
+	res, err := s.Catalog.ListSkipSegments(ctx, &catalogv1.ListSkipSegmentsRequest{EpisodeId: episodeID})
+	if err != nil {
+		if s.Log != nil {
+			s.Log.Warn("list skip segments", zap.String("episodeID", episodeID), zap.Error(err))
+		}
+		return
+	}
+	stored := pickSkipSegments(res.GetSegments())
+	for kind, dst := range map[string]**streamingv1.PlaybackIntroOutro{
+		"intro": &p.Intro, "outro": &p.Outro, "recap": &p.Recap, "preview": &p.Preview,
+	} {
+		if *dst == nil {
+			*dst = stored[kind]
+		}
+	}
+}
+
+// pickSkipSegments chooses one stored segment per kind: a provider segment
+// when there is one, otherwise the highest-scored community segment that has
+// at least minUserSkipScore.
+func pickSkipSegments(segs []*catalogv1.SkipSegment) map[string]*streamingv1.PlaybackIntroOutro {
+	best := map[string]*catalogv1.SkipSegment{}
+	for _, g := range segs {
+		user := g.GetSource() == "user"
+		if user && g.GetScore() < minUserSkipScore {
+			continue
+		}
+		cur, ok := best[g.GetKind()]
+		switch {
+		case !ok:
+		case cur.GetSource() != "user":
+			// A provider segment is already chosen.
+			continue
+		case user && g.GetScore() <= cur.GetScore():
+			continue
+		}
+		best[g.GetKind()] = g
+	}
+	out := make(map[string]*streamingv1.PlaybackIntroOutro, len(best))
+	for kind, g := range best {
+		out[kind] = &streamingv1.PlaybackIntroOutro{Start: g.GetStart(), End: g.GetEnd()}
+	}
+	return out
+}
+
+func (s *ResolverService) checkAvailability(ctx context.Context, episodeID string) error {
+	if c := geo.FromIncoming(ctx); c != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, geo.MetadataKey, c)
+	}
+	res, err := s.Catalog.GetEpisodeAvailability(ctx, &catalogv1.GetEpisodeAvailabilityRequest{EpisodeId: episodeID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return status.Error(codes.NotFound, "episode not found")
+		}
+		return status.Errorf(codes.Unavailable, "catalog availability: %v", err)
+	}
+	if res.GetAvailable() {
+		return nil
+	}
+	if res.GetReason() == "region" {
+		return status.Error(codes.PermissionDenied, "episode not available in your region")
+	}
+	return status.Error(codes.NotFound, "episode not available")
+}
+
+func (s *ResolverService) resolveProviderEpisode(ctx context.Context, episodeID, provider string) (string, error) {
+	res, err := s.Catalog.GetProviderEpisodeID(ctx, &catalogv1.GetProviderEpisodeIDRequest{EpisodeId: episodeID, Provider: provider})
 	if err != nil {
-		return "", status.Error(codes.NotFound, "provider episode not found")
+		return "", status.Errorf(codes.NotFound, "%s: provider episode not found", provider)
 	}
 	if res.GetProviderEpisodeId() == "" {
-		return "", status.Error(codes.NotFound, "provider episode not found")
+		return "", status.Errorf(codes.NotFound, "%s: provider episode not found", provider)
 	}
 	return res.GetProviderEpisodeId(), nil
 }
@@ -120,6 +287,7 @@ func (s *ResolverService) resolveProviderEpisode(ctx context.Context, episodeID
 func toResponse(c *cachedPlayback) *streamingv1.GetPlaybackResponse {
 	resp := &streamingv1.GetPlaybackResponse{
 		ProviderEpisodeId: c.ProviderEpisodeID,
+		Provider:          c.Provider,
 		Headers:           &streamingv1.PlaybackHeaders{Headers: c.Headers},
 	}
 	for i := range c.Sources {
@@ -130,6 +298,8 @@ func toResponse(c *cachedPlayback) *streamingv1.GetPlaybackResponse {
 	}
 	resp.Intro = c.Intro
 	resp.Outro = c.Outro
+	resp.Recap = c.Recap
+	resp.Preview = c.Preview
 	return resp
 }
 
@@ -0,0 +1,97 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	catalogv1 "github.com/example/anime-platform/gen/catalog/v1"
+	"github.com/example/anime-platform/services/streaming-resolver/internal/hianime"
+)
+
+func TestPickSkipSegments(t *testing.T) {
+	got := pickSkipSegments([]*catalogv1.SkipSegment{
+		{Kind: "intro", Source: "user", Score: 5, Start: 1, End: 91},
+		{Kind: "intro", Source: "hianime", Start: 0, End: 90},
+		{Kind: "outro", Source: "user", Score: 2, Start: 1300, End: 1390},
+		{Kind: "outro", Source: "user", Score: 4, Start: 1310, End: 1400},
+		{Kind: "recap", Source: "user", Score: 0, Start: 90, End: 120},
+	})
+	if in := got["intro"]; in == nil || in.GetEnd() != 90 {
+		t.Fatalf("intro = %v, want the provider segment", in)
+	}
+	if out := got["outro"]; out == nil || out.GetEnd() != 1400 {
+		t.Fatalf("outro = %v, want the best-scored user segment", out)
+	}
+	if _, ok := got["recap"]; ok {
+		t.Fatal("recap without net upvotes should not be served")
+	}
+}
+
+type fakeCatalog struct {
+	catalogv1.CatalogServiceClient
+	mappings map[string]string
+}
+
+func (f fakeCatalog) GetProviderEpisodeID(_ context.Context, req *catalogv1.GetProviderEpisodeIDRequest, _ ...grpc.CallOption) (*catalogv1.GetProviderEpisodeIDResponse, error) {
+	id, ok := f.mappings[req.GetProvider()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return &catalogv1.GetProviderEpisodeIDResponse{ProviderEpisodeId: id}, nil
+}
+
+type fakeProvider struct {
+	serversErr error
+	sources    []string
+}
+
+func (f fakeProvider) GetServers(context.Context, string) (*hianime.ServersResponse, error) {
+	if f.serversErr != nil {
+		return nil, f.serversErr
+	}
+	return &hianime.ServersResponse{Status: 200}, nil
+}
+
+func (f fakeProvider) GetSources(context.Context, string, string, string) (*hianime.SourcesResponse, error) {
+	out := &hianime.SourcesResponse{Status: 200}
+	for _, u := range f.sources {
+		out.Data.Sources = append(out.Data.Sources, struct {
+			URL    string `json:"url"`
+			IsM3U8 bool   `json:"isM3U8"`
+			Type   string `json:"type"`
+		}{URL: u, IsM3U8: true})
+	}
+	return out, nil
+}
+
+func TestResolveChainFailover(t *testing.T) {
+	s := &ResolverService{Catalog: fakeCatalog{mappings: map[string]string{"down": "d-1", "empty": "e-1", "up": "u-1"}}}
+	chain := []Provider{
+		{Name: "unmapped", Provider: fakeProvider{sources: []string{"https://unmapped/x.m3u8"}}},
+		{Name: "down", Provider: fakeProvider{serversErr: errors.New("circuit open")}},
+		{Name: "empty", Provider: fakeProvider{}},
+		{Name: "up", Provider: fakeProvider{sources: []string{"https://up/x.m3u8"}}},
+	}
+	out, err := s.resolveChain(context.Background(), chain, "ep", "sub", "hd-1")
+	if err != nil {
+		t.Fatalf("resolveChain: %v", err)
+	}
+	if out.Provider != "up" || out.ProviderEpisodeID != "u-1" || len(out.Sources) != 1 {
+		t.Fatalf("got provider %q episode %q sources %v", out.Provider, out.ProviderEpisodeID, out.Sources)
+	}
+
+	// With every provider failing, the outage wins over the missing mapping.
+	_, err = s.resolveChain(context.Background(), chain[:3], "ep", "sub", "hd-1")
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("err = %v, want Unavailable", err)
+	}
+	_, err = s.resolveChain(context.Background(), chain[:1], "ep", "sub", "hd-1")
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("err = %v, want NotFound", err)
+	}
+}
@@ -35,8 +35,11 @@ type sourcesResponse struct {
 	Tracks            []trackItem       `json:"tracks"`
 	Intro             *introOutro       `json:"intro,omitempty"`
 	Outro             *introOutro       `json:"outro,omitempty"`
+	Recap             *introOutro       `json:"recap,omitempty"`
+	Preview           *introOutro       `json:"preview,omitempty"`
 	Headers           map[string]string `json:"headers,omitempty"`
 	ProviderEpisodeID string            `json:"provider_episode_id,omitempty"`
+	Provider          string            `json:"provider,omitempty"`
 }
 
 // Sources returns an HTTP handler that resolves streaming sources for an episode.
@@ -74,17 +77,23 @@ func Sources(client streamingv1.StreamingResolverServiceClient, log *zap.Logger)
 		for _, t := range resp.GetTracks() {
 			out.Tracks = append(out.Tracks, trackItem{Kind: t.GetKind(), File: t.GetFile(), Label: t.GetLabel(), Language: t.GetLanguage()})
 		}
-		if resp.GetIntro() != nil && resp.GetIntro().GetEnd() > 0 {
-			out.Intro = &introOutro{Start: resp.GetIntro().GetStart(), End: resp.GetIntro().GetEnd()}
-		}
-		if resp.GetOutro() != nil && resp.GetOutro().GetEnd() > 0 {
-			out.Outro = &introOutro{Start: resp.GetOutro().GetStart(), End: resp.GetOutro().GetEnd()}
-		}
+		out.Intro = skipSegment(resp.GetIntro())
+		out.Outro = skipSegment(resp.GetOutro())
+		out.Recap = skipSegment(resp.GetRecap())
+		out.Preview = skipSegment(resp.GetPreview())
 		if resp.GetHeaders() != nil {
 			out.Headers = resp.GetHeaders().GetHeaders()
 		}
 		out.ProviderEpisodeID = resp.GetProviderEpisodeId()
+		out.Provider = resp.GetProvider()
 
 		api.WriteJSON(w, http.StatusOK, out)
 	}
 }
+
+func skipSegment(p *streamingv1.PlaybackIntroOutro) *introOutro {
+	if p == nil || p.GetEnd() <= 0 {
+		return nil
+	}
+	return &introOutro{Start: p.GetStart(), End: p.GetEnd()}
+}